@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -17,12 +18,21 @@ import (
 	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/store"
 	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/store/metrics"
 )
 
 const (
 	greetingBanner = `Welcome to Slash!`
 )
 
+// defaultConcurrencyLimits are the concurrency limits of the expensive methods, see profile.ConcurrencyLimits.
+var defaultConcurrencyLimits = []string{
+	"/slash.api.v1.ShortcutService/GetShortcutAnalytics=4:5s",
+	"/slash.api.v1.ShortcutService/ExportShortcuts=2:5s",
+	"/slash.api.v1.ShortcutService/StreamShortcuts=4:5s",
+	"/slash.api.v1.WorkspaceService/ExportAuditLogs=2:5s",
+}
+
 var (
 	rootCmd = &cobra.Command{
 		Use:   "slash",
@@ -35,6 +45,31 @@ var (
 				DSN:     viper.GetString("dsn"),
 				Driver:  viper.GetString("driver"),
 				Version: common.GetCurrentVersion(viper.GetString("mode")),
+
+				PostgresSSLMode:     viper.GetString("postgres_sslmode"),
+				PostgresSSLRootCert: viper.GetString("postgres_sslrootcert"),
+				PostgresSSLCert:     viper.GetString("postgres_sslcert"),
+				PostgresSSLKey:      viper.GetString("postgres_sslkey"),
+
+				APIRateLimit:       viper.GetInt("api_rate_limit"),
+				APICreateRateLimit: viper.GetInt("api_create_rate_limit"),
+				ConcurrencyLimits:  viper.GetStringSlice("concurrency_limits"),
+				Metrics:            viper.GetBool("metrics"),
+				TrustedProxies:     viper.GetStringSlice("trusted_proxies"),
+
+				SignInBackoffBase:       viper.GetDuration("sign_in_backoff_base"),
+				SignInBackoffMultiplier: viper.GetFloat64("sign_in_backoff_multiplier"),
+				SignInBackoffMax:        viper.GetDuration("sign_in_backoff_max"),
+				PasswordHashCost:        viper.GetInt("password_hash_cost"),
+				MaxUsers:                viper.GetInt("max_users"),
+				ShortcutCacheSize:       viper.GetInt("shortcut_cache_size"),
+				ShortcutCacheTTL:        viper.GetDuration("shortcut_cache_ttl"),
+				MigrationDryRun:         viper.GetBool("migration_dry_run"),
+
+				ReservedShortcutNames: viper.GetStringSlice("reserved_shortcut_names"),
+				SeedShortcuts:         viper.GetStringSlice("seed_shortcuts"),
+				WorkspaceIsolation:    viper.GetString("workspace_isolation"),
+				Workspaces:            viper.GetStringSlice("workspaces"),
 			}
 			if err := serverProfile.Validate(); err != nil {
 				panic(err)
@@ -47,8 +82,20 @@ var (
 				slog.Error("failed to create db driver", "error", err)
 				return
 			}
+			var storeMetrics *metrics.Metrics
+			if serverProfile.Metrics {
+				storeMetrics = metrics.New()
+				dbDriver = metrics.NewDriver(dbDriver, storeMetrics)
+			}
 
 			storeInstance := store.New(dbDriver, serverProfile)
+			if serverProfile.MigrationDryRun {
+				defer cancel()
+				if err := printMigrationStatus(ctx, storeInstance); err != nil {
+					slog.Error("failed to get migration status", "error", err)
+				}
+				return
+			}
 			if err := storeInstance.Migrate(ctx); err != nil {
 				cancel()
 				slog.Error("failed to migrate db", "error", err)
@@ -60,6 +107,9 @@ var (
 				slog.Error("failed to create server", "error", err)
 				return
 			}
+			if storeMetrics != nil {
+				s.RegisterStoreMetrics(storeMetrics)
+			}
 
 			c := make(chan os.Signal, 1)
 			// Trigger graceful shutdown on SIGINT or SIGTERM.
@@ -92,6 +142,14 @@ func init() {
 	viper.SetDefault("mode", "demo")
 	viper.SetDefault("driver", "sqlite")
 	viper.SetDefault("port", 8082)
+	viper.SetDefault("api_rate_limit", 600)
+	viper.SetDefault("api_create_rate_limit", 60)
+	viper.SetDefault("concurrency_limits", defaultConcurrencyLimits)
+	viper.SetDefault("sign_in_backoff_base", time.Second)
+	viper.SetDefault("sign_in_backoff_multiplier", 2)
+	viper.SetDefault("sign_in_backoff_max", 15*time.Minute)
+	viper.SetDefault("shortcut_cache_size", 1000)
+	viper.SetDefault("shortcut_cache_ttl", time.Minute)
 
 	rootCmd.PersistentFlags().String("mode", "demo", `mode of server, can be "prod" or "dev" or "demo"`)
 	rootCmd.PersistentFlags().String("addr", "", "address of server")
@@ -99,6 +157,27 @@ func init() {
 	rootCmd.PersistentFlags().String("data", "", "data directory")
 	rootCmd.PersistentFlags().String("driver", "sqlite", "database driver")
 	rootCmd.PersistentFlags().String("dsn", "", "database source name(aka. DSN)")
+	rootCmd.PersistentFlags().String("postgres-sslmode", "", `sslmode of the postgres connection, can be "disable", "require", "verify-ca" or "verify-full"`)
+	rootCmd.PersistentFlags().String("postgres-sslrootcert", "", "CA certificate file to verify the postgres server")
+	rootCmd.PersistentFlags().String("postgres-sslcert", "", "client certificate file of the postgres connection")
+	rootCmd.PersistentFlags().String("postgres-sslkey", "", "client private key file of the postgres connection")
+	rootCmd.PersistentFlags().Int("api-rate-limit", 600, "API requests per minute allowed for each user, 0 means unlimited")
+	rootCmd.PersistentFlags().Int("api-create-rate-limit", 60, "create requests per minute allowed for each user, 0 means unlimited")
+	rootCmd.PersistentFlags().StringSlice("concurrency-limits", defaultConcurrencyLimits, `"<method>=<max concurrency>[:<queue timeout>]" limits of the concurrent calls of the expensive methods`)
+	rootCmd.PersistentFlags().Bool("metrics", false, "serve the store operation metrics on /metrics")
+	rootCmd.PersistentFlags().StringSlice("trusted-proxies", nil, "IPs or CIDRs of the proxies whose X-Forwarded-Proto and X-Forwarded-For headers are trusted")
+	rootCmd.PersistentFlags().Duration("sign-in-backoff-base", time.Second, "lockout window after a failed sign in of an email, 0 disables the lockout")
+	rootCmd.PersistentFlags().Float64("sign-in-backoff-multiplier", 2, "growth of the sign in lockout window with each successive failure")
+	rootCmd.PersistentFlags().Duration("sign-in-backoff-max", 15*time.Minute, "maximum sign in lockout window")
+	rootCmd.PersistentFlags().Int("password-hash-cost", 0, "bcrypt cost of the password hashes, 0 uses the bcrypt default")
+	rootCmd.PersistentFlags().Int("max-users", 0, "maximum number of active users when the license sets none, 0 means 5")
+	rootCmd.PersistentFlags().Int("shortcut-cache-size", 1000, "number of shortcut names cached for the redirects, 0 disables the cache")
+	rootCmd.PersistentFlags().Duration("shortcut-cache-ttl", time.Minute, "time the shortcut names are cached for")
+	rootCmd.PersistentFlags().Bool("migration-dry-run", false, "report the pending migrations and exit without applying them")
+	rootCmd.PersistentFlags().StringSlice("reserved-shortcut-names", nil, "shortcut names only admins can create")
+	rootCmd.PersistentFlags().StringSlice("seed-shortcuts", nil, `"name=link" shortcuts created once by the first admin, their names are reserved`)
+	rootCmd.PersistentFlags().String("workspace-isolation", "", `resolve the workspace of the requests from their "host" or "path" prefix`)
+	rootCmd.PersistentFlags().StringSlice("workspaces", nil, "ids of the workspaces isolated from each other")
 
 	if err := viper.BindPFlag("mode", rootCmd.PersistentFlags().Lookup("mode")); err != nil {
 		panic(err)
@@ -115,6 +194,69 @@ func init() {
 	if err := viper.BindPFlag("dsn", rootCmd.PersistentFlags().Lookup("dsn")); err != nil {
 		panic(err)
 	}
+	if err := viper.BindPFlag("postgres_sslmode", rootCmd.PersistentFlags().Lookup("postgres-sslmode")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("postgres_sslrootcert", rootCmd.PersistentFlags().Lookup("postgres-sslrootcert")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("postgres_sslcert", rootCmd.PersistentFlags().Lookup("postgres-sslcert")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("postgres_sslkey", rootCmd.PersistentFlags().Lookup("postgres-sslkey")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api_rate_limit", rootCmd.PersistentFlags().Lookup("api-rate-limit")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("api_create_rate_limit", rootCmd.PersistentFlags().Lookup("api-create-rate-limit")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("concurrency_limits", rootCmd.PersistentFlags().Lookup("concurrency-limits")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("metrics", rootCmd.PersistentFlags().Lookup("metrics")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("trusted_proxies", rootCmd.PersistentFlags().Lookup("trusted-proxies")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("sign_in_backoff_base", rootCmd.PersistentFlags().Lookup("sign-in-backoff-base")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("sign_in_backoff_multiplier", rootCmd.PersistentFlags().Lookup("sign-in-backoff-multiplier")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("sign_in_backoff_max", rootCmd.PersistentFlags().Lookup("sign-in-backoff-max")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("password_hash_cost", rootCmd.PersistentFlags().Lookup("password-hash-cost")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("max_users", rootCmd.PersistentFlags().Lookup("max-users")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("shortcut_cache_size", rootCmd.PersistentFlags().Lookup("shortcut-cache-size")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("shortcut_cache_ttl", rootCmd.PersistentFlags().Lookup("shortcut-cache-ttl")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("migration_dry_run", rootCmd.PersistentFlags().Lookup("migration-dry-run")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("reserved_shortcut_names", rootCmd.PersistentFlags().Lookup("reserved-shortcut-names")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("seed_shortcuts", rootCmd.PersistentFlags().Lookup("seed-shortcuts")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("workspace_isolation", rootCmd.PersistentFlags().Lookup("workspace-isolation")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("workspaces", rootCmd.PersistentFlags().Lookup("workspaces")); err != nil {
+		panic(err)
+	}
 
 	viper.SetEnvPrefix("slash")
 	viper.AutomaticEnv()
@@ -136,6 +278,26 @@ func printGreetings(serverProfile *profile.Profile) {
 	println("---")
 }
 
+// printMigrationStatus prints the migrations that would be applied on start, without applying them.
+func printMigrationStatus(ctx context.Context, storeInstance *store.Store) error {
+	migrationStatus, err := storeInstance.GetMigrationStatus(ctx)
+	if err != nil {
+		return err
+	}
+	println("---")
+	println("Migration status")
+	fmt.Printf("current schema version: %s\n", migrationStatus.CurrentVersion)
+	fmt.Printf("target schema version: %s\n", migrationStatus.SchemaVersion)
+	if len(migrationStatus.Pending) == 0 {
+		println("no pending migrations")
+	}
+	for _, pendingMigration := range migrationStatus.Pending {
+		fmt.Printf("pending: %s (%s)\n", pendingMigration.Version, pendingMigration.FilePath)
+	}
+	println("---")
+	return nil
+}
+
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		panic(err)
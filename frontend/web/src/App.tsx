@@ -37,6 +37,20 @@ function App() {
     favicon.href = new TextDecoder().decode(workspaceStore.setting.branding);
   }, [workspaceStore.setting.branding]);
 
+  useEffect(() => {
+    const hasCustomBranding = workspaceStore.checkFeatureAvailable(FeatureType.CustomeBranding);
+    if (!hasCustomBranding) {
+      return;
+    }
+
+    if (workspaceStore.profile.title) {
+      document.title = workspaceStore.profile.title;
+    }
+    if (workspaceStore.profile.themeColor) {
+      document.documentElement.style.setProperty("--theme-color", workspaceStore.profile.themeColor);
+    }
+  }, [workspaceStore.profile.title, workspaceStore.profile.themeColor]);
+
   useEffect(() => {
     const root = document.documentElement;
     if (colorScheme === "light") {
@@ -2,8 +2,8 @@ import { Button, Input, Modal, ModalDialog } from "@mui/joy";
 import { useState } from "react";
 import { toast } from "react-hot-toast";
 import { useTranslation } from "react-i18next";
+import { authServiceClient } from "@/grpcweb";
 import useLoading from "@/hooks/useLoading";
-import { useUserStore } from "@/stores";
 import Icon from "./Icon";
 
 interface Props {
@@ -13,7 +13,7 @@ interface Props {
 const ChangePasswordDialog: React.FC<Props> = (props: Props) => {
   const { onClose } = props;
   const { t } = useTranslation();
-  const userStore = useUserStore();
+  const [currentPassword, setCurrentPassword] = useState("");
   const [newPassword, setNewPassword] = useState("");
   const [newPasswordAgain, setNewPasswordAgain] = useState("");
   const requestState = useLoading(false);
@@ -22,6 +22,11 @@ const ChangePasswordDialog: React.FC<Props> = (props: Props) => {
     onClose();
   };
 
+  const handleCurrentPasswordChanged = (e: React.ChangeEvent<HTMLInputElement>) => {
+    const text = e.target.value as string;
+    setCurrentPassword(text);
+  };
+
   const handleNewPasswordChanged = (e: React.ChangeEvent<HTMLInputElement>) => {
     const text = e.target.value as string;
     setNewPassword(text);
@@ -33,7 +38,7 @@ const ChangePasswordDialog: React.FC<Props> = (props: Props) => {
   };
 
   const handleSaveBtnClick = async () => {
-    if (newPassword === "" || newPasswordAgain === "") {
+    if (currentPassword === "" || newPassword === "" || newPasswordAgain === "") {
       toast.error("Please fill all inputs");
       return;
     }
@@ -46,13 +51,11 @@ const ChangePasswordDialog: React.FC<Props> = (props: Props) => {
 
     requestState.setLoading();
     try {
-      userStore.patchUser(
-        {
-          id: userStore.getCurrentUser().id,
-          password: newPassword,
-        },
-        ["password"],
-      );
+      await authServiceClient.changePassword({
+        currentPassword,
+        newPassword,
+        revokeOtherSessions: true,
+      });
       onClose();
       toast("Password changed");
     } catch (error: any) {
@@ -72,6 +75,10 @@ const ChangePasswordDialog: React.FC<Props> = (props: Props) => {
           </Button>
         </div>
         <div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">Current Password</span>
+            <Input className="w-full" type="password" value={currentPassword} onChange={handleCurrentPasswordChanged} />
+          </div>
           <div className="w-full flex flex-col justify-start items-start mb-3">
             <span className="mb-2">New Password</span>
             <Input className="w-full" type="text" value={newPassword} onChange={handleNewPasswordChanged} />
@@ -10,7 +10,9 @@ interface Props {
 const Logo = ({ className }: Props) => {
   const workspaceStore = useWorkspaceStore();
   const hasCustomBranding = workspaceStore.checkFeatureAvailable(FeatureType.CustomeBranding);
-  const branding = hasCustomBranding && workspaceStore.setting.branding ? new TextDecoder().decode(workspaceStore.setting.branding) : "";
+  const branding = !hasCustomBranding
+    ? ""
+    : workspaceStore.profile.logoUrl || (workspaceStore.setting.branding ? new TextDecoder().decode(workspaceStore.setting.branding) : "");
   return (
     <div className={classNames("w-8 h-auto dark:text-gray-500 rounded-lg overflow-hidden", className)}>
       {branding ? (
@@ -1,4 +1,4 @@
-import { createChannel, createClientFactory, FetchTransport } from "nice-grpc-web";
+import { ClientError, ClientMiddleware, createChannel, createClientFactory, FetchTransport, Status } from "nice-grpc-web";
 import { AuthServiceDefinition } from "./types/proto/api/v1/auth_service";
 import { CollectionServiceDefinition } from "./types/proto/api/v1/collection_service";
 import { ShortcutServiceDefinition } from "./types/proto/api/v1/shortcut_service";
@@ -16,7 +16,39 @@ const channel = createChannel(
   }),
 );
 
-const clientFactory = createClientFactory();
+// The refresh of the session shared by the calls failing at the same time.
+let pendingRefresh: Promise<boolean> | undefined;
+
+const refreshSession = (): Promise<boolean> => {
+  if (!pendingRefresh) {
+    pendingRefresh = createClientFactory()
+      .create(AuthServiceDefinition, channel)
+      .refreshToken({})
+      .then(() => true)
+      .catch(() => false)
+      .finally(() => {
+        pendingRefresh = undefined;
+      });
+  }
+  return pendingRefresh;
+};
+
+// refreshSessionMiddleware retries the unary calls once after refreshing the session when the access token expired.
+const refreshSessionMiddleware: ClientMiddleware = async function* (call, options) {
+  if (call.requestStream || call.responseStream) {
+    return yield* call.next(call.request, options);
+  }
+  try {
+    return yield* call.next(call.request, options);
+  } catch (error) {
+    if (!(error instanceof ClientError) || error.code !== Status.UNAUTHENTICATED || !(await refreshSession())) {
+      throw error;
+    }
+    return yield* call.next(call.request, options);
+  }
+};
+
+const clientFactory = createClientFactory().use(refreshSessionMiddleware);
 
 export const workspaceServiceClient = clientFactory.create(WorkspaceServiceDefinition, channel);
 
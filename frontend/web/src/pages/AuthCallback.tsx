@@ -45,7 +45,7 @@ const AuthCallback = () => {
     const redirectUri = absolutifyLink("/auth/callback");
     (async () => {
       try {
-        await authServiceClient.signInWithSSO({
+        await authServiceClient.oAuthSignIn({
           idpId,
           code,
           redirectUri,
@@ -1,6 +1,7 @@
 import { Button, Input } from "@mui/joy";
 import { useEffect, useState } from "react";
 import { useTranslation } from "react-i18next";
+import { useSearchParams } from "react-router-dom";
 import useLocalStorage from "react-use/lib/useLocalStorage";
 import CreateShortcutDrawer from "@/components/CreateShortcutDrawer";
 import FilterView from "@/components/FilterView";
@@ -23,6 +24,7 @@ const ShortcutDashboard: React.FC = () => {
   const currentUser = useUserStore().getCurrentUser();
   const shortcutStore = useShortcutStore();
   const viewStore = useViewStore();
+  const [searchParams] = useSearchParams();
   const shortcutList = shortcutStore.getShortcutList();
   const [state, setState] = useState<State>({
     showCreateShortcutDrawer: false,
@@ -33,6 +35,11 @@ const ShortcutDashboard: React.FC = () => {
 
   useEffect(() => {
     setLastVisited("/shortcuts");
+    // Unknown shortcut names can be redirected here to search for them.
+    const search = searchParams.get("search");
+    if (search) {
+      viewStore.setFilter({ search });
+    }
     Promise.all([shortcutStore.fetchShortcutList()]).finally(() => {
       loadingState.setFinish();
     });
@@ -182,13 +182,15 @@ const ShortcutDetail = () => {
           </Tooltip>
         </div>
 
-        <div className="w-full flex flex-col mt-8">
-          <h3 id="analytics" className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
-            <Icon.BarChart2 className="w-6 h-auto mr-1" />
-            {t("analytics.self")}
-          </h3>
-          <AnalyticsView className="mt-4 w-full grid grid-cols-1 sm:grid-cols-2 gap-2 sm:gap-4" shortcutId={shortcut.id} />
-        </div>
+        {havePermission && (
+          <div className="w-full flex flex-col mt-8">
+            <h3 id="analytics" className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
+              <Icon.BarChart2 className="w-6 h-auto mr-1" />
+              {t("analytics.self")}
+            </h3>
+            <AnalyticsView className="mt-4 w-full grid grid-cols-1 sm:grid-cols-2 gap-2 sm:gap-4" shortcutId={shortcut.id} />
+          </div>
+        )}
       </div>
 
       {showQRCodeDialog && <GenerateQRCodeDialog shortcut={shortcut} onClose={() => setShowQRCodeDialog(false)} />}
@@ -28,6 +28,25 @@ const SignIn: React.FC = () => {
         oauth2Config.scopes.join(" "),
       )}`;
       window.location.href = authUrl;
+    } else if (identityProvider.type === IdentityProvider_Type.OIDC) {
+      const redirectUri = absolutifyLink("/auth/callback");
+      const oidcConfig = identityProvider.config?.oidc;
+      if (!oidcConfig) {
+        toast.error("Identity provider configuration is invalid.");
+        return;
+      }
+      // The authorization endpoint is discovered from the issuer.
+      const response = await fetch(`${oidcConfig.issuer.replace(/\/$/, "")}/.well-known/openid-configuration`);
+      if (!response.ok) {
+        toast.error("Failed to discover the identity provider configuration.");
+        return;
+      }
+      const { authorization_endpoint: authorizationEndpoint } = await response.json();
+      const scopes = ["openid", ...(oidcConfig.scopes.length > 0 ? oidcConfig.scopes : ["email", "profile"])];
+      const authUrl = `${authorizationEndpoint}?client_id=${
+        oidcConfig.clientId
+      }&redirect_uri=${redirectUri}&state=${stateQueryParameter}&response_type=code&scope=${encodeURIComponent(scopes.join(" "))}`;
+      window.location.href = authUrl;
     }
   };
 
@@ -17,7 +17,13 @@ const getDefaultState = (): State => {
 const useShortcutStore = create(
   combine(getDefaultState(), (set, get) => ({
     fetchShortcutList: async () => {
-      const { shortcuts } = await shortcutServiceClient.listShortcuts({});
+      const shortcuts: Shortcut[] = [];
+      let pageToken = "";
+      do {
+        const response = await shortcutServiceClient.listShortcuts({ pageSize: 1000, pageToken });
+        shortcuts.push(...response.shortcuts);
+        pageToken = response.nextPageToken;
+      } while (pageToken);
       const shortcutMap = get().shortcutMapById;
       shortcuts.forEach((shortcut) => {
         shortcutMap[shortcut.id] = shortcut;
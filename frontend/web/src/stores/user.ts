@@ -29,7 +29,13 @@ const useUserStore = create<UserState>()((set, get) => ({
   userMapById: {},
   userSettingMapById: {},
   fetchUserList: async () => {
-    const { users } = await userServiceClient.listUsers({});
+    const users: User[] = [];
+    let pageToken = "";
+    do {
+      const response = await userServiceClient.listUsers({ pageToken, includeArchived: true });
+      users.push(...response.users);
+      pageToken = response.nextPageToken;
+    } while (pageToken);
     const userMap = get().userMapById;
     users.forEach((user) => {
       userMap[user.id] = user;
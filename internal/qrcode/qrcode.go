@@ -0,0 +1,508 @@
+// Package qrcode encodes data as QR codes in byte mode and renders them as PNG or SVG images.
+package qrcode
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Level is the error correction level of a QR code, the share of the codewords that can be restored.
+type Level int
+
+const (
+	// Low restores about 7% of the codewords.
+	Low Level = iota
+	// Medium restores about 15% of the codewords.
+	Medium
+	// Quartile restores about 25% of the codewords.
+	Quartile
+	// High restores about 30% of the codewords.
+	High
+)
+
+const (
+	minVersion = 1
+	maxVersion = 40
+	// QuietZone is the width in modules of the light border around the symbol required by readers.
+	QuietZone = 4
+)
+
+// ErrDataTooLong is returned when the data doesn't fit in the largest QR code of the level.
+var ErrDataTooLong = errors.New("data too long")
+
+// eccCodewordsPerBlock is the number of error correction codewords of each block by level and version.
+var eccCodewordsPerBlock = [4][41]int{
+	{-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// numErrorCorrectionBlocks is the number of blocks the codewords are split in by level and version.
+var numErrorCorrectionBlocks = [4][41]int{
+	{-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// formatBits are the bits of the levels in the format information, which aren't in the order of the levels.
+var formatBits = [4]int{1, 0, 3, 2}
+
+// Code is a QR code, a square of dark and light modules.
+type Code struct {
+	// Size is the number of modules on each side of the symbol, without the quiet zone.
+	Size    int
+	version int
+	level   Level
+	modules [][]bool
+	// isFunction marks the modules of the function patterns, which hold no data and aren't masked.
+	isFunction [][]bool
+}
+
+// Encode returns the smallest QR code of the level that encodes the data in byte mode, with the mask of the lowest penalty.
+func Encode(data []byte, level Level) (*Code, error) {
+	if level < Low || level > High {
+		return nil, errors.Errorf("invalid level %d", level)
+	}
+	version := minVersion
+	for ; version <= maxVersion; version++ {
+		if 4+getCharCountBits(version)+len(data)*8 <= getNumDataCodewords(version, level)*8 {
+			break
+		}
+	}
+	if version > maxVersion {
+		return nil, ErrDataTooLong
+	}
+
+	bits := &bitBuffer{}
+	bits.append(0x4, 4)
+	bits.append(len(data), getCharCountBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+	capacity := getNumDataCodewords(version, level) * 8
+	bits.append(0, min(4, capacity-len(bits.bits)))
+	bits.append(0, (8-len(bits.bits)%8)%8)
+	for pad := 0xEC; len(bits.bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		bits.append(pad, 8)
+	}
+	codewords := make([]byte, len(bits.bits)/8)
+	for i, bit := range bits.bits {
+		if bit {
+			codewords[i>>3] |= 1 << (7 - i&7)
+		}
+	}
+
+	code := newCode(version, level)
+	code.drawCodewords(addErrorCorrection(codewords, version, level))
+	bestMask, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		code.applyMask(mask)
+		code.drawFormatBits(mask)
+		if penalty := code.getPenalty(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask, bestPenalty = mask, penalty
+		}
+		// Masks are their own inverse.
+		code.applyMask(mask)
+	}
+	code.applyMask(bestMask)
+	code.drawFormatBits(bestMask)
+	return code, nil
+}
+
+// Dark returns whether the module at the column x and row y is dark, false outside of the symbol.
+func (c *Code) Dark(x, y int) bool {
+	return x >= 0 && x < c.Size && y >= 0 && y < c.Size && c.modules[y][x]
+}
+
+func newCode(version int, level Level) *Code {
+	size := version*4 + 17
+	code := &Code{
+		Size:       size,
+		version:    version,
+		level:      level,
+		modules:    make([][]bool, size),
+		isFunction: make([][]bool, size),
+	}
+	for i := 0; i < size; i++ {
+		code.modules[i] = make([]bool, size)
+		code.isFunction[i] = make([]bool, size)
+	}
+	code.drawFunctionPatterns()
+	return code
+}
+
+func (c *Code) setFunction(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.isFunction[y][x] = true
+}
+
+func (c *Code) drawFunctionPatterns() {
+	for i := 0; i < c.Size; i++ {
+		c.setFunction(6, i, i%2 == 0)
+		c.setFunction(i, 6, i%2 == 0)
+	}
+	c.drawFinderPattern(3, 3)
+	c.drawFinderPattern(c.Size-4, 3)
+	c.drawFinderPattern(3, c.Size-4)
+
+	positions := getAlignmentPatternPositions(c.version)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// The corners of the finder patterns have no alignment pattern.
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			c.drawAlignmentPattern(x, y)
+		}
+	}
+
+	// The format bits are reserved before the data is drawn, and set once the mask is chosen.
+	c.drawFormatBits(0)
+	c.drawVersionBits()
+}
+
+// drawFinderPattern draws the finder pattern centered at the module with its separator.
+func (c *Code) drawFinderPattern(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || xx >= c.Size || yy < 0 || yy >= c.Size {
+				continue
+			}
+			distance := max(abs(dx), abs(dy))
+			c.setFunction(xx, yy, distance != 2 && distance != 4)
+		}
+	}
+}
+
+func (c *Code) drawAlignmentPattern(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// drawFormatBits draws the two copies of the level and the mask with their BCH code, and the dark module.
+func (c *Code) drawFormatBits(mask int) {
+	data := formatBits[c.level]<<3 | mask
+	remainder := data
+	for i := 0; i < 10; i++ {
+		remainder = (remainder << 1) ^ ((remainder >> 9) * 0x537)
+	}
+	bits := (data<<10 | remainder) ^ 0x5412
+	bit := func(i int) bool {
+		return (bits>>i)&1 != 0
+	}
+
+	for i := 0; i <= 5; i++ {
+		c.setFunction(8, i, bit(i))
+	}
+	c.setFunction(8, 7, bit(6))
+	c.setFunction(8, 8, bit(7))
+	c.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		c.setFunction(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		c.setFunction(c.Size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunction(8, c.Size-15+i, bit(i))
+	}
+	c.setFunction(8, c.Size-8, true)
+}
+
+// drawVersionBits draws the two copies of the version with its BCH code, from version 7.
+func (c *Code) drawVersionBits() {
+	if c.version < 7 {
+		return
+	}
+	remainder := c.version
+	for i := 0; i < 12; i++ {
+		remainder = (remainder << 1) ^ ((remainder >> 11) * 0x1F25)
+	}
+	bits := c.version<<12 | remainder
+	for i := 0; i < 18; i++ {
+		dark := (bits>>i)&1 != 0
+		a, b := c.Size-11+i%3, i/3
+		c.setFunction(a, b, dark)
+		c.setFunction(b, a, dark)
+	}
+}
+
+// drawCodewords draws the bits of the codewords in the zigzag of two module wide columns from the bottom right.
+func (c *Code) drawCodewords(codewords []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		// The vertical timing pattern is skipped.
+		if right == 6 {
+			right = 5
+		}
+		for vertical := 0; vertical < c.Size; vertical++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vertical
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vertical
+				}
+				if !c.isFunction[y][x] && i < len(codewords)*8 {
+					c.modules[y][x] = (codewords[i>>3]>>(7-i&7))&1 != 0
+					i++
+				}
+			}
+		}
+	}
+}
+
+// applyMask flips the data modules selected by the mask pattern.
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert && !c.isFunction[y][x] {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// getPenalty returns the penalty of the symbol by the rules of the specification, lower is easier to read.
+func (c *Code) getPenalty() int {
+	penalty := 0
+	// Runs of five or more modules of the same color in rows and columns.
+	for i := 0; i < c.Size; i++ {
+		rowRun, columnRun := 1, 1
+		for j := 1; j < c.Size; j++ {
+			if c.modules[i][j] == c.modules[i][j-1] {
+				rowRun++
+			} else {
+				rowRun = 1
+			}
+			if rowRun == 5 {
+				penalty += 3
+			} else if rowRun > 5 {
+				penalty++
+			}
+			if c.modules[j][i] == c.modules[j-1][i] {
+				columnRun++
+			} else {
+				columnRun = 1
+			}
+			if columnRun == 5 {
+				penalty += 3
+			} else if columnRun > 5 {
+				penalty++
+			}
+		}
+	}
+	// Blocks of 2x2 modules of the same color.
+	for y := 0; y < c.Size-1; y++ {
+		for x := 0; x < c.Size-1; x++ {
+			dark := c.modules[y][x]
+			if dark == c.modules[y][x+1] && dark == c.modules[y+1][x] && dark == c.modules[y+1][x+1] {
+				penalty += 3
+			}
+		}
+	}
+	// Patterns like the finder patterns, dark-light-dark-dark-dark-light-dark next to four light modules.
+	finderLike := []bool{true, false, true, true, true, false, true}
+	for i := 0; i < c.Size; i++ {
+		for j := 0; j+7 <= c.Size; j++ {
+			if c.matchesFinderLike(finderLike, func(k int) bool { return c.modules[i][j+k] }, j) {
+				penalty += 40
+			}
+			if c.matchesFinderLike(finderLike, func(k int) bool { return c.modules[j+k][i] }, j) {
+				penalty += 40
+			}
+		}
+	}
+	// The share of dark modules away from half, by steps of 5%.
+	dark := 0
+	for _, row := range c.modules {
+		for _, module := range row {
+			if module {
+				dark++
+			}
+		}
+	}
+	total := c.Size * c.Size
+	penalty += abs(dark*20-total*10) / total * 10
+	return penalty
+}
+
+// matchesFinderLike returns whether the line starting at the offset matches the pattern with four light modules
+// before or after it, the modules outside of the symbol being light.
+func (c *Code) matchesFinderLike(pattern []bool, module func(int) bool, offset int) bool {
+	for k, dark := range pattern {
+		if module(k) != dark {
+			return false
+		}
+	}
+	light := func(from, to int) bool {
+		for k := from; k < to; k++ {
+			if offset+k >= 0 && offset+k < c.Size && module(k) {
+				return false
+			}
+		}
+		return true
+	}
+	return light(-4, 0) || light(len(pattern), len(pattern)+4)
+}
+
+// addErrorCorrection splits the data codewords in blocks, appends their error correction codewords and interleaves them.
+func addErrorCorrection(data []byte, version int, level Level) []byte {
+	numBlocks := numErrorCorrectionBlocks[level][version]
+	eccLength := eccCodewordsPerBlock[level][version]
+	rawCodewords := getNumRawDataModules(version) / 8
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortBlockLength := rawCodewords / numBlocks
+
+	divisor := getReedSolomonDivisor(eccLength)
+	blocks := make([][]byte, 0, numBlocks)
+	for i, k := 0, 0; i < numBlocks; i++ {
+		dataLength := shortBlockLength - eccLength
+		if i >= numShortBlocks {
+			dataLength++
+		}
+		block := append([]byte{}, data[k:k+dataLength]...)
+		k += dataLength
+		ecc := getReedSolomonRemainder(block, divisor)
+		// The short blocks are padded to interleave them with the long ones, the padding is skipped below.
+		if i < numShortBlocks {
+			block = append(block, 0)
+		}
+		blocks = append(blocks, append(block, ecc...))
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := range blocks[0] {
+		for j, block := range blocks {
+			if i != shortBlockLength-eccLength || j >= numShortBlocks {
+				result = append(result, block[i])
+			}
+		}
+	}
+	return result
+}
+
+// getReedSolomonDivisor returns the coefficients of the generator polynomial of the degree, from the highest power
+// without its leading one.
+func getReedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = multiplyGF(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = multiplyGF(root, 0x02)
+	}
+	return result
+}
+
+func getReedSolomonRemainder(data []byte, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coefficient := range divisor {
+			result[i] ^= multiplyGF(coefficient, factor)
+		}
+	}
+	return result
+}
+
+// multiplyGF multiplies in the Galois field GF(2^8) of the QR codes, modulo x^8 + x^4 + x^3 + x^2 + 1.
+func multiplyGF(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+// getAlignmentPatternPositions returns the ascending positions of the centers of the alignment patterns on both axes.
+func getAlignmentPatternPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	numAlign := version/7 + 2
+	step := (version*8 + numAlign*3 + 5) / (numAlign*4 - 4) * 2
+	positions := make([]int, numAlign)
+	positions[0] = 6
+	for i, position := numAlign-1, version*4+17-7; i >= 1; i, position = i-1, position-step {
+		positions[i] = position
+	}
+	return positions
+}
+
+// getNumRawDataModules returns the number of modules of the version that hold the codewords, with the remainder bits.
+func getNumRawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		numAlign := version/7 + 2
+		result -= (25*numAlign-10)*numAlign - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+// getNumDataCodewords returns the number of data codewords of the version and level, without the error correction.
+func getNumDataCodewords(version int, level Level) int {
+	return getNumRawDataModules(version)/8 - eccCodewordsPerBlock[level][version]*numErrorCorrectionBlocks[level][version]
+}
+
+// getCharCountBits returns the length of the character count of the byte mode for the version.
+func getCharCountBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+type bitBuffer struct {
+	bits []bool
+}
+
+// append appends the length low bits of the value, from the most significant.
+func (b *bitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		b.bits = append(b.bits, (value>>i)&1 != 0)
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,218 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapacity(t *testing.T) {
+	// The byte mode capacities of the specification, by version and level.
+	capacities := map[int][4]int{
+		1:  {17, 14, 11, 7},
+		2:  {32, 26, 20, 14},
+		5:  {106, 84, 60, 44},
+		7:  {154, 122, 86, 64},
+		9:  {230, 180, 130, 98},
+		10: {271, 213, 151, 119},
+		40: {2953, 2331, 1663, 1273},
+	}
+	for version, capacity := range capacities {
+		for level := Low; level <= High; level++ {
+			bits := getNumDataCodewords(version, level)*8 - 4 - getCharCountBits(version)
+			require.Equal(t, capacity[level], bits/8, "version %d level %d", version, level)
+		}
+	}
+	for level := Low; level <= High; level++ {
+		code, err := Encode(bytes.Repeat([]byte("a"), capacities[40][level]), level)
+		require.NoError(t, err)
+		require.Equal(t, 177, code.Size)
+		_, err = Encode(bytes.Repeat([]byte("a"), capacities[40][level]+1), level)
+		require.ErrorIs(t, err, ErrDataTooLong)
+	}
+}
+
+func TestAlignmentPatternPositions(t *testing.T) {
+	require.Empty(t, getAlignmentPatternPositions(1))
+	require.Equal(t, []int{6, 18}, getAlignmentPatternPositions(2))
+	require.Equal(t, []int{6, 22, 38}, getAlignmentPatternPositions(7))
+	require.Equal(t, []int{6, 34, 60, 86, 112, 138}, getAlignmentPatternPositions(32))
+}
+
+func TestEncode(t *testing.T) {
+	for _, test := range []struct {
+		data    string
+		level   Level
+		version int
+	}{
+		{data: "https://s.example.com/s/docs", level: Medium, version: 3},
+		{data: "https://s.example.com/s/docs", level: High, version: 4},
+		{data: "https://slash.example.com/s/" + strings.Repeat("long-name-", 10), level: Quartile, version: 9},
+		{data: strings.Repeat("0123456789", 30), level: Low, version: 11},
+	} {
+		code, err := Encode([]byte(test.data), test.level)
+		require.NoError(t, err)
+		require.Equal(t, test.version*4+17, code.Size)
+
+		// Both copies of the format information hold the level and a mask with a valid BCH code.
+		first, second := 0, 0
+		for i := 0; i < 15; i++ {
+			var x, y int
+			switch {
+			case i <= 5:
+				x, y = 8, i
+			case i == 6:
+				x, y = 8, 7
+			case i == 7:
+				x, y = 8, 8
+			case i == 8:
+				x, y = 7, 8
+			default:
+				x, y = 14-i, 8
+			}
+			if code.Dark(x, y) {
+				first |= 1 << i
+			}
+			if i < 8 {
+				x, y = code.Size-1-i, 8
+			} else {
+				x, y = 8, code.Size-15+i
+			}
+			if code.Dark(x, y) {
+				second |= 1 << i
+			}
+		}
+		require.Equal(t, first, second)
+		format := first ^ 0x5412
+		require.Equal(t, formatBits[test.level], format>>13)
+		mask := (format >> 10) & 7
+		remainder := format >> 10
+		for i := 0; i < 10; i++ {
+			remainder = (remainder << 1) ^ ((remainder >> 9) * 0x537)
+		}
+		require.Equal(t, remainder, format&0x3FF)
+
+		// The unmasked codewords of each block have no Reed-Solomon syndrome and the data is in byte mode.
+		code.applyMask(mask)
+		codewords := readCodewords(code)
+		code.applyMask(mask)
+		numBlocks := numErrorCorrectionBlocks[test.level][test.version]
+		eccLength := eccCodewordsPerBlock[test.level][test.version]
+		blocks := make([][]byte, numBlocks)
+		numShortBlocks := numBlocks - len(codewords)%numBlocks
+		shortDataLength := len(codewords)/numBlocks - eccLength
+		k := 0
+		for i := 0; i < shortDataLength+1; i++ {
+			for j := range blocks {
+				if i < shortDataLength || j >= numShortBlocks {
+					blocks[j] = append(blocks[j], codewords[k])
+					k++
+				}
+			}
+		}
+		data := []byte{}
+		for _, block := range blocks {
+			data = append(data, block...)
+		}
+		for i := 0; i < eccLength; i++ {
+			for j := range blocks {
+				blocks[j] = append(blocks[j], codewords[k])
+				k++
+			}
+		}
+		require.Equal(t, len(codewords), k)
+		for _, block := range blocks {
+			root := byte(1)
+			for i := 0; i < eccLength; i++ {
+				syndrome := byte(0)
+				for _, b := range block {
+					syndrome = multiplyGF(syndrome, root) ^ b
+				}
+				require.Zero(t, syndrome)
+				root = multiplyGF(root, 0x02)
+			}
+		}
+		require.Equal(t, byte(0x4), data[0]>>4)
+		countBits := getCharCountBits(test.version)
+		count := int(data[0]&0xF)<<4 | int(data[1]>>4)
+		offset := 1
+		if countBits == 16 {
+			count = count<<8 | int(data[1]&0xF)<<4 | int(data[2]>>4)
+			offset = 2
+		}
+		require.Equal(t, len(test.data), count)
+		decoded := make([]byte, count)
+		for i := range decoded {
+			decoded[i] = data[offset+i]<<4 | data[offset+i+1]>>4
+		}
+		require.Equal(t, test.data, string(decoded))
+	}
+}
+
+func TestVersionBits(t *testing.T) {
+	code := newCode(7, Low)
+	bits := 0
+	for i := 0; i < 18; i++ {
+		if code.Dark(code.Size-11+i%3, i/3) {
+			bits |= 1 << i
+		}
+	}
+	require.Equal(t, 0x07C94, bits)
+}
+
+func TestRender(t *testing.T) {
+	code, err := Encode([]byte("https://s.example.com/s/docs"), Medium)
+	require.NoError(t, err)
+	_, err = code.PNG(code.Size + QuietZone*2 - 1)
+	require.Error(t, err)
+
+	content, err := code.PNG(256)
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(content))
+	require.NoError(t, err)
+	require.Equal(t, 256, img.Bounds().Dx())
+	scale, margin, err := code.getModuleScale(256)
+	require.NoError(t, err)
+	for _, module := range [][2]int{{0, 0}, {1, 1}, {7, 0}, {code.Size - 1, 0}} {
+		x, y := margin+module[0]*scale, margin+module[1]*scale
+		r, _, _, _ := img.At(x, y).RGBA()
+		require.Equal(t, code.Dark(module[0], module[1]), r == 0, "module %v", module)
+	}
+	r, _, _, _ := img.At(0, 0).RGBA()
+	require.NotZero(t, r)
+
+	svg, err := code.SVG(256)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(svg), `<svg xmlns="http://www.w3.org/2000/svg" width="256" height="256"`))
+	require.Contains(t, string(svg), "h6v6h-6z")
+}
+
+// readCodewords reads the codewords back in the order they are drawn.
+func readCodewords(code *Code) []byte {
+	codewords := make([]byte, getNumRawDataModules(code.version)/8)
+	i := 0
+	for right := code.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vertical := 0; vertical < code.Size; vertical++ {
+			y := vertical
+			if (right+1)&2 == 0 {
+				y = code.Size - 1 - vertical
+			}
+			for _, x := range []int{right, right - 1} {
+				if code.isFunction[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+				if code.modules[y][x] {
+					codewords[i/8] |= 1 << (7 - i%8)
+				}
+				i++
+			}
+		}
+	}
+	return codewords
+}
@@ -0,0 +1,69 @@
+package qrcode
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+
+	"github.com/pkg/errors"
+)
+
+// getModuleScale returns the width in pixels of the modules in a square image of the size with the quiet zone,
+// and the margin that centers the symbol.
+func (c *Code) getModuleScale(size int) (int, int, error) {
+	modules := c.Size + QuietZone*2
+	scale := size / modules
+	if scale < 1 {
+		return 0, 0, errors.Errorf("size %d is smaller than the %d modules of the code", size, modules)
+	}
+	return scale, (size - c.Size*scale) / 2, nil
+}
+
+// PNG returns the code as a black and white square PNG image of the size in pixels.
+func (c *Code) PNG(size int) ([]byte, error) {
+	scale, margin, err := c.getModuleScale(size)
+	if err != nil {
+		return nil, err
+	}
+	img := image.NewPaletted(image.Rect(0, 0, size, size), color.Palette{color.White, color.Black})
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if !c.modules[y][x] {
+				continue
+			}
+			for dy := 0; dy < scale; dy++ {
+				offset := img.PixOffset(margin+x*scale, margin+y*scale+dy)
+				for dx := 0; dx < scale; dx++ {
+					img.Pix[offset+dx] = 1
+				}
+			}
+		}
+	}
+	buffer := &bytes.Buffer{}
+	if err := png.Encode(buffer, img); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// SVG returns the code as a square SVG image of the size in pixels, with a dark path on a white background.
+func (c *Code) SVG(size int) ([]byte, error) {
+	scale, margin, err := c.getModuleScale(size)
+	if err != nil {
+		return nil, err
+	}
+	buffer := &bytes.Buffer{}
+	fmt.Fprintf(buffer, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, size, size)
+	fmt.Fprintf(buffer, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, size, size)
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.modules[y][x] {
+				fmt.Fprintf(buffer, "M%d %dh%dv%dh-%dz", margin+x*scale, margin+y*scale, scale, scale, scale)
+			}
+		}
+	}
+	buffer.WriteString(`"/></svg>`)
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,65 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCode(t *testing.T) {
+	// The SHA-1 test vectors of RFC 6238, truncated to the 6 digits of the codes.
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	tests := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for ts, want := range tests {
+		code, err := GenerateCode(secret, time.Unix(ts, 0))
+		require.NoError(t, err)
+		require.Equal(t, want, code, ts)
+	}
+	_, err := GenerateCode("not base32!", time.Now())
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	code, err := GenerateCode(secret, now)
+	require.NoError(t, err)
+
+	step, ok := Validate(secret, code, now)
+	require.True(t, ok)
+	require.Equal(t, now.Unix()/30, step)
+	// The codes of the adjacent steps are accepted for clock drift.
+	_, ok = Validate(secret, code, now.Add(Period))
+	require.True(t, ok)
+	_, ok = Validate(secret, code, now.Add(-Period))
+	require.True(t, ok)
+	_, ok = Validate(secret, code, now.Add(2*Period))
+	require.False(t, ok)
+	_, ok = Validate(secret, "", now)
+	require.False(t, ok)
+	_, ok = Validate(secret, code+"0", now)
+	require.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	u, err := url.Parse(URI("Slash", "alice@example.com", "JBSWY3DPEHPK3PXP"))
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", u.Scheme)
+	require.Equal(t, "totp", u.Host)
+	require.Equal(t, "/Slash:alice@example.com", u.Path)
+	require.Equal(t, "JBSWY3DPEHPK3PXP", u.Query().Get("secret"))
+	require.Equal(t, "Slash", u.Query().Get("issuer"))
+	require.Equal(t, "6", u.Query().Get("digits"))
+	require.Equal(t, "30", u.Query().Get("period"))
+}
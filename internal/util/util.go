@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"net/mail"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -13,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/nyaruka/phonenumbers"
 	"github.com/pkg/errors"
+	"golang.org/x/text/language"
 )
 
 // ConvertStringToInt32 converts a string to int32.
@@ -50,17 +52,25 @@ var letters = []rune("0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUV
 
 // RandomString returns a random string with length n.
 func RandomString(n int) (string, error) {
+	return RandomStringFromAlphabet(letters, n)
+}
+
+// RandomStringFromAlphabet returns a random string with length n of the characters in alphabet.
+func RandomStringFromAlphabet(alphabet []rune, n int) (string, error) {
+	if len(alphabet) == 0 {
+		return "", errors.New("alphabet is empty")
+	}
 	var sb strings.Builder
 	sb.Grow(n)
 	for i := 0; i < n; i++ {
 		// The reason for using crypto/rand instead of math/rand is that
 		// the former relies on hardware to generate random numbers and
 		// thus has a stronger source of random numbers.
-		randNum, err := rand.Int(rand.Reader, big.NewInt(int64(len(letters))))
+		randNum, err := rand.Int(rand.Reader, big.NewInt(int64(len(alphabet))))
 		if err != nil {
 			return "", err
 		}
-		if _, err := sb.WriteRune(letters[randNum.Uint64()]); err != nil {
+		if _, err := sb.WriteRune(alphabet[randNum.Uint64()]); err != nil {
 			return "", err
 		}
 	}
@@ -161,3 +171,31 @@ func ValidateURI(uri string) bool {
 	u, err := url.Parse(uri)
 	return err == nil && u.Scheme != "" && u.Host != ""
 }
+
+// MatchLocale returns the locale of locales best matching the Accept-Language header value, e.g. "fr-CH, fr;q=0.9, en;q=0.8".
+// It returns false when the header is empty or invalid, or when none of the locales matches it.
+func MatchLocale(acceptLanguage string, locales []string) (string, bool) {
+	preferred, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(preferred) == 0 {
+		return "", false
+	}
+	// Sort the locales so that the same one wins among equally good matches.
+	sorted := append([]string{}, locales...)
+	sort.Strings(sorted)
+	supported, supportedLocales := []language.Tag{}, []string{}
+	for _, locale := range sorted {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			continue
+		}
+		supported, supportedLocales = append(supported, tag), append(supportedLocales, locale)
+	}
+	if len(supported) == 0 {
+		return "", false
+	}
+	_, index, confidence := language.NewMatcher(supported).Match(preferred...)
+	if confidence == language.No {
+		return "", false
+	}
+	return supportedLocales[index], true
+}
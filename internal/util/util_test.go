@@ -141,3 +141,53 @@ func TestValidatePhone(t *testing.T) {
 		}
 	}
 }
+
+func TestMatchLocale(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		locales        []string
+		want           string
+		ok             bool
+	}{
+		{
+			acceptLanguage: "fr-CH, fr;q=0.9, en;q=0.8",
+			locales:        []string{"en", "fr"},
+			want:           "fr",
+			ok:             true,
+		},
+		{
+			acceptLanguage: "de, en;q=0.5",
+			locales:        []string{"en", "fr"},
+			want:           "en",
+			ok:             true,
+		},
+		{
+			acceptLanguage: "en-US",
+			locales:        []string{"en-GB", "fr"},
+			want:           "en-GB",
+			ok:             true,
+		},
+		{
+			acceptLanguage: "ja",
+			locales:        []string{"en", "fr"},
+			ok:             false,
+		},
+		{
+			acceptLanguage: "",
+			locales:        []string{"en"},
+			ok:             false,
+		},
+		{
+			acceptLanguage: "en",
+			locales:        []string{"not a locale"},
+			ok:             false,
+		},
+	}
+
+	for _, test := range tests {
+		got, ok := MatchLocale(test.acceptLanguage, test.locales)
+		if got != test.want || ok != test.ok {
+			t.Errorf("MatchLocale %q %v, got %q %v, want %q %v", test.acceptLanguage, test.locales, got, ok, test.want, test.ok)
+		}
+	}
+}
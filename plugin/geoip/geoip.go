@@ -0,0 +1,20 @@
+package geoip
+
+import (
+	"context"
+)
+
+// Location is the geolocation of an IP address.
+type Location struct {
+	Country string
+	City    string
+	// ASN is the autonomous system number, e.g. "AS15169".
+	ASN          string
+	Organization string
+}
+
+// Resolver looks up the geolocation of IP addresses, e.g. backed by a GeoIP database or service.
+type Resolver interface {
+	// Lookup returns the location of the ip, or nil if it is unknown.
+	Lookup(ctx context.Context, ip string) (*Location, error)
+}
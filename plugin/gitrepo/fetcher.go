@@ -0,0 +1,129 @@
+package gitrepo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultGitHubBaseURL = "https://api.github.com"
+	DefaultGitLabBaseURL = "https://gitlab.com"
+	// DefaultFetchTimeout bounds the fetch of a file.
+	DefaultFetchTimeout = 10 * time.Second
+	// DefaultMaxFileSize is the size in bytes of the largest file read.
+	DefaultMaxFileSize = 1 << 20
+)
+
+// ErrFileNotFound is returned when the file doesn't exist in the repository at the commit.
+var ErrFileNotFound = errors.New("file not found")
+
+// Repository is a repository on a provider, with the token reading its files.
+type Repository struct {
+	Provider Provider
+	// Name is the full name of the repository, e.g. "owner/repo".
+	Name string
+	// BaseURL is the API URL of self-hosted providers, empty uses the one of github.com or gitlab.com.
+	BaseURL string
+	// AccessToken reads the files of private repositories, empty for public ones.
+	AccessToken string
+}
+
+// FileFetcher reads the files of repositories.
+type FileFetcher interface {
+	FetchFile(ctx context.Context, repository *Repository, commit, path string) ([]byte, error)
+}
+
+// HTTPFetcher reads the files of repositories through the REST APIs of the providers.
+type HTTPFetcher struct {
+	client      *http.Client
+	maxFileSize int64
+}
+
+// NewHTTPFetcher returns an HTTPFetcher with the timeout of each fetch and the max file size in bytes.
+func NewHTTPFetcher(timeout time.Duration, maxFileSize int64) *HTTPFetcher {
+	return &HTTPFetcher{
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		maxFileSize: maxFileSize,
+	}
+}
+
+// FetchFile returns the content of the file at the path of the repository at the commit.
+func (f *HTTPFetcher) FetchFile(ctx context.Context, repository *Repository, commit, path string) ([]byte, error) {
+	request, err := newFileRequest(ctx, repository, commit, path)
+	if err != nil {
+		return nil, err
+	}
+	response, err := f.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch file")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrFileNotFound
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %d", response.StatusCode)
+	}
+	content, err := io.ReadAll(io.LimitReader(response.Body, f.maxFileSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read file")
+	}
+	if int64(len(content)) > f.maxFileSize {
+		return nil, errors.Errorf("file is larger than %d bytes", f.maxFileSize)
+	}
+	return content, nil
+}
+
+// newFileRequest returns the request of the raw content of the file on the API of the provider.
+func newFileRequest(ctx context.Context, repository *Repository, commit, path string) (*http.Request, error) {
+	baseURL := strings.TrimSuffix(repository.BaseURL, "/")
+	query := url.Values{"ref": {commit}}
+	var fileURL string
+	switch repository.Provider {
+	case ProviderGitHub:
+		if baseURL == "" {
+			baseURL = DefaultGitHubBaseURL
+		}
+		fileURL = fmt.Sprintf("%s/repos/%s/contents/%s?%s", baseURL, escapePath(repository.Name), escapePath(path), query.Encode())
+	case ProviderGitLab:
+		if baseURL == "" {
+			baseURL = DefaultGitLabBaseURL
+		}
+		// GitLab identifies the projects and files by their whole paths, with the slashes escaped.
+		fileURL = fmt.Sprintf("%s/api/v4/projects/%s/repository/files/%s/raw?%s", baseURL, url.PathEscape(repository.Name), url.PathEscape(path), query.Encode())
+	default:
+		return nil, errors.Errorf("unsupported provider %q", repository.Provider)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	if repository.Provider == ProviderGitHub {
+		request.Header.Set("Accept", "application/vnd.github.raw")
+		if repository.AccessToken != "" {
+			request.Header.Set("Authorization", "Bearer "+repository.AccessToken)
+		}
+	} else if repository.AccessToken != "" {
+		request.Header.Set("PRIVATE-TOKEN", repository.AccessToken)
+	}
+	return request, nil
+}
+
+// escapePath escapes each segment of the slash-separated path.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,162 @@
+package gitrepo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyGitHubSignature(t *testing.T) {
+	payload := []byte(`{"ref":"refs/heads/main"}`)
+	signature := SignGitHubPayload("secret", payload)
+	require.True(t, strings.HasPrefix(signature, "sha256="))
+	require.True(t, VerifyGitHubSignature("secret", payload, signature))
+	require.False(t, VerifyGitHubSignature("other", payload, signature))
+	require.False(t, VerifyGitHubSignature("secret", []byte(`{"ref":"refs/heads/dev"}`), signature))
+	require.False(t, VerifyGitHubSignature("secret", payload, strings.TrimPrefix(signature, "sha256=")))
+	require.False(t, VerifyGitHubSignature("", payload, SignGitHubPayload("", payload)))
+
+	require.True(t, VerifyGitLabToken("secret", "secret"))
+	require.False(t, VerifyGitLabToken("secret", "secret2"))
+	require.False(t, VerifyGitLabToken("", ""))
+}
+
+func TestParsePushEvent(t *testing.T) {
+	event, err := ParsePushEvent(ProviderGitHub, []byte(`{
+		"ref": "refs/heads/main",
+		"after": "abc123",
+		"repository": {"full_name": "acme/links", "default_branch": "main"},
+		"commits": [
+			{"added": ["links.yaml"], "modified": ["README.md"], "removed": []},
+			{"added": [], "modified": ["links.yaml"], "removed": ["old.txt"]}
+		]
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, &PushEvent{
+		Repository:    "acme/links",
+		DefaultBranch: "main",
+		Branch:        "main",
+		Commit:        "abc123",
+		ChangedFiles:  []string{"links.yaml", "README.md", "old.txt"},
+	}, event)
+	require.True(t, event.IsDefaultBranch())
+	require.True(t, event.ChangesFile("links.yaml"))
+	require.False(t, event.ChangesFile("docs/links.yaml"))
+
+	event, err = ParsePushEvent(ProviderGitHub, []byte(`{"ref": "refs/tags/v1.0.0", "repository": {"default_branch": "main"}}`))
+	require.NoError(t, err)
+	require.False(t, event.IsDefaultBranch())
+
+	event, err = ParsePushEvent(ProviderGitLab, []byte(`{
+		"ref": "refs/heads/main",
+		"after": "def456",
+		"checkout_sha": "def456",
+		"total_commits_count": 30,
+		"project": {"path_with_namespace": "group/sub/links", "default_branch": "main"},
+		"commits": [{"added": [], "modified": ["README.md"], "removed": []}]
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, "group/sub/links", event.Repository)
+	require.True(t, event.IsDefaultBranch())
+	// Only a part of the commits are listed, so any file may have changed.
+	require.True(t, event.Truncated)
+	require.True(t, event.ChangesFile("links.yaml"))
+
+	event, err = ParsePushEvent(ProviderGitLab, []byte(`{"ref": "refs/heads/main", "project": {"default_branch": "main"}}`))
+	require.NoError(t, err)
+	require.True(t, event.Deleted)
+	require.False(t, event.IsDefaultBranch())
+
+	_, err = ParsePushEvent(ProviderGitHub, []byte(`not json`))
+	require.Error(t, err)
+	require.True(t, IsPushEvent(ProviderGitHub, "push"))
+	require.False(t, IsPushEvent(ProviderGitHub, "ping"))
+	require.True(t, IsPushEvent(ProviderGitLab, "Push Hook"))
+}
+
+func TestParseLinksFile(t *testing.T) {
+	linksFile, err := ParseLinksFile([]byte(`
+shortcuts:
+  - name: docs
+    link: https://docs.example.com
+    title: Docs
+    tags: [docs, dev]
+    visibility: Public
+  - name: wiki
+    link: https://wiki.example.com
+`))
+	require.NoError(t, err)
+	require.Equal(t, []*Link{
+		{Name: "docs", Link: "https://docs.example.com", Title: "Docs", Tags: []string{"docs", "dev"}, Visibility: "Public"},
+		{Name: "wiki", Link: "https://wiki.example.com"},
+	}, linksFile.Shortcuts)
+
+	linksFile, err = ParseLinksFile([]byte(""))
+	require.NoError(t, err)
+	require.Empty(t, linksFile.Shortcuts)
+
+	for _, content := range []string{
+		"shortcuts:\n  - name: docs\n",
+		"shortcuts:\n  - name: docs\n    link: a\n  - name: docs\n    link: b\n",
+		"shortcuts:\n  - name: docs\n    link: a\n    visibility: secret\n",
+		"shortcuts:\n  - name: docs\n    url: a\n",
+		"shortcuts: docs",
+	} {
+		_, err := ParseLinksFile([]byte(content))
+		require.Error(t, err, content)
+	}
+}
+
+func TestHTTPFetcher(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.EscapedPath() == "/repos/acme/links/contents/config/links.yaml":
+			require.Equal(t, "abc123", r.URL.Query().Get("ref"))
+			require.Equal(t, "application/vnd.github.raw", r.Header.Get("Accept"))
+			require.Equal(t, "Bearer github-token", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte("github"))
+		case r.URL.EscapedPath() == "/api/v4/projects/group%2Flinks/repository/files/config%2Flinks.yaml/raw":
+			require.Equal(t, "def456", r.URL.Query().Get("ref"))
+			require.Equal(t, "gitlab-token", r.Header.Get("PRIVATE-TOKEN"))
+			_, _ = w.Write([]byte("gitlab"))
+		case strings.HasSuffix(r.URL.Path, "/large.yaml"):
+			_, _ = w.Write([]byte(strings.Repeat("a", 2048)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	fetcher := NewHTTPFetcher(time.Second, 1024)
+
+	content, err := fetcher.FetchFile(ctx, &Repository{
+		Provider:    ProviderGitHub,
+		Name:        "acme/links",
+		BaseURL:     server.URL + "/",
+		AccessToken: "github-token",
+	}, "abc123", "config/links.yaml")
+	require.NoError(t, err)
+	require.Equal(t, "github", string(content))
+
+	gitlab := &Repository{
+		Provider:    ProviderGitLab,
+		Name:        "group/links",
+		BaseURL:     server.URL,
+		AccessToken: "gitlab-token",
+	}
+	content, err = fetcher.FetchFile(ctx, gitlab, "def456", "config/links.yaml")
+	require.NoError(t, err)
+	require.Equal(t, "gitlab", string(content))
+
+	_, err = fetcher.FetchFile(ctx, gitlab, "def456", "missing.yaml")
+	require.ErrorIs(t, err, ErrFileNotFound)
+	_, err = fetcher.FetchFile(ctx, gitlab, "def456", "large.yaml")
+	require.Error(t, err)
+	_, err = fetcher.FetchFile(ctx, &Repository{Provider: "bitbucket", Name: "acme/links"}, "abc123", "links.yaml")
+	require.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package gitrepo
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultLinksFilePath is the path of the links file in the repositories.
+const DefaultLinksFilePath = "links.yaml"
+
+// LinksFile is the shortcuts declared in the links file of a repository, e.g.
+//
+//	shortcuts:
+//	  - name: docs
+//	    link: https://docs.example.com
+//	    tags: [docs]
+//	    visibility: workspace
+type LinksFile struct {
+	Shortcuts []*Link `yaml:"shortcuts"`
+}
+
+// Link is a shortcut of the links file.
+type Link struct {
+	Name        string   `yaml:"name"`
+	Link        string   `yaml:"link"`
+	Title       string   `yaml:"title"`
+	Description string   `yaml:"description"`
+	Tags        []string `yaml:"tags"`
+	// Visibility is "public", "workspace" or "private", empty keeps the visibility of an existing shortcut and
+	// uses the default visibility of the workspace for a new one.
+	Visibility string `yaml:"visibility"`
+}
+
+// ParseLinksFile parses the YAML links file, whose shortcuts must have a unique name and a link.
+func ParseLinksFile(content []byte) (*LinksFile, error) {
+	linksFile := &LinksFile{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(linksFile); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "failed to parse links file")
+	}
+	names := map[string]bool{}
+	for i, link := range linksFile.Shortcuts {
+		if link == nil || link.Name == "" || link.Link == "" {
+			return nil, errors.Errorf("shortcut %d: name and link are required", i+1)
+		}
+		if names[link.Name] {
+			return nil, errors.Errorf("shortcut %q is declared more than once", link.Name)
+		}
+		names[link.Name] = true
+		switch strings.ToLower(link.Visibility) {
+		case "", "public", "workspace", "private":
+		default:
+			return nil, errors.Errorf("shortcut %q: invalid visibility %q", link.Name, link.Visibility)
+		}
+	}
+	return linksFile, nil
+}
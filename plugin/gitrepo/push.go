@@ -0,0 +1,181 @@
+// Package gitrepo receives the push webhooks of GitHub and GitLab repositories and reads their files.
+package gitrepo
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Provider is the hosting service of a repository.
+type Provider string
+
+const (
+	ProviderGitHub Provider = "github"
+	ProviderGitLab Provider = "gitlab"
+)
+
+const (
+	// GitHubEventHeader and GitLabEventHeader are the headers of the type of the webhook events.
+	GitHubEventHeader = "X-GitHub-Event"
+	GitLabEventHeader = "X-Gitlab-Event"
+	// GitHubSignatureHeader is the header of the HMAC-SHA256 signature of the GitHub webhook payloads.
+	GitHubSignatureHeader = "X-Hub-Signature-256"
+	// GitLabTokenHeader is the header of the secret token of the GitLab webhooks.
+	GitLabTokenHeader = "X-Gitlab-Token"
+
+	githubPushEvent = "push"
+	gitlabPushEvent = "Push Hook"
+	// maxGitHubPushCommits is the number of commits listed at most in the GitHub push payloads.
+	maxGitHubPushCommits = 20
+)
+
+// PushEvent is a push of commits to a branch of a repository.
+type PushEvent struct {
+	// Repository is the full name of the repository, e.g. "owner/repo".
+	Repository    string
+	DefaultBranch string
+	// Branch is the pushed branch, empty when a tag is pushed.
+	Branch string
+	// Commit is the head commit of the branch after the push.
+	Commit string
+	// Deleted is whether the push deleted the branch.
+	Deleted bool
+	// ChangedFiles is the paths added, modified or removed by the listed commits.
+	ChangedFiles []string
+	// Truncated is whether the payload lists only a part of the pushed commits, so ChangedFiles may miss some paths.
+	Truncated bool
+}
+
+// IsDefaultBranch returns whether the push updated the default branch of the repository.
+func (e *PushEvent) IsDefaultBranch() bool {
+	return e.Branch != "" && e.Branch == e.DefaultBranch && !e.Deleted
+}
+
+// ChangesFile returns whether the push may have changed the file at the path.
+func (e *PushEvent) ChangesFile(path string) bool {
+	return e.Truncated || slices.Contains(e.ChangedFiles, path)
+}
+
+type pushCommit struct {
+	Added    []string `json:"added"`
+	Modified []string `json:"modified"`
+	Removed  []string `json:"removed"`
+}
+
+type githubPushPayload struct {
+	Ref        string `json:"ref"`
+	After      string `json:"after"`
+	Deleted    bool   `json:"deleted"`
+	Repository struct {
+		FullName      string `json:"full_name"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"repository"`
+	Commits []*pushCommit `json:"commits"`
+}
+
+type gitlabPushPayload struct {
+	Ref               string `json:"ref"`
+	After             string `json:"after"`
+	CheckoutSHA       string `json:"checkout_sha"`
+	TotalCommitsCount int    `json:"total_commits_count"`
+	Project           struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+		DefaultBranch     string `json:"default_branch"`
+	} `json:"project"`
+	Commits []*pushCommit `json:"commits"`
+}
+
+// IsPushEvent returns whether the value of the event header of the provider is a push.
+func IsPushEvent(provider Provider, event string) bool {
+	switch provider {
+	case ProviderGitHub:
+		return event == githubPushEvent
+	case ProviderGitLab:
+		return event == gitlabPushEvent
+	default:
+		return false
+	}
+}
+
+// ParsePushEvent parses the push webhook payload of the provider.
+func ParsePushEvent(provider Provider, payload []byte) (*PushEvent, error) {
+	switch provider {
+	case ProviderGitHub:
+		githubPayload := &githubPushPayload{}
+		if err := json.Unmarshal(payload, githubPayload); err != nil {
+			return nil, errors.Wrap(err, "failed to parse push payload")
+		}
+		return &PushEvent{
+			Repository:    githubPayload.Repository.FullName,
+			DefaultBranch: githubPayload.Repository.DefaultBranch,
+			Branch:        getBranch(githubPayload.Ref),
+			Commit:        githubPayload.After,
+			Deleted:       githubPayload.Deleted,
+			ChangedFiles:  getChangedFiles(githubPayload.Commits),
+			Truncated:     len(githubPayload.Commits) >= maxGitHubPushCommits,
+		}, nil
+	case ProviderGitLab:
+		gitlabPayload := &gitlabPushPayload{}
+		if err := json.Unmarshal(payload, gitlabPayload); err != nil {
+			return nil, errors.Wrap(err, "failed to parse push payload")
+		}
+		return &PushEvent{
+			Repository:    gitlabPayload.Project.PathWithNamespace,
+			DefaultBranch: gitlabPayload.Project.DefaultBranch,
+			Branch:        getBranch(gitlabPayload.Ref),
+			Commit:        gitlabPayload.After,
+			// GitLab has no checkout commit when the branch is deleted.
+			Deleted:      gitlabPayload.CheckoutSHA == "",
+			ChangedFiles: getChangedFiles(gitlabPayload.Commits),
+			Truncated:    gitlabPayload.TotalCommitsCount > len(gitlabPayload.Commits),
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported provider %q", provider)
+	}
+}
+
+// VerifyGitHubSignature returns whether the signature header is the HMAC-SHA256 of the payload with the secret.
+func VerifyGitHubSignature(secret string, payload []byte, signature string) bool {
+	return secret != "" && hmac.Equal([]byte(signature), []byte(SignGitHubPayload(secret, payload)))
+}
+
+// SignGitHubPayload returns the signature header of the payload with the secret, as sent by GitHub.
+func SignGitHubPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyGitLabToken returns whether the token header is the secret token.
+func VerifyGitLabToken(secret, token string) bool {
+	return secret != "" && subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
+
+func getBranch(ref string) string {
+	branch, ok := strings.CutPrefix(ref, "refs/heads/")
+	if !ok {
+		return ""
+	}
+	return branch
+}
+
+func getChangedFiles(commits []*pushCommit) []string {
+	changedFiles := []string{}
+	for _, commit := range commits {
+		for _, files := range [][]string{commit.Added, commit.Modified, commit.Removed} {
+			for _, file := range files {
+				if !slices.Contains(changedFiles, file) {
+					changedFiles = append(changedFiles, file)
+				}
+			}
+		}
+	}
+	return changedFiles
+}
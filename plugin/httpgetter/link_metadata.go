@@ -0,0 +1,209 @@
+package httpgetter
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// DefaultFetchTimeout bounds the whole fetch of a page, redirects included.
+	DefaultFetchTimeout = 5 * time.Second
+	// DefaultMaxBodySize is the number of bytes of a page read at most, the title and favicon are in its head.
+	DefaultMaxBodySize = 1 << 20
+	maxRedirects       = 5
+)
+
+// ErrNonPublicAddress is returned when the link or one of its redirects resolves to a private, loopback or
+// otherwise non public address.
+var ErrNonPublicAddress = errors.New("address is not public")
+
+// nonPublicPrefixes are the ranges that aren't reachable on the internet and aren't covered by the netip.Addr methods.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// LinkMetadata is the title and favicon of the page of a link.
+type LinkMetadata struct {
+	Title      string
+	FaviconURL string
+}
+
+// LinkMetadataFetcher fetches the metadata of the page of a link.
+type LinkMetadataFetcher interface {
+	FetchLinkMetadata(ctx context.Context, link string) (*LinkMetadata, error)
+}
+
+// SafeFetcher fetches the pages of links from public addresses only, with a timeout and a cap on the body size.
+// The addresses are checked on every connection, so neither redirects nor DNS answers can reach private networks.
+type SafeFetcher struct {
+	client      *http.Client
+	maxBodySize int64
+}
+
+// NewSafeFetcher returns a SafeFetcher with the timeout of the whole fetch and the max body size in bytes.
+func NewSafeFetcher(timeout time.Duration, maxBodySize int64) *SafeFetcher {
+	return newSafeFetcher(timeout, maxBodySize, IsPublicIP)
+}
+
+func newSafeFetcher(timeout time.Duration, maxBodySize int64, allowIP func(netip.Addr) bool) *SafeFetcher {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !allowIP(addrPort.Addr()) {
+				return errors.Wrap(ErrNonPublicAddress, addrPort.Addr().String())
+			}
+			return nil
+		},
+	}
+	client := &http.Client{
+		Timeout: timeout,
+		// Proxies would connect to the addresses on our behalf without the check.
+		Transport: &http.Transport{
+			DialContext:            dialer.DialContext,
+			TLSHandshakeTimeout:    timeout,
+			ResponseHeaderTimeout:  timeout,
+			MaxResponseHeaderBytes: 64 << 10,
+		},
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errors.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			if request.URL.Scheme != "http" && request.URL.Scheme != "https" {
+				return errors.Errorf("unsupported redirect scheme %q", request.URL.Scheme)
+			}
+			return nil
+		},
+	}
+	return &SafeFetcher{
+		client:      client,
+		maxBodySize: maxBodySize,
+	}
+}
+
+// FetchLinkMetadata returns the title and favicon of the HTML page of the link, empty when the page has none.
+func (f *SafeFetcher) FetchLinkMetadata(ctx context.Context, link string) (*LinkMetadata, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "text/html")
+	response, err := f.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %d", response.StatusCode)
+	}
+	mediatype, err := getMediatype(response)
+	if err != nil {
+		return nil, err
+	}
+	if mediatype != "text/html" {
+		return nil, errors.New("not a HTML page")
+	}
+	// The favicon is relative to the page after the redirects.
+	return extractLinkMetadata(io.LimitReader(response.Body, f.maxBodySize), response.Request.URL), nil
+}
+
+// extractLinkMetadata returns the title and the first favicon declared in the head of the page at the base URL.
+func extractLinkMetadata(body io.Reader, base *url.URL) *LinkMetadata {
+	tokenizer := html.NewTokenizer(body)
+	linkMetadata := &LinkMetadata{}
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+		if tokenType == html.EndTagToken {
+			if token := tokenizer.Token(); token.DataAtom == atom.Head {
+				break
+			}
+			continue
+		}
+		if tokenType != html.StartTagToken && tokenType != html.SelfClosingTagToken {
+			continue
+		}
+		token := tokenizer.Token()
+		if token.DataAtom == atom.Body {
+			break
+		}
+		if token.DataAtom == atom.Title && linkMetadata.Title == "" {
+			if tokenizer.Next() == html.TextToken {
+				linkMetadata.Title = strings.Join(strings.Fields(tokenizer.Token().Data), " ")
+			}
+		} else if token.DataAtom == atom.Link && linkMetadata.FaviconURL == "" {
+			linkMetadata.FaviconURL = extractFaviconURL(token, base)
+		}
+	}
+	return linkMetadata
+}
+
+// extractFaviconURL returns the absolute URL of the link token when it is an icon, empty otherwise.
+func extractFaviconURL(token html.Token, base *url.URL) string {
+	isIcon, href := false, ""
+	for _, attr := range token.Attr {
+		switch attr.Key {
+		case "rel":
+			for _, rel := range strings.Fields(strings.ToLower(attr.Val)) {
+				if rel == "icon" {
+					isIcon = true
+				}
+			}
+		case "href":
+			href = strings.TrimSpace(attr.Val)
+		}
+	}
+	if !isIcon || href == "" {
+		return ""
+	}
+	u, err := base.Parse(href)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	return u.String()
+}
+
+// IsPublicIP returns whether the address is reachable on the internet, excluding the private, loopback,
+// link-local, multicast and reserved ranges.
+func IsPublicIP(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() || addr.IsMulticast() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+package httpgetter
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractLinkMetadata(t *testing.T) {
+	base, err := url.Parse("https://example.com/docs/page")
+	require.NoError(t, err)
+	tests := []struct {
+		body         string
+		linkMetadata LinkMetadata
+	}{
+		{
+			body:         `<html><head><title> The   docs </title><link rel="shortcut icon" href="/favicon.png"></head></html>`,
+			linkMetadata: LinkMetadata{Title: "The docs", FaviconURL: "https://example.com/favicon.png"},
+		},
+		{
+			body:         `<head><link rel="stylesheet" href="a.css"><link rel="icon" href="icon.svg"><link rel="icon" href="other.svg"></head>`,
+			linkMetadata: LinkMetadata{FaviconURL: "https://example.com/docs/icon.svg"},
+		},
+		{
+			body:         `<head><link rel="icon" href="javascript:alert(1)"></head><body><title>Not the title</title></body>`,
+			linkMetadata: LinkMetadata{},
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.linkMetadata, *extractLinkMetadata(strings.NewReader(test.body), base))
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for address, public := range map[string]bool{
+		"93.184.216.34":        true,
+		"2606:4700::1111":      true,
+		"127.0.0.1":            false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"169.254.169.254":      false,
+		"100.64.0.1":           false,
+		"0.0.0.0":              false,
+		"::1":                  false,
+		"fd00::1":              false,
+		"fe80::1":              false,
+		"::ffff:192.168.1.1":   false,
+		"64:ff9b::a9fe:a9fe":   false,
+		"255.255.255.255":      false,
+		"224.0.0.1":            false,
+		"::ffff:93.184.216.34": true,
+	} {
+		require.Equal(t, public, IsPublicIP(netip.MustParseAddr(address)), address)
+	}
+}
+
+func TestSafeFetcher(t *testing.T) {
+	ctx := context.Background()
+	page := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		switch r.URL.Path {
+		case "/large":
+			_, _ = w.Write([]byte("<html><head>" + strings.Repeat(" ", 2048) + "<title>Too far</title></head></html>"))
+		case "/image":
+			w.Header().Set("Content-Type", "image/png")
+		default:
+			_, _ = w.Write([]byte(`<html><head><title>Page</title><link rel="icon" href="/favicon.ico"></head></html>`))
+		}
+	}))
+	defer page.Close()
+
+	// The loopback address of the test server isn't public.
+	_, err := NewSafeFetcher(time.Second, DefaultMaxBodySize).FetchLinkMetadata(ctx, page.URL)
+	require.ErrorIs(t, err, ErrNonPublicAddress)
+
+	pageAddr := netip.MustParseAddrPort(page.Listener.Addr().String()).Addr()
+	fetcher := newSafeFetcher(time.Second, 1024, func(addr netip.Addr) bool { return addr == pageAddr })
+	linkMetadata, err := fetcher.FetchLinkMetadata(ctx, page.URL)
+	require.NoError(t, err)
+	require.Equal(t, LinkMetadata{Title: "Page", FaviconURL: page.URL + "/favicon.ico"}, *linkMetadata)
+
+	// The body is read up to the max size.
+	linkMetadata, err = fetcher.FetchLinkMetadata(ctx, page.URL+"/large")
+	require.NoError(t, err)
+	require.Equal(t, LinkMetadata{}, *linkMetadata)
+	_, err = fetcher.FetchLinkMetadata(ctx, page.URL+"/image")
+	require.Error(t, err)
+	_, err = fetcher.FetchLinkMetadata(ctx, "file:///etc/passwd")
+	require.Error(t, err)
+
+	// Redirects to other addresses are checked too.
+	listener, err := net.Listen("tcp", "127.0.0.2:0")
+	require.NoError(t, err)
+	redirect := httptest.NewUnstartedServer(http.RedirectHandler(page.URL, http.StatusFound))
+	redirect.Listener.Close()
+	redirect.Listener = listener
+	redirect.Start()
+	defer redirect.Close()
+	redirectAddr := netip.MustParseAddrPort(listener.Addr().String()).Addr()
+	fetcher = newSafeFetcher(time.Second, 1024, func(addr netip.Addr) bool { return addr == redirectAddr })
+	_, err = fetcher.FetchLinkMetadata(ctx, redirect.URL)
+	require.ErrorIs(t, err, ErrNonPublicAddress)
+
+	// The timeout bounds slow pages.
+	slow := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer slow.Close()
+	slowAddr := netip.MustParseAddrPort(slow.Listener.Addr().String()).Addr()
+	fetcher = newSafeFetcher(100*time.Millisecond, 1024, func(addr netip.Addr) bool { return addr == slowAddr })
+	start := time.Now()
+	_, err = fetcher.FetchLinkMetadata(ctx, slow.URL)
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 2*time.Second)
+}
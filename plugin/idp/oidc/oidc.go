@@ -0,0 +1,274 @@
+// Package oidc is the plugin for OpenID Connect Identity Provider.
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/yourselfhosted/slash/plugin/idp"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// defaultScopes are the scopes requested besides openid when the config has none.
+var defaultScopes = []string{"email", "profile"}
+
+// IdentityProvider represents an OpenID Connect Identity Provider.
+type IdentityProvider struct {
+	config *storepb.IdentityProviderConfig_OIDCConfig
+}
+
+// NewIdentityProvider initializes a new OpenID Connect Identity Provider with the given configuration.
+func NewIdentityProvider(config *storepb.IdentityProviderConfig_OIDCConfig) (*IdentityProvider, error) {
+	if config == nil {
+		return nil, errors.New("the oidc config is empty")
+	}
+	for v, field := range map[string]string{
+		config.Issuer:       "issuer",
+		config.ClientId:     "clientId",
+		config.ClientSecret: "clientSecret",
+	} {
+		if v == "" {
+			return nil, errors.Errorf(`the field "%s" is empty but required`, field)
+		}
+	}
+
+	return &IdentityProvider{
+		config: config,
+	}, nil
+}
+
+// ProviderMetadata is the part of the OpenID provider configuration used to sign in.
+type ProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Discover returns the provider configuration the issuer serves.
+func (p *IdentityProvider) Discover(ctx context.Context) (*ProviderMetadata, error) {
+	metadata := &ProviderMetadata{}
+	if err := getJSON(ctx, strings.TrimSuffix(p.config.Issuer, "/")+"/.well-known/openid-configuration", metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to get provider configuration")
+	}
+	// The issuer of the configuration must be the configured one, or its tokens would be trusted for another provider.
+	if metadata.Issuer != p.config.Issuer {
+		return nil, errors.Errorf("the provider configuration is for the issuer %q instead of %q", metadata.Issuer, p.config.Issuer)
+	}
+	if metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, errors.New("the provider configuration has no token endpoint or jwks uri")
+	}
+	return metadata, nil
+}
+
+// ExchangeToken exchanges the authorization code for an ID token, and returns the user information of its verified claims.
+// The identifier is the subject of the user at the provider.
+func (p *IdentityProvider) ExchangeToken(ctx context.Context, redirectURL, code string) (*idp.IdentityProviderUserInfo, error) {
+	metadata, err := p.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scopes := p.config.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+	conf := &oauth2.Config{
+		ClientID:     p.config.ClientId,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       append([]string{"openid"}, scopes...),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   metadata.AuthorizationEndpoint,
+			TokenURL:  metadata.TokenEndpoint,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+	token, err := conf.Exchange(ctx, code)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to exchange id token")
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New(`missing "id_token" from authorization response`)
+	}
+
+	claims, err := p.verifyIDToken(ctx, metadata, rawIDToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to verify id token")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New(`the id token has no "sub" claim`)
+	}
+	if claims.Email == "" {
+		return nil, errors.New(`the id token has no "email" claim`)
+	}
+	if !claims.isEmailVerified() {
+		return nil, errors.Errorf("the email %q is not verified by the provider", claims.Email)
+	}
+	userInfo := &idp.IdentityProviderUserInfo{
+		Identifier:  claims.Subject,
+		Email:       claims.Email,
+		DisplayName: claims.Name,
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = claims.Email
+	}
+	return userInfo, nil
+}
+
+// idTokenClaims is the claims of an ID token used to sign in.
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Email string `json:"email"`
+	// EmailVerified is a boolean, or a string for some providers.
+	EmailVerified any    `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+func (c *idTokenClaims) isEmailVerified() bool {
+	switch v := c.EmailVerified.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
+
+// verifyIDToken returns the claims of the ID token once its signature is verified with the keys of the provider,
+// and it is checked to be issued by the provider for the client and not expired.
+func (p *IdentityProvider) verifyIDToken(ctx context.Context, metadata *ProviderMetadata, rawIDToken string) (*idTokenClaims, error) {
+	keySet := &jsonWebKeySet{}
+	if err := getJSON(ctx, metadata.JWKSURI, keySet); err != nil {
+		return nil, errors.Wrap(err, "failed to get json web key set")
+	}
+	claims := &idTokenClaims{}
+	if _, err := jwt.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return keySet.getPublicKey(kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(p.config.ClientId),
+		jwt.WithExpirationRequired(),
+	); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+type jsonWebKeySet struct {
+	Keys []*jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// getPublicKey returns the signing key with the key ID, or the only signing key of the set when the token has no key ID.
+func (s *jsonWebKeySet) getPublicKey(kid string) (any, error) {
+	keys := []*jsonWebKey{}
+	for _, key := range s.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		if kid == "" || key.Kid == kid {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) != 1 {
+		return nil, errors.Errorf("no single signing key with the key id %q", kid)
+	}
+	return keys[0].publicKey()
+}
+
+func (k *jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid rsa modulus")
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil || !e.IsInt64() {
+			return nil, errors.New("invalid rsa exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid ec x coordinate")
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid ec y coordinate")
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("the ec point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to new http request")
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %s", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return errors.Wrap(err, "failed to decode response body")
+	}
+	return nil
+}
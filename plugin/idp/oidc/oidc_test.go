@@ -0,0 +1,201 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/plugin/idp"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+func TestNewIdentityProvider(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *storepb.IdentityProviderConfig_OIDCConfig
+		containsErr string
+	}{
+		{
+			name:        "no config",
+			config:      nil,
+			containsErr: "the oidc config is empty",
+		},
+		{
+			name: "no issuer",
+			config: &storepb.IdentityProviderConfig_OIDCConfig{
+				ClientId:     "test-client-id",
+				ClientSecret: "test-client-secret",
+			},
+			containsErr: `the field "issuer" is empty but required`,
+		},
+		{
+			name: "no client secret",
+			config: &storepb.IdentityProviderConfig_OIDCConfig{
+				Issuer:   "https://accounts.example.com",
+				ClientId: "test-client-id",
+			},
+			containsErr: `the field "clientSecret" is empty but required`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewIdentityProvider(test.config)
+			assert.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
+
+// newMockProvider returns an OpenID provider publishing the key, whose token endpoint returns the ID token with the claims
+// signed by the signing key.
+func newMockProvider(t *testing.T, key, signingKey *rsa.PrivateKey, code string, claims func(issuer string) jwt.MapClaims) *httptest.Server {
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                 s.URL,
+			"authorization_endpoint": s.URL + "/authorize",
+			"token_endpoint":         s.URL + "/token",
+			"jwks_uri":               s.URL + "/jwks",
+		}))
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]any{{
+				"kty": "RSA",
+				"kid": "test-key",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		}))
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("code") != code {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims(s.URL))
+		token.Header["kid"] = "test-key"
+		rawIDToken, err := token.SignedString(signingKey)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "test-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     rawIDToken,
+		}))
+	})
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	const (
+		testClientID = "test-client-id"
+		testCode     = "test-code"
+	)
+	validClaims := func(issuer string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            issuer,
+			"sub":            "user-1",
+			"aud":            testClientID,
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"email":          "john.doe@example.com",
+			"email_verified": true,
+			"name":           "John Doe",
+		}
+	}
+	newIdentityProvider := func(s *httptest.Server) *IdentityProvider {
+		identityProvider, err := NewIdentityProvider(&storepb.IdentityProviderConfig_OIDCConfig{
+			Issuer:       s.URL,
+			ClientId:     testClientID,
+			ClientSecret: "test-client-secret",
+		})
+		require.NoError(t, err)
+		return identityProvider
+	}
+
+	s := newMockProvider(t, key, key, testCode, validClaims)
+	userInfo, err := newIdentityProvider(s).ExchangeToken(ctx, "https://example.com/auth/callback", testCode)
+	require.NoError(t, err)
+	assert.Equal(t, &idp.IdentityProviderUserInfo{
+		Identifier:  "user-1",
+		Email:       "john.doe@example.com",
+		DisplayName: "John Doe",
+	}, userInfo)
+
+	_, err = newIdentityProvider(s).ExchangeToken(ctx, "https://example.com/auth/callback", "wrong-code")
+	assert.ErrorContains(t, err, "failed to exchange id token")
+
+	tests := []struct {
+		name        string
+		signingKey  *rsa.PrivateKey
+		claims      func(issuer string) jwt.MapClaims
+		containsErr string
+	}{
+		{
+			name:       "unverified email",
+			signingKey: key,
+			claims: func(issuer string) jwt.MapClaims {
+				claims := validClaims(issuer)
+				claims["email_verified"] = "false"
+				return claims
+			},
+			containsErr: "is not verified",
+		},
+		{
+			name:       "other audience",
+			signingKey: key,
+			claims: func(issuer string) jwt.MapClaims {
+				claims := validClaims(issuer)
+				claims["aud"] = "other-client-id"
+				return claims
+			},
+			containsErr: "failed to verify id token",
+		},
+		{
+			name:       "expired",
+			signingKey: key,
+			claims: func(issuer string) jwt.MapClaims {
+				claims := validClaims(issuer)
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+				return claims
+			},
+			containsErr: "failed to verify id token",
+		},
+		{
+			name:        "other signing key",
+			signingKey:  otherKey,
+			claims:      validClaims,
+			containsErr: "failed to verify id token",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newMockProvider(t, key, test.signingKey, testCode, test.claims)
+			_, err := newIdentityProvider(s).ExchangeToken(ctx, "https://example.com/auth/callback", testCode)
+			assert.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
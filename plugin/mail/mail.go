@@ -89,6 +89,16 @@ func (e *Email) SetBody(body string) *Email {
 	return e
 }
 
+// To returns the to addresses of the email.
+func (e *Email) To() []string {
+	return e.e.To
+}
+
+// Body returns the html body of the email.
+func (e *Email) Body() string {
+	return string(e.e.HTML)
+}
+
 // The ContentType is the type of the content.
 // https://cloud.google.com/appengine/docs/legacy/standard/php/mail/mail-with-headers-attachments.
 type ContentType string
@@ -134,6 +144,11 @@ const (
 	SMTPEncryptionTypeSTARTTLS
 )
 
+// Mailer sends emails, e.g. the SMTPClient.
+type Mailer interface {
+	SendMail(e *Email) error
+}
+
 // SMTPClient is the client of SMTP.
 type SMTPClient struct {
 	host           string
@@ -0,0 +1,80 @@
+// Package shortener shortens the links of shortcuts through external link shorteners.
+package shortener
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LinkShortener returns a shortened url for a link.
+type LinkShortener interface {
+	Shorten(ctx context.Context, link string) (string, error)
+}
+
+// NoopShortener returns links unchanged.
+type NoopShortener struct{}
+
+func (NoopShortener) Shorten(_ context.Context, link string) (string, error) {
+	return link, nil
+}
+
+const httpShortenerTimeout = 10 * time.Second
+
+// HTTPShortener posts `{"url": "<link>"}` to the endpoint and expects `{"url": "<short url>"}` in return.
+type HTTPShortener struct {
+	Endpoint string
+	Client   *http.Client
+}
+
+func NewHTTPShortener(endpoint string) *HTTPShortener {
+	return &HTTPShortener{
+		Endpoint: endpoint,
+		Client: &http.Client{
+			Timeout: httpShortenerTimeout,
+		},
+	}
+}
+
+type httpShortenerMessage struct {
+	URL string `json:"url"`
+}
+
+func (s *HTTPShortener) Shorten(ctx context.Context, link string) (string, error) {
+	body, err := json.Marshal(&httpShortenerMessage{URL: link})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal request")
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := s.Client.Do(request)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to do request")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return "", errors.Errorf("unexpected status code %d", response.StatusCode)
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read response body")
+	}
+	message := &httpShortenerMessage{}
+	if err := json.Unmarshal(responseBody, message); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal response body")
+	}
+	if u, err := url.Parse(message.URL); err != nil || u.Host == "" {
+		return "", errors.Errorf("invalid shortened url %q", message.URL)
+	}
+	return message.URL, nil
+}
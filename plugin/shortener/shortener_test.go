@@ -0,0 +1,37 @@
+package shortener
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPShortener(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		message := &httpShortenerMessage{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(message))
+		switch message.URL {
+		case "https://example.com/a/very/long/link":
+			_, _ = w.Write([]byte(`{"url": "https://sho.rt/abc"}`))
+		case "https://example.com/invalid":
+			_, _ = w.Write([]byte(`{"url": ""}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	shortener := NewHTTPShortener(server.URL)
+	link, err := shortener.Shorten(ctx, "https://example.com/a/very/long/link")
+	require.NoError(t, err)
+	require.Equal(t, "https://sho.rt/abc", link)
+	_, err = shortener.Shorten(ctx, "https://example.com/invalid")
+	require.Error(t, err)
+	_, err = shortener.Shorten(ctx, "https://example.com/unknown")
+	require.Error(t, err)
+}
@@ -0,0 +1,46 @@
+// Package summarizer summarizes the long descriptions of shortcuts for list views.
+package summarizer
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Summarizer returns a summary of the text of at most maxLength characters.
+type Summarizer interface {
+	Summarize(ctx context.Context, text string, maxLength int) (string, error)
+}
+
+// NoopSummarizer returns texts unchanged, leaving them to be truncated.
+type NoopSummarizer struct{}
+
+func (NoopSummarizer) Summarize(_ context.Context, text string, _ int) (string, error) {
+	return text, nil
+}
+
+const ellipsis = "…"
+
+// Truncate cuts the text to at most maxLength characters, at a word boundary when possible, with an ellipsis.
+func Truncate(text string, maxLength int) string {
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) <= maxLength {
+		return text
+	}
+	if maxLength <= 0 {
+		return ""
+	}
+
+	runes := []rune(text)
+	truncated := string(runes[:maxLength-1])
+	// Drop the partial last word unless the cut falls on a word boundary.
+	if !unicode.IsSpace(runes[maxLength-1]) {
+		if i := strings.LastIndexFunc(truncated, unicode.IsSpace); i > len(truncated)/2 {
+			truncated = truncated[:i]
+		}
+	}
+	return strings.TrimRightFunc(truncated, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + ellipsis
+}
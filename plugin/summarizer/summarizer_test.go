@@ -0,0 +1,42 @@
+package summarizer
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		text      string
+		maxLength int
+		want      string
+	}{
+		{
+			text:      "short",
+			maxLength: 10,
+			want:      "short",
+		},
+		{
+			text:      "the quick brown fox jumps over the lazy dog",
+			maxLength: 20,
+			want:      "the quick brown fox…",
+		},
+		{
+			text:      "averyveryverylongwordwithoutanyspaces",
+			maxLength: 10,
+			want:      "averyvery…",
+		},
+		{
+			text:      "héllo wörld, ünïcode",
+			maxLength: 14,
+			want:      "héllo wörld…",
+		},
+	}
+	for _, test := range tests {
+		got := Truncate(test.text, test.maxLength)
+		require.Equal(t, test.want, got)
+		require.LessOrEqual(t, utf8.RuneCountInString(got), test.maxLength)
+	}
+}
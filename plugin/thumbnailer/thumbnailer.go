@@ -0,0 +1,98 @@
+// Package thumbnailer renders the preview thumbnails of the destinations of shortcuts through external renderers.
+package thumbnailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultMaxSize is the number of bytes of a thumbnail accepted at most.
+const DefaultMaxSize = 1 << 20
+
+// Thumbnail is a preview image of a page.
+type Thumbnail struct {
+	ContentType string
+	Content     []byte
+}
+
+// Thumbnailer renders a thumbnail of the page of a link.
+type Thumbnailer interface {
+	Thumbnail(ctx context.Context, link string) (*Thumbnail, error)
+}
+
+// IsImageContentType returns whether the content type is one of the image formats served as thumbnails.
+func IsImageContentType(contentType string) bool {
+	switch contentType {
+	case "image/png", "image/jpeg", "image/webp", "image/gif":
+		return true
+	}
+	return false
+}
+
+const httpThumbnailerTimeout = 30 * time.Second
+
+// HTTPThumbnailer posts `{"url": "<link>"}` to the endpoint of a renderer and expects the image in return.
+type HTTPThumbnailer struct {
+	Endpoint string
+	Client   *http.Client
+	// MaxSize is the number of bytes of the image read at most, larger images are rejected.
+	MaxSize int64
+}
+
+func NewHTTPThumbnailer(endpoint string) *HTTPThumbnailer {
+	return &HTTPThumbnailer{
+		Endpoint: endpoint,
+		Client: &http.Client{
+			Timeout: httpThumbnailerTimeout,
+		},
+		MaxSize: DefaultMaxSize,
+	}
+}
+
+type httpThumbnailerMessage struct {
+	URL string `json:"url"`
+}
+
+func (t *HTTPThumbnailer) Thumbnail(ctx context.Context, link string) (*Thumbnail, error) {
+	body, err := json.Marshal(&httpThumbnailerMessage{URL: link})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, t.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := t.Client.Do(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to do request")
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, errors.Errorf("unexpected status code %d", response.StatusCode)
+	}
+	contentType, _, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	if err != nil || !IsImageContentType(strings.ToLower(contentType)) {
+		return nil, errors.Errorf("unsupported content type %q", response.Header.Get("Content-Type"))
+	}
+	content, err := io.ReadAll(io.LimitReader(response.Body, t.MaxSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+	if int64(len(content)) > t.MaxSize {
+		return nil, errors.Errorf("thumbnail is larger than %d bytes", t.MaxSize)
+	}
+	return &Thumbnail{
+		ContentType: strings.ToLower(contentType),
+		Content:     content,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package thumbnailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPThumbnailer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		message := &httpThumbnailerMessage{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(message))
+		switch message.URL {
+		case "https://example.com":
+			w.Header().Set("Content-Type", "image/PNG")
+			_, _ = w.Write([]byte("png"))
+		case "https://example.com/large":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(bytes.Repeat([]byte("x"), 16))
+		case "https://example.com/html":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte("<html></html>"))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	thumbnailer := NewHTTPThumbnailer(server.URL)
+	thumbnailer.MaxSize = 8
+	thumbnail, err := thumbnailer.Thumbnail(ctx, "https://example.com")
+	require.NoError(t, err)
+	require.Equal(t, "image/png", thumbnail.ContentType)
+	require.Equal(t, []byte("png"), thumbnail.Content)
+	_, err = thumbnailer.Thumbnail(ctx, "https://example.com/large")
+	require.Error(t, err)
+	_, err = thumbnailer.Thumbnail(ctx, "https://example.com/html")
+	require.Error(t, err)
+	_, err = thumbnailer.Thumbnail(ctx, "https://example.com/unknown")
+	require.Error(t, err)
+}
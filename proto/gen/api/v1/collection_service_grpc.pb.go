@@ -20,12 +20,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CollectionService_ListCollections_FullMethodName     = "/slash.api.v1.CollectionService/ListCollections"
-	CollectionService_GetCollection_FullMethodName       = "/slash.api.v1.CollectionService/GetCollection"
-	CollectionService_GetCollectionByName_FullMethodName = "/slash.api.v1.CollectionService/GetCollectionByName"
-	CollectionService_CreateCollection_FullMethodName    = "/slash.api.v1.CollectionService/CreateCollection"
-	CollectionService_UpdateCollection_FullMethodName    = "/slash.api.v1.CollectionService/UpdateCollection"
-	CollectionService_DeleteCollection_FullMethodName    = "/slash.api.v1.CollectionService/DeleteCollection"
+	CollectionService_ListCollections_FullMethodName              = "/slash.api.v1.CollectionService/ListCollections"
+	CollectionService_GetCollection_FullMethodName                = "/slash.api.v1.CollectionService/GetCollection"
+	CollectionService_GetCollectionByName_FullMethodName          = "/slash.api.v1.CollectionService/GetCollectionByName"
+	CollectionService_CreateCollection_FullMethodName             = "/slash.api.v1.CollectionService/CreateCollection"
+	CollectionService_UpdateCollection_FullMethodName             = "/slash.api.v1.CollectionService/UpdateCollection"
+	CollectionService_DeleteCollection_FullMethodName             = "/slash.api.v1.CollectionService/DeleteCollection"
+	CollectionService_AddShortcutToCollection_FullMethodName      = "/slash.api.v1.CollectionService/AddShortcutToCollection"
+	CollectionService_RemoveShortcutFromCollection_FullMethodName = "/slash.api.v1.CollectionService/RemoveShortcutFromCollection"
+	CollectionService_CreateCollectionShareToken_FullMethodName   = "/slash.api.v1.CollectionService/CreateCollectionShareToken"
+	CollectionService_DeleteCollectionShareToken_FullMethodName   = "/slash.api.v1.CollectionService/DeleteCollectionShareToken"
+	CollectionService_GetCollectionByShareToken_FullMethodName    = "/slash.api.v1.CollectionService/GetCollectionByShareToken"
 )
 
 // CollectionServiceClient is the client API for CollectionService service.
@@ -44,6 +49,16 @@ type CollectionServiceClient interface {
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
 	// DeleteCollection deletes a collection by id.
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AddShortcutToCollection appends a shortcut to a collection, unless the collection already has it.
+	AddShortcutToCollection(ctx context.Context, in *AddShortcutToCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// RemoveShortcutFromCollection removes a shortcut from a collection, if the collection has it.
+	RemoveShortcutFromCollection(ctx context.Context, in *RemoveShortcutFromCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// CreateCollectionShareToken generates the share token of a collection, revoking the previous one.
+	CreateCollectionShareToken(ctx context.Context, in *CreateCollectionShareTokenRequest, opts ...grpc.CallOption) (*CreateCollectionShareTokenResponse, error)
+	// DeleteCollectionShareToken revokes the share token of a collection.
+	DeleteCollectionShareToken(ctx context.Context, in *DeleteCollectionShareTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetCollectionByShareToken returns a shared collection and its shortcuts, without authentication.
+	GetCollectionByShareToken(ctx context.Context, in *GetCollectionByShareTokenRequest, opts ...grpc.CallOption) (*GetCollectionByShareTokenResponse, error)
 }
 
 type collectionServiceClient struct {
@@ -114,6 +129,56 @@ func (c *collectionServiceClient) DeleteCollection(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *collectionServiceClient) AddShortcutToCollection(ctx context.Context, in *AddShortcutToCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_AddShortcutToCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) RemoveShortcutFromCollection(ctx context.Context, in *RemoveShortcutFromCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_RemoveShortcutFromCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) CreateCollectionShareToken(ctx context.Context, in *CreateCollectionShareTokenRequest, opts ...grpc.CallOption) (*CreateCollectionShareTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCollectionShareTokenResponse)
+	err := c.cc.Invoke(ctx, CollectionService_CreateCollectionShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) DeleteCollectionShareToken(ctx context.Context, in *DeleteCollectionShareTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_DeleteCollectionShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GetCollectionByShareToken(ctx context.Context, in *GetCollectionByShareTokenRequest, opts ...grpc.CallOption) (*GetCollectionByShareTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionByShareTokenResponse)
+	err := c.cc.Invoke(ctx, CollectionService_GetCollectionByShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CollectionServiceServer is the server API for CollectionService service.
 // All implementations must embed UnimplementedCollectionServiceServer
 // for forward compatibility.
@@ -130,6 +195,16 @@ type CollectionServiceServer interface {
 	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error)
 	// DeleteCollection deletes a collection by id.
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error)
+	// AddShortcutToCollection appends a shortcut to a collection, unless the collection already has it.
+	AddShortcutToCollection(context.Context, *AddShortcutToCollectionRequest) (*Collection, error)
+	// RemoveShortcutFromCollection removes a shortcut from a collection, if the collection has it.
+	RemoveShortcutFromCollection(context.Context, *RemoveShortcutFromCollectionRequest) (*Collection, error)
+	// CreateCollectionShareToken generates the share token of a collection, revoking the previous one.
+	CreateCollectionShareToken(context.Context, *CreateCollectionShareTokenRequest) (*CreateCollectionShareTokenResponse, error)
+	// DeleteCollectionShareToken revokes the share token of a collection.
+	DeleteCollectionShareToken(context.Context, *DeleteCollectionShareTokenRequest) (*emptypb.Empty, error)
+	// GetCollectionByShareToken returns a shared collection and its shortcuts, without authentication.
+	GetCollectionByShareToken(context.Context, *GetCollectionByShareTokenRequest) (*GetCollectionByShareTokenResponse, error)
 	mustEmbedUnimplementedCollectionServiceServer()
 }
 
@@ -158,6 +233,21 @@ func (UnimplementedCollectionServiceServer) UpdateCollection(context.Context, *U
 func (UnimplementedCollectionServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
 }
+func (UnimplementedCollectionServiceServer) AddShortcutToCollection(context.Context, *AddShortcutToCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddShortcutToCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) RemoveShortcutFromCollection(context.Context, *RemoveShortcutFromCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveShortcutFromCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) CreateCollectionShareToken(context.Context, *CreateCollectionShareTokenRequest) (*CreateCollectionShareTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollectionShareToken not implemented")
+}
+func (UnimplementedCollectionServiceServer) DeleteCollectionShareToken(context.Context, *DeleteCollectionShareTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollectionShareToken not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetCollectionByShareToken(context.Context, *GetCollectionByShareTokenRequest) (*GetCollectionByShareTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionByShareToken not implemented")
+}
 func (UnimplementedCollectionServiceServer) mustEmbedUnimplementedCollectionServiceServer() {}
 func (UnimplementedCollectionServiceServer) testEmbeddedByValue()                           {}
 
@@ -287,6 +377,96 @@ func _CollectionService_DeleteCollection_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CollectionService_AddShortcutToCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddShortcutToCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).AddShortcutToCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_AddShortcutToCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).AddShortcutToCollection(ctx, req.(*AddShortcutToCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_RemoveShortcutFromCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveShortcutFromCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).RemoveShortcutFromCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_RemoveShortcutFromCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).RemoveShortcutFromCollection(ctx, req.(*RemoveShortcutFromCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_CreateCollectionShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionShareTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).CreateCollectionShareToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_CreateCollectionShareToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).CreateCollectionShareToken(ctx, req.(*CreateCollectionShareTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_DeleteCollectionShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionShareTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).DeleteCollectionShareToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_DeleteCollectionShareToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).DeleteCollectionShareToken(ctx, req.(*DeleteCollectionShareTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_GetCollectionByShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionByShareTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GetCollectionByShareToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_GetCollectionByShareToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GetCollectionByShareToken(ctx, req.(*GetCollectionByShareTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CollectionService_ServiceDesc is the grpc.ServiceDesc for CollectionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -318,6 +498,26 @@ var CollectionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCollection",
 			Handler:    _CollectionService_DeleteCollection_Handler,
 		},
+		{
+			MethodName: "AddShortcutToCollection",
+			Handler:    _CollectionService_AddShortcutToCollection_Handler,
+		},
+		{
+			MethodName: "RemoveShortcutFromCollection",
+			Handler:    _CollectionService_RemoveShortcutFromCollection_Handler,
+		},
+		{
+			MethodName: "CreateCollectionShareToken",
+			Handler:    _CollectionService_CreateCollectionShareToken_Handler,
+		},
+		{
+			MethodName: "DeleteCollectionShareToken",
+			Handler:    _CollectionService_DeleteCollectionShareToken_Handler,
+		},
+		{
+			MethodName: "GetCollectionByShareToken",
+			Handler:    _CollectionService_GetCollectionByShareToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/collection_service.proto",
@@ -8,6 +8,7 @@ package apiv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,13 +21,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ShortcutService_ListShortcuts_FullMethodName        = "/slash.api.v1.ShortcutService/ListShortcuts"
-	ShortcutService_GetShortcut_FullMethodName          = "/slash.api.v1.ShortcutService/GetShortcut"
-	ShortcutService_GetShortcutByName_FullMethodName    = "/slash.api.v1.ShortcutService/GetShortcutByName"
-	ShortcutService_CreateShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/CreateShortcut"
-	ShortcutService_UpdateShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/UpdateShortcut"
-	ShortcutService_DeleteShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/DeleteShortcut"
-	ShortcutService_GetShortcutAnalytics_FullMethodName = "/slash.api.v1.ShortcutService/GetShortcutAnalytics"
+	ShortcutService_ListShortcuts_FullMethodName            = "/slash.api.v1.ShortcutService/ListShortcuts"
+	ShortcutService_StreamShortcuts_FullMethodName          = "/slash.api.v1.ShortcutService/StreamShortcuts"
+	ShortcutService_GetShortcut_FullMethodName              = "/slash.api.v1.ShortcutService/GetShortcut"
+	ShortcutService_GetShortcutByName_FullMethodName        = "/slash.api.v1.ShortcutService/GetShortcutByName"
+	ShortcutService_CreateShortcut_FullMethodName           = "/slash.api.v1.ShortcutService/CreateShortcut"
+	ShortcutService_ApplyShortcut_FullMethodName            = "/slash.api.v1.ShortcutService/ApplyShortcut"
+	ShortcutService_UpdateShortcut_FullMethodName           = "/slash.api.v1.ShortcutService/UpdateShortcut"
+	ShortcutService_DeleteShortcut_FullMethodName           = "/slash.api.v1.ShortcutService/DeleteShortcut"
+	ShortcutService_RestoreShortcut_FullMethodName          = "/slash.api.v1.ShortcutService/RestoreShortcut"
+	ShortcutService_GetShortcutAnalytics_FullMethodName     = "/slash.api.v1.ShortcutService/GetShortcutAnalytics"
+	ShortcutService_GetShortcutQRCode_FullMethodName        = "/slash.api.v1.ShortcutService/GetShortcutQRCode"
+	ShortcutService_GetShortcutThumbnail_FullMethodName     = "/slash.api.v1.ShortcutService/GetShortcutThumbnail"
+	ShortcutService_ListShortcutAccess_FullMethodName       = "/slash.api.v1.ShortcutService/ListShortcutAccess"
+	ShortcutService_ImportShortcutsCSV_FullMethodName       = "/slash.api.v1.ShortcutService/ImportShortcutsCSV"
+	ShortcutService_PreviewImport_FullMethodName            = "/slash.api.v1.ShortcutService/PreviewImport"
+	ShortcutService_CanonicalizeLink_FullMethodName         = "/slash.api.v1.ShortcutService/CanonicalizeLink"
+	ShortcutService_BatchRelinkShortcuts_FullMethodName     = "/slash.api.v1.ShortcutService/BatchRelinkShortcuts"
+	ShortcutService_GetUserShortcutDashboard_FullMethodName = "/slash.api.v1.ShortcutService/GetUserShortcutDashboard"
+	ShortcutService_ExportShortcuts_FullMethodName          = "/slash.api.v1.ShortcutService/ExportShortcuts"
 )
 
 // ShortcutServiceClient is the client API for ShortcutService service.
@@ -35,18 +48,48 @@ const (
 type ShortcutServiceClient interface {
 	// ListShortcuts returns a list of shortcuts.
 	ListShortcuts(ctx context.Context, in *ListShortcutsRequest, opts ...grpc.CallOption) (*ListShortcutsResponse, error)
+	// StreamShortcuts streams all the shortcuts ListShortcuts lists for the caller, one page per message.
+	StreamShortcuts(ctx context.Context, in *StreamShortcutsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamShortcutsResponse], error)
 	// GetShortcut returns a shortcut by id.
 	GetShortcut(ctx context.Context, in *GetShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
-	// GetShortcutByName returns a shortcut by name.
+	// GetShortcutByName returns the shortcut the name resolves to for the caller: their private shortcut with the name
+	// first, then the workspace or public one.
 	GetShortcutByName(ctx context.Context, in *GetShortcutByNameRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// CreateShortcut creates a shortcut.
 	CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
+	// ApplyShortcut creates the shortcut if its name is free, or updates the caller's shortcut with the same name.
+	ApplyShortcut(ctx context.Context, in *ApplyShortcutRequest, opts ...grpc.CallOption) (*ApplyShortcutResponse, error)
 	// UpdateShortcut updates a shortcut.
 	UpdateShortcut(ctx context.Context, in *UpdateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
-	// DeleteShortcut deletes a shortcut by name.
+	// DeleteShortcut archives a shortcut by id or name, or removes it when permanent.
 	DeleteShortcut(ctx context.Context, in *DeleteShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RestoreShortcut restores an archived shortcut.
+	RestoreShortcut(ctx context.Context, in *RestoreShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// GetShortcutAnalytics returns the analytics for a shortcut.
 	GetShortcutAnalytics(ctx context.Context, in *GetShortcutAnalyticsRequest, opts ...grpc.CallOption) (*GetShortcutAnalyticsResponse, error)
+	// GetShortcutQRCode returns a QR code image of the short URL of a shortcut on the instance URL.
+	GetShortcutQRCode(ctx context.Context, in *GetShortcutQRCodeRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// GetShortcutThumbnail returns the preview thumbnail of the link of a shortcut.
+	// The thumbnails are rendered in the background after the link is set, NotFound until then.
+	GetShortcutThumbnail(ctx context.Context, in *GetShortcutThumbnailRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// ListShortcutAccess returns who can currently read a shortcut.
+	ListShortcutAccess(ctx context.Context, in *ListShortcutAccessRequest, opts ...grpc.CallOption) (*ListShortcutAccessResponse, error)
+	// ImportShortcutsCSV creates shortcuts from the rows of a CSV file in a single transaction.
+	// Invalid rows fail on their own and are reported in the results without writing them.
+	ImportShortcutsCSV(ctx context.Context, in *ImportShortcutsCSVRequest, opts ...grpc.CallOption) (*ImportShortcutsCSVResponse, error)
+	// PreviewImport reports what ImportShortcutsCSV would do with each row, without writing anything.
+	PreviewImport(ctx context.Context, in *PreviewImportRequest, opts ...grpc.CallOption) (*PreviewImportResponse, error)
+	// CanonicalizeLink returns the canonical form of a link, used to compare links and to detect the shortcuts to the same destination.
+	CanonicalizeLink(ctx context.Context, in *CanonicalizeLinkRequest, opts ...grpc.CallOption) (*CanonicalizeLinkResponse, error)
+	// BatchRelinkShortcuts replaces the pattern in the links of the active shortcuts of the caller, or of all of them for admins,
+	// in a single transaction. It fails without writing anything when any of the resulting links is invalid.
+	BatchRelinkShortcuts(ctx context.Context, in *BatchRelinkShortcutsRequest, opts ...grpc.CallOption) (*BatchRelinkShortcutsResponse, error)
+	// GetUserShortcutDashboard returns the favorite, recently visited and recently created shortcuts of the caller
+	// in a single response.
+	GetUserShortcutDashboard(ctx context.Context, in *GetUserShortcutDashboardRequest, opts ...grpc.CallOption) (*GetUserShortcutDashboardResponse, error)
+	// ExportShortcuts streams the shortcuts created by the caller, or all the shortcuts for admins, in order of name,
+	// one CSV row or JSON line per chunk.
+	ExportShortcuts(ctx context.Context, in *ExportShortcutsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
 }
 
 type shortcutServiceClient struct {
@@ -67,6 +110,25 @@ func (c *shortcutServiceClient) ListShortcuts(ctx context.Context, in *ListShort
 	return out, nil
 }
 
+func (c *shortcutServiceClient) StreamShortcuts(ctx context.Context, in *StreamShortcutsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamShortcutsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ShortcutService_ServiceDesc.Streams[0], ShortcutService_StreamShortcuts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamShortcutsRequest, StreamShortcutsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ShortcutService_StreamShortcutsClient = grpc.ServerStreamingClient[StreamShortcutsResponse]
+
 func (c *shortcutServiceClient) GetShortcut(ctx context.Context, in *GetShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Shortcut)
@@ -97,6 +159,16 @@ func (c *shortcutServiceClient) CreateShortcut(ctx context.Context, in *CreateSh
 	return out, nil
 }
 
+func (c *shortcutServiceClient) ApplyShortcut(ctx context.Context, in *ApplyShortcutRequest, opts ...grpc.CallOption) (*ApplyShortcutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyShortcutResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ApplyShortcut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortcutServiceClient) UpdateShortcut(ctx context.Context, in *UpdateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Shortcut)
@@ -117,6 +189,16 @@ func (c *shortcutServiceClient) DeleteShortcut(ctx context.Context, in *DeleteSh
 	return out, nil
 }
 
+func (c *shortcutServiceClient) RestoreShortcut(ctx context.Context, in *RestoreShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shortcut)
+	err := c.cc.Invoke(ctx, ShortcutService_RestoreShortcut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortcutServiceClient) GetShortcutAnalytics(ctx context.Context, in *GetShortcutAnalyticsRequest, opts ...grpc.CallOption) (*GetShortcutAnalyticsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetShortcutAnalyticsResponse)
@@ -127,24 +209,153 @@ func (c *shortcutServiceClient) GetShortcutAnalytics(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *shortcutServiceClient) GetShortcutQRCode(ctx context.Context, in *GetShortcutQRCodeRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ShortcutService_GetShortcutQRCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) GetShortcutThumbnail(ctx context.Context, in *GetShortcutThumbnailRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ShortcutService_GetShortcutThumbnail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutAccess(ctx context.Context, in *ListShortcutAccessRequest, opts ...grpc.CallOption) (*ListShortcutAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutAccessResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ImportShortcutsCSV(ctx context.Context, in *ImportShortcutsCSVRequest, opts ...grpc.CallOption) (*ImportShortcutsCSVResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportShortcutsCSVResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ImportShortcutsCSV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) PreviewImport(ctx context.Context, in *PreviewImportRequest, opts ...grpc.CallOption) (*PreviewImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewImportResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_PreviewImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) CanonicalizeLink(ctx context.Context, in *CanonicalizeLinkRequest, opts ...grpc.CallOption) (*CanonicalizeLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CanonicalizeLinkResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_CanonicalizeLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) BatchRelinkShortcuts(ctx context.Context, in *BatchRelinkShortcutsRequest, opts ...grpc.CallOption) (*BatchRelinkShortcutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchRelinkShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_BatchRelinkShortcuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) GetUserShortcutDashboard(ctx context.Context, in *GetUserShortcutDashboardRequest, opts ...grpc.CallOption) (*GetUserShortcutDashboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserShortcutDashboardResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_GetUserShortcutDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ExportShortcuts(ctx context.Context, in *ExportShortcutsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ShortcutService_ServiceDesc.Streams[1], ShortcutService_ExportShortcuts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportShortcutsRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ShortcutService_ExportShortcutsClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
 // ShortcutServiceServer is the server API for ShortcutService service.
 // All implementations must embed UnimplementedShortcutServiceServer
 // for forward compatibility.
 type ShortcutServiceServer interface {
 	// ListShortcuts returns a list of shortcuts.
 	ListShortcuts(context.Context, *ListShortcutsRequest) (*ListShortcutsResponse, error)
+	// StreamShortcuts streams all the shortcuts ListShortcuts lists for the caller, one page per message.
+	StreamShortcuts(*StreamShortcutsRequest, grpc.ServerStreamingServer[StreamShortcutsResponse]) error
 	// GetShortcut returns a shortcut by id.
 	GetShortcut(context.Context, *GetShortcutRequest) (*Shortcut, error)
-	// GetShortcutByName returns a shortcut by name.
+	// GetShortcutByName returns the shortcut the name resolves to for the caller: their private shortcut with the name
+	// first, then the workspace or public one.
 	GetShortcutByName(context.Context, *GetShortcutByNameRequest) (*Shortcut, error)
 	// CreateShortcut creates a shortcut.
 	CreateShortcut(context.Context, *CreateShortcutRequest) (*Shortcut, error)
+	// ApplyShortcut creates the shortcut if its name is free, or updates the caller's shortcut with the same name.
+	ApplyShortcut(context.Context, *ApplyShortcutRequest) (*ApplyShortcutResponse, error)
 	// UpdateShortcut updates a shortcut.
 	UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error)
-	// DeleteShortcut deletes a shortcut by name.
+	// DeleteShortcut archives a shortcut by id or name, or removes it when permanent.
 	DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error)
+	// RestoreShortcut restores an archived shortcut.
+	RestoreShortcut(context.Context, *RestoreShortcutRequest) (*Shortcut, error)
 	// GetShortcutAnalytics returns the analytics for a shortcut.
 	GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error)
+	// GetShortcutQRCode returns a QR code image of the short URL of a shortcut on the instance URL.
+	GetShortcutQRCode(context.Context, *GetShortcutQRCodeRequest) (*httpbody.HttpBody, error)
+	// GetShortcutThumbnail returns the preview thumbnail of the link of a shortcut.
+	// The thumbnails are rendered in the background after the link is set, NotFound until then.
+	GetShortcutThumbnail(context.Context, *GetShortcutThumbnailRequest) (*httpbody.HttpBody, error)
+	// ListShortcutAccess returns who can currently read a shortcut.
+	ListShortcutAccess(context.Context, *ListShortcutAccessRequest) (*ListShortcutAccessResponse, error)
+	// ImportShortcutsCSV creates shortcuts from the rows of a CSV file in a single transaction.
+	// Invalid rows fail on their own and are reported in the results without writing them.
+	ImportShortcutsCSV(context.Context, *ImportShortcutsCSVRequest) (*ImportShortcutsCSVResponse, error)
+	// PreviewImport reports what ImportShortcutsCSV would do with each row, without writing anything.
+	PreviewImport(context.Context, *PreviewImportRequest) (*PreviewImportResponse, error)
+	// CanonicalizeLink returns the canonical form of a link, used to compare links and to detect the shortcuts to the same destination.
+	CanonicalizeLink(context.Context, *CanonicalizeLinkRequest) (*CanonicalizeLinkResponse, error)
+	// BatchRelinkShortcuts replaces the pattern in the links of the active shortcuts of the caller, or of all of them for admins,
+	// in a single transaction. It fails without writing anything when any of the resulting links is invalid.
+	BatchRelinkShortcuts(context.Context, *BatchRelinkShortcutsRequest) (*BatchRelinkShortcutsResponse, error)
+	// GetUserShortcutDashboard returns the favorite, recently visited and recently created shortcuts of the caller
+	// in a single response.
+	GetUserShortcutDashboard(context.Context, *GetUserShortcutDashboardRequest) (*GetUserShortcutDashboardResponse, error)
+	// ExportShortcuts streams the shortcuts created by the caller, or all the shortcuts for admins, in order of name,
+	// one CSV row or JSON line per chunk.
+	ExportShortcuts(*ExportShortcutsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
 	mustEmbedUnimplementedShortcutServiceServer()
 }
 
@@ -158,6 +369,9 @@ type UnimplementedShortcutServiceServer struct{}
 func (UnimplementedShortcutServiceServer) ListShortcuts(context.Context, *ListShortcutsRequest) (*ListShortcutsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListShortcuts not implemented")
 }
+func (UnimplementedShortcutServiceServer) StreamShortcuts(*StreamShortcutsRequest, grpc.ServerStreamingServer[StreamShortcutsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamShortcuts not implemented")
+}
 func (UnimplementedShortcutServiceServer) GetShortcut(context.Context, *GetShortcutRequest) (*Shortcut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShortcut not implemented")
 }
@@ -167,15 +381,48 @@ func (UnimplementedShortcutServiceServer) GetShortcutByName(context.Context, *Ge
 func (UnimplementedShortcutServiceServer) CreateShortcut(context.Context, *CreateShortcutRequest) (*Shortcut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateShortcut not implemented")
 }
+func (UnimplementedShortcutServiceServer) ApplyShortcut(context.Context, *ApplyShortcutRequest) (*ApplyShortcutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyShortcut not implemented")
+}
 func (UnimplementedShortcutServiceServer) UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateShortcut not implemented")
 }
 func (UnimplementedShortcutServiceServer) DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteShortcut not implemented")
 }
+func (UnimplementedShortcutServiceServer) RestoreShortcut(context.Context, *RestoreShortcutRequest) (*Shortcut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreShortcut not implemented")
+}
 func (UnimplementedShortcutServiceServer) GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutAnalytics not implemented")
 }
+func (UnimplementedShortcutServiceServer) GetShortcutQRCode(context.Context, *GetShortcutQRCodeRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutQRCode not implemented")
+}
+func (UnimplementedShortcutServiceServer) GetShortcutThumbnail(context.Context, *GetShortcutThumbnailRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutThumbnail not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutAccess(context.Context, *ListShortcutAccessRequest) (*ListShortcutAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutAccess not implemented")
+}
+func (UnimplementedShortcutServiceServer) ImportShortcutsCSV(context.Context, *ImportShortcutsCSVRequest) (*ImportShortcutsCSVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportShortcutsCSV not implemented")
+}
+func (UnimplementedShortcutServiceServer) PreviewImport(context.Context, *PreviewImportRequest) (*PreviewImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewImport not implemented")
+}
+func (UnimplementedShortcutServiceServer) CanonicalizeLink(context.Context, *CanonicalizeLinkRequest) (*CanonicalizeLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalizeLink not implemented")
+}
+func (UnimplementedShortcutServiceServer) BatchRelinkShortcuts(context.Context, *BatchRelinkShortcutsRequest) (*BatchRelinkShortcutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRelinkShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) GetUserShortcutDashboard(context.Context, *GetUserShortcutDashboardRequest) (*GetUserShortcutDashboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserShortcutDashboard not implemented")
+}
+func (UnimplementedShortcutServiceServer) ExportShortcuts(*ExportShortcutsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportShortcuts not implemented")
+}
 func (UnimplementedShortcutServiceServer) mustEmbedUnimplementedShortcutServiceServer() {}
 func (UnimplementedShortcutServiceServer) testEmbeddedByValue()                         {}
 
@@ -215,6 +462,17 @@ func _ShortcutService_ListShortcuts_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_StreamShortcuts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamShortcutsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ShortcutServiceServer).StreamShortcuts(m, &grpc.GenericServerStream[StreamShortcutsRequest, StreamShortcutsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ShortcutService_StreamShortcutsServer = grpc.ServerStreamingServer[StreamShortcutsResponse]
+
 func _ShortcutService_GetShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetShortcutRequest)
 	if err := dec(in); err != nil {
@@ -269,6 +527,24 @@ func _ShortcutService_CreateShortcut_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_ApplyShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ApplyShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ApplyShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ApplyShortcut(ctx, req.(*ApplyShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShortcutService_UpdateShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateShortcutRequest)
 	if err := dec(in); err != nil {
@@ -305,6 +581,24 @@ func _ShortcutService_DeleteShortcut_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_RestoreShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).RestoreShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_RestoreShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).RestoreShortcut(ctx, req.(*RestoreShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShortcutService_GetShortcutAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetShortcutAnalyticsRequest)
 	if err := dec(in); err != nil {
@@ -323,6 +617,161 @@ func _ShortcutService_GetShortcutAnalytics_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_GetShortcutQRCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortcutQRCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).GetShortcutQRCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_GetShortcutQRCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).GetShortcutQRCode(ctx, req.(*GetShortcutQRCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_GetShortcutThumbnail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortcutThumbnailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).GetShortcutThumbnail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_GetShortcutThumbnail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).GetShortcutThumbnail(ctx, req.(*GetShortcutThumbnailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutAccess(ctx, req.(*ListShortcutAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ImportShortcutsCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportShortcutsCSVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ImportShortcutsCSV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ImportShortcutsCSV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ImportShortcutsCSV(ctx, req.(*ImportShortcutsCSVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_PreviewImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).PreviewImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_PreviewImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).PreviewImport(ctx, req.(*PreviewImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_CanonicalizeLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanonicalizeLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).CanonicalizeLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_CanonicalizeLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).CanonicalizeLink(ctx, req.(*CanonicalizeLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_BatchRelinkShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRelinkShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).BatchRelinkShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_BatchRelinkShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).BatchRelinkShortcuts(ctx, req.(*BatchRelinkShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_GetUserShortcutDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserShortcutDashboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).GetUserShortcutDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_GetUserShortcutDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).GetUserShortcutDashboard(ctx, req.(*GetUserShortcutDashboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ExportShortcuts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportShortcutsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ShortcutServiceServer).ExportShortcuts(m, &grpc.GenericServerStream[ExportShortcutsRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ShortcutService_ExportShortcutsServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
 // ShortcutService_ServiceDesc is the grpc.ServiceDesc for ShortcutService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -346,6 +795,10 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateShortcut",
 			Handler:    _ShortcutService_CreateShortcut_Handler,
 		},
+		{
+			MethodName: "ApplyShortcut",
+			Handler:    _ShortcutService_ApplyShortcut_Handler,
+		},
 		{
 			MethodName: "UpdateShortcut",
 			Handler:    _ShortcutService_UpdateShortcut_Handler,
@@ -354,11 +807,58 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteShortcut",
 			Handler:    _ShortcutService_DeleteShortcut_Handler,
 		},
+		{
+			MethodName: "RestoreShortcut",
+			Handler:    _ShortcutService_RestoreShortcut_Handler,
+		},
 		{
 			MethodName: "GetShortcutAnalytics",
 			Handler:    _ShortcutService_GetShortcutAnalytics_Handler,
 		},
+		{
+			MethodName: "GetShortcutQRCode",
+			Handler:    _ShortcutService_GetShortcutQRCode_Handler,
+		},
+		{
+			MethodName: "GetShortcutThumbnail",
+			Handler:    _ShortcutService_GetShortcutThumbnail_Handler,
+		},
+		{
+			MethodName: "ListShortcutAccess",
+			Handler:    _ShortcutService_ListShortcutAccess_Handler,
+		},
+		{
+			MethodName: "ImportShortcutsCSV",
+			Handler:    _ShortcutService_ImportShortcutsCSV_Handler,
+		},
+		{
+			MethodName: "PreviewImport",
+			Handler:    _ShortcutService_PreviewImport_Handler,
+		},
+		{
+			MethodName: "CanonicalizeLink",
+			Handler:    _ShortcutService_CanonicalizeLink_Handler,
+		},
+		{
+			MethodName: "BatchRelinkShortcuts",
+			Handler:    _ShortcutService_BatchRelinkShortcuts_Handler,
+		},
+		{
+			MethodName: "GetUserShortcutDashboard",
+			Handler:    _ShortcutService_GetUserShortcutDashboard_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamShortcuts",
+			Handler:       _ShortcutService_StreamShortcuts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportShortcuts",
+			Handler:       _ShortcutService_ExportShortcuts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/shortcut_service.proto",
 }
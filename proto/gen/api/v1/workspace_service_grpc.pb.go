@@ -8,9 +8,11 @@ package apiv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,9 +21,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorkspaceService_GetWorkspaceProfile_FullMethodName    = "/slash.api.v1.WorkspaceService/GetWorkspaceProfile"
-	WorkspaceService_GetWorkspaceSetting_FullMethodName    = "/slash.api.v1.WorkspaceService/GetWorkspaceSetting"
-	WorkspaceService_UpdateWorkspaceSetting_FullMethodName = "/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting"
+	WorkspaceService_GetWorkspaceProfile_FullMethodName        = "/slash.api.v1.WorkspaceService/GetWorkspaceProfile"
+	WorkspaceService_GetWorkspaceSetting_FullMethodName        = "/slash.api.v1.WorkspaceService/GetWorkspaceSetting"
+	WorkspaceService_UpdateWorkspaceSetting_FullMethodName     = "/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting"
+	WorkspaceService_GetServerConfig_FullMethodName            = "/slash.api.v1.WorkspaceService/GetServerConfig"
+	WorkspaceService_ExportAuditLogs_FullMethodName            = "/slash.api.v1.WorkspaceService/ExportAuditLogs"
+	WorkspaceService_ListEmbedTokens_FullMethodName            = "/slash.api.v1.WorkspaceService/ListEmbedTokens"
+	WorkspaceService_CreateEmbedToken_FullMethodName           = "/slash.api.v1.WorkspaceService/CreateEmbedToken"
+	WorkspaceService_DeleteEmbedToken_FullMethodName           = "/slash.api.v1.WorkspaceService/DeleteEmbedToken"
+	WorkspaceService_ListTagPolicies_FullMethodName            = "/slash.api.v1.WorkspaceService/ListTagPolicies"
+	WorkspaceService_UpsertTagPolicy_FullMethodName            = "/slash.api.v1.WorkspaceService/UpsertTagPolicy"
+	WorkspaceService_DeleteTagPolicy_FullMethodName            = "/slash.api.v1.WorkspaceService/DeleteTagPolicy"
+	WorkspaceService_ListRepoSyncs_FullMethodName              = "/slash.api.v1.WorkspaceService/ListRepoSyncs"
+	WorkspaceService_CreateRepoSync_FullMethodName             = "/slash.api.v1.WorkspaceService/CreateRepoSync"
+	WorkspaceService_DeleteRepoSync_FullMethodName             = "/slash.api.v1.WorkspaceService/DeleteRepoSync"
+	WorkspaceService_GetMigrationStatus_FullMethodName         = "/slash.api.v1.WorkspaceService/GetMigrationStatus"
+	WorkspaceService_RebuildShortcutSearchIndex_FullMethodName = "/slash.api.v1.WorkspaceService/RebuildShortcutSearchIndex"
 )
 
 // WorkspaceServiceClient is the client API for WorkspaceService service.
@@ -29,8 +44,38 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WorkspaceServiceClient interface {
 	GetWorkspaceProfile(ctx context.Context, in *GetWorkspaceProfileRequest, opts ...grpc.CallOption) (*WorkspaceProfile, error)
+	// GetWorkspaceSetting returns all the workspace settings to the admins, and only the ones the web app needs to the
+	// others, e.g. on the sign in page.
 	GetWorkspaceSetting(ctx context.Context, in *GetWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
+	// UpdateWorkspaceSetting updates the settings of the update mask, it is only allowed for admins.
+	// It returns all the workspace settings.
 	UpdateWorkspaceSetting(ctx context.Context, in *UpdateWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
+	// GetServerConfig returns the effective server configuration with secrets redacted.
+	GetServerConfig(ctx context.Context, in *GetServerConfigRequest, opts ...grpc.CallOption) (*ServerConfig, error)
+	// ExportAuditLogs streams the audit logs in order of creation, one CSV row or JSON line per chunk.
+	ExportAuditLogs(ctx context.Context, in *ExportAuditLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+	// ListEmbedTokens returns the embed tokens of the workspace, without their token strings.
+	ListEmbedTokens(ctx context.Context, in *ListEmbedTokensRequest, opts ...grpc.CallOption) (*ListEmbedTokensResponse, error)
+	// CreateEmbedToken creates a token for the embed widget of the public shortcuts.
+	CreateEmbedToken(ctx context.Context, in *CreateEmbedTokenRequest, opts ...grpc.CallOption) (*EmbedToken, error)
+	// DeleteEmbedToken revokes an embed token.
+	DeleteEmbedToken(ctx context.Context, in *DeleteEmbedTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListTagPolicies returns the tag policies of the workspace.
+	ListTagPolicies(ctx context.Context, in *ListTagPoliciesRequest, opts ...grpc.CallOption) (*ListTagPoliciesResponse, error)
+	// UpsertTagPolicy grants a user a role over the shortcuts with a tag, replacing the role of the user for the tag.
+	UpsertTagPolicy(ctx context.Context, in *UpsertTagPolicyRequest, opts ...grpc.CallOption) (*TagPolicy, error)
+	// DeleteTagPolicy revokes a tag policy.
+	DeleteTagPolicy(ctx context.Context, in *DeleteTagPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListRepoSyncs returns the repositories whose links file is synced to shortcuts, without their secrets.
+	ListRepoSyncs(ctx context.Context, in *ListRepoSyncsRequest, opts ...grpc.CallOption) (*ListRepoSyncsResponse, error)
+	// CreateRepoSync syncs the shortcuts with the links file of a repository on each push to its default branch.
+	CreateRepoSync(ctx context.Context, in *CreateRepoSyncRequest, opts ...grpc.CallOption) (*RepoSync, error)
+	// DeleteRepoSync stops the sync of a repository, the synced shortcuts are kept.
+	DeleteRepoSync(ctx context.Context, in *DeleteRepoSyncRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetMigrationStatus returns the applied migrations of the database and the ones applied on the next start.
+	GetMigrationStatus(ctx context.Context, in *GetMigrationStatusRequest, opts ...grpc.CallOption) (*MigrationStatus, error)
+	// RebuildShortcutSearchIndex rebuilds the search index of the shortcut filter, in case it drifted from the shortcuts.
+	RebuildShortcutSearchIndex(ctx context.Context, in *RebuildShortcutSearchIndexRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type workspaceServiceClient struct {
@@ -71,13 +116,182 @@ func (c *workspaceServiceClient) UpdateWorkspaceSetting(ctx context.Context, in
 	return out, nil
 }
 
+func (c *workspaceServiceClient) GetServerConfig(ctx context.Context, in *GetServerConfigRequest, opts ...grpc.CallOption) (*ServerConfig, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerConfig)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetServerConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ExportAuditLogs(ctx context.Context, in *ExportAuditLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WorkspaceService_ServiceDesc.Streams[0], WorkspaceService_ExportAuditLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportAuditLogsRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkspaceService_ExportAuditLogsClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+func (c *workspaceServiceClient) ListEmbedTokens(ctx context.Context, in *ListEmbedTokensRequest, opts ...grpc.CallOption) (*ListEmbedTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmbedTokensResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListEmbedTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) CreateEmbedToken(ctx context.Context, in *CreateEmbedTokenRequest, opts ...grpc.CallOption) (*EmbedToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmbedToken)
+	err := c.cc.Invoke(ctx, WorkspaceService_CreateEmbedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeleteEmbedToken(ctx context.Context, in *DeleteEmbedTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkspaceService_DeleteEmbedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListTagPolicies(ctx context.Context, in *ListTagPoliciesRequest, opts ...grpc.CallOption) (*ListTagPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagPoliciesResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListTagPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) UpsertTagPolicy(ctx context.Context, in *UpsertTagPolicyRequest, opts ...grpc.CallOption) (*TagPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagPolicy)
+	err := c.cc.Invoke(ctx, WorkspaceService_UpsertTagPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeleteTagPolicy(ctx context.Context, in *DeleteTagPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkspaceService_DeleteTagPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListRepoSyncs(ctx context.Context, in *ListRepoSyncsRequest, opts ...grpc.CallOption) (*ListRepoSyncsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRepoSyncsResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListRepoSyncs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) CreateRepoSync(ctx context.Context, in *CreateRepoSyncRequest, opts ...grpc.CallOption) (*RepoSync, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RepoSync)
+	err := c.cc.Invoke(ctx, WorkspaceService_CreateRepoSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeleteRepoSync(ctx context.Context, in *DeleteRepoSyncRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkspaceService_DeleteRepoSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) GetMigrationStatus(ctx context.Context, in *GetMigrationStatusRequest, opts ...grpc.CallOption) (*MigrationStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MigrationStatus)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetMigrationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) RebuildShortcutSearchIndex(ctx context.Context, in *RebuildShortcutSearchIndexRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkspaceService_RebuildShortcutSearchIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceServiceServer is the server API for WorkspaceService service.
 // All implementations must embed UnimplementedWorkspaceServiceServer
 // for forward compatibility.
 type WorkspaceServiceServer interface {
 	GetWorkspaceProfile(context.Context, *GetWorkspaceProfileRequest) (*WorkspaceProfile, error)
+	// GetWorkspaceSetting returns all the workspace settings to the admins, and only the ones the web app needs to the
+	// others, e.g. on the sign in page.
 	GetWorkspaceSetting(context.Context, *GetWorkspaceSettingRequest) (*WorkspaceSetting, error)
+	// UpdateWorkspaceSetting updates the settings of the update mask, it is only allowed for admins.
+	// It returns all the workspace settings.
 	UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error)
+	// GetServerConfig returns the effective server configuration with secrets redacted.
+	GetServerConfig(context.Context, *GetServerConfigRequest) (*ServerConfig, error)
+	// ExportAuditLogs streams the audit logs in order of creation, one CSV row or JSON line per chunk.
+	ExportAuditLogs(*ExportAuditLogsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	// ListEmbedTokens returns the embed tokens of the workspace, without their token strings.
+	ListEmbedTokens(context.Context, *ListEmbedTokensRequest) (*ListEmbedTokensResponse, error)
+	// CreateEmbedToken creates a token for the embed widget of the public shortcuts.
+	CreateEmbedToken(context.Context, *CreateEmbedTokenRequest) (*EmbedToken, error)
+	// DeleteEmbedToken revokes an embed token.
+	DeleteEmbedToken(context.Context, *DeleteEmbedTokenRequest) (*emptypb.Empty, error)
+	// ListTagPolicies returns the tag policies of the workspace.
+	ListTagPolicies(context.Context, *ListTagPoliciesRequest) (*ListTagPoliciesResponse, error)
+	// UpsertTagPolicy grants a user a role over the shortcuts with a tag, replacing the role of the user for the tag.
+	UpsertTagPolicy(context.Context, *UpsertTagPolicyRequest) (*TagPolicy, error)
+	// DeleteTagPolicy revokes a tag policy.
+	DeleteTagPolicy(context.Context, *DeleteTagPolicyRequest) (*emptypb.Empty, error)
+	// ListRepoSyncs returns the repositories whose links file is synced to shortcuts, without their secrets.
+	ListRepoSyncs(context.Context, *ListRepoSyncsRequest) (*ListRepoSyncsResponse, error)
+	// CreateRepoSync syncs the shortcuts with the links file of a repository on each push to its default branch.
+	CreateRepoSync(context.Context, *CreateRepoSyncRequest) (*RepoSync, error)
+	// DeleteRepoSync stops the sync of a repository, the synced shortcuts are kept.
+	DeleteRepoSync(context.Context, *DeleteRepoSyncRequest) (*emptypb.Empty, error)
+	// GetMigrationStatus returns the applied migrations of the database and the ones applied on the next start.
+	GetMigrationStatus(context.Context, *GetMigrationStatusRequest) (*MigrationStatus, error)
+	// RebuildShortcutSearchIndex rebuilds the search index of the shortcut filter, in case it drifted from the shortcuts.
+	RebuildShortcutSearchIndex(context.Context, *RebuildShortcutSearchIndexRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
 
@@ -97,6 +311,45 @@ func (UnimplementedWorkspaceServiceServer) GetWorkspaceSetting(context.Context,
 func (UnimplementedWorkspaceServiceServer) UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceSetting not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) GetServerConfig(context.Context, *GetServerConfigRequest) (*ServerConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerConfig not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ExportAuditLogs(*ExportAuditLogsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAuditLogs not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListEmbedTokens(context.Context, *ListEmbedTokensRequest) (*ListEmbedTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmbedTokens not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) CreateEmbedToken(context.Context, *CreateEmbedTokenRequest) (*EmbedToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmbedToken not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeleteEmbedToken(context.Context, *DeleteEmbedTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmbedToken not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListTagPolicies(context.Context, *ListTagPoliciesRequest) (*ListTagPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTagPolicies not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) UpsertTagPolicy(context.Context, *UpsertTagPolicyRequest) (*TagPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTagPolicy not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeleteTagPolicy(context.Context, *DeleteTagPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTagPolicy not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListRepoSyncs(context.Context, *ListRepoSyncsRequest) (*ListRepoSyncsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepoSyncs not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) CreateRepoSync(context.Context, *CreateRepoSyncRequest) (*RepoSync, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRepoSync not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeleteRepoSync(context.Context, *DeleteRepoSyncRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepoSync not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetMigrationStatus(context.Context, *GetMigrationStatusRequest) (*MigrationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMigrationStatus not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) RebuildShortcutSearchIndex(context.Context, *RebuildShortcutSearchIndexRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildShortcutSearchIndex not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) mustEmbedUnimplementedWorkspaceServiceServer() {}
 func (UnimplementedWorkspaceServiceServer) testEmbeddedByValue()                          {}
 
@@ -172,6 +425,233 @@ func _WorkspaceService_UpdateWorkspaceSetting_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_GetServerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetServerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetServerConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetServerConfig(ctx, req.(*GetServerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ExportAuditLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAuditLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkspaceServiceServer).ExportAuditLogs(m, &grpc.GenericServerStream[ExportAuditLogsRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkspaceService_ExportAuditLogsServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
+func _WorkspaceService_ListEmbedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmbedTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListEmbedTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListEmbedTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListEmbedTokens(ctx, req.(*ListEmbedTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_CreateEmbedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmbedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).CreateEmbedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_CreateEmbedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).CreateEmbedToken(ctx, req.(*CreateEmbedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeleteEmbedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmbedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeleteEmbedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DeleteEmbedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeleteEmbedToken(ctx, req.(*DeleteEmbedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListTagPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListTagPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListTagPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListTagPolicies(ctx, req.(*ListTagPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_UpsertTagPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTagPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).UpsertTagPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_UpsertTagPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).UpsertTagPolicy(ctx, req.(*UpsertTagPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeleteTagPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeleteTagPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DeleteTagPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeleteTagPolicy(ctx, req.(*DeleteTagPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListRepoSyncs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepoSyncsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListRepoSyncs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListRepoSyncs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListRepoSyncs(ctx, req.(*ListRepoSyncsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_CreateRepoSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRepoSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).CreateRepoSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_CreateRepoSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).CreateRepoSync(ctx, req.(*CreateRepoSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeleteRepoSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepoSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeleteRepoSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_DeleteRepoSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeleteRepoSync(ctx, req.(*DeleteRepoSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_GetMigrationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMigrationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetMigrationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetMigrationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetMigrationStatus(ctx, req.(*GetMigrationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_RebuildShortcutSearchIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildShortcutSearchIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).RebuildShortcutSearchIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_RebuildShortcutSearchIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).RebuildShortcutSearchIndex(ctx, req.(*RebuildShortcutSearchIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,7 +671,61 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWorkspaceSetting",
 			Handler:    _WorkspaceService_UpdateWorkspaceSetting_Handler,
 		},
+		{
+			MethodName: "GetServerConfig",
+			Handler:    _WorkspaceService_GetServerConfig_Handler,
+		},
+		{
+			MethodName: "ListEmbedTokens",
+			Handler:    _WorkspaceService_ListEmbedTokens_Handler,
+		},
+		{
+			MethodName: "CreateEmbedToken",
+			Handler:    _WorkspaceService_CreateEmbedToken_Handler,
+		},
+		{
+			MethodName: "DeleteEmbedToken",
+			Handler:    _WorkspaceService_DeleteEmbedToken_Handler,
+		},
+		{
+			MethodName: "ListTagPolicies",
+			Handler:    _WorkspaceService_ListTagPolicies_Handler,
+		},
+		{
+			MethodName: "UpsertTagPolicy",
+			Handler:    _WorkspaceService_UpsertTagPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteTagPolicy",
+			Handler:    _WorkspaceService_DeleteTagPolicy_Handler,
+		},
+		{
+			MethodName: "ListRepoSyncs",
+			Handler:    _WorkspaceService_ListRepoSyncs_Handler,
+		},
+		{
+			MethodName: "CreateRepoSync",
+			Handler:    _WorkspaceService_CreateRepoSync_Handler,
+		},
+		{
+			MethodName: "DeleteRepoSync",
+			Handler:    _WorkspaceService_DeleteRepoSync_Handler,
+		},
+		{
+			MethodName: "GetMigrationStatus",
+			Handler:    _WorkspaceService_GetMigrationStatus_Handler,
+		},
+		{
+			MethodName: "RebuildShortcutSearchIndex",
+			Handler:    _WorkspaceService_RebuildShortcutSearchIndex_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportAuditLogs",
+			Handler:       _WorkspaceService_ExportAuditLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/workspace_service.proto",
 }
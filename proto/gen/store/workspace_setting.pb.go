@@ -32,6 +32,10 @@ const (
 	WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED WorkspaceSettingKey = 3
 	// Workspace identity provider settings.
 	WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER WorkspaceSettingKey = 4
+	// Workspace embed widget settings.
+	WorkspaceSettingKey_WORKSPACE_SETTING_EMBED WorkspaceSettingKey = 5
+	// Workspace repository sync settings.
+	WorkspaceSettingKey_WORKSPACE_SETTING_REPO_SYNC WorkspaceSettingKey = 6
 	// TODO: remove the following keys.
 	// The license key.
 	WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY WorkspaceSettingKey = 10
@@ -51,6 +55,8 @@ var (
 		2:  "WORKSPACE_SETTING_SECURITY",
 		3:  "WORKSPACE_SETTING_SHORTCUT_RELATED",
 		4:  "WORKSPACE_SETTING_IDENTITY_PROVIDER",
+		5:  "WORKSPACE_SETTING_EMBED",
+		6:  "WORKSPACE_SETTING_REPO_SYNC",
 		10: "WORKSPACE_SETTING_LICENSE_KEY",
 		11: "WORKSPACE_SETTING_SECRET_SESSION",
 		12: "WORKSPACE_SETTING_CUSTOM_STYLE",
@@ -62,6 +68,8 @@ var (
 		"WORKSPACE_SETTING_SECURITY":           2,
 		"WORKSPACE_SETTING_SHORTCUT_RELATED":   3,
 		"WORKSPACE_SETTING_IDENTITY_PROVIDER":  4,
+		"WORKSPACE_SETTING_EMBED":              5,
+		"WORKSPACE_SETTING_REPO_SYNC":          6,
 		"WORKSPACE_SETTING_LICENSE_KEY":        10,
 		"WORKSPACE_SETTING_SECRET_SESSION":     11,
 		"WORKSPACE_SETTING_CUSTOM_STYLE":       12,
@@ -96,6 +104,361 @@ func (WorkspaceSettingKey) EnumDescriptor() ([]byte, []int) {
 	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0}
 }
 
+type WorkspaceSetting_SecuritySetting_SessionLimitPolicy int32
+
+const (
+	WorkspaceSetting_SecuritySetting_SESSION_LIMIT_POLICY_UNSPECIFIED WorkspaceSetting_SecuritySetting_SessionLimitPolicy = 0
+	// Sign out the oldest session to make room for the new one.
+	WorkspaceSetting_SecuritySetting_EVICT_OLDEST WorkspaceSetting_SecuritySetting_SessionLimitPolicy = 1
+	// Reject signing in until a session is signed out or expires.
+	WorkspaceSetting_SecuritySetting_REJECT WorkspaceSetting_SecuritySetting_SessionLimitPolicy = 2
+)
+
+// Enum value maps for WorkspaceSetting_SecuritySetting_SessionLimitPolicy.
+var (
+	WorkspaceSetting_SecuritySetting_SessionLimitPolicy_name = map[int32]string{
+		0: "SESSION_LIMIT_POLICY_UNSPECIFIED",
+		1: "EVICT_OLDEST",
+		2: "REJECT",
+	}
+	WorkspaceSetting_SecuritySetting_SessionLimitPolicy_value = map[string]int32{
+		"SESSION_LIMIT_POLICY_UNSPECIFIED": 0,
+		"EVICT_OLDEST":                     1,
+		"REJECT":                           2,
+	}
+)
+
+func (x WorkspaceSetting_SecuritySetting_SessionLimitPolicy) Enum() *WorkspaceSetting_SecuritySetting_SessionLimitPolicy {
+	p := new(WorkspaceSetting_SecuritySetting_SessionLimitPolicy)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSetting_SecuritySetting_SessionLimitPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSetting_SecuritySetting_SessionLimitPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (WorkspaceSetting_SecuritySetting_SessionLimitPolicy) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[1]
+}
+
+func (x WorkspaceSetting_SecuritySetting_SessionLimitPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSetting_SecuritySetting_SessionLimitPolicy.Descriptor instead.
+func (WorkspaceSetting_SecuritySetting_SessionLimitPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+type WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy int32
+
+const (
+	WorkspaceSetting_ShortcutRelatedSetting_COLLECTION_VISIBILITY_POLICY_UNSPECIFIED WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy = 0
+	// Raise the visibility of the added shortcuts to the one of the collection.
+	WorkspaceSetting_ShortcutRelatedSetting_RAISE_SHORTCUT_VISIBILITY WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy = 1
+	// Reject adding shortcuts less visible than the collection.
+	WorkspaceSetting_ShortcutRelatedSetting_REJECT_LESS_VISIBLE_SHORTCUTS WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy = 2
+)
+
+// Enum value maps for WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy.
+var (
+	WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy_name = map[int32]string{
+		0: "COLLECTION_VISIBILITY_POLICY_UNSPECIFIED",
+		1: "RAISE_SHORTCUT_VISIBILITY",
+		2: "REJECT_LESS_VISIBLE_SHORTCUTS",
+	}
+	WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy_value = map[string]int32{
+		"COLLECTION_VISIBILITY_POLICY_UNSPECIFIED": 0,
+		"RAISE_SHORTCUT_VISIBILITY":                1,
+		"REJECT_LESS_VISIBLE_SHORTCUTS":            2,
+	}
+)
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy) Enum() *WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy {
+	p := new(WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[2]
+}
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy.Descriptor instead.
+func (WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 2, 0}
+}
+
+type WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy int32
+
+const (
+	WorkspaceSetting_ShortcutRelatedSetting_VIEW_COUNT_PRIVACY_UNSPECIFIED WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy = 0
+	// Show the view count in ranges, e.g. "100+".
+	WorkspaceSetting_ShortcutRelatedSetting_BUCKET_VIEW_COUNT WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy = 1
+	// Hide the view count.
+	WorkspaceSetting_ShortcutRelatedSetting_HIDE_VIEW_COUNT WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy = 2
+)
+
+// Enum value maps for WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy.
+var (
+	WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy_name = map[int32]string{
+		0: "VIEW_COUNT_PRIVACY_UNSPECIFIED",
+		1: "BUCKET_VIEW_COUNT",
+		2: "HIDE_VIEW_COUNT",
+	}
+	WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy_value = map[string]int32{
+		"VIEW_COUNT_PRIVACY_UNSPECIFIED": 0,
+		"BUCKET_VIEW_COUNT":              1,
+		"HIDE_VIEW_COUNT":                2,
+	}
+)
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy) Enum() *WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy {
+	p := new(WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[3].Descriptor()
+}
+
+func (WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[3]
+}
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy.Descriptor instead.
+func (WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 2, 1}
+}
+
+type WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution int32
+
+const (
+	WorkspaceSetting_ShortcutRelatedSetting_TAG_POLICY_CONFLICT_RESOLUTION_UNSPECIFIED WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution = 0
+	// Grant the most permissive role of the tag policies of the shortcut.
+	WorkspaceSetting_ShortcutRelatedSetting_MOST_PERMISSIVE WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution = 1
+	// Grant the least permissive role of the tag policies of the shortcut, none when a tag has no policy for the user.
+	WorkspaceSetting_ShortcutRelatedSetting_MOST_RESTRICTIVE WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution = 2
+)
+
+// Enum value maps for WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution.
+var (
+	WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution_name = map[int32]string{
+		0: "TAG_POLICY_CONFLICT_RESOLUTION_UNSPECIFIED",
+		1: "MOST_PERMISSIVE",
+		2: "MOST_RESTRICTIVE",
+	}
+	WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution_value = map[string]int32{
+		"TAG_POLICY_CONFLICT_RESOLUTION_UNSPECIFIED": 0,
+		"MOST_PERMISSIVE":  1,
+		"MOST_RESTRICTIVE": 2,
+	}
+)
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution) Enum() *WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution {
+	p := new(WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[4].Descriptor()
+}
+
+func (WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[4]
+}
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution.Descriptor instead.
+func (WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 2, 2}
+}
+
+type WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder int32
+
+const (
+	WorkspaceSetting_ShortcutRelatedSetting_CANONICAL_LINK_QUERY_ORDER_UNSPECIFIED WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder = 0
+	// Sort the query parameters by name, keeping the order of the values of a name.
+	WorkspaceSetting_ShortcutRelatedSetting_SORT_BY_NAME WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder = 1
+	// Keep the query parameters in order, for destinations where it matters.
+	WorkspaceSetting_ShortcutRelatedSetting_PRESERVE_ORDER WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder = 2
+)
+
+// Enum value maps for WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder.
+var (
+	WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder_name = map[int32]string{
+		0: "CANONICAL_LINK_QUERY_ORDER_UNSPECIFIED",
+		1: "SORT_BY_NAME",
+		2: "PRESERVE_ORDER",
+	}
+	WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder_value = map[string]int32{
+		"CANONICAL_LINK_QUERY_ORDER_UNSPECIFIED": 0,
+		"SORT_BY_NAME":                           1,
+		"PRESERVE_ORDER":                         2,
+	}
+)
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder) Enum() *WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder {
+	p := new(WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[5].Descriptor()
+}
+
+func (WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[5]
+}
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder.Descriptor instead.
+func (WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 2, 3}
+}
+
+type WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode int32
+
+const (
+	WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_MODE_UNSPECIFIED WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode = 0
+	// Redirect to the search of the shortcuts for the attempted name.
+	WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_SEARCH WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode = 1
+	// Redirect to the URL of url_template.
+	WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_URL_TEMPLATE WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode = 2
+)
+
+// Enum value maps for WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode.
+var (
+	WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "SEARCH",
+		2: "URL_TEMPLATE",
+	}
+	WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"SEARCH":           1,
+		"URL_TEMPLATE":     2,
+	}
+)
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode) Enum() *WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode {
+	p := new(WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[6].Descriptor()
+}
+
+func (WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[6]
+}
+
+func (x WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode.Descriptor instead.
+func (WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 2, 0, 0}
+}
+
+type WorkspaceSetting_RepoSyncSetting_RepoSync_Provider int32
+
+const (
+	WorkspaceSetting_RepoSyncSetting_RepoSync_PROVIDER_UNSPECIFIED WorkspaceSetting_RepoSyncSetting_RepoSync_Provider = 0
+	WorkspaceSetting_RepoSyncSetting_RepoSync_GITHUB               WorkspaceSetting_RepoSyncSetting_RepoSync_Provider = 1
+	WorkspaceSetting_RepoSyncSetting_RepoSync_GITLAB               WorkspaceSetting_RepoSyncSetting_RepoSync_Provider = 2
+)
+
+// Enum value maps for WorkspaceSetting_RepoSyncSetting_RepoSync_Provider.
+var (
+	WorkspaceSetting_RepoSyncSetting_RepoSync_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "GITHUB",
+		2: "GITLAB",
+	}
+	WorkspaceSetting_RepoSyncSetting_RepoSync_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"GITHUB":               1,
+		"GITLAB":               2,
+	}
+)
+
+func (x WorkspaceSetting_RepoSyncSetting_RepoSync_Provider) Enum() *WorkspaceSetting_RepoSyncSetting_RepoSync_Provider {
+	p := new(WorkspaceSetting_RepoSyncSetting_RepoSync_Provider)
+	*p = x
+	return p
+}
+
+func (x WorkspaceSetting_RepoSyncSetting_RepoSync_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceSetting_RepoSyncSetting_RepoSync_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[7].Descriptor()
+}
+
+func (WorkspaceSetting_RepoSyncSetting_RepoSync_Provider) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[7]
+}
+
+func (x WorkspaceSetting_RepoSyncSetting_RepoSync_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceSetting_RepoSyncSetting_RepoSync_Provider.Descriptor instead.
+func (WorkspaceSetting_RepoSyncSetting_RepoSync_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 5, 0, 0}
+}
+
 type WorkspaceSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -109,6 +472,8 @@ type WorkspaceSetting struct {
 	//	*WorkspaceSetting_Security
 	//	*WorkspaceSetting_ShortcutRelated
 	//	*WorkspaceSetting_IdentityProvider
+	//	*WorkspaceSetting_Embed
+	//	*WorkspaceSetting_RepoSync
 	Value isWorkspaceSetting_Value `protobuf_oneof:"value"`
 }
 
@@ -191,6 +556,20 @@ func (x *WorkspaceSetting) GetIdentityProvider() *WorkspaceSetting_IdentityProvi
 	return nil
 }
 
+func (x *WorkspaceSetting) GetEmbed() *WorkspaceSetting_EmbedSetting {
+	if x, ok := x.GetValue().(*WorkspaceSetting_Embed); ok {
+		return x.Embed
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetRepoSync() *WorkspaceSetting_RepoSyncSetting {
+	if x, ok := x.GetValue().(*WorkspaceSetting_RepoSync); ok {
+		return x.RepoSync
+	}
+	return nil
+}
+
 type isWorkspaceSetting_Value interface {
 	isWorkspaceSetting_Value()
 }
@@ -211,6 +590,14 @@ type WorkspaceSetting_IdentityProvider struct {
 	IdentityProvider *WorkspaceSetting_IdentityProviderSetting `protobuf:"bytes,6,opt,name=identity_provider,json=identityProvider,proto3,oneof"`
 }
 
+type WorkspaceSetting_Embed struct {
+	Embed *WorkspaceSetting_EmbedSetting `protobuf:"bytes,7,opt,name=embed,proto3,oneof"`
+}
+
+type WorkspaceSetting_RepoSync struct {
+	RepoSync *WorkspaceSetting_RepoSyncSetting `protobuf:"bytes,8,opt,name=repo_sync,json=repoSync,proto3,oneof"`
+}
+
 func (*WorkspaceSetting_General) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_Security) isWorkspaceSetting_Value() {}
@@ -219,6 +606,10 @@ func (*WorkspaceSetting_ShortcutRelated) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_IdentityProvider) isWorkspaceSetting_Value() {}
 
+func (*WorkspaceSetting_Embed) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_RepoSync) isWorkspaceSetting_Value() {}
+
 type WorkspaceSetting_GeneralSetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -229,6 +620,12 @@ type WorkspaceSetting_GeneralSetting struct {
 	InstanceUrl   string `protobuf:"bytes,3,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
 	Branding      []byte `protobuf:"bytes,4,opt,name=branding,proto3" json:"branding,omitempty"`
 	CustomStyle   string `protobuf:"bytes,5,opt,name=custom_style,json=customStyle,proto3" json:"custom_style,omitempty"`
+	// The name of the instance shown instead of Slash.
+	Title string `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	// The http(s) URL of the logo shown instead of the one of Slash.
+	LogoUrl string `protobuf:"bytes,7,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	// The accent color of the web app, as a hex string e.g. "#2563eb".
+	ThemeColor string `protobuf:"bytes,8,opt,name=theme_color,json=themeColor,proto3" json:"theme_color,omitempty"`
 }
 
 func (x *WorkspaceSetting_GeneralSetting) Reset() {
@@ -296,6 +693,27 @@ func (x *WorkspaceSetting_GeneralSetting) GetCustomStyle() string {
 	return ""
 }
 
+func (x *WorkspaceSetting_GeneralSetting) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_GeneralSetting) GetThemeColor() string {
+	if x != nil {
+		return x.ThemeColor
+	}
+	return ""
+}
+
 type WorkspaceSetting_SecuritySetting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -303,6 +721,38 @@ type WorkspaceSetting_SecuritySetting struct {
 
 	DisallowUserRegistration bool `protobuf:"varint,1,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
 	DisallowPasswordAuth     bool `protobuf:"varint,2,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
+	// The maximum number of active sessions of a user, zero means unlimited.
+	MaxSessionsPerUser int32 `protobuf:"varint,3,opt,name=max_sessions_per_user,json=maxSessionsPerUser,proto3" json:"max_sessions_per_user,omitempty"`
+	// What to do when signing in over max_sessions_per_user, defaults to EVICT_OLDEST.
+	SessionLimitPolicy WorkspaceSetting_SecuritySetting_SessionLimitPolicy `protobuf:"varint,4,opt,name=session_limit_policy,json=sessionLimitPolicy,proto3,enum=slash.store.WorkspaceSetting_SecuritySetting_SessionLimitPolicy" json:"session_limit_policy,omitempty"`
+	// The number of days the audit logs are kept, zero keeps them forever.
+	AuditLogRetentionDays int32 `protobuf:"varint,5,opt,name=audit_log_retention_days,json=auditLogRetentionDays,proto3" json:"audit_log_retention_days,omitempty"`
+	// Whether the users signing up must verify their email before they can sign in.
+	RequireEmailVerification bool `protobuf:"varint,6,opt,name=require_email_verification,json=requireEmailVerification,proto3" json:"require_email_verification,omitempty"`
+	// The number of failed password sign ins of a client IP or an email within the window after which their sign ins
+	// are rejected, zero means unlimited.
+	MaxFailedSignIns int32 `protobuf:"varint,7,opt,name=max_failed_sign_ins,json=maxFailedSignIns,proto3" json:"max_failed_sign_ins,omitempty"`
+	// The minutes of the sliding window of the failed sign ins, zero means 15.
+	FailedSignInWindowMinutes int32 `protobuf:"varint,8,opt,name=failed_sign_in_window_minutes,json=failedSignInWindowMinutes,proto3" json:"failed_sign_in_window_minutes,omitempty"`
+	// The number of consecutive failed sign ins of a user after which the account is locked, zero disables the lockout.
+	AccountLockoutThreshold int32 `protobuf:"varint,9,opt,name=account_lockout_threshold,json=accountLockoutThreshold,proto3" json:"account_lockout_threshold,omitempty"`
+	// The minutes an account stays locked, zero means 15.
+	AccountLockoutMinutes int32 `protobuf:"varint,10,opt,name=account_lockout_minutes,json=accountLockoutMinutes,proto3" json:"account_lockout_minutes,omitempty"`
+	// The minimum number of characters of the passwords, zero means 8.
+	PasswordMinLength int32 `protobuf:"varint,11,opt,name=password_min_length,json=passwordMinLength,proto3" json:"password_min_length,omitempty"`
+	// Whether the passwords must contain an uppercase letter.
+	PasswordRequireUppercase bool `protobuf:"varint,12,opt,name=password_require_uppercase,json=passwordRequireUppercase,proto3" json:"password_require_uppercase,omitempty"`
+	// Whether the passwords must contain a lowercase letter.
+	PasswordRequireLowercase bool `protobuf:"varint,13,opt,name=password_require_lowercase,json=passwordRequireLowercase,proto3" json:"password_require_lowercase,omitempty"`
+	// Whether the passwords must contain a digit.
+	PasswordRequireDigit bool `protobuf:"varint,14,opt,name=password_require_digit,json=passwordRequireDigit,proto3" json:"password_require_digit,omitempty"`
+	// Whether the passwords must contain a symbol, i.e. a character other than a letter or a digit.
+	PasswordRequireSymbol bool `protobuf:"varint,15,opt,name=password_require_symbol,json=passwordRequireSymbol,proto3" json:"password_require_symbol,omitempty"`
+	// The number of days after which the users who signed up without verifying their email are deleted, zero keeps
+	// them forever.
+	UnverifiedUserTtlDays int32 `protobuf:"varint,16,opt,name=unverified_user_ttl_days,json=unverifiedUserTtlDays,proto3" json:"unverified_user_ttl_days,omitempty"`
+	// Whether the destructive actions are rejected for the admins impersonating a user.
+	BlockDestructiveActionsWhenImpersonating bool `protobuf:"varint,17,opt,name=block_destructive_actions_when_impersonating,json=blockDestructiveActionsWhenImpersonating,proto3" json:"block_destructive_actions_when_impersonating,omitempty"`
 }
 
 func (x *WorkspaceSetting_SecuritySetting) Reset() {
@@ -349,61 +799,323 @@ func (x *WorkspaceSetting_SecuritySetting) GetDisallowPasswordAuth() bool {
 	return false
 }
 
-type WorkspaceSetting_ShortcutRelatedSetting struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	DefaultVisibility Visibility `protobuf:"varint,1,opt,name=default_visibility,json=defaultVisibility,proto3,enum=slash.store.Visibility" json:"default_visibility,omitempty"`
+func (x *WorkspaceSetting_SecuritySetting) GetMaxSessionsPerUser() int32 {
+	if x != nil {
+		return x.MaxSessionsPerUser
+	}
+	return 0
 }
 
-func (x *WorkspaceSetting_ShortcutRelatedSetting) Reset() {
-	*x = WorkspaceSetting_ShortcutRelatedSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WorkspaceSetting_SecuritySetting) GetSessionLimitPolicy() WorkspaceSetting_SecuritySetting_SessionLimitPolicy {
+	if x != nil {
+		return x.SessionLimitPolicy
+	}
+	return WorkspaceSetting_SecuritySetting_SESSION_LIMIT_POLICY_UNSPECIFIED
 }
 
-func (x *WorkspaceSetting_ShortcutRelatedSetting) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WorkspaceSetting_SecuritySetting) GetAuditLogRetentionDays() int32 {
+	if x != nil {
+		return x.AuditLogRetentionDays
+	}
+	return 0
 }
 
-func (*WorkspaceSetting_ShortcutRelatedSetting) ProtoMessage() {}
+func (x *WorkspaceSetting_SecuritySetting) GetRequireEmailVerification() bool {
+	if x != nil {
+		return x.RequireEmailVerification
+	}
+	return false
+}
 
-func (x *WorkspaceSetting_ShortcutRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[3]
+func (x *WorkspaceSetting_SecuritySetting) GetMaxFailedSignIns() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MaxFailedSignIns
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use WorkspaceSetting_ShortcutRelatedSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting_ShortcutRelatedSetting) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 2}
+func (x *WorkspaceSetting_SecuritySetting) GetFailedSignInWindowMinutes() int32 {
+	if x != nil {
+		return x.FailedSignInWindowMinutes
+	}
+	return 0
 }
 
-func (x *WorkspaceSetting_ShortcutRelatedSetting) GetDefaultVisibility() Visibility {
+func (x *WorkspaceSetting_SecuritySetting) GetAccountLockoutThreshold() int32 {
 	if x != nil {
-		return x.DefaultVisibility
+		return x.AccountLockoutThreshold
 	}
-	return Visibility_VISIBILITY_UNSPECIFIED
+	return 0
 }
 
-type WorkspaceSetting_IdentityProviderSetting struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *WorkspaceSetting_SecuritySetting) GetAccountLockoutMinutes() int32 {
+	if x != nil {
+		return x.AccountLockoutMinutes
+	}
+	return 0
+}
 
-	IdentityProviders []*IdentityProvider `protobuf:"bytes,1,rep,name=identity_providers,json=identityProviders,proto3" json:"identity_providers,omitempty"`
+func (x *WorkspaceSetting_SecuritySetting) GetPasswordMinLength() int32 {
+	if x != nil {
+		return x.PasswordMinLength
+	}
+	return 0
 }
 
-func (x *WorkspaceSetting_IdentityProviderSetting) Reset() {
-	*x = WorkspaceSetting_IdentityProviderSetting{}
+func (x *WorkspaceSetting_SecuritySetting) GetPasswordRequireUppercase() bool {
+	if x != nil {
+		return x.PasswordRequireUppercase
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_SecuritySetting) GetPasswordRequireLowercase() bool {
+	if x != nil {
+		return x.PasswordRequireLowercase
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_SecuritySetting) GetPasswordRequireDigit() bool {
+	if x != nil {
+		return x.PasswordRequireDigit
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_SecuritySetting) GetPasswordRequireSymbol() bool {
+	if x != nil {
+		return x.PasswordRequireSymbol
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_SecuritySetting) GetUnverifiedUserTtlDays() int32 {
+	if x != nil {
+		return x.UnverifiedUserTtlDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_SecuritySetting) GetBlockDestructiveActionsWhenImpersonating() bool {
+	if x != nil {
+		return x.BlockDestructiveActionsWhenImpersonating
+	}
+	return false
+}
+
+type WorkspaceSetting_ShortcutRelatedSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DefaultVisibility Visibility `protobuf:"varint,1,opt,name=default_visibility,json=defaultVisibility,proto3,enum=slash.store.Visibility" json:"default_visibility,omitempty"`
+	// The number of days without visits after which a shortcut is archived.
+	// Zero disables auto-archiving.
+	AutoArchiveUnusedDays int32 `protobuf:"varint,2,opt,name=auto_archive_unused_days,json=autoArchiveUnusedDays,proto3" json:"auto_archive_unused_days,omitempty"`
+	// The default order of listed shortcuts, e.g. "name" or "created_ts desc".
+	DefaultShortcutOrder string `protobuf:"bytes,3,opt,name=default_shortcut_order,json=defaultShortcutOrder,proto3" json:"default_shortcut_order,omitempty"`
+	// The upper bound of the per-shortcut redirects per minute, zero means no bound.
+	MaxRedirectRateLimit int32 `protobuf:"varint,4,opt,name=max_redirect_rate_limit,json=maxRedirectRateLimit,proto3" json:"max_redirect_rate_limit,omitempty"`
+	// The endpoint of the HTTP link shortener applied to the links of new shortcuts, empty disables it.
+	LinkShortenerUrl string `protobuf:"bytes,5,opt,name=link_shortener_url,json=linkShortenerUrl,proto3" json:"link_shortener_url,omitempty"`
+	// The characters of the generated shortcut names, empty uses alphanumerics.
+	GeneratedNameAlphabet string `protobuf:"bytes,6,opt,name=generated_name_alphabet,json=generatedNameAlphabet,proto3" json:"generated_name_alphabet,omitempty"`
+	// The length of the generated shortcut names, zero uses the default.
+	GeneratedNameLength int32 `protobuf:"varint,7,opt,name=generated_name_length,json=generatedNameLength,proto3" json:"generated_name_length,omitempty"`
+	// What to do with shortcuts less visible than the collection they are added to, unspecified allows them.
+	CollectionVisibilityPolicy WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy `protobuf:"varint,8,opt,name=collection_visibility_policy,json=collectionVisibilityPolicy,proto3,enum=slash.store.WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy" json:"collection_visibility_policy,omitempty"`
+	// How the view counts of shortcuts are shown to users other than their creator and admins, unspecified shows them exactly.
+	ViewCountPrivacy WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy `protobuf:"varint,9,opt,name=view_count_privacy,json=viewCountPrivacy,proto3,enum=slash.store.WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy" json:"view_count_privacy,omitempty"`
+	// The number of hours after signing up during which users are limited to new_user_shortcut_quota shortcuts.
+	// Zero disables the cooldown. Admins and the users created by admins are not limited.
+	NewUserShortcutCooldownHours int32 `protobuf:"varint,10,opt,name=new_user_shortcut_cooldown_hours,json=newUserShortcutCooldownHours,proto3" json:"new_user_shortcut_cooldown_hours,omitempty"`
+	// The number of shortcuts users can create during the cooldown, zero allows none.
+	NewUserShortcutQuota int32 `protobuf:"varint,11,opt,name=new_user_shortcut_quota,json=newUserShortcutQuota,proto3" json:"new_user_shortcut_quota,omitempty"`
+	// The query parameters removed from the links of shortcuts when they are created or updated, e.g. "utm_source".
+	// The names match case-insensitively, and a trailing "*" matches by prefix, e.g. "utm_*".
+	StrippedLinkQueryParams []string `protobuf:"bytes,12,rep,name=stripped_link_query_params,json=strippedLinkQueryParams,proto3" json:"stripped_link_query_params,omitempty"`
+	// The max-age in seconds the redirects of public shortcuts can be cached by browsers and CDNs, zero disables it.
+	// The redirects of the other shortcuts are never cached.
+	PublicRedirectCacheMaxAge int32 `protobuf:"varint,13,opt,name=public_redirect_cache_max_age,json=publicRedirectCacheMaxAge,proto3" json:"public_redirect_cache_max_age,omitempty"`
+	// Where the redirects of unknown shortcut names go, unspecified shows the not found page.
+	NotFoundRedirect *WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect `protobuf:"bytes,14,opt,name=not_found_redirect,json=notFoundRedirect,proto3" json:"not_found_redirect,omitempty"`
+	// How the tag policies of a user combine over a shortcut with several restricted tags, unspecified is MOST_PERMISSIVE.
+	TagPolicyConflictResolution WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution `protobuf:"varint,15,opt,name=tag_policy_conflict_resolution,json=tagPolicyConflictResolution,proto3,enum=slash.store.WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution" json:"tag_policy_conflict_resolution,omitempty"`
+	// The names of the seed shortcuts already created, which are never seeded again even once deleted.
+	SeededShortcutNames []string `protobuf:"bytes,16,rep,name=seeded_shortcut_names,json=seededShortcutNames,proto3" json:"seeded_shortcut_names,omitempty"`
+	// How the query parameters of canonical links are ordered, unspecified is SORT_BY_NAME.
+	CanonicalLinkQueryOrder WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder `protobuf:"varint,17,opt,name=canonical_link_query_order,json=canonicalLinkQueryOrder,proto3,enum=slash.store.WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder" json:"canonical_link_query_order,omitempty"`
+	// The endpoint of the HTTP renderer of the preview thumbnails of the links of shortcuts, empty disables them.
+	ThumbnailRendererUrl string `protobuf:"bytes,18,opt,name=thumbnail_renderer_url,json=thumbnailRendererUrl,proto3" json:"thumbnail_renderer_url,omitempty"`
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) Reset() {
+	*x = WorkspaceSetting_ShortcutRelatedSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_ShortcutRelatedSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_ShortcutRelatedSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_ShortcutRelatedSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetDefaultVisibility() Visibility {
+	if x != nil {
+		return x.DefaultVisibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetAutoArchiveUnusedDays() int32 {
+	if x != nil {
+		return x.AutoArchiveUnusedDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetDefaultShortcutOrder() string {
+	if x != nil {
+		return x.DefaultShortcutOrder
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetMaxRedirectRateLimit() int32 {
+	if x != nil {
+		return x.MaxRedirectRateLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetLinkShortenerUrl() string {
+	if x != nil {
+		return x.LinkShortenerUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetGeneratedNameAlphabet() string {
+	if x != nil {
+		return x.GeneratedNameAlphabet
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetGeneratedNameLength() int32 {
+	if x != nil {
+		return x.GeneratedNameLength
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetCollectionVisibilityPolicy() WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy {
+	if x != nil {
+		return x.CollectionVisibilityPolicy
+	}
+	return WorkspaceSetting_ShortcutRelatedSetting_COLLECTION_VISIBILITY_POLICY_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetViewCountPrivacy() WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy {
+	if x != nil {
+		return x.ViewCountPrivacy
+	}
+	return WorkspaceSetting_ShortcutRelatedSetting_VIEW_COUNT_PRIVACY_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetNewUserShortcutCooldownHours() int32 {
+	if x != nil {
+		return x.NewUserShortcutCooldownHours
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetNewUserShortcutQuota() int32 {
+	if x != nil {
+		return x.NewUserShortcutQuota
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetStrippedLinkQueryParams() []string {
+	if x != nil {
+		return x.StrippedLinkQueryParams
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetPublicRedirectCacheMaxAge() int32 {
+	if x != nil {
+		return x.PublicRedirectCacheMaxAge
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetNotFoundRedirect() *WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect {
+	if x != nil {
+		return x.NotFoundRedirect
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetTagPolicyConflictResolution() WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution {
+	if x != nil {
+		return x.TagPolicyConflictResolution
+	}
+	return WorkspaceSetting_ShortcutRelatedSetting_TAG_POLICY_CONFLICT_RESOLUTION_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetSeededShortcutNames() []string {
+	if x != nil {
+		return x.SeededShortcutNames
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetCanonicalLinkQueryOrder() WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder {
+	if x != nil {
+		return x.CanonicalLinkQueryOrder
+	}
+	return WorkspaceSetting_ShortcutRelatedSetting_CANONICAL_LINK_QUERY_ORDER_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetThumbnailRendererUrl() string {
+	if x != nil {
+		return x.ThumbnailRendererUrl
+	}
+	return ""
+}
+
+type WorkspaceSetting_IdentityProviderSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdentityProviders []*IdentityProvider `protobuf:"bytes,1,rep,name=identity_providers,json=identityProviders,proto3" json:"identity_providers,omitempty"`
+}
+
+func (x *WorkspaceSetting_IdentityProviderSetting) Reset() {
+	*x = WorkspaceSetting_IdentityProviderSetting{}
 	mi := &file_store_workspace_setting_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
@@ -439,6 +1151,381 @@ func (x *WorkspaceSetting_IdentityProviderSetting) GetIdentityProviders() []*Ide
 	return nil
 }
 
+type WorkspaceSetting_EmbedSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The embed tokens, a token is revoked by removing it.
+	EmbedTokens []*WorkspaceSetting_EmbedSetting_EmbedToken `protobuf:"bytes,1,rep,name=embed_tokens,json=embedTokens,proto3" json:"embed_tokens,omitempty"`
+}
+
+func (x *WorkspaceSetting_EmbedSetting) Reset() {
+	*x = WorkspaceSetting_EmbedSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_EmbedSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_EmbedSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_EmbedSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_EmbedSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_EmbedSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *WorkspaceSetting_EmbedSetting) GetEmbedTokens() []*WorkspaceSetting_EmbedSetting_EmbedToken {
+	if x != nil {
+		return x.EmbedTokens
+	}
+	return nil
+}
+
+type WorkspaceSetting_RepoSyncSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The synced repositories, a sync is stopped by removing it.
+	RepoSyncs []*WorkspaceSetting_RepoSyncSetting_RepoSync `protobuf:"bytes,1,rep,name=repo_syncs,json=repoSyncs,proto3" json:"repo_syncs,omitempty"`
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting) Reset() {
+	*x = WorkspaceSetting_RepoSyncSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_RepoSyncSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_RepoSyncSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_RepoSyncSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_RepoSyncSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting) GetRepoSyncs() []*WorkspaceSetting_RepoSyncSetting_RepoSync {
+	if x != nil {
+		return x.RepoSyncs
+	}
+	return nil
+}
+
+type WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=slash.store.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode" json:"mode,omitempty"`
+	// The absolute http(s) URL of the URL_TEMPLATE mode, where "{name}" is replaced with the attempted name,
+	// e.g. "https://wiki.example.com/search?q={name}".
+	UrlTemplate string `protobuf:"bytes,2,opt,name=url_template,json=urlTemplate,proto3" json:"url_template,omitempty"`
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect) Reset() {
+	*x = WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect{}
+	mi := &file_store_workspace_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect) ProtoMessage() {}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 2, 0}
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect) GetMode() WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_MODE_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect) GetUrlTemplate() string {
+	if x != nil {
+		return x.UrlTemplate
+	}
+	return ""
+}
+
+type WorkspaceSetting_EmbedSetting_EmbedToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId   int32  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTs   int64  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Only the shortcuts with the tag are embedded when set.
+	Tag string `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Only the shortcuts of the collection are embedded when set.
+	CollectionId int32 `protobuf:"varint,6,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// The origins allowed by CORS.
+	AllowedOrigins []string `protobuf:"bytes,7,rep,name=allowed_origins,json=allowedOrigins,proto3" json:"allowed_origins,omitempty"`
+}
+
+func (x *WorkspaceSetting_EmbedSetting_EmbedToken) Reset() {
+	*x = WorkspaceSetting_EmbedSetting_EmbedToken{}
+	mi := &file_store_workspace_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_EmbedSetting_EmbedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_EmbedSetting_EmbedToken) ProtoMessage() {}
+
+func (x *WorkspaceSetting_EmbedSetting_EmbedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_EmbedSetting_EmbedToken.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_EmbedSetting_EmbedToken) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 4, 0}
+}
+
+func (x *WorkspaceSetting_EmbedSetting_EmbedToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_EmbedSetting_EmbedToken) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_EmbedSetting_EmbedToken) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_EmbedSetting_EmbedToken) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_EmbedSetting_EmbedToken) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_EmbedSetting_EmbedToken) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_EmbedSetting_EmbedToken) GetAllowedOrigins() []string {
+	if x != nil {
+		return x.AllowedOrigins
+	}
+	return nil
+}
+
+type WorkspaceSetting_RepoSyncSetting_RepoSync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId int32                                              `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTs int64                                              `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	Provider  WorkspaceSetting_RepoSyncSetting_RepoSync_Provider `protobuf:"varint,4,opt,name=provider,proto3,enum=slash.store.WorkspaceSetting_RepoSyncSetting_RepoSync_Provider" json:"provider,omitempty"`
+	// The full name of the repository, e.g. "owner/repo" on GitHub or "group/project" on GitLab.
+	Repository string `protobuf:"bytes,5,opt,name=repository,proto3" json:"repository,omitempty"`
+	// The API URL of self-hosted providers, empty uses the one of github.com or gitlab.com.
+	BaseUrl string `protobuf:"bytes,6,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	// The path of the links file in the repository, empty uses "links.yaml".
+	FilePath string `protobuf:"bytes,7,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	// The shortcuts of the links file are created, updated and deleted as the user.
+	UserId int32 `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The secret of the webhook signatures on GitHub, or the secret token of the webhook on GitLab.
+	WebhookSecret string `protobuf:"bytes,9,opt,name=webhook_secret,json=webhookSecret,proto3" json:"webhook_secret,omitempty"`
+	// The token reading the links file, empty for public repositories.
+	AccessToken string `protobuf:"bytes,10,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The names of the shortcuts created by the sync, the ones removed from the links file are deleted.
+	ShortcutNames []string `protobuf:"bytes,11,rep,name=shortcut_names,json=shortcutNames,proto3" json:"shortcut_names,omitempty"`
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) Reset() {
+	*x = WorkspaceSetting_RepoSyncSetting_RepoSync{}
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_RepoSyncSetting_RepoSync) ProtoMessage() {}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_RepoSyncSetting_RepoSync.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_RepoSyncSetting_RepoSync) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 5, 0}
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetProvider() WorkspaceSetting_RepoSyncSetting_RepoSync_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceSetting_RepoSyncSetting_RepoSync_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetWebhookSecret() string {
+	if x != nil {
+		return x.WebhookSecret
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_RepoSyncSetting_RepoSync) GetShortcutNames() []string {
+	if x != nil {
+		return x.ShortcutNames
+	}
+	return nil
+}
+
 var File_store_workspace_setting_proto protoreflect.FileDescriptor
 
 var file_store_workspace_setting_proto_rawDesc = []byte{
@@ -447,7 +1534,7 @@ var file_store_workspace_setting_proto_rawDesc = []byte{
 	0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x12, 0x73, 0x74,
 	0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x69, 0x64, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xd1, 0x07, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53,
+	0x6f, 0x22, 0xcb, 0x28, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53,
 	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72,
 	0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
@@ -474,74 +1561,342 @@ var file_store_workspace_setting_proto_rawDesc = []byte{
 	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
 	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
 	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x10, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x1a, 0xba, 0x01, 0x0a,
-	0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
-	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x63,
-	0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72,
-	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x72,
-	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x1a, 0x85, 0x01, 0x0a, 0x0f, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a,
-	0x1a, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x18, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x64,
-	0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x64, 0x69, 0x73,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x75, 0x74,
-	0x68, 0x1a, 0x60, 0x0a, 0x16, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x12, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x1a, 0x67, 0x0a, 0x17, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4c,
-	0x0a, 0x12, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x61,
-	0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x42, 0x07, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0xe3, 0x02, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a,
-	0x21, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43,
-	0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41,
-	0x4c, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45,
-	0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54,
-	0x59, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45,
-	0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x43, 0x55,
-	0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x57,
+	0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x05,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x12, 0x4c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x1a, 0x8c,
+	0x02, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x72, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x62, 0x72, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x1a, 0x95, 0x09,
+	0x0a, 0x0f, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x3c, 0x0a, 0x1a, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x34, 0x0a, 0x16, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x41, 0x75, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x72, 0x0a, 0x14, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x18,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x49,
+	0x6e, 0x73, 0x12, 0x40, 0x0a, 0x1d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x36, 0x0a, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d,
+	0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x3c, 0x0a, 0x1a, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x70,
+	0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x70, 0x70,
+	0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x63, 0x61, 0x73, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x77, 0x65, 0x72,
+	0x63, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x44, 0x69, 0x67, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x37, 0x0a, 0x18, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x54, 0x74, 0x6c, 0x44, 0x61, 0x79, 0x73, 0x12, 0x5e, 0x0a, 0x2c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x69, 0x6d,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x28, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x57, 0x68, 0x65, 0x6e, 0x49, 0x6d,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x58, 0x0a, 0x12, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x56, 0x49, 0x43, 0x54,
+	0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x10, 0x02, 0x1a, 0xd8, 0x10, 0x0a, 0x16, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63,
+	0x75, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x46, 0x0a, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x61, 0x75, 0x74, 0x6f,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x63, 0x75, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63,
+	0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x69, 0x6e, 0x6b,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x36, 0x0a, 0x17,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x62, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6c, 0x70, 0x68,
+	0x61, 0x62, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x91, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x4f, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x1a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x73, 0x0a, 0x12,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x45, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56,
+	0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52,
+	0x10, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x12, 0x46, 0x0a, 0x20, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f,
+	0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1c, 0x6e, 0x65, 0x77,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x43, 0x6f, 0x6f, 0x6c,
+	0x64, 0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6e, 0x65, 0x77,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6e, 0x65, 0x77, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x12, 0x3b, 0x0a, 0x1a, 0x73, 0x74, 0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x73, 0x74, 0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x4c, 0x69,
+	0x6e, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x40, 0x0a,
+	0x1d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12,
+	0x73, 0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x63, 0x75, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x52, 0x10, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x12, 0x95, 0x01, 0x0a, 0x1e, 0x74, 0x61, 0x67, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x50, 0x2e,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x63, 0x75, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x54, 0x61, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x1b, 0x74, 0x61, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15,
+	0x73, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x73, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x89, 0x01, 0x0a, 0x1a, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4c, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x6f,
+	0x6e, 0x69, 0x63, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x17, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x4c, 0x69,
+	0x6e, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16,
+	0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x68,
+	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x72, 0x55,
+	0x72, 0x6c, 0x1a, 0xd1, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x5e, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4a, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x46,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75,
+	0x72, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x0a, 0x04, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x41, 0x52,
+	0x43, 0x48, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x52, 0x4c, 0x5f, 0x54, 0x45, 0x4d, 0x50,
+	0x4c, 0x41, 0x54, 0x45, 0x10, 0x02, 0x22, 0x8c, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x28, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x41, 0x49, 0x53, 0x45, 0x5f, 0x53, 0x48, 0x4f,
+	0x52, 0x54, 0x43, 0x55, 0x54, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4c, 0x45, 0x53,
+	0x53, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x43,
+	0x55, 0x54, 0x53, 0x10, 0x02, 0x22, 0x62, 0x0a, 0x10, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x1e, 0x56, 0x49, 0x45,
+	0x57, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x49, 0x44, 0x45, 0x5f, 0x56, 0x49, 0x45,
+	0x57, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x22, 0x78, 0x0a, 0x1b, 0x54, 0x61, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x2a, 0x54, 0x41, 0x47, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x4f, 0x53, 0x54,
+	0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x4d, 0x4f, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x49, 0x56,
+	0x45, 0x10, 0x02, 0x22, 0x6b, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x4c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2a,
+	0x0a, 0x26, 0x43, 0x41, 0x4e, 0x4f, 0x4e, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
+	0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
+	0x50, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x02,
+	0x1a, 0x67, 0x0a, 0x17, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4c, 0x0a, 0x12, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x1a, 0xc7, 0x02, 0x0a, 0x0c, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x58, 0x0a, 0x0c, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x35, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x45, 0x6d, 0x62, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x62,
+	0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0b, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x1a, 0xdc, 0x01, 0x0a, 0x0a, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x73, 0x1a, 0xc0, 0x04, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x79, 0x6e, 0x63,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x55, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53,
+	0x79, 0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x1a, 0xd5,
+	0x03, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x73, 0x12, 0x5b, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3f, 0x2e, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53,
+	0x79, 0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53,
+	0x79, 0x6e, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x63, 0x75, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x47, 0x49, 0x54, 0x48, 0x55, 0x42, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x49,
+	0x54, 0x4c, 0x41, 0x42, 0x10, 0x02, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a,
+	0xa1, 0x03, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x4f, 0x52, 0x4b, 0x53,
+	0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x45, 0x59,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1e, 0x0a,
+	0x1a, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x26, 0x0a,
+	0x22, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x43, 0x55, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41,
+	0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54,
+	0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x10, 0x04, 0x12, 0x1b,
+	0x0a, 0x17, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54,
+	0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x57,
 	0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47,
-	0x5f, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44,
-	0x45, 0x52, 0x10, 0x04, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43,
-	0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53,
-	0x45, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x0a, 0x12, 0x24, 0x0a, 0x20, 0x57, 0x4f, 0x52, 0x4b, 0x53,
-	0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x45, 0x43,
-	0x52, 0x45, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x22, 0x0a,
-	0x1e, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x59, 0x4c, 0x45, 0x10,
-	0x0c, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53,
-	0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x56,
-	0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x0d, 0x42, 0xa6, 0x01, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42,
-	0x15, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x6c, 0x66, 0x68, 0x6f, 0x73,
-	0x74, 0x65, 0x64, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa,
-	0x02, 0x0b, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0xca, 0x02, 0x0b,
-	0x53, 0x6c, 0x61, 0x73, 0x68, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0xe2, 0x02, 0x17, 0x53, 0x6c,
-	0x61, 0x73, 0x68, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x3a, 0x3a, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d,
+	0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x0a, 0x12,
+	0x24, 0x0a, 0x20, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41,
+	0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f,
+	0x4d, 0x5f, 0x53, 0x54, 0x59, 0x4c, 0x45, 0x10, 0x0c, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x4f, 0x52,
+	0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44,
+	0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54,
+	0x59, 0x10, 0x0d, 0x42, 0xa6, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x15, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x6c, 0x66, 0x68, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x0b, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0xca, 0x02, 0x0b, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x5c, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0xe2, 0x02, 0x17, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x5c, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -556,31 +1911,55 @@ func file_store_workspace_setting_proto_rawDescGZIP() []byte {
 	return file_store_workspace_setting_proto_rawDescData
 }
 
-var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_store_workspace_setting_proto_goTypes = []any{
-	(WorkspaceSettingKey)(0),                         // 0: slash.store.WorkspaceSettingKey
-	(*WorkspaceSetting)(nil),                         // 1: slash.store.WorkspaceSetting
-	(*WorkspaceSetting_GeneralSetting)(nil),          // 2: slash.store.WorkspaceSetting.GeneralSetting
-	(*WorkspaceSetting_SecuritySetting)(nil),         // 3: slash.store.WorkspaceSetting.SecuritySetting
-	(*WorkspaceSetting_ShortcutRelatedSetting)(nil),  // 4: slash.store.WorkspaceSetting.ShortcutRelatedSetting
-	(*WorkspaceSetting_IdentityProviderSetting)(nil), // 5: slash.store.WorkspaceSetting.IdentityProviderSetting
-	(Visibility)(0),                                  // 6: slash.store.Visibility
-	(*IdentityProvider)(nil),                         // 7: slash.store.IdentityProvider
+	(WorkspaceSettingKey)(0),                                                 // 0: slash.store.WorkspaceSettingKey
+	(WorkspaceSetting_SecuritySetting_SessionLimitPolicy)(0),                 // 1: slash.store.WorkspaceSetting.SecuritySetting.SessionLimitPolicy
+	(WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy)(0),  // 2: slash.store.WorkspaceSetting.ShortcutRelatedSetting.CollectionVisibilityPolicy
+	(WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy)(0),            // 3: slash.store.WorkspaceSetting.ShortcutRelatedSetting.ViewCountPrivacy
+	(WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution)(0), // 4: slash.store.WorkspaceSetting.ShortcutRelatedSetting.TagPolicyConflictResolution
+	(WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder)(0),     // 5: slash.store.WorkspaceSetting.ShortcutRelatedSetting.CanonicalLinkQueryOrder
+	(WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode)(0),       // 6: slash.store.WorkspaceSetting.ShortcutRelatedSetting.NotFoundRedirect.Mode
+	(WorkspaceSetting_RepoSyncSetting_RepoSync_Provider)(0),                  // 7: slash.store.WorkspaceSetting.RepoSyncSetting.RepoSync.Provider
+	(*WorkspaceSetting)(nil),                                                 // 8: slash.store.WorkspaceSetting
+	(*WorkspaceSetting_GeneralSetting)(nil),                                  // 9: slash.store.WorkspaceSetting.GeneralSetting
+	(*WorkspaceSetting_SecuritySetting)(nil),                                 // 10: slash.store.WorkspaceSetting.SecuritySetting
+	(*WorkspaceSetting_ShortcutRelatedSetting)(nil),                          // 11: slash.store.WorkspaceSetting.ShortcutRelatedSetting
+	(*WorkspaceSetting_IdentityProviderSetting)(nil),                         // 12: slash.store.WorkspaceSetting.IdentityProviderSetting
+	(*WorkspaceSetting_EmbedSetting)(nil),                                    // 13: slash.store.WorkspaceSetting.EmbedSetting
+	(*WorkspaceSetting_RepoSyncSetting)(nil),                                 // 14: slash.store.WorkspaceSetting.RepoSyncSetting
+	(*WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect)(nil),         // 15: slash.store.WorkspaceSetting.ShortcutRelatedSetting.NotFoundRedirect
+	(*WorkspaceSetting_EmbedSetting_EmbedToken)(nil),                         // 16: slash.store.WorkspaceSetting.EmbedSetting.EmbedToken
+	(*WorkspaceSetting_RepoSyncSetting_RepoSync)(nil),                        // 17: slash.store.WorkspaceSetting.RepoSyncSetting.RepoSync
+	(Visibility)(0),          // 18: slash.store.Visibility
+	(*IdentityProvider)(nil), // 19: slash.store.IdentityProvider
 }
 var file_store_workspace_setting_proto_depIdxs = []int32{
-	0, // 0: slash.store.WorkspaceSetting.key:type_name -> slash.store.WorkspaceSettingKey
-	2, // 1: slash.store.WorkspaceSetting.general:type_name -> slash.store.WorkspaceSetting.GeneralSetting
-	3, // 2: slash.store.WorkspaceSetting.security:type_name -> slash.store.WorkspaceSetting.SecuritySetting
-	4, // 3: slash.store.WorkspaceSetting.shortcut_related:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting
-	5, // 4: slash.store.WorkspaceSetting.identity_provider:type_name -> slash.store.WorkspaceSetting.IdentityProviderSetting
-	6, // 5: slash.store.WorkspaceSetting.ShortcutRelatedSetting.default_visibility:type_name -> slash.store.Visibility
-	7, // 6: slash.store.WorkspaceSetting.IdentityProviderSetting.identity_providers:type_name -> slash.store.IdentityProvider
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	0,  // 0: slash.store.WorkspaceSetting.key:type_name -> slash.store.WorkspaceSettingKey
+	9,  // 1: slash.store.WorkspaceSetting.general:type_name -> slash.store.WorkspaceSetting.GeneralSetting
+	10, // 2: slash.store.WorkspaceSetting.security:type_name -> slash.store.WorkspaceSetting.SecuritySetting
+	11, // 3: slash.store.WorkspaceSetting.shortcut_related:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting
+	12, // 4: slash.store.WorkspaceSetting.identity_provider:type_name -> slash.store.WorkspaceSetting.IdentityProviderSetting
+	13, // 5: slash.store.WorkspaceSetting.embed:type_name -> slash.store.WorkspaceSetting.EmbedSetting
+	14, // 6: slash.store.WorkspaceSetting.repo_sync:type_name -> slash.store.WorkspaceSetting.RepoSyncSetting
+	1,  // 7: slash.store.WorkspaceSetting.SecuritySetting.session_limit_policy:type_name -> slash.store.WorkspaceSetting.SecuritySetting.SessionLimitPolicy
+	18, // 8: slash.store.WorkspaceSetting.ShortcutRelatedSetting.default_visibility:type_name -> slash.store.Visibility
+	2,  // 9: slash.store.WorkspaceSetting.ShortcutRelatedSetting.collection_visibility_policy:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting.CollectionVisibilityPolicy
+	3,  // 10: slash.store.WorkspaceSetting.ShortcutRelatedSetting.view_count_privacy:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting.ViewCountPrivacy
+	15, // 11: slash.store.WorkspaceSetting.ShortcutRelatedSetting.not_found_redirect:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting.NotFoundRedirect
+	4,  // 12: slash.store.WorkspaceSetting.ShortcutRelatedSetting.tag_policy_conflict_resolution:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting.TagPolicyConflictResolution
+	5,  // 13: slash.store.WorkspaceSetting.ShortcutRelatedSetting.canonical_link_query_order:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting.CanonicalLinkQueryOrder
+	19, // 14: slash.store.WorkspaceSetting.IdentityProviderSetting.identity_providers:type_name -> slash.store.IdentityProvider
+	16, // 15: slash.store.WorkspaceSetting.EmbedSetting.embed_tokens:type_name -> slash.store.WorkspaceSetting.EmbedSetting.EmbedToken
+	17, // 16: slash.store.WorkspaceSetting.RepoSyncSetting.repo_syncs:type_name -> slash.store.WorkspaceSetting.RepoSyncSetting.RepoSync
+	6,  // 17: slash.store.WorkspaceSetting.ShortcutRelatedSetting.NotFoundRedirect.mode:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting.NotFoundRedirect.Mode
+	7,  // 18: slash.store.WorkspaceSetting.RepoSyncSetting.RepoSync.provider:type_name -> slash.store.WorkspaceSetting.RepoSyncSetting.RepoSync.Provider
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_store_workspace_setting_proto_init() }
@@ -595,14 +1974,16 @@ func file_store_workspace_setting_proto_init() {
 		(*WorkspaceSetting_Security)(nil),
 		(*WorkspaceSetting_ShortcutRelated)(nil),
 		(*WorkspaceSetting_IdentityProvider)(nil),
+		(*WorkspaceSetting_Embed)(nil),
+		(*WorkspaceSetting_RepoSync)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_workspace_setting_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,10 +2,16 @@ package profile
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -24,8 +30,70 @@ type Profile struct {
 	Driver string
 	// Version is the current version of server.
 	Version string
+	// PostgresSSLMode is the sslmode of the postgres connection, e.g. "require" or "verify-full".
+	// It overrides the sslmode of the DSN when set.
+	PostgresSSLMode string
+	// PostgresSSLRootCert is the path to the CA certificate used to verify the postgres server.
+	PostgresSSLRootCert string
+	// PostgresSSLCert and PostgresSSLKey are the paths to the client certificate and its key.
+	PostgresSSLCert string
+	PostgresSSLKey  string
+	// APIRateLimit is the number of API requests per minute allowed for each user, zero means unlimited.
+	APIRateLimit int
+	// APICreateRateLimit is the number of create requests per minute allowed for each user, zero means unlimited.
+	APICreateRateLimit int
+	// ConcurrencyLimits are the "<method>=<max concurrency>[:<queue timeout>]" limits of the concurrent calls of the
+	// expensive methods, keyed by their full gRPC method name. The over-limit calls wait for a free slot up to the queue
+	// timeout, and fail fast without one.
+	ConcurrencyLimits []string
+	// Metrics enables the store operation metrics served on /metrics.
+	Metrics bool
+	// TrustedProxies are the IPs or CIDRs of the proxies whose X-Forwarded-Proto and X-Forwarded-For headers are
+	// trusted.
+	TrustedProxies []string
+	// SignInBackoffBase is the lockout window after a failed password sign in of an email, zero disables the lockout.
+	// SignInBackoffMultiplier grows the window with each successive failure, up to SignInBackoffMax.
+	SignInBackoffBase       time.Duration
+	SignInBackoffMultiplier float64
+	SignInBackoffMax        time.Duration
+	// PasswordHashCost is the bcrypt cost of the password hashes, zero uses the bcrypt default.
+	// The costs out of the bcrypt range are clamped to it.
+	PasswordHashCost int
+	// MaxUsers is the maximum number of active users of a workspace whose license doesn't set one, zero means 5.
+	MaxUsers int
+	// ShortcutCacheSize is the number of shortcut names whose shortcuts are cached for their resolution, zero disables
+	// the cache. The cached names expire after ShortcutCacheTTL.
+	ShortcutCacheSize int
+	ShortcutCacheTTL  time.Duration
+	// MigrationDryRun reports the pending migrations and exits without changing the schema nor starting the server.
+	MigrationDryRun bool
+	// ReservedShortcutNames are the shortcut names only admins can create, matched case-insensitively.
+	ReservedShortcutNames []string
+	// SeedShortcuts are the "name=link" shortcuts created once by the first admin when the workspace is initialized.
+	// Their names are reserved as well.
+	SeedShortcuts []string
+	// WorkspaceIsolation resolves the workspace of the requests from their "host" or "path" prefix, empty disables it.
+	// The host "<workspace>.example.com" or the path prefix "/w/<workspace>" selects one of the Workspaces,
+	// the other requests are in the default workspace.
+	WorkspaceIsolation string
+	// Workspaces are the ids of the workspaces isolated from each other and from the default workspace.
+	Workspaces []string
 }
 
+const (
+	WorkspaceIsolationHost = "host"
+	WorkspaceIsolationPath = "path"
+)
+
+// WorkspacePathPrefix is the path prefix of the workspaces with the path isolation.
+const WorkspacePathPrefix = "/w/"
+
+// workspaceIDPattern is the format of the workspace ids, which are host labels.
+var workspaceIDPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// postgresSSLModes is the sslmode values supported by the postgres driver.
+var postgresSSLModes = []string{"disable", "require", "verify-ca", "verify-full"}
+
 func (p *Profile) IsDev() bool {
 	return p.Mode != "prod"
 }
@@ -82,5 +150,240 @@ func (p *Profile) Validate() error {
 		p.DSN = filepath.Join(dataDir, dbFile)
 	}
 
+	if p.APIRateLimit < 0 || p.APICreateRateLimit < 0 {
+		return errors.New("api rate limits must not be negative")
+	}
+
+	if p.SignInBackoffBase < 0 {
+		return errors.New("sign in backoff base must not be negative")
+	}
+	if p.SignInBackoffBase > 0 {
+		if p.SignInBackoffMultiplier < 1 {
+			return errors.New("sign in backoff multiplier must be at least 1")
+		}
+		if p.SignInBackoffMax < p.SignInBackoffBase {
+			return errors.New("sign in backoff max must not be less than the base")
+		}
+	}
+
+	if p.ShortcutCacheSize < 0 {
+		return errors.New("shortcut cache size must not be negative")
+	}
+	if p.ShortcutCacheSize > 0 && p.ShortcutCacheTTL <= 0 {
+		return errors.New("shortcut cache ttl must be positive")
+	}
+
+	if p.Driver == "postgres" {
+		if err := p.validatePostgresTLS(); err != nil {
+			return err
+		}
+	}
+
+	for _, trustedProxy := range p.TrustedProxies {
+		if _, err := parseIPNet(trustedProxy); err != nil {
+			return errors.Wrapf(err, "invalid trusted proxy %q", trustedProxy)
+		}
+	}
+
+	for _, concurrencyLimit := range p.ConcurrencyLimits {
+		if _, _, _, err := ParseConcurrencyLimit(concurrencyLimit); err != nil {
+			return errors.Wrapf(err, "invalid concurrency limit %q", concurrencyLimit)
+		}
+	}
+
+	for _, seedShortcut := range p.SeedShortcuts {
+		if _, _, err := ParseSeedShortcut(seedShortcut); err != nil {
+			return errors.Wrapf(err, "invalid seed shortcut %q", seedShortcut)
+		}
+	}
+
+	if err := p.validateWorkspaces(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *Profile) validateWorkspaces() error {
+	if p.WorkspaceIsolation != "" && p.WorkspaceIsolation != WorkspaceIsolationHost && p.WorkspaceIsolation != WorkspaceIsolationPath {
+		return errors.Errorf("invalid workspace isolation %q, must be %s or %s", p.WorkspaceIsolation, WorkspaceIsolationHost, WorkspaceIsolationPath)
+	}
+	if p.WorkspaceIsolation == "" && len(p.Workspaces) > 0 {
+		return errors.New("workspaces require a workspace isolation")
+	}
+	for i, workspaceID := range p.Workspaces {
+		if !workspaceIDPattern.MatchString(workspaceID) {
+			return errors.Errorf("invalid workspace id %q, must be a lowercase host label", workspaceID)
+		}
+		if slices.Contains(p.Workspaces[:i], workspaceID) {
+			return errors.Errorf("workspace %q is declared more than once", workspaceID)
+		}
+	}
+	return nil
+}
+
+// ResolveWorkspace returns the workspace of a request to the host and path, and the path within the workspace.
+// The requests that don't select one of the workspaces are in the default workspace, whose id is empty.
+func (p *Profile) ResolveWorkspace(host, path string) (string, string) {
+	switch p.WorkspaceIsolation {
+	case WorkspaceIsolationHost:
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		label, _, ok := strings.Cut(strings.ToLower(host), ".")
+		if ok && slices.Contains(p.Workspaces, label) {
+			return label, path
+		}
+	case WorkspaceIsolationPath:
+		rest, ok := strings.CutPrefix(path, WorkspacePathPrefix)
+		if !ok {
+			break
+		}
+		workspaceID, workspacePath, _ := strings.Cut(rest, "/")
+		if slices.Contains(p.Workspaces, workspaceID) {
+			return workspaceID, "/" + workspacePath
+		}
+	}
+	return "", path
+}
+
+// IsWorkspace returns whether the workspace id is the default workspace or one of the workspaces.
+func (p *Profile) IsWorkspace(workspaceID string) bool {
+	return workspaceID == "" || slices.Contains(p.Workspaces, workspaceID)
+}
+
+// ParseSeedShortcut returns the name and the link of a "name=link" seed shortcut.
+func ParseSeedShortcut(seedShortcut string) (string, string, error) {
+	name, link, ok := strings.Cut(seedShortcut, "=")
+	name, link = strings.TrimSpace(name), strings.TrimSpace(link)
+	if !ok || name == "" || link == "" {
+		return "", "", errors.New(`must be "name=link"`)
+	}
+	if u, err := url.Parse(link); err != nil || u.Scheme == "" || u.Host == "" {
+		return "", "", errors.Errorf("invalid link %q", link)
+	}
+	return name, link, nil
+}
+
+// ParseConcurrencyLimit returns the method, the max concurrency and the queue timeout of a
+// "<method>=<max concurrency>[:<queue timeout>]" concurrency limit.
+func ParseConcurrencyLimit(concurrencyLimit string) (string, int, time.Duration, error) {
+	method, limit, ok := strings.Cut(concurrencyLimit, "=")
+	method, limit = strings.TrimSpace(method), strings.TrimSpace(limit)
+	if !ok || !strings.HasPrefix(method, "/") || !strings.Contains(method[1:], "/") {
+		return "", 0, 0, errors.New(`must be "<method>=<max concurrency>[:<queue timeout>]" with a full method name`)
+	}
+	maxConcurrency, queueTimeout := limit, ""
+	if i := strings.Index(limit, ":"); i >= 0 {
+		maxConcurrency, queueTimeout = limit[:i], limit[i+1:]
+	}
+	n, err := strconv.Atoi(maxConcurrency)
+	if err != nil || n <= 0 {
+		return "", 0, 0, errors.Errorf("invalid max concurrency %q, must be a positive integer", maxConcurrency)
+	}
+	var timeout time.Duration
+	if queueTimeout != "" {
+		timeout, err = time.ParseDuration(queueTimeout)
+		if err != nil || timeout < 0 {
+			return "", 0, 0, errors.Errorf("invalid queue timeout %q", queueTimeout)
+		}
+	}
+	return method, n, timeout, nil
+}
+
+// IsReservedShortcutName returns whether the name is reserved or seeded, case-insensitively.
+func (p *Profile) IsReservedShortcutName(name string) bool {
+	for _, reservedName := range p.ReservedShortcutNames {
+		if strings.EqualFold(strings.TrimSpace(reservedName), name) {
+			return true
+		}
+	}
+	for _, seedShortcut := range p.SeedShortcuts {
+		if seedName, _, err := ParseSeedShortcut(seedShortcut); err == nil && strings.EqualFold(seedName, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTrustedProxy returns whether the ip is one of the trusted proxies.
+func (p *Profile) IsTrustedProxy(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, trustedProxy := range p.TrustedProxies {
+		ipNet, err := parseIPNet(trustedProxy)
+		if err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetClientIP returns the IP of the client of a request from the remote address and the X-Forwarded-For header.
+// The header is only followed through the trusted proxies: its entries are read from the last one, which the closest
+// proxy appended, up to the first one that isn't a trusted proxy. The clients can't spoof their IP with the header.
+func (p *Profile) GetClientIP(remoteAddr, forwardedFor string) string {
+	ip := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		ip = host
+	}
+	if forwardedFor == "" || !p.IsTrustedProxy(net.ParseIP(ip)) {
+		return ip
+	}
+	entries := strings.Split(forwardedFor, ",")
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := net.ParseIP(strings.TrimSpace(entries[i]))
+		if entry == nil {
+			break
+		}
+		ip = entry.String()
+		if !p.IsTrustedProxy(entry) {
+			break
+		}
+	}
+	return ip
+}
+
+// parseIPNet parses a CIDR, or an IP as the network of this single address.
+func parseIPNet(s string) (*net.IPNet, error) {
+	if strings.Contains(s, "/") {
+		_, ipNet, err := net.ParseCIDR(s)
+		return ipNet, err
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, errors.New("not an IP or CIDR")
+	}
+	bits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		ip, bits = ip.To4(), 8*net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// IsPostgresTLSRequired returns whether the postgres connection must be encrypted.
+func (p *Profile) IsPostgresTLSRequired() bool {
+	return p.PostgresSSLMode != "" && p.PostgresSSLMode != "disable"
+}
+
+func (p *Profile) validatePostgresTLS() error {
+	if p.PostgresSSLMode != "" && !slices.Contains(postgresSSLModes, p.PostgresSSLMode) {
+		return errors.Errorf("invalid postgres sslmode %q, must be one of %s", p.PostgresSSLMode, strings.Join(postgresSSLModes, ", "))
+	}
+	if (p.PostgresSSLCert == "") != (p.PostgresSSLKey == "") {
+		return errors.New("postgres ssl cert and key must be set together")
+	}
+	if !p.IsPostgresTLSRequired() && (p.PostgresSSLRootCert != "" || p.PostgresSSLCert != "") {
+		return errors.New("postgres ssl certificates require an sslmode other than disable")
+	}
+	for _, file := range []string{p.PostgresSSLRootCert, p.PostgresSSLCert, p.PostgresSSLKey} {
+		if file == "" {
+			continue
+		}
+		if _, err := os.Stat(file); err != nil {
+			return errors.Wrapf(err, "unable to access postgres ssl file %s", file)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,162 @@
+package profile
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePostgresTLS(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.pem")
+	require.NoError(t, os.WriteFile(certFile, []byte("cert"), 0600))
+
+	tests := []struct {
+		profile *Profile
+		valid   bool
+	}{
+		{&Profile{}, true},
+		{&Profile{PostgresSSLMode: "verify-full", PostgresSSLRootCert: certFile}, true},
+		{&Profile{PostgresSSLMode: "require", PostgresSSLCert: certFile, PostgresSSLKey: certFile}, true},
+		{&Profile{PostgresSSLMode: "prefer"}, false},
+		{&Profile{PostgresSSLMode: "require", PostgresSSLCert: certFile}, false},
+		{&Profile{PostgresSSLMode: "disable", PostgresSSLRootCert: certFile}, false},
+		{&Profile{PostgresSSLMode: "verify-ca", PostgresSSLRootCert: filepath.Join(dir, "missing.pem")}, false},
+	}
+	for _, test := range tests {
+		err := test.profile.validatePostgresTLS()
+		if test.valid {
+			require.NoError(t, err, test.profile)
+		} else {
+			require.Error(t, err, test.profile)
+		}
+	}
+}
+
+func TestIsTrustedProxy(t *testing.T) {
+	profile := &Profile{TrustedProxies: []string{"10.0.0.0/8", "192.0.2.1", "2001:db8::1"}}
+	tests := []struct {
+		ip      string
+		trusted bool
+	}{
+		{"10.1.2.3", true},
+		{"192.0.2.1", true},
+		{"192.0.2.2", false},
+		{"2001:db8::1", true},
+		{"203.0.113.7", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.trusted, profile.IsTrustedProxy(net.ParseIP(test.ip)), test.ip)
+	}
+	_, err := parseIPNet("not-an-ip")
+	require.Error(t, err)
+}
+
+func TestGetClientIP(t *testing.T) {
+	profile := &Profile{TrustedProxies: []string{"10.0.0.0/8"}}
+	tests := []struct {
+		remoteAddr   string
+		forwardedFor string
+		ip           string
+	}{
+		{"203.0.113.7:1234", "", "203.0.113.7"},
+		// The header of untrusted clients is ignored.
+		{"203.0.113.7:1234", "198.51.100.1", "203.0.113.7"},
+		{"10.0.0.1:1234", "", "10.0.0.1"},
+		{"10.0.0.1:1234", "203.0.113.7", "203.0.113.7"},
+		// The entries the client prepended to the ones of the trusted proxies are ignored.
+		{"10.0.0.1:1234", "198.51.100.1, 203.0.113.7, 10.0.0.2", "203.0.113.7"},
+		{"10.0.0.1:1234", "10.0.0.3, 10.0.0.2", "10.0.0.3"},
+		{"10.0.0.1:1234", "not-an-ip, 203.0.113.7", "203.0.113.7"},
+		{"10.0.0.1:1234", "203.0.113.7, not-an-ip", "10.0.0.1"},
+		{"[2001:db8::2]:1234", "198.51.100.1", "2001:db8::2"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.ip, profile.GetClientIP(test.remoteAddr, test.forwardedFor), test)
+	}
+}
+
+func TestReservedShortcutNames(t *testing.T) {
+	profile := &Profile{
+		ReservedShortcutNames: []string{"admin-only"},
+		SeedShortcuts:         []string{"help = https://help.example.com"},
+	}
+	require.True(t, profile.IsReservedShortcutName("admin-only"))
+	require.True(t, profile.IsReservedShortcutName("Help"))
+	require.False(t, profile.IsReservedShortcutName("docs"))
+
+	name, link, err := ParseSeedShortcut("help = https://help.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "help", name)
+	require.Equal(t, "https://help.example.com", link)
+	for _, seedShortcut := range []string{"help", "=https://help.example.com", "help=", "help=not a link"} {
+		_, _, err := ParseSeedShortcut(seedShortcut)
+		require.Error(t, err, seedShortcut)
+	}
+}
+
+func TestParseConcurrencyLimit(t *testing.T) {
+	method, maxConcurrency, queueTimeout, err := ParseConcurrencyLimit("/slash.api.v1.ShortcutService/ExportShortcuts = 2:5s")
+	require.NoError(t, err)
+	require.Equal(t, "/slash.api.v1.ShortcutService/ExportShortcuts", method)
+	require.Equal(t, 2, maxConcurrency)
+	require.Equal(t, 5*time.Second, queueTimeout)
+
+	_, maxConcurrency, queueTimeout, err = ParseConcurrencyLimit("/slash.api.v1.ShortcutService/ExportShortcuts=4")
+	require.NoError(t, err)
+	require.Equal(t, 4, maxConcurrency)
+	require.Zero(t, queueTimeout)
+
+	for _, concurrencyLimit := range []string{
+		"/slash.api.v1.ShortcutService/ExportShortcuts",
+		"ExportShortcuts=2",
+		"/ExportShortcuts=2",
+		"/slash.api.v1.ShortcutService/ExportShortcuts=0",
+		"/slash.api.v1.ShortcutService/ExportShortcuts=two",
+		"/slash.api.v1.ShortcutService/ExportShortcuts=2:soon",
+		"/slash.api.v1.ShortcutService/ExportShortcuts=2:-1s",
+	} {
+		_, _, _, err := ParseConcurrencyLimit(concurrencyLimit)
+		require.Error(t, err, concurrencyLimit)
+	}
+}
+
+func TestResolveWorkspace(t *testing.T) {
+	tests := []struct {
+		profile     *Profile
+		host        string
+		path        string
+		workspaceID string
+		rest        string
+	}{
+		{&Profile{}, "acme.example.com", "/s/docs", "", "/s/docs"},
+		{&Profile{WorkspaceIsolation: WorkspaceIsolationHost, Workspaces: []string{"acme"}}, "acme.example.com:5231", "/s/docs", "acme", "/s/docs"},
+		{&Profile{WorkspaceIsolation: WorkspaceIsolationHost, Workspaces: []string{"acme"}}, "ACME.example.com", "/", "acme", "/"},
+		{&Profile{WorkspaceIsolation: WorkspaceIsolationHost, Workspaces: []string{"acme"}}, "other.example.com", "/s/docs", "", "/s/docs"},
+		{&Profile{WorkspaceIsolation: WorkspaceIsolationHost, Workspaces: []string{"acme"}}, "acme", "/s/docs", "", "/s/docs"},
+		{&Profile{WorkspaceIsolation: WorkspaceIsolationPath, Workspaces: []string{"acme"}}, "example.com", "/w/acme/s/docs", "acme", "/s/docs"},
+		{&Profile{WorkspaceIsolation: WorkspaceIsolationPath, Workspaces: []string{"acme"}}, "example.com", "/w/acme", "acme", "/"},
+		{&Profile{WorkspaceIsolation: WorkspaceIsolationPath, Workspaces: []string{"acme"}}, "example.com", "/w/other/s/docs", "", "/w/other/s/docs"},
+		{&Profile{WorkspaceIsolation: WorkspaceIsolationPath, Workspaces: []string{"acme"}}, "acme.example.com", "/s/docs", "", "/s/docs"},
+	}
+	for _, test := range tests {
+		workspaceID, rest := test.profile.ResolveWorkspace(test.host, test.path)
+		require.Equal(t, test.workspaceID, workspaceID, test.host+test.path)
+		require.Equal(t, test.rest, rest, test.host+test.path)
+	}
+
+	for _, profile := range []*Profile{
+		{WorkspaceIsolation: "subdomain", Workspaces: []string{"acme"}},
+		{Workspaces: []string{"acme"}},
+		{WorkspaceIsolation: WorkspaceIsolationPath, Workspaces: []string{"Acme"}},
+		{WorkspaceIsolation: WorkspaceIsolationPath, Workspaces: []string{"acme", "acme"}},
+	} {
+		require.Error(t, profile.validateWorkspaces(), profile)
+	}
+	require.NoError(t, (&Profile{WorkspaceIsolation: WorkspaceIsolationHost, Workspaces: []string{"acme", "team-1"}}).validateWorkspaces())
+}
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (s *APIV1Service) ListAccessTokens(ctx context.Context, request *v1pb.ListAccessTokensRequest) (*v1pb.ListAccessTokensResponse, error) {
+	user, err := s.getAccessTokensUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list access tokens: %v", err)
+	}
+
+	currentAccessToken := getAccessTokenFromContext(ctx)
+	accessTokens := []*v1pb.AccessTokenInfo{}
+	for _, userAccessToken := range userAccessTokens {
+		if !isSessionAlive(userAccessToken, s.Secret) {
+			continue
+		}
+		accessTokens = append(accessTokens, s.convertAccessTokenInfoFromStore(userAccessToken, currentAccessToken))
+	}
+	// Sort by created time in descending order.
+	slices.SortStableFunc(accessTokens, func(a, b *v1pb.AccessTokenInfo) int {
+		return cmp.Compare(b.CreatedTime.GetSeconds(), a.CreatedTime.GetSeconds())
+	})
+	return &v1pb.ListAccessTokensResponse{
+		AccessTokens: accessTokens,
+	}, nil
+}
+
+// RevokeAccessToken removes the access token, and the refresh token of its session, from the user.
+// Revoking the access token of the request signs out like SignOut.
+func (s *APIV1Service) RevokeAccessToken(ctx context.Context, request *v1pb.RevokeAccessTokenRequest) (*emptypb.Empty, error) {
+	if request.TokenId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token id is required")
+	}
+	user, err := s.getAccessTokensUser(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := s.removeUserAccessTokens(ctx, user, func(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) bool {
+		return accessTokenID(userAccessToken) == request.TokenId
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(revoked) == 0 {
+		return nil, status.Errorf(codes.NotFound, "access token not found")
+	}
+	if revoked[0].AccessToken == getAccessTokenFromContext(ctx) {
+		if err := expireSessionCookies(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getAccessTokensUser returns the user whose access tokens are managed: the current user, or any user for admins.
+func (s *APIV1Service) getAccessTokensUser(ctx context.Context, userID int32) (*store.User, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || (currentUser.ID != userID && currentUser.Role != store.RoleAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	if currentUser.ID == userID {
+		return currentUser, nil
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID:              &userID,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	return user, nil
+}
+
+// accessTokenID returns the identifier exposed instead of the access token: the id of the refresh token family of a
+// session, which outlives the rotated access tokens, or the hash of the access token.
+func accessTokenID(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) string {
+	if userAccessToken.RefreshTokenFamilyId != "" {
+		return userAccessToken.RefreshTokenFamilyId
+	}
+	return hashToken(userAccessToken.AccessToken)
+}
+
+func (s *APIV1Service) convertAccessTokenInfoFromStore(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken, currentAccessToken string) *v1pb.AccessTokenInfo {
+	accessToken := &v1pb.AccessTokenInfo{
+		Id:          accessTokenID(userAccessToken),
+		Description: userAccessToken.Description,
+		Current:     userAccessToken.AccessToken == currentAccessToken,
+	}
+	// The access token of a session may be expired while the session can still be refreshed.
+	claims := &ClaimsMessage{}
+	_, _ = jwt.ParseWithClaims(userAccessToken.AccessToken, claims, secretKeyFunc(s.Secret), jwt.WithoutClaimsValidation())
+	// The access tokens stored before their creation time was recorded fall back to the issue time of the token.
+	if userAccessToken.CreatedTs != 0 {
+		accessToken.CreatedTime = timestamppb.New(time.Unix(userAccessToken.CreatedTs, 0))
+	} else if claims.IssuedAt != nil {
+		accessToken.CreatedTime = timestamppb.New(claims.IssuedAt.Time)
+	}
+	if userAccessToken.LastUsedTs != 0 {
+		accessToken.LastUsedTime = timestamppb.New(time.Unix(userAccessToken.LastUsedTs, 0))
+	}
+	if userAccessToken.RefreshTokenFamilyId != "" {
+		accessToken.ExpireTime = timestamppb.New(time.Unix(userAccessToken.RefreshTokenExpiresTs, 0))
+	} else if claims.ExpiresAt != nil {
+		accessToken.ExpireTime = timestamppb.New(claims.ExpiresAt.Time)
+	}
+	return accessToken
+}
@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestAccessTokens(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	// Tokens issued in the same second with the same expiration are identical, so the testing token is replaced by one expiring later.
+	_, err := s.removeUserAccessTokens(ctx, user, func(*storepb.UserSetting_AccessTokensSetting_AccessToken) bool { return true })
+	require.NoError(t, err)
+	created, err := s.CreateUserAccessToken(withUser(ctx, user), &v1pb.CreateUserAccessTokenRequest{
+		Id:          user.ID,
+		Description: "cli",
+		ExpiresAt:   timestamppb.New(time.Now().Add(2 * time.Hour)),
+	})
+	require.NoError(t, err)
+	personalAccessToken := created.AccessToken
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	stream := &testingServerTransportStream{}
+	_, err = s.SignIn(grpc.NewContextWithServerTransportStream(ctx, stream), &v1pb.SignInRequest{Email: user.Email, Password: "password"})
+	require.NoError(t, err)
+	var sessionAccessToken string
+	for _, cookie := range (&http.Response{Header: http.Header{"Set-Cookie": stream.header.Get("Set-Cookie")}}).Cookies() {
+		if cookie.Name == AccessTokenCookieName {
+			sessionAccessToken = cookie.Value
+		}
+	}
+	require.NotEmpty(t, sessionAccessToken)
+	sessionCtx := withUser(metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+sessionAccessToken)), user)
+	list := func(ctx context.Context, id int32) []*v1pb.AccessTokenInfo {
+		response, err := s.ListAccessTokens(ctx, &v1pb.ListAccessTokensRequest{Id: id})
+		require.NoError(t, err)
+		return response.AccessTokens
+	}
+
+	// Authenticating records the last used time of the token.
+	_, err = NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, personalAccessToken)
+	require.NoError(t, err)
+
+	accessTokens := list(sessionCtx, user.ID)
+	require.Len(t, accessTokens, 2)
+	byDescription := map[string]*v1pb.AccessTokenInfo{}
+	for _, accessToken := range accessTokens {
+		require.NotContains(t, accessToken.Id, ".")
+		require.NotNil(t, accessToken.CreatedTime)
+		byDescription[accessToken.Description] = accessToken
+	}
+	session := byDescription[sessionAccessTokenDescription]
+	require.NotNil(t, session)
+	require.True(t, session.Current)
+	require.Nil(t, session.LastUsedTime)
+	require.WithinDuration(t, time.Now().Add(RefreshTokenDuration), session.ExpireTime.AsTime(), time.Minute)
+	personal := byDescription["cli"]
+	require.NotNil(t, personal)
+	require.False(t, personal.Current)
+	require.WithinDuration(t, time.Now(), personal.LastUsedTime.AsTime(), time.Minute)
+	for _, accessToken := range accessTokens {
+		require.False(t, strings.Contains(accessToken.String(), sessionAccessToken) || strings.Contains(accessToken.String(), personalAccessToken))
+	}
+
+	// Only the user and the admins manage the tokens of the user.
+	_, err = s.ListAccessTokens(withUser(ctx, other), &v1pb.ListAccessTokensRequest{Id: user.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.RevokeAccessToken(withUser(ctx, other), &v1pb.RevokeAccessTokenRequest{Id: user.ID, TokenId: personal.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ListAccessTokens(withUser(ctx, admin), &v1pb.ListAccessTokensRequest{Id: 404})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Len(t, list(withUser(ctx, admin), user.ID), 2)
+
+	// Admins revoke the tokens of other users.
+	_, err = s.RevokeAccessToken(withUser(ctx, admin), &v1pb.RevokeAccessTokenRequest{Id: user.ID, TokenId: personal.Id})
+	require.NoError(t, err)
+	_, err = NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, personalAccessToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.RevokeAccessToken(withUser(ctx, admin), &v1pb.RevokeAccessTokenRequest{Id: user.ID, TokenId: personal.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.RevokeAccessToken(withUser(ctx, admin), &v1pb.RevokeAccessTokenRequest{Id: user.ID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Revoking the token of the request signs out.
+	stream = &testingServerTransportStream{}
+	_, err = s.RevokeAccessToken(grpc.NewContextWithServerTransportStream(sessionCtx, stream), &v1pb.RevokeAccessTokenRequest{Id: user.ID, TokenId: session.Id})
+	require.NoError(t, err)
+	require.Len(t, stream.header.Get("Set-Cookie"), 2)
+	_, err = NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, sessionAccessToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Empty(t, list(withUser(ctx, user), user.ID))
+}
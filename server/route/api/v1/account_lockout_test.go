@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestAccountLockout(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	_, err = s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{AccountLockoutThreshold: 3, AccountLockoutMinutes: 10},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"account_lockout_threshold", "account_lockout_minutes"}},
+	})
+	require.NoError(t, err)
+	_, err = s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{AccountLockoutMinutes: maxAccountLockoutMinutes + 1},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"account_lockout_minutes"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	signIn := func(password string) error {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: user.Email, Password: password})
+		return err
+	}
+
+	// Only the consecutive failures count, a successful sign in resets them.
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("wrong")))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("wrong")))
+	require.NoError(t, signIn("password"))
+	for i := 0; i < 3; i++ {
+		require.Equal(t, codes.InvalidArgument, status.Code(signIn("wrong")))
+	}
+
+	// The locked account rejects even the right password.
+	err = signIn("password")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, accountLockedError, status.Convert(err).Message())
+	lockedUser, err := s.GetUser(withUser(ctx, admin), &v1pb.GetUserRequest{Id: user.ID})
+	require.NoError(t, err)
+	require.NotNil(t, lockedUser.LockExpireTime)
+	require.WithinDuration(t, time.Now().Add(10*time.Minute), lockedUser.LockExpireTime.AsTime(), time.Minute)
+
+	// An admin lifts the lockout.
+	unlockedUser, err := s.UnlockUser(withUser(ctx, admin), &v1pb.UnlockUserRequest{Id: user.ID})
+	require.NoError(t, err)
+	require.Nil(t, unlockedUser.LockExpireTime)
+	require.NoError(t, signIn("password"))
+	_, err = s.UnlockUser(withUser(ctx, admin), &v1pb.UnlockUserRequest{Id: 404})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The lockout ends by itself too.
+	for i := 0; i < 3; i++ {
+		require.Equal(t, codes.InvalidArgument, status.Code(signIn("wrong")))
+	}
+	lockedUntilTs := time.Now().Add(-time.Second).Unix()
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, LockedUntilTs: &lockedUntilTs})
+	require.NoError(t, err)
+	require.NoError(t, signIn("password"))
+
+	// The archived accounts are rejected with a message alike the one of the locked accounts.
+	archived := storepb.RowStatus_ARCHIVED
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, RowStatus: &archived})
+	require.NoError(t, err)
+	err = signIn("password")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, accountArchivedError, status.Convert(err).Message())
+}
@@ -2,8 +2,10 @@ package v1
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
@@ -26,6 +28,9 @@ const (
 	userIDContextKey ContextKey = iota
 )
 
+// accessTokenTouchInterval bounds how often the last used time of an access token is written.
+const accessTokenTouchInterval = time.Minute
+
 // GRPCAuthInterceptor is the auth interceptor for gRPC server.
 type GRPCAuthInterceptor struct {
 	Store  *store.Store
@@ -42,6 +47,24 @@ func NewGRPCAuthInterceptor(store *store.Store, secret string) *GRPCAuthIntercep
 
 // AuthenticationInterceptor is the unary interceptor for gRPC API.
 func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	childCtx, err := in.authorize(ctx, serverInfo.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(childCtx, request)
+}
+
+// StreamAuthenticationInterceptor applies the same rules as AuthenticationInterceptor to the streaming methods.
+func (in *GRPCAuthInterceptor) StreamAuthenticationInterceptor(server any, stream grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	childCtx, err := in.authorize(stream.Context(), serverInfo.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(server, &authenticatedServerStream{ServerStream: stream, ctx: childCtx})
+}
+
+// authorize authenticates the caller of the method and returns the context holding its user ID.
+func (in *GRPCAuthInterceptor) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to parse metadata from incoming context")
@@ -53,8 +76,8 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 
 	userID, err := in.authenticate(ctx, accessToken)
 	if err != nil {
-		if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
-			return handler(ctx, request)
+		if isUnauthorizeAllowedMethod(fullMethod) {
+			return ctx, nil
 		}
 		return nil, err
 	}
@@ -67,13 +90,66 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", userID)
 	}
-	if isOnlyForAdminAllowedMethod(serverInfo.FullMethod) && user.Role != store.RoleAdmin {
+	if isOnlyForAdminAllowedMethod(fullMethod) && user.Role != store.RoleAdmin {
 		return nil, status.Errorf(codes.PermissionDenied, "user ID %q is not admin", userID)
 	}
+	if err := in.checkImpersonation(ctx, fullMethod, userID, accessToken); err != nil {
+		return nil, err
+	}
 
 	// Stores userID into context.
-	childCtx := context.WithValue(ctx, userIDContextKey, userID)
-	return handler(childCtx, request)
+	return context.WithValue(ctx, userIDContextKey, userID), nil
+}
+
+// checkImpersonation rejects the methods an impersonation token can't call, and the impersonation tokens of the
+// admins who aren't active admins anymore.
+func (in *GRPCAuthInterceptor) checkImpersonation(ctx context.Context, fullMethod string, userID int32, accessToken string) error {
+	accessTokens, err := in.Store.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get user access tokens")
+	}
+	impersonatorID := int32(0)
+	for _, userAccessToken := range accessTokens {
+		if userAccessToken.AccessToken == accessToken {
+			impersonatorID = userAccessToken.ImpersonatorId
+			break
+		}
+	}
+	if impersonatorID == 0 {
+		return nil
+	}
+	impersonator, err := in.Store.GetUser(ctx, &store.FindUser{
+		ID: &impersonatorID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get impersonator")
+	}
+	if impersonator == nil || impersonator.Role != store.RoleAdmin {
+		return status.Errorf(codes.Unauthenticated, "the impersonator is not an admin anymore")
+	}
+	if methodsBlockedWhenImpersonating[fullMethod] {
+		return status.Errorf(codes.PermissionDenied, "not allowed while impersonating a user")
+	}
+	if destructiveMethods[fullMethod] {
+		securitySetting, err := in.Store.GetWorkspaceSecuritySetting(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to get workspace security setting")
+		}
+		if securitySetting.BlockDestructiveActionsWhenImpersonating {
+			return status.Errorf(codes.PermissionDenied, "destructive actions are blocked while impersonating a user")
+		}
+	}
+	return nil
+}
+
+// authenticatedServerStream overrides the context of the stream with the authenticated one.
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
 }
 
 func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken string) (int32, error) {
@@ -108,7 +184,8 @@ func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken str
 		return 0, status.Errorf(codes.Unauthenticated, "malformed ID %q in the access token", claims.Subject)
 	}
 	user, err := in.Store.GetUser(ctx, &store.FindUser{
-		ID: &userID,
+		ID:              &userID,
+		IncludeArchived: true,
 	})
 	if err != nil {
 		return 0, status.Errorf(codes.Unauthenticated, "failed to find user ID %q in the access token", userID)
@@ -127,10 +204,29 @@ func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken str
 	if !validateAccessToken(accessToken, accessTokens) {
 		return 0, status.Errorf(codes.Unauthenticated, "invalid access token")
 	}
+	in.touchAccessToken(ctx, userID, accessToken, accessTokens)
 
 	return userID, nil
 }
 
+// touchAccessToken updates the last used time of the access token, at most once per accessTokenTouchInterval.
+// The failures are logged, they don't fail the authentication.
+func (in *GRPCAuthInterceptor) touchAccessToken(ctx context.Context, userID int32, accessToken string, userAccessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) {
+	now := time.Now()
+	for _, userAccessToken := range userAccessTokens {
+		if userAccessToken.AccessToken != accessToken {
+			continue
+		}
+		if now.Sub(time.Unix(userAccessToken.LastUsedTs, 0)) < accessTokenTouchInterval {
+			return
+		}
+		if err := in.Store.TouchUserAccessToken(ctx, userID, accessToken, now.Unix()); err != nil {
+			slog.Warn("failed to touch access token", slog.Int("user_id", int(userID)), slog.String("error", err.Error()))
+		}
+		return
+	}
+}
+
 func getTokenFromMetadata(md metadata.MD) (string, error) {
 	// Try to get the token from the authorization header first.
 	authorizationHeaders := md.Get("Authorization")
@@ -142,16 +238,31 @@ func getTokenFromMetadata(md metadata.MD) (string, error) {
 		return authHeaderParts[1], nil
 	}
 	// Try to get the token from the cookie header.
-	var accessToken string
+	return getCookieFromMetadata(md, AccessTokenCookieName), nil
+}
+
+// getAccessTokenFromContext returns the access token of the incoming request, empty when there is none.
+func getAccessTokenFromContext(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	accessToken, err := getTokenFromMetadata(md)
+	if err != nil {
+		return ""
+	}
+	return accessToken
+}
+
+// getCookieFromMetadata returns the value of the cookie with the name, empty when there is none.
+func getCookieFromMetadata(md metadata.MD, name string) string {
+	var value string
 	for _, t := range append(md.Get("grpcgateway-cookie"), md.Get("cookie")...) {
 		header := http.Header{}
 		header.Add("Cookie", t)
 		request := http.Request{Header: header}
-		if v, _ := request.Cookie(AccessTokenCookieName); v != nil {
-			accessToken = v.Value
+		if v, _ := request.Cookie(name); v != nil {
+			value = v.Value
 		}
 	}
-	return accessToken, nil
+	return value
 }
 
 func audienceContains(audience jwt.ClaimStrings, token string) bool {
@@ -3,16 +3,27 @@ package v1
 import "strings"
 
 var allowedMethodsWhenUnauthorized = map[string]bool{
-	"/slash.api.v1.WorkspaceService/GetWorkspaceProfile":  true,
-	"/slash.api.v1.WorkspaceService/GetWorkspaceSetting":  true,
-	"/slash.api.v1.AuthService/GetAuthStatus":             true,
-	"/slash.api.v1.AuthService/SignIn":                    true,
-	"/slash.api.v1.AuthService/SignInWithSSO":             true,
-	"/slash.api.v1.AuthService/SignUp":                    true,
-	"/slash.api.v1.AuthService/SignOut":                   true,
-	"/slash.api.v1.ShortcutService/GetShortcut":           true,
-	"/slash.api.v1.ShortcutService/GetShortcutByName":     true,
-	"/slash.api.v1.CollectionService/GetCollectionByName": true,
+	"/slash.api.v1.WorkspaceService/GetWorkspaceProfile":        true,
+	"/slash.api.v1.WorkspaceService/GetWorkspaceSetting":        true,
+	"/slash.api.v1.AuthService/GetAuthStatus":                   true,
+	"/slash.api.v1.AuthService/GetAuthMethods":                  true,
+	"/slash.api.v1.AuthService/SignIn":                          true,
+	"/slash.api.v1.AuthService/SignInWithSSO":                   true,
+	"/slash.api.v1.AuthService/OAuthSignIn":                     true,
+	"/slash.api.v1.AuthService/SignUp":                          true,
+	"/slash.api.v1.AuthService/VerifyEmail":                     true,
+	"/slash.api.v1.AuthService/RequestPasswordReset":            true,
+	"/slash.api.v1.AuthService/ResetPassword":                   true,
+	"/slash.api.v1.AuthService/RefreshToken":                    true,
+	"/slash.api.v1.AuthService/SignOut":                         true,
+	"/slash.api.v1.AuthService/ValidateToken":                   true,
+	"/slash.api.v1.ShortcutService/GetShortcut":                 true,
+	"/slash.api.v1.ShortcutService/GetShortcutByName":           true,
+	"/slash.api.v1.ShortcutService/GetShortcutQRCode":           true,
+	"/slash.api.v1.ShortcutService/GetShortcutThumbnail":        true,
+	"/slash.api.v1.CollectionService/GetCollection":             true,
+	"/slash.api.v1.CollectionService/GetCollectionByName":       true,
+	"/slash.api.v1.CollectionService/GetCollectionByShareToken": true,
 }
 
 // isUnauthorizeAllowedMethod returns true if the method is allowed to be called when the user is not authorized.
@@ -24,13 +35,53 @@ func isUnauthorizeAllowedMethod(methodName string) bool {
 }
 
 var allowedMethodsOnlyForAdmin = map[string]bool{
-	"/slash.api.v1.UserService/CreateUser":                  true,
-	"/slash.api.v1.UserService/DeleteUser":                  true,
-	"/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting": true,
-	"/slash.api.v1.SubscriptionService/UpdateSubscription":  true,
+	"/slash.api.v1.UserService/ListUsers":                       true,
+	"/slash.api.v1.UserService/CreateUser":                      true,
+	"/slash.api.v1.UserService/BatchCreateUsers":                true,
+	"/slash.api.v1.UserService/DeleteUser":                      true,
+	"/slash.api.v1.UserService/UnlockUser":                      true,
+	"/slash.api.v1.UserService/TransferAdmin":                   true,
+	"/slash.api.v1.UserService/ImpersonateUser":                 true,
+	"/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting":     true,
+	"/slash.api.v1.WorkspaceService/GetServerConfig":            true,
+	"/slash.api.v1.WorkspaceService/ExportAuditLogs":            true,
+	"/slash.api.v1.WorkspaceService/ListEmbedTokens":            true,
+	"/slash.api.v1.WorkspaceService/CreateEmbedToken":           true,
+	"/slash.api.v1.WorkspaceService/DeleteEmbedToken":           true,
+	"/slash.api.v1.WorkspaceService/ListTagPolicies":            true,
+	"/slash.api.v1.WorkspaceService/UpsertTagPolicy":            true,
+	"/slash.api.v1.WorkspaceService/DeleteTagPolicy":            true,
+	"/slash.api.v1.WorkspaceService/ListRepoSyncs":              true,
+	"/slash.api.v1.WorkspaceService/CreateRepoSync":             true,
+	"/slash.api.v1.WorkspaceService/DeleteRepoSync":             true,
+	"/slash.api.v1.WorkspaceService/GetMigrationStatus":         true,
+	"/slash.api.v1.WorkspaceService/RebuildShortcutSearchIndex": true,
+	"/slash.api.v1.SubscriptionService/UpdateSubscription":      true,
 }
 
 // isOnlyForAdminAllowedMethod returns true if the method is allowed to be called only by admin.
 func isOnlyForAdminAllowedMethod(methodName string) bool {
 	return allowedMethodsOnlyForAdmin[methodName]
 }
+
+// methodsBlockedWhenImpersonating can't be called with an impersonation token, they would let the admin keep access to
+// the user past the token, or read their credentials.
+var methodsBlockedWhenImpersonating = map[string]bool{
+	"/slash.api.v1.AuthService/EnrollTOTP":            true,
+	"/slash.api.v1.AuthService/ActivateTOTP":          true,
+	"/slash.api.v1.UserService/ListUserAccessTokens":  true,
+	"/slash.api.v1.UserService/CreateUserAccessToken": true,
+	"/slash.api.v1.UserService/ImpersonateUser":       true,
+}
+
+// destructiveMethods are rejected for impersonation tokens when the workspace blocks the destructive actions.
+var destructiveMethods = map[string]bool{
+	"/slash.api.v1.CollectionService/DeleteCollection":     true,
+	"/slash.api.v1.ShortcutService/DeleteShortcut":         true,
+	"/slash.api.v1.ShortcutService/BatchRelinkShortcuts":   true,
+	"/slash.api.v1.SubscriptionService/DeleteSubscription": true,
+	"/slash.api.v1.UserService/UpdateUser":                 true,
+	"/slash.api.v1.UserService/DeleteUser":                 true,
+	"/slash.api.v1.UserService/DeleteUserAccessToken":      true,
+	"/slash.api.v1.UserService/RevokeAccessToken":          true,
+}
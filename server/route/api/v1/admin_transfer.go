@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// TransferAdmin hands the administration of the workspace over to another user. The caller re-authenticates as the
+// session alone isn't enough for it. The workspace is never left without an active admin: the promoted user is an
+// active user other than the caller, and they are promoted before the caller is demoted.
+func (s *APIV1Service) TransferAdmin(ctx context.Context, request *v1pb.TransferAdminRequest) (*v1pb.TransferAdminResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.reauthenticate(ctx, currentUser, request.Password, request.TotpCode); err != nil {
+		return nil, err
+	}
+	if request.Id == currentUser.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot transfer the admin role to yourself")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID:              &request.Id,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	// An archived admin can't sign in to administer the workspace.
+	if user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot transfer the admin role to an archived user, the workspace would have no active admin")
+	}
+
+	// The user is promoted first, so that a failure in between leaves two admins rather than none.
+	if user.Role != store.RoleAdmin {
+		adminRole := store.RoleAdmin
+		if user, err = s.Store.UpdateUser(ctx, &store.UpdateUser{
+			ID:   user.ID,
+			Role: &adminRole,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+		}
+	}
+	if request.DemoteSelf {
+		userRole := store.RoleUser
+		if currentUser, err = s.Store.UpdateUser(ctx, &store.UpdateUser{
+			ID:   currentUser.ID,
+			Role: &userRole,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+		}
+	}
+	s.createAdminTransferActivity(ctx, currentUser, &storepb.ActivityUserAdminTransferPayload{
+		UserId:  user.ID,
+		Demoted: request.DemoteSelf,
+	})
+	return &v1pb.TransferAdminResponse{
+		User:          convertUserFromStore(user),
+		PreviousAdmin: convertUserFromStore(currentUser),
+	}, nil
+}
+
+// reauthenticate checks the password of the user, and their two-factor authentication code when they enrolled in it.
+// The wrong passwords are throttled as the ones of sign ins.
+func (s *APIV1Service) reauthenticate(ctx context.Context, user *store.User, password, totpCode string) error {
+	if err := s.signInThrottler.check(store.GetWorkspaceID(ctx), user.Email); err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		s.signInThrottler.fail(store.GetWorkspaceID(ctx), user.Email)
+		return status.Errorf(codes.PermissionDenied, "incorrect password")
+	}
+	if err := s.checkSignInTOTP(ctx, user, totpCode); err != nil {
+		if totpCode != "" && status.Code(err) == codes.Unauthenticated {
+			s.signInThrottler.fail(store.GetWorkspaceID(ctx), user.Email)
+		}
+		return err
+	}
+	s.signInThrottler.succeed(store.GetWorkspaceID(ctx), user.Email)
+	return nil
+}
+
+func (s *APIV1Service) createAdminTransferActivity(ctx context.Context, user *store.User, payload *storepb.ActivityUserAdminTransferPayload) {
+	payload.Ip, payload.UserAgent = getClientInfo(ctx)
+	payloadStr, err := protojson.Marshal(payload)
+	if err != nil {
+		slog.Warn("failed to marshal admin transfer activity payload", slog.String("error", err.Error()))
+		return
+	}
+	if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityUserAdminTransfer,
+		Level:     store.ActivityWarn,
+		Payload:   string(payloadStr),
+	}); err != nil {
+		slog.Warn("failed to create admin transfer activity", slog.String("error", err.Error()))
+	}
+}
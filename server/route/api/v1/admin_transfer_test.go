@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestTransferAdmin(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	archived, _ := createTestingUser(ctx, t, s, "archived", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: admin.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	archivedStatus := storepb.RowStatus_ARCHIVED
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: archived.ID, RowStatus: &archivedStatus})
+	require.NoError(t, err)
+	transfer := func(id int32, password string) (*v1pb.TransferAdminResponse, error) {
+		return s.TransferAdmin(withUser(ctx, admin), &v1pb.TransferAdminRequest{
+			Id:         id,
+			DemoteSelf: true,
+			Password:   password,
+		})
+	}
+
+	// The admin re-authenticates with their password.
+	_, err = transfer(user.ID, "wrong")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The admin can't leave the workspace without an active admin.
+	_, err = transfer(admin.ID, "password")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = transfer(archived.ID, "password")
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = transfer(404, "password")
+	require.Equal(t, codes.NotFound, status.Code(err))
+	unchanged, err := s.Store.GetUser(ctx, &store.FindUser{ID: &admin.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.RoleAdmin, unchanged.Role)
+
+	response, err := transfer(user.ID, "password")
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Role_ADMIN, response.User.Role)
+	require.Equal(t, v1pb.Role_USER, response.PreviousAdmin.Role)
+	promoted, err := s.Store.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.RoleAdmin, promoted.Role)
+
+	// The transfer is audited.
+	activityType := store.ActivityUserAdminTransfer
+	activities, err := s.Store.ListActivities(ctx, &store.FindActivity{Type: activityType})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	require.Equal(t, admin.ID, activities[0].CreatorID)
+	payload := &storepb.ActivityUserAdminTransferPayload{}
+	require.NoError(t, protojson.Unmarshal([]byte(activities[0].Payload), payload))
+	require.Equal(t, user.ID, payload.UserId)
+	require.True(t, payload.Demoted)
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// auditLogExportBatchSize is the number of activities read from the store at once while exporting.
+const auditLogExportBatchSize = 500
+
+var auditLogCSVHeader = []string{"id", "creator_id", "create_time", "type", "level", "payload"}
+
+// auditLogJSONLine is an activity as exported in the JSON format.
+type auditLogJSONLine struct {
+	ID         int32           `json:"id"`
+	CreatorID  int32           `json:"creatorId"`
+	CreateTime string          `json:"createTime"`
+	Type       string          `json:"type"`
+	Level      string          `json:"level"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+}
+
+func (s *APIV1Service) ExportAuditLogs(request *v1pb.ExportAuditLogsRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	ctx := stream.Context()
+	format := request.Format
+	if format == v1pb.ExportAuditLogsRequest_FORMAT_UNSPECIFIED {
+		format = v1pb.ExportAuditLogsRequest_CSV
+	}
+	contentType := "text/csv"
+	if format == v1pb.ExportAuditLogsRequest_JSON {
+		contentType = "application/x-ndjson"
+	}
+	send := func(data []byte) error {
+		return stream.Send(&httpbody.HttpBody{ContentType: contentType, Data: data})
+	}
+
+	if format == v1pb.ExportAuditLogsRequest_CSV {
+		line, err := formatCSVLine(auditLogCSVHeader)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to format audit log: %v", err)
+		}
+		if err := send(line); err != nil {
+			return err
+		}
+	}
+
+	limit := auditLogExportBatchSize
+	find := &store.FindActivity{
+		Limit: &limit,
+	}
+	if request.StartTime != nil {
+		createdTsAfter := request.StartTime.AsTime().Unix()
+		find.CreatedTsAfter = &createdTsAfter
+	}
+	for {
+		activities, err := s.Store.ListActivities(ctx, find)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list activities: %v", err)
+		}
+		for _, activity := range activities {
+			line, err := formatAuditLog(activity, format)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to format audit log: %v", err)
+			}
+			if err := send(line); err != nil {
+				return err
+			}
+		}
+		if len(activities) < auditLogExportBatchSize {
+			return nil
+		}
+		find.IDAfter = &activities[len(activities)-1].ID
+	}
+}
+
+// formatAuditLog returns the activity as a line of the format without the trailing newline.
+func formatAuditLog(activity *store.Activity, format v1pb.ExportAuditLogsRequest_Format) ([]byte, error) {
+	createTime := time.Unix(activity.CreatedTs, 0).UTC().Format(time.RFC3339)
+	if format == v1pb.ExportAuditLogsRequest_JSON {
+		line := &auditLogJSONLine{
+			ID:         activity.ID,
+			CreatorID:  activity.CreatorID,
+			CreateTime: createTime,
+			Type:       string(activity.Type),
+			Level:      string(activity.Level),
+		}
+		if activity.Payload != "" {
+			if json.Valid([]byte(activity.Payload)) {
+				line.Payload = json.RawMessage(activity.Payload)
+			} else {
+				payload, err := json.Marshal(activity.Payload)
+				if err != nil {
+					return nil, err
+				}
+				line.Payload = payload
+			}
+		}
+		return json.Marshal(line)
+	}
+	return formatCSVLine([]string{
+		strconv.Itoa(int(activity.ID)),
+		strconv.Itoa(int(activity.CreatorID)),
+		createTime,
+		string(activity.Type),
+		string(activity.Level),
+		activity.Payload,
+	})
+}
+
+func formatCSVLine(record []string) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+	if err := writer.Write(record); err != nil {
+		return nil, errors.Wrap(err, "failed to write csv record")
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, errors.Wrap(err, "failed to flush csv record")
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// testingHTTPBodyStream collects the chunks sent by streaming handlers outside of a gRPC server.
+type testingHTTPBodyStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*httpbody.HttpBody
+}
+
+func (s *testingHTTPBodyStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testingHTTPBodyStream) Send(chunk *httpbody.HttpBody) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestExportAuditLogs(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	signIn, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: admin.ID,
+		Type:      store.ActivityUserSignIn,
+		Level:     store.ActivityInfo,
+		Payload:   `{"ip":"203.0.113.7","userAgent":"curl, \"quoted\""}`,
+	})
+	require.NoError(t, err)
+	for i := 0; i < auditLogExportBatchSize; i++ {
+		_, err := s.Store.CreateActivity(ctx, &store.Activity{
+			Type:  store.ActivityShortcutView,
+			Level: store.ActivityInfo,
+		})
+		require.NoError(t, err)
+	}
+	export := func(request *v1pb.ExportAuditLogsRequest) ([]string, string) {
+		stream := &testingHTTPBodyStream{ctx: withUser(ctx, admin)}
+		require.NoError(t, s.ExportAuditLogs(request, stream))
+		lines := []string{}
+		for _, chunk := range stream.chunks {
+			lines = append(lines, string(chunk.Data))
+		}
+		return lines, stream.chunks[0].ContentType
+	}
+	createTime := time.Unix(signIn.CreatedTs, 0).UTC().Format(time.RFC3339)
+
+	// CSV by default, with a header row and all the audit logs across batches.
+	lines, contentType := export(&v1pb.ExportAuditLogsRequest{})
+	require.Equal(t, "text/csv", contentType)
+	require.Equal(t, auditLogExportBatchSize+2, len(lines))
+	require.Equal(t, "id,creator_id,create_time,type,level,payload", lines[0])
+	require.Equal(t, fmt.Sprintf(`%d,%d,%s,user.sign-in,INFO,"{""ip"":""203.0.113.7"",""userAgent"":""curl, \""quoted\""""}"`, signIn.ID, admin.ID, createTime), lines[1])
+	require.True(t, strings.HasSuffix(lines[len(lines)-1], ",shortcut.view,INFO,"))
+
+	lines, contentType = export(&v1pb.ExportAuditLogsRequest{Format: v1pb.ExportAuditLogsRequest_JSON})
+	require.Equal(t, "application/x-ndjson", contentType)
+	require.Equal(t, auditLogExportBatchSize+1, len(lines))
+	require.Equal(t, fmt.Sprintf(`{"id":%d,"creatorId":%d,"createTime":"%s","type":"user.sign-in","level":"INFO","payload":{"ip":"203.0.113.7","userAgent":"curl, \"quoted\""}}`, signIn.ID, admin.ID, createTime), lines[0])
+	require.True(t, strings.HasSuffix(lines[1], `"type":"shortcut.view","level":"INFO"}`))
+
+	// Audit logs created before the start time are left out.
+	lines, _ = export(&v1pb.ExportAuditLogsRequest{StartTime: timestamppb.New(time.Unix(signIn.CreatedTs+3600, 0))})
+	require.Equal(t, []string{"id,creator_id,create_time,type,level,payload"}, lines)
+}
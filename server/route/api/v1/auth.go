@@ -5,6 +5,9 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/internal/util"
 )
 
 const (
@@ -22,6 +25,20 @@ const (
 	CookieExpDuration = AccessTokenDuration - 1*time.Minute
 	// AccessTokenCookieName is the cookie name of access token.
 	AccessTokenCookieName = "slash.access-token"
+	// RefreshTokenAudienceName is the audience name of the refresh token.
+	RefreshTokenAudienceName = "user.refresh-token"
+	RefreshTokenDuration     = 30 * 24 * time.Hour
+	// RefreshTokenCookieName is the cookie name of refresh token.
+	RefreshTokenCookieName = "slash.refresh-token"
+	// EmbedTokenAudienceName is the audience name of the embed token.
+	EmbedTokenAudienceName = "workspace.embed-token"
+	// EmailVerificationTokenAudienceName is the audience name of the email verification token.
+	EmailVerificationTokenAudienceName = "user.email-verification"
+	EmailVerificationTokenDuration     = 24 * time.Hour
+	// PasswordResetTokenDuration is how long a password reset token can be used.
+	PasswordResetTokenDuration = time.Hour
+	// ImpersonationTokenDuration is how long an admin can impersonate a user with an impersonation token.
+	ImpersonationTokenDuration = 30 * time.Minute
 )
 
 type ClaimsMessage struct {
@@ -29,12 +46,60 @@ type ClaimsMessage struct {
 	jwt.RegisteredClaims
 }
 
+// RefreshTokenClaimsMessage is the claims of a refresh token, each one has its own ID within the family of the session.
+type RefreshTokenClaimsMessage struct {
+	FamilyID string `json:"fid"`
+	jwt.RegisteredClaims
+}
+
 // GenerateAccessToken generates an access token.
 // username is the email of the user.
 func GenerateAccessToken(username string, userID int32, expirationTime time.Time, secret []byte) (string, error) {
 	return generateToken(username, userID, AccessTokenAudienceName, expirationTime, secret)
 }
 
+// GenerateEmailVerificationToken generates a token verifying the email of the user who signed up.
+func GenerateEmailVerificationToken(email string, userID int32, expirationTime time.Time, secret []byte) (string, error) {
+	return generateToken(email, userID, EmailVerificationTokenAudienceName, expirationTime, secret)
+}
+
+// GenerateEmbedToken generates a non-expiring embed token with the id of the workspace embed token setting,
+// it is revoked by removing the setting.
+func GenerateEmbedToken(id string, creatorID int32, secret []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &ClaimsMessage{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:   Issuer,
+			Audience: jwt.ClaimStrings{EmbedTokenAudienceName},
+			IssuedAt: jwt.NewNumericDate(time.Now()),
+			Subject:  fmt.Sprint(creatorID),
+			ID:       id,
+		},
+	})
+	token.Header["kid"] = KeyID
+	return token.SignedString(secret)
+}
+
+// GenerateRefreshToken generates a refresh token of the token family of a session.
+func GenerateRefreshToken(userID int32, familyID string, expirationTime time.Time, secret []byte) (string, error) {
+	id, err := util.RandomString(16)
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &RefreshTokenClaimsMessage{
+		FamilyID: familyID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{RefreshTokenAudienceName},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			Subject:   fmt.Sprint(userID),
+			ID:        id,
+		},
+	})
+	token.Header["kid"] = KeyID
+	return token.SignedString(secret)
+}
+
 // generateToken generates a jwt token.
 func generateToken(username string, userID int32, audience string, expirationTime time.Time, secret []byte) (string, error) {
 	registeredClaims := jwt.RegisteredClaims{
@@ -62,3 +127,38 @@ func generateToken(username string, userID int32, audience string, expirationTim
 
 	return tokenString, nil
 }
+
+// parseAccessToken verifies the access token signed with the secret and returns its claims.
+func parseAccessToken(accessToken, secret string) (*ClaimsMessage, error) {
+	claims := &ClaimsMessage{}
+	if _, err := jwt.ParseWithClaims(accessToken, claims, secretKeyFunc(secret)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// parseRefreshToken verifies the refresh token signed with the secret and returns its claims.
+func parseRefreshToken(refreshToken, secret string) (*RefreshTokenClaimsMessage, error) {
+	claims := &RefreshTokenClaimsMessage{}
+	if _, err := jwt.ParseWithClaims(refreshToken, claims, secretKeyFunc(secret), jwt.WithAudience(RefreshTokenAudienceName), jwt.WithExpirationRequired()); err != nil {
+		return nil, err
+	}
+	if claims.FamilyID == "" {
+		return nil, errors.New("the refresh token has no family id")
+	}
+	return claims, nil
+}
+
+func secretKeyFunc(secret string) jwt.Keyfunc {
+	return func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, errors.Errorf("unexpected token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		}
+		if kid, ok := t.Header["kid"].(string); ok {
+			if kid == KeyID {
+				return []byte(secret), nil
+			}
+		}
+		return nil, errors.Errorf("unexpected token kid=%v", t.Header["kid"])
+	}
+}
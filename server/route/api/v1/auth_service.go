@@ -3,18 +3,27 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mssola/useragent"
+	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/yourselfhosted/slash/internal/util"
-	"github.com/yourselfhosted/slash/plugin/idp"
-	"github.com/yourselfhosted/slash/plugin/idp/oauth2"
+	"github.com/yourselfhosted/slash/plugin/mail"
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/service/license"
@@ -23,6 +32,13 @@ import (
 
 const (
 	unmatchedEmailAndPasswordError = "unmatched email and password"
+
+	signInMethodPassword          = "password"
+	signInMethodSSO               = "sso"
+	signInMethodEmailVerification = "email_verification"
+
+	// sessionAccessTokenDescription is the description of the access tokens issued by signing in.
+	sessionAccessTokenDescription = "user login"
 )
 
 func (s *APIV1Service) GetAuthStatus(ctx context.Context, _ *v1pb.GetAuthStatusRequest) (*v1pb.User, error) {
@@ -36,120 +52,124 @@ func (s *APIV1Service) GetAuthStatus(ctx context.Context, _ *v1pb.GetAuthStatusR
 	return convertUserFromStore(user), nil
 }
 
-func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest) (*v1pb.User, error) {
-	user, err := s.Store.GetUser(ctx, &store.FindUser{
-		Email: &request.Email,
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
-	}
-	if user == nil {
-		return nil, status.Errorf(codes.InvalidArgument, unmatchedEmailAndPasswordError)
-	}
-	// Compare the stored hashed password, with the hashed version of the password that was received.
-	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, unmatchedEmailAndPasswordError)
+// GetAuthMethods returns the sign in methods of the workspace for the email, whether or not it has an account.
+// The methods of the accounts, e.g. whether they enrolled in two-factor authentication, are not returned as they would
+// reveal which emails have one.
+func (s *APIV1Service) GetAuthMethods(ctx context.Context, request *v1pb.GetAuthMethodsRequest) (*v1pb.GetAuthMethodsResponse, error) {
+	if !util.ValidateEmail(request.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email address")
 	}
-
 	workspaceSecuritySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get workspace security setting: %v", err)
 	}
-	if workspaceSecuritySetting.DisallowPasswordAuth && user.Role == store.RoleUser {
-		return nil, status.Errorf(codes.PermissionDenied, "password authentication is not allowed")
-	}
-	if user.RowStatus == storepb.RowStatus_ARCHIVED {
-		return nil, status.Errorf(codes.PermissionDenied, "user has been archived")
+	response := &v1pb.GetAuthMethodsResponse{
+		Password:       !workspaceSecuritySetting.DisallowPasswordAuth,
+		OauthProviders: []*v1pb.WorkspaceProfile_OAuthProvider{},
+		MfaAvailable:   true,
 	}
-
-	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
-	}
-	return convertUserFromStore(user), nil
-}
-
-func (s *APIV1Service) SignInWithSSO(ctx context.Context, request *v1pb.SignInWithSSORequest) (*v1pb.User, error) {
 	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
-		return nil, status.Errorf(codes.PermissionDenied, "SSO is not available in the current plan")
+		return response, nil
 	}
-
 	identityProviderSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
 		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %s", err)
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
 	}
-	if identityProviderSetting == nil || identityProviderSetting.GetIdentityProvider() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
-	}
-	var identityProvider *storepb.IdentityProvider
-	for _, idp := range identityProviderSetting.GetIdentityProvider().IdentityProviders {
-		if idp.Id == request.IdpId {
-			identityProvider = idp
-			break
+	identityProviders := []*storepb.IdentityProvider{}
+	for _, identityProvider := range identityProviderSetting.GetIdentityProvider().GetIdentityProviders() {
+		if isIdentityProviderEmail(identityProvider, request.Email) {
+			identityProviders = append(identityProviders, identityProvider)
 		}
 	}
-	if identityProvider == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
-	}
+	response.OauthProviders = convertOAuthProvidersFromStore(identityProviders)
+	return response, nil
+}
 
-	var userInfo *idp.IdentityProviderUserInfo
-	if identityProvider.Type == storepb.IdentityProvider_OAUTH2 {
-		oauth2IdentityProvider, err := oauth2.NewIdentityProvider(identityProvider.Config.GetOauth2())
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create oauth2 identity provider, err: %s", err)
-		}
-		token, err := oauth2IdentityProvider.ExchangeToken(ctx, request.RedirectUri, request.Code)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to exchange token, err: %s", err)
-		}
-		userInfo, err = oauth2IdentityProvider.UserInfo(token)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get user info, err: %s", err)
-		}
+func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest) (*v1pb.User, error) {
+	workspaceSecuritySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace security setting: %v", err)
 	}
-
-	email := userInfo.Identifier
-	if !util.ValidateEmail(email) {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid email address")
+	signInRateLimit := getSignInRateLimit(workspaceSecuritySetting)
+	ip, _ := getClientInfo(ctx)
+	// The first key is the one of the email, only it is reset by signing in: an attacker would otherwise reset the
+	// failures of their IP by signing in to their own account.
+	rateLimitKeys := signInRateLimitKeys(store.GetWorkspaceID(ctx), ip, request.Email)
+	if err := s.signInRateLimiter.check(signInRateLimit, rateLimitKeys...); err != nil {
+		return nil, err
+	}
+	if err := s.signInThrottler.check(store.GetWorkspaceID(ctx), request.Email); err != nil {
+		return nil, err
+	}
+	fail := func() {
+		s.signInThrottler.fail(store.GetWorkspaceID(ctx), request.Email)
+		s.signInRateLimiter.fail(signInRateLimit, rateLimitKeys...)
 	}
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
-		Email: &email,
+		Email:           &request.Email,
+		IncludeArchived: true,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user, err: %s", err)
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
 	if user == nil {
-		if err := s.checkSeatAvailability(ctx); err != nil {
+		fail()
+		return nil, status.Errorf(codes.InvalidArgument, unmatchedEmailAndPasswordError)
+	}
+	// The credentials are not checked during the lockout, even the correct ones are rejected.
+	if isUserLocked(user, time.Now()) {
+		return nil, rejectSignIn(user, "locked", accountLockedError)
+	}
+	// Compare the stored hashed password, with the hashed version of the password that was received.
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
+		fail()
+		if err := s.recordFailedSignIn(ctx, user, workspaceSecuritySetting); err != nil {
 			return nil, err
 		}
-		userCreate := &store.User{
-			Email:    email,
-			Nickname: userInfo.DisplayName,
-			// The new signup user should be normal user by default.
-			Role: store.RoleUser,
-		}
-		password, err := util.RandomString(20)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to generate random password, err: %s", err)
-		}
-		passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to generate password hash, err: %s", err)
-		}
-		userCreate.PasswordHash = string(passwordHash)
-		user, err = s.Store.CreateUser(ctx, userCreate)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create user, err: %s", err)
+		return nil, status.Errorf(codes.InvalidArgument, unmatchedEmailAndPasswordError)
+	}
+	if err := s.checkSignInTOTP(ctx, user, request.TotpCode); err != nil {
+		// The guesses of the codes are throttled as the ones of the passwords.
+		if request.TotpCode != "" && status.Code(err) == codes.Unauthenticated {
+			fail()
+			if err := s.recordFailedSignIn(ctx, user, workspaceSecuritySetting); err != nil {
+				return nil, err
+			}
 		}
+		return nil, err
+	}
+	s.signInThrottler.succeed(store.GetWorkspaceID(ctx), request.Email)
+	s.signInRateLimiter.succeed(rateLimitKeys[0])
+	if user, err = s.resetFailedSignIns(ctx, user); err != nil {
+		return nil, err
+	}
+	user = s.rehashPassword(ctx, user, request.Password)
+
+	if workspaceSecuritySetting.DisallowPasswordAuth && user.Role == store.RoleUser {
+		return nil, status.Errorf(codes.PermissionDenied, "password authentication is not allowed")
 	}
 	if user.RowStatus == storepb.RowStatus_ARCHIVED {
-		return nil, status.Errorf(codes.PermissionDenied, "user has been archived")
+		return nil, rejectSignIn(user, "archived", accountArchivedError)
+	}
+	emailVerification, err := s.getUserEmailVerification(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if emailVerification != nil && !emailVerification.Verified {
+		return nil, status.Errorf(codes.FailedPrecondition, "email is not verified")
 	}
 
+	if err := s.enforceSessionLimit(ctx, user); err != nil {
+		return nil, err
+	}
 	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to sign in, err: %s", err)
+		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
 	}
+	s.createUserSignInActivity(ctx, user, &storepb.ActivityUserSignInPayload{
+		Method: signInMethodPassword,
+	})
 	return convertUserFromStore(user), nil
 }
 
@@ -167,7 +187,10 @@ func (s *APIV1Service) SignUp(ctx context.Context, request *v1pb.SignUpRequest)
 		return nil, err
 	}
 
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if err := validatePassword(request.Password, workspaceSecuritySetting); err != nil {
+		return nil, err
+	}
+	passwordHash, err := s.hashPassword(request.Password)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
 	}
@@ -175,9 +198,11 @@ func (s *APIV1Service) SignUp(ctx context.Context, request *v1pb.SignUpRequest)
 	create := &store.User{
 		Email:        request.Email,
 		Nickname:     request.Nickname,
-		PasswordHash: string(passwordHash),
+		PasswordHash: passwordHash,
 	}
-	existingUsers, err := s.Store.ListUsers(ctx, &store.FindUser{})
+	existingUsers, err := s.Store.ListUsers(ctx, &store.FindUser{
+		IncludeArchived: true,
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
 	}
@@ -192,51 +217,391 @@ func (s *APIV1Service) SignUp(ctx context.Context, request *v1pb.SignUpRequest)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
+	// The first admin initializes the workspace.
+	if user.Role == store.RoleAdmin {
+		if err := s.SeedWorkspaceShortcuts(ctx); err != nil {
+			slog.Warn("failed to seed workspace shortcuts", slog.String("error", err.Error()))
+		}
+	}
+	// The first admin is verified, nobody could let them in otherwise.
+	if workspaceSecuritySetting.RequireEmailVerification && user.Role != store.RoleAdmin {
+		if err := s.requestEmailVerification(ctx, user); err != nil {
+			return nil, err
+		}
+		return convertUserFromStore(user), nil
+	}
+	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
+	}
+	return convertUserFromStore(user), nil
+}
+
+func (s *APIV1Service) VerifyEmail(ctx context.Context, request *v1pb.VerifyEmailRequest) (*v1pb.User, error) {
+	if request.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "verification token is required")
+	}
+	claims, err := parseAccessToken(request.Token, s.Secret)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, status.Errorf(codes.InvalidArgument, "verification token is expired")
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification token")
+	}
+	if !audienceContains(claims.Audience, EmailVerificationTokenAudienceName) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification token")
+	}
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification token")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID:              &userID,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	// The token only verifies the email it was issued for.
+	if user == nil || user.Email != claims.Name {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification token")
+	}
+	if user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.PermissionDenied, "user has been archived")
+	}
+	emailVerification, err := s.getUserEmailVerification(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	// Verified tokens are not accepted again, otherwise they could sign in until they expire.
+	if emailVerification == nil || emailVerification.Verified {
+		return nil, status.Errorf(codes.FailedPrecondition, "email is already verified")
+	}
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION,
+		Value: &storepb.UserSetting_EmailVerification{
+			EmailVerification: &storepb.UserSetting_EmailVerificationSetting{
+				Verified: true,
+			},
+		},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+
+	if err := s.enforceSessionLimit(ctx, user); err != nil {
+		return nil, err
+	}
 	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
 	}
+	s.createUserSignInActivity(ctx, user, &storepb.ActivityUserSignInPayload{
+		Method: signInMethodEmailVerification,
+	})
 	return convertUserFromStore(user), nil
 }
 
+// requestEmailVerification marks the email of the user who signed up as unverified and mails them its verification
+// link. Without a mail server, the token is logged for the admins to pass on to the user.
+func (s *APIV1Service) requestEmailVerification(ctx context.Context, user *store.User) error {
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION,
+		Value: &storepb.UserSetting_EmailVerification{
+			EmailVerification: &storepb.UserSetting_EmailVerificationSetting{},
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	token, err := GenerateEmailVerificationToken(user.Email, user.ID, time.Now().Add(EmailVerificationTokenDuration), []byte(s.Secret))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to generate verification token: %v", err)
+	}
+	if s.Mailer == nil {
+		slog.Info("email verification requested", slog.Int("user_id", int(user.ID)), slog.String("email", user.Email), slog.String("token", token))
+		return nil
+	}
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	body := fmt.Sprintf("<p>Use the token <code>%s</code> to verify your email. It expires in %v.</p>", token, EmailVerificationTokenDuration)
+	if instanceURL := generalSetting.GetInstanceUrl(); instanceURL != "" {
+		link := fmt.Sprintf("%s/auth/verify-email?token=%s", strings.TrimSuffix(instanceURL, "/"), url.QueryEscape(token))
+		body = fmt.Sprintf("<p><a href=\"%s\">Verify your email</a>. The link expires in %v.</p>", link, EmailVerificationTokenDuration)
+	}
+	email := mail.NewEmailMsg().
+		SetFrom(s.MailFrom).
+		AddTo(user.Email).
+		SetSubject("Verify your Slash email").
+		SetBody(body)
+	// The user is signed up by now, so the token is logged for the admins to pass on rather than failing.
+	if err := s.Mailer.SendMail(email); err != nil {
+		slog.Error("failed to send email verification", slog.Int("user_id", int(user.ID)), slog.String("token", token), slog.String("error", err.Error()))
+	}
+	return nil
+}
+
+// getUserEmailVerification returns the email verification of the user, nil for the users who never had to verify their email.
+func (s *APIV1Service) getUserEmailVerification(ctx context.Context, user *store.User) (*storepb.UserSetting_EmailVerificationSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user email verification: %v", err)
+	}
+	if userSetting == nil {
+		return nil, nil
+	}
+	return userSetting.GetEmailVerification(), nil
+}
+
+// doSignIn starts a session of the user, its access token and refresh token are set as cookies.
 func (s *APIV1Service) doSignIn(ctx context.Context, user *store.User, expireTime time.Time) error {
-	accessToken, err := GenerateAccessToken(user.Email, user.ID, expireTime, []byte(s.Secret))
+	familyID, err := util.RandomString(16)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to generate refresh token family id: %v", err)
+	}
+	tokens, err := s.generateSessionTokens(user, expireTime, familyID)
 	if err != nil {
-		return status.Errorf(codes.Internal, "failed to generate access token: %v", err)
+		return err
 	}
-	if err := s.UpsertAccessTokenToStore(ctx, user, accessToken, "user login"); err != nil {
+	if err := s.Store.UpsertUserAccessTokens(ctx, user.ID, tokens.userAccessToken); err != nil {
 		return status.Errorf(codes.Internal, "failed to upsert access token to store: %v", err)
 	}
+	return setSessionCookies(ctx, tokens)
+}
 
-	cookie := fmt.Sprintf("%s=%s; Path=/; Expires=%s; HttpOnly; SameSite=Strict", AccessTokenCookieName, accessToken, time.Now().Add(AccessTokenDuration).Format(time.RFC1123))
-	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
-		"Set-Cookie": cookie,
-	})); err != nil {
-		return status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+// enforceSessionLimit makes room for a new session of the user when the workspace limits the sessions per user.
+// Depending on the policy, the oldest sessions are signed out or signing in is rejected.
+// Expired sessions, whose access token and refresh token are both expired, are dropped and the access tokens not
+// issued by signing in are left alone.
+func (s *APIV1Service) enforceSessionLimit(ctx context.Context, user *store.User) error {
+	securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace security setting: %v", err)
+	}
+	maxSessions := int(securitySetting.MaxSessionsPerUser)
+	if maxSessions <= 0 {
+		return nil
+	}
+	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list access tokens: %v", err)
 	}
 
+	// The access tokens are stored in the order they are issued.
+	kept, sessions := []*storepb.UserSetting_AccessTokensSetting_AccessToken{}, []*storepb.UserSetting_AccessTokensSetting_AccessToken{}
+	for _, userAccessToken := range userAccessTokens {
+		if userAccessToken.Description != sessionAccessTokenDescription {
+			kept = append(kept, userAccessToken)
+		} else if isSessionAlive(userAccessToken, s.Secret) {
+			sessions = append(sessions, userAccessToken)
+		}
+	}
+	if len(sessions) >= maxSessions {
+		if securitySetting.SessionLimitPolicy == storepb.WorkspaceSetting_SecuritySetting_REJECT {
+			return status.Errorf(codes.PermissionDenied, "Maximum number of sessions %d reached", maxSessions)
+		}
+		sessions = sessions[len(sessions)-maxSessions+1:]
+	}
+	kept = append(kept, sessions...)
+	if len(kept) == len(userAccessTokens) {
+		return nil
+	}
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.UserSetting_AccessTokensSetting{
+				AccessTokens: kept,
+			},
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
 	return nil
 }
 
-func (*APIV1Service) SignOut(ctx context.Context, _ *v1pb.SignOutRequest) (*emptypb.Empty, error) {
-	// Set the cookie header to expire access token.
-	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
-		"Set-Cookie": fmt.Sprintf("%s=; Path=/; Expires=Thu, 01 Jan 1970 00:00:00 GMT; HttpOnly; SameSite=Strict", AccessTokenCookieName),
-	})); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+// createUserSignInActivity records the sign-in enriched with the client information for auditing.
+// Failures are logged only so that auditing never blocks signing in.
+func (s *APIV1Service) createUserSignInActivity(ctx context.Context, user *store.User, payload *storepb.ActivityUserSignInPayload) {
+	payload.Ip, payload.UserAgent = getClientInfo(ctx)
+	ua := useragent.New(payload.UserAgent)
+	payload.Browser, _ = ua.Browser()
+	payload.Os = ua.OSInfo().Name
+	if s.GeoIPResolver != nil && payload.Ip != "" {
+		location, err := s.GeoIPResolver.Lookup(ctx, payload.Ip)
+		if err != nil {
+			slog.Warn("failed to look up geolocation", slog.String("ip", payload.Ip), slog.String("error", err.Error()))
+		} else if location != nil {
+			payload.GeoLocation = &storepb.ActivityUserSignInPayload_GeoLocation{
+				Country:      location.Country,
+				City:         location.City,
+				Asn:          location.ASN,
+				Organization: location.Organization,
+			}
+		}
+	}
+
+	payloadStr, err := protojson.Marshal(payload)
+	if err != nil {
+		slog.Warn("failed to marshal sign in activity payload", slog.String("error", err.Error()))
+		return
+	}
+	if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityUserSignIn,
+		Level:     store.ActivityInfo,
+		Payload:   string(payloadStr),
+	}); err != nil {
+		slog.Warn("failed to create sign in activity", slog.String("error", err.Error()))
 	}
-	return &emptypb.Empty{}, nil
 }
 
-func (s *APIV1Service) checkSeatAvailability(ctx context.Context) error {
-	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeUnlimitedAccounts) {
-		userList, err := s.Store.ListUsers(ctx, &store.FindUser{})
-		if err != nil {
-			return status.Errorf(codes.Internal, "failed to list users: %v", err)
+// getClientInfo returns the ip and user agent of the client from the request metadata. The ip is the one the gateway
+// or the gRPC-Web proxy tells for their clients, the peer address when they can't tell it.
+func getClientInfo(ctx context.Context) (ip string, userAgent string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := append(md.Get("grpcgateway-user-agent"), md.Get("user-agent")...); len(values) > 0 {
+		userAgent = values[0]
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
 		}
-		seats := s.LicenseService.GetSubscription().Seats
-		if len(userList) >= int(seats) {
-			return status.Errorf(codes.FailedPrecondition, "maximum number of users %d reached", seats)
+	}
+	// The gateway and the gRPC-Web proxy replace the ip the client sends them, and the gRPC server only listens on the
+	// loopback for the gateway.
+	if values := md.Get(clientIPMetadataKey); len(values) > 0 {
+		ip = values[0]
+	}
+	return ip, userAgent
+}
+
+// SignOut revokes the access token of the request, along with its refresh token, and expires the cookies.
+// Signing out without a valid access token only expires the cookies.
+func (s *APIV1Service) SignOut(ctx context.Context, _ *v1pb.SignOutRequest) (*emptypb.Empty, error) {
+	if err := s.revokeRequestAccessToken(ctx); err != nil {
+		return nil, err
+	}
+	if err := expireSessionCookies(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// expireSessionCookies sets the cookie headers to expire the access token and the refresh token.
+func expireSessionCookies(ctx context.Context) error {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(
+		"Set-Cookie", buildAccessTokenCookie(ctx, "", time.Unix(0, 0)),
+		"Set-Cookie", buildRefreshTokenCookie(ctx, "", time.Unix(0, 0)),
+	)); err != nil {
+		return status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+	}
+	return nil
+}
+
+// revokeRequestAccessToken removes the access token of the request from the access tokens of its user.
+func (s *APIV1Service) revokeRequestAccessToken(ctx context.Context) error {
+	accessToken := getAccessTokenFromContext(ctx)
+	if accessToken == "" {
+		return nil
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil
+	}
+	_, err = s.removeUserAccessTokens(ctx, user, func(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) bool {
+		return userAccessToken.AccessToken == accessToken
+	})
+	return err
+}
+
+// ValidateToken checks the signature, expiration and revocation of the access token and the status of its user.
+// Invalid tokens are reported in the response rather than as errors.
+func (s *APIV1Service) ValidateToken(ctx context.Context, request *v1pb.ValidateTokenRequest) (*v1pb.ValidateTokenResponse, error) {
+	if request.AccessToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "access token is required")
+	}
+	invalid := func(reason string) (*v1pb.ValidateTokenResponse, error) {
+		return &v1pb.ValidateTokenResponse{InvalidReason: reason}, nil
+	}
+
+	claims, err := parseAccessToken(request.AccessToken, s.Secret)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return invalid("access token is expired")
 		}
+		return invalid("access token is malformed or has an invalid signature")
+	}
+	if !audienceContains(claims.Audience, AccessTokenAudienceName) {
+		return invalid("access token audience mismatch")
+	}
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return invalid("access token has a malformed user ID")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID:              &userID,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, err: %v", err)
+	}
+	if user == nil {
+		return invalid("user not found")
+	}
+	if user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return invalid("user is archived")
+	}
+	accessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user access tokens, err: %v", err)
+	}
+	if !validateAccessToken(request.AccessToken, accessTokens) {
+		return invalid("access token is revoked")
+	}
+
+	response := &v1pb.ValidateTokenResponse{
+		Valid:  true,
+		UserId: user.ID,
+		Role:   convertUserRoleFromStore(user.Role),
+	}
+	if claims.ExpiresAt != nil {
+		response.ExpireTime = timestamppb.New(claims.ExpiresAt.Time)
+	}
+	return response, nil
+}
+
+// checkSeatAvailability returns a ResourceExhausted error when the active users reached the maximum of the license.
+// The archived users don't take a seat.
+func (s *APIV1Service) checkSeatAvailability(ctx context.Context) error {
+	maxUsers := s.LicenseService.MaxUsers()
+	if maxUsers == 0 {
+		return nil
+	}
+	userList, err := s.listActiveUsers(ctx)
+	if err != nil {
+		return err
+	}
+	if len(userList) >= maxUsers {
+		return status.Errorf(codes.ResourceExhausted, "the workspace reached its maximum of %d users", maxUsers)
 	}
 	return nil
 }
+
+func (s *APIV1Service) listActiveUsers(ctx context.Context) ([]*store.User, error) {
+	rowStatus := storepb.RowStatus_NORMAL
+	userList, err := s.Store.ListUsers(ctx, &store.FindUser{
+		RowStatus: &rowStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	return userList, nil
+}
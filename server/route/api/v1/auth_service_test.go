@@ -0,0 +1,654 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yourselfhosted/slash/internal/totp"
+	"github.com/yourselfhosted/slash/plugin/geoip"
+	"github.com/yourselfhosted/slash/plugin/mail"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// testingServerTransportStream accepts the headers set by handlers outside of a gRPC server.
+type testingServerTransportStream struct {
+	header metadata.MD
+}
+
+func (*testingServerTransportStream) Method() string { return "" }
+
+func (s *testingServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *testingServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (*testingServerTransportStream) SetTrailer(metadata.MD) error { return nil }
+
+type stubGeoIPResolver struct {
+	locations map[string]*geoip.Location
+}
+
+func (r *stubGeoIPResolver) Lookup(_ context.Context, ip string) (*geoip.Location, error) {
+	location, ok := r.locations[ip]
+	if !ok {
+		return nil, errors.Errorf("unknown ip %s", ip)
+	}
+	return location, nil
+}
+
+func TestSignInLoginEnrichment(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	s.GeoIPResolver = &stubGeoIPResolver{
+		locations: map[string]*geoip.Location{
+			"203.0.113.7": {Country: "NL", City: "Amsterdam", ASN: "AS64496", Organization: "Example Net"},
+		},
+	}
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+
+	signIn := func(userAgent, ip string) {
+		md := metadata.Pairs("grpcgateway-user-agent", userAgent, clientIPMetadataKey, ip)
+		ctx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ctx, md), &testingServerTransportStream{})
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: user.Email, Password: "password"})
+		require.NoError(t, err)
+	}
+	signIn("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36", "203.0.113.7")
+	// Unknown locations don't fail the sign in.
+	signIn("Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0", "198.51.100.1")
+
+	response, err := s.ListUserLogins(withUser(ctx, user), &v1pb.ListUserLoginsRequest{Id: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Logins))
+	latest, first := response.Logins[0], response.Logins[1]
+	require.Equal(t, "198.51.100.1", latest.Ip)
+	require.Equal(t, "Firefox", latest.Browser)
+	require.Equal(t, "Linux", latest.Os)
+	require.Empty(t, latest.Country)
+	require.Equal(t, signInMethodPassword, first.Method)
+	require.Equal(t, "203.0.113.7", first.Ip)
+	require.Equal(t, "Chrome", first.Browser)
+	require.Equal(t, "Windows", first.Os)
+	require.Contains(t, first.UserAgent, "Chrome/120.0.0.0")
+	require.Equal(t, "NL", first.Country)
+	require.Equal(t, "Amsterdam", first.City)
+	require.Equal(t, "AS64496", first.Asn)
+	require.Equal(t, "Example Net", first.Organization)
+
+	response, err = s.ListUserLogins(withUser(ctx, user), &v1pb.ListUserLoginsRequest{Id: user.ID, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Logins))
+	require.Equal(t, "198.51.100.1", response.Logins[0].Ip)
+
+	// Logins are visible to the user and admins only.
+	response, err = s.ListUserLogins(withUser(ctx, admin), &v1pb.ListUserLoginsRequest{Id: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Logins))
+	_, err = s.ListUserLogins(withUser(ctx, other), &v1pb.ListUserLoginsRequest{Id: user.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGRPCWebSignInClientIP(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	s.Profile.TrustedProxies = []string{"10.0.0.0/8"}
+	grpcServer := grpc.NewServer()
+	v1pb.RegisterAuthServiceServer(grpcServer, s)
+	handler := newGRPCWebHandler(s.Profile, grpcServer)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	signIn := func(remoteAddr string, header map[string]string) string {
+		request := newGRPCWebRequest(t, "/slash.api.v1.AuthService/SignIn", &v1pb.SignInRequest{Email: user.Email, Password: "password"})
+		request.RemoteAddr = remoteAddr
+		for key, value := range header {
+			request.Header.Set(key, value)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		response, err := s.ListUserLogins(withUser(ctx, user), &v1pb.ListUserLoginsRequest{Id: user.ID, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, response.Logins, 1)
+		return response.Logins[0].Ip
+	}
+
+	// The header telling the handlers the ip of the client can't be sent by the client, even through a trusted proxy.
+	require.Equal(t, "203.0.113.7", signIn("203.0.113.7:1234", map[string]string{clientIPMetadataKey: "198.51.100.1"}))
+	require.Equal(t, "198.51.100.9", signIn("10.0.0.1:1234", map[string]string{"X-Forwarded-For": "198.51.100.9", clientIPMetadataKey: "198.51.100.1"}))
+	require.Equal(t, "127.0.0.1", signIn("127.0.0.1:1234", map[string]string{clientIPMetadataKey: "198.51.100.1"}))
+}
+
+func TestSignInSessionLimit(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	// Tokens issued in the same second with the same expiration are identical, so distinct ones are created upfront.
+	personalAccessToken, err := GenerateAccessToken(user.Email, user.ID, time.Now().Add(AccessTokenDuration+time.Hour), []byte(s.Secret))
+	require.NoError(t, err)
+	_, err = s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.UserSetting_AccessTokensSetting{
+				AccessTokens: []*storepb.UserSetting_AccessTokensSetting_AccessToken{{AccessToken: personalAccessToken}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	sessions := []string{}
+	for i := 1; i <= 2; i++ {
+		accessToken, err := GenerateAccessToken(user.Email, user.ID, time.Now().Add(time.Duration(i)*time.Hour), []byte(s.Secret))
+		require.NoError(t, err)
+		require.NoError(t, s.UpsertAccessTokenToStore(ctx, user, accessToken, sessionAccessTokenDescription))
+		sessions = append(sessions, accessToken)
+	}
+	updateSetting := func(setting *v1pb.WorkspaceSetting, paths ...string) {
+		_, err := s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+			Setting:    setting,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		require.NoError(t, err)
+	}
+	signIn := func() error {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: user.Email, Password: "password"})
+		return err
+	}
+	listAccessTokens := func() []string {
+		userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+		require.NoError(t, err)
+		accessTokens := []string{}
+		for _, userAccessToken := range userAccessTokens {
+			accessTokens = append(accessTokens, userAccessToken.AccessToken)
+		}
+		return accessTokens
+	}
+
+	// Rejected at the limit.
+	updateSetting(&v1pb.WorkspaceSetting{
+		MaxSessionsPerUser: 2,
+		SessionLimitPolicy: v1pb.WorkspaceSetting_REJECT,
+	}, "max_sessions_per_user", "session_limit_policy")
+	require.Equal(t, codes.PermissionDenied, status.Code(signIn()))
+	require.Equal(t, []string{personalAccessToken, sessions[0], sessions[1]}, listAccessTokens())
+
+	// The oldest session is evicted at the limit, and the personal access tokens are kept.
+	updateSetting(&v1pb.WorkspaceSetting{
+		SessionLimitPolicy: v1pb.WorkspaceSetting_EVICT_OLDEST,
+	}, "session_limit_policy")
+	require.NoError(t, signIn())
+	accessTokens := listAccessTokens()
+	require.Equal(t, 3, len(accessTokens))
+	require.Equal(t, []string{personalAccessToken, sessions[1]}, accessTokens[:2])
+	require.NotContains(t, accessTokens, sessions[0])
+	_, err = NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, sessions[0])
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, sessions[1])
+	require.NoError(t, err)
+}
+
+func TestValidateToken(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, accessToken := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	archived, archivedAccessToken := createTestingUser(ctx, t, s, "archived", store.RoleUser)
+	validate := func(accessToken string) *v1pb.ValidateTokenResponse {
+		response, err := s.ValidateToken(ctx, &v1pb.ValidateTokenRequest{AccessToken: accessToken})
+		require.NoError(t, err)
+		return response
+	}
+
+	response := validate(accessToken)
+	require.True(t, response.Valid)
+	require.Empty(t, response.InvalidReason)
+	require.Equal(t, user.ID, response.UserId)
+	require.Equal(t, v1pb.Role_USER, response.Role)
+	require.WithinDuration(t, time.Now().Add(AccessTokenDuration), response.ExpireTime.AsTime(), time.Minute)
+
+	expiredAccessToken, err := GenerateAccessToken(user.Email, user.ID, time.Now().Add(-time.Hour), []byte(s.Secret))
+	require.NoError(t, err)
+	require.NoError(t, s.UpsertAccessTokenToStore(ctx, user, expiredAccessToken, "expired"))
+	response = validate(expiredAccessToken)
+	require.False(t, response.Valid)
+	require.Equal(t, "access token is expired", response.InvalidReason)
+	require.Zero(t, response.UserId)
+
+	revokedAccessToken, err := GenerateAccessToken(user.Email, user.ID, time.Now().Add(time.Hour), []byte(s.Secret))
+	require.NoError(t, err)
+	response = validate(revokedAccessToken)
+	require.False(t, response.Valid)
+	require.Equal(t, "access token is revoked", response.InvalidReason)
+
+	forgedAccessToken, err := GenerateAccessToken(user.Email, user.ID, time.Now().Add(time.Hour), []byte("another-secret"))
+	require.NoError(t, err)
+	response = validate(forgedAccessToken)
+	require.False(t, response.Valid)
+	require.Equal(t, "access token is malformed or has an invalid signature", response.InvalidReason)
+
+	rowStatus := storepb.RowStatus_ARCHIVED
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: archived.ID, RowStatus: &rowStatus})
+	require.NoError(t, err)
+	response = validate(archivedAccessToken)
+	require.False(t, response.Valid)
+	require.Equal(t, "user is archived", response.InvalidReason)
+
+	_, err = s.ValidateToken(ctx, &v1pb.ValidateTokenRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSignOut(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, accessToken := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	otherAccessToken, err := GenerateAccessToken(user.Email, user.ID, time.Now().Add(time.Hour), []byte(s.Secret))
+	require.NoError(t, err)
+	require.NoError(t, s.UpsertAccessTokenToStore(ctx, user, otherAccessToken, sessionAccessTokenDescription))
+	signOut := func(md metadata.MD) error {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		if md != nil {
+			ctx = withUser(metadata.NewIncomingContext(ctx, md), user)
+		}
+		_, err := s.SignOut(ctx, &v1pb.SignOutRequest{})
+		return err
+	}
+	validate := func(accessToken string) *v1pb.ValidateTokenResponse {
+		response, err := s.ValidateToken(ctx, &v1pb.ValidateTokenRequest{AccessToken: accessToken})
+		require.NoError(t, err)
+		return response
+	}
+
+	// Signing out without an access token succeeds.
+	require.NoError(t, signOut(nil))
+	require.True(t, validate(accessToken).Valid)
+
+	// The access token of the request is revoked, the other ones of the user are kept.
+	require.NoError(t, signOut(metadata.Pairs("authorization", "Bearer "+accessToken)))
+	response := validate(accessToken)
+	require.False(t, response.Valid)
+	require.Equal(t, "access token is revoked", response.InvalidReason)
+	require.True(t, validate(otherAccessToken).Valid)
+	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, userAccessTokens, 1)
+
+	// Signing out again is a no-op.
+	require.NoError(t, signOut(metadata.Pairs("authorization", "Bearer "+accessToken)))
+	require.NoError(t, signOut(metadata.Pairs("cookie", AccessTokenCookieName+"="+otherAccessToken)))
+	require.False(t, validate(otherAccessToken).Valid)
+}
+
+func TestSignUpEmailVerification(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	signUp := func(email string) (*v1pb.User, *testingServerTransportStream) {
+		stream := &testingServerTransportStream{}
+		user, err := s.SignUp(grpc.NewContextWithServerTransportStream(ctx, stream), &v1pb.SignUpRequest{
+			Email:    email,
+			Nickname: email,
+			Password: "correct-horse",
+		})
+		require.NoError(t, err)
+		return user, stream
+	}
+	signIn := func(email string) error {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: email, Password: "correct-horse"})
+		return err
+	}
+	verifyEmail := func(token string) (*testingServerTransportStream, error) {
+		stream := &testingServerTransportStream{}
+		_, err := s.VerifyEmail(grpc.NewContextWithServerTransportStream(ctx, stream), &v1pb.VerifyEmailRequest{Token: token})
+		return stream, err
+	}
+
+	// Without the setting, signing up signs the user in.
+	_, stream := signUp("before@example.com")
+	require.NotEmpty(t, stream.header.Get("Set-Cookie"))
+	require.NoError(t, signIn("before@example.com"))
+
+	_, err := s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{RequireEmailVerification: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"require_email_verification"}},
+	})
+	require.NoError(t, err)
+
+	// The users who signed up before the setting are not affected.
+	require.NoError(t, signIn("before@example.com"))
+	user, stream := signUp("after@example.com")
+	require.Empty(t, stream.header.Get("Set-Cookie"))
+	err = signIn("after@example.com")
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Contains(t, err.Error(), "email is not verified")
+
+	// Only the verification tokens of the email are accepted.
+	accessToken, err := GenerateAccessToken(user.Email, user.Id, time.Now().Add(time.Hour), []byte(s.Secret))
+	require.NoError(t, err)
+	_, err = verifyEmail(accessToken)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	expiredToken, err := GenerateEmailVerificationToken(user.Email, user.Id, time.Now().Add(-time.Hour), []byte(s.Secret))
+	require.NoError(t, err)
+	_, err = verifyEmail(expiredToken)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	otherEmailToken, err := GenerateEmailVerificationToken("other@example.com", user.Id, time.Now().Add(time.Hour), []byte(s.Secret))
+	require.NoError(t, err)
+	_, err = verifyEmail(otherEmailToken)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Verifying the email signs the user in, once.
+	token, err := GenerateEmailVerificationToken(user.Email, user.Id, time.Now().Add(EmailVerificationTokenDuration), []byte(s.Secret))
+	require.NoError(t, err)
+	stream, err = verifyEmail(token)
+	require.NoError(t, err)
+	require.NotEmpty(t, stream.header.Get("Set-Cookie"))
+	require.NoError(t, signIn("after@example.com"))
+	_, err = verifyEmail(token)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestSignUpEmailVerificationMail(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	mailer := &testingMailer{}
+	s.Mailer, s.MailFrom = mailer, "Slash <slash@example.com>"
+	securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+	require.NoError(t, err)
+	securitySetting.RequireEmailVerification = true
+	_, err = s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+		Value: &storepb.WorkspaceSetting_Security{Security: securitySetting},
+	})
+	require.NoError(t, err)
+	signUp := func(email string) *testingServerTransportStream {
+		stream := &testingServerTransportStream{}
+		_, err := s.SignUp(grpc.NewContextWithServerTransportStream(ctx, stream), &v1pb.SignUpRequest{
+			Email:    email,
+			Nickname: email,
+			Password: "correct-horse",
+		})
+		require.NoError(t, err)
+		return stream
+	}
+
+	// The first admin is verified, setting up the workspace doesn't lock them out.
+	stream := signUp("admin@example.com")
+	require.NotEmpty(t, stream.header.Get("Set-Cookie"))
+	require.Empty(t, mailer.emails)
+	admin, err := s.Store.GetUser(ctx, &store.FindUser{Email: proto.String("admin@example.com")})
+	require.NoError(t, err)
+	require.Equal(t, store.RoleAdmin, admin.Role)
+	_, err = s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{InstanceUrl: "https://slash.example.com/"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"instance_url"}},
+	})
+	require.NoError(t, err)
+
+	// The other users are mailed a verification link.
+	stream = signUp("user@example.com")
+	require.Empty(t, stream.header.Get("Set-Cookie"))
+	require.Len(t, mailer.emails, 1)
+	require.Equal(t, []string{"<user@example.com>"}, mailer.emails[0].To())
+	link := regexp.MustCompile(`href="https://slash\.example\.com/auth/verify-email\?token=([\w.-]+)"`).FindStringSubmatch(mailer.emails[0].Body())
+	require.Len(t, link, 2)
+	_, err = s.VerifyEmail(grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{}), &v1pb.VerifyEmailRequest{Token: link[1]})
+	require.NoError(t, err)
+}
+
+type testingMailer struct {
+	emails []*mail.Email
+}
+
+func (m *testingMailer) SendMail(e *mail.Email) error {
+	m.emails = append(m.emails, e)
+	return nil
+}
+
+func TestResetPassword(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	mailer := &testingMailer{}
+	s.Mailer, s.MailFrom = mailer, "Slash <slash@example.com>"
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	signIn := func(password string) error {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: user.Email, Password: password})
+		return err
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	require.NoError(t, signIn("password"))
+	requestToken := func() string {
+		_, err := s.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: user.Email})
+		require.NoError(t, err)
+		email := mailer.emails[len(mailer.emails)-1]
+		require.Equal(t, []string{"<" + user.Email + ">"}, email.To())
+		token := regexp.MustCompile(`<code>(\w+)</code>`).FindStringSubmatch(email.Body())
+		require.Len(t, token, 2)
+		return token[1]
+	}
+	resetPassword := func(token, password string) error {
+		_, err := s.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Email: user.Email, Token: token, Password: password})
+		return err
+	}
+
+	// The unknown emails are not told apart.
+	_, err = s.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: "unknown@example.com"})
+	require.NoError(t, err)
+	require.Empty(t, mailer.emails)
+
+	// Only the hash of the token is stored.
+	token := requestToken()
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &user.ID, Key: storepb.UserSettingKey_USER_SETTING_PASSWORD_RESET})
+	require.NoError(t, err)
+	require.Equal(t, hashToken(token), userSetting.GetPasswordReset().TokenHash)
+	require.Equal(t, codes.InvalidArgument, status.Code(resetPassword("wrong", "new-password")))
+	_, err = s.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Email: "unknown@example.com", Token: token, Password: "new-password"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The tokens expire.
+	userSetting.GetPasswordReset().ExpiresTs = time.Now().Add(-time.Minute).Unix()
+	_, err = s.Store.UpsertUserSetting(ctx, userSetting)
+	require.NoError(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(resetPassword(token, "new-password")))
+
+	// Resetting the password signs out the sessions, and the token is used once.
+	token = requestToken()
+	require.Equal(t, codes.InvalidArgument, status.Code(resetPassword(token, "short")))
+	require.NoError(t, resetPassword(token, "new-password"))
+	accessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, accessTokens)
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("password")))
+	require.NoError(t, signIn("new-password"))
+	require.Equal(t, codes.InvalidArgument, status.Code(resetPassword(token, "other-password")))
+}
+
+func TestChangePassword(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, accessToken := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	otherAccessToken, err := GenerateAccessToken(user.Email, user.ID, time.Now().Add(time.Hour), []byte(s.Secret))
+	require.NoError(t, err)
+	require.NoError(t, s.UpsertAccessTokenToStore(ctx, user, otherAccessToken, "other session"))
+	userCtx := metadata.NewIncomingContext(withUser(ctx, user), metadata.Pairs("authorization", "Bearer "+accessToken))
+	changePassword := func(currentPassword, newPassword string, revokeOtherSessions bool) error {
+		_, err := s.ChangePassword(userCtx, &v1pb.ChangePasswordRequest{
+			CurrentPassword:     currentPassword,
+			NewPassword:         newPassword,
+			RevokeOtherSessions: revokeOtherSessions,
+		})
+		return err
+	}
+
+	require.Equal(t, codes.Unauthenticated, status.Code(changePassword("wrong", "new-password", false)))
+	require.Equal(t, codes.InvalidArgument, status.Code(changePassword("password", "short", false)))
+	require.NoError(t, changePassword("password", "new-password", false))
+	accessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, accessTokens, 2)
+
+	// Only the session of the request is kept when the others are revoked.
+	require.Equal(t, codes.Unauthenticated, status.Code(changePassword("password", "other-password", true)))
+	require.NoError(t, changePassword("new-password", "other-password", true))
+	accessTokens, err = s.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, accessTokens, 1)
+	require.Equal(t, accessToken, accessTokens[0].AccessToken)
+	updated, err := s.Store.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(updated.PasswordHash), []byte("other-password")))
+}
+
+func TestSignInWithTOTP(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	signIn := func(totpCode string) error {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: user.Email, Password: "password", TotpCode: totpCode})
+		return err
+	}
+	requireTOTPRequired := func(err error) {
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		details := status.Convert(err).Details()
+		require.Len(t, details, 1)
+		require.Equal(t, totpRequiredErrorReason, details[0].(*errdetails.ErrorInfo).Reason)
+	}
+
+	// The enrollment is not required until it is activated.
+	enrollment, err := s.EnrollTOTP(withUser(ctx, user), &v1pb.EnrollTOTPRequest{})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(enrollment.Uri, "otpauth://totp/"))
+	require.Contains(t, enrollment.Uri, "secret="+enrollment.Secret)
+	require.Len(t, enrollment.RecoveryCodes, recoveryCodeCount)
+	require.NoError(t, signIn(""))
+	_, err = s.ActivateTOTP(withUser(ctx, user), &v1pb.ActivateTOTPRequest{Code: "000000"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	// The recovery codes are stored hashed.
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{UserID: &user.ID, Key: storepb.UserSettingKey_USER_SETTING_TOTP})
+	require.NoError(t, err)
+	require.Equal(t, hashToken(enrollment.RecoveryCodes[0]), userSetting.GetTotp().RecoveryCodeHashes[0])
+
+	code, err := totp.GenerateCode(enrollment.Secret, time.Now().Add(-totp.Period))
+	require.NoError(t, err)
+	_, err = s.ActivateTOTP(withUser(ctx, user), &v1pb.ActivateTOTPRequest{Code: code})
+	require.NoError(t, err)
+	_, err = s.EnrollTOTP(withUser(ctx, user), &v1pb.EnrollTOTPRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// A correct password isn't enough once it is activated.
+	requireTOTPRequired(signIn(""))
+	requireTOTPRequired(signIn("000000"))
+	// The code used to activate can't be replayed, the next one is accepted once.
+	requireTOTPRequired(signIn(code))
+	code, err = totp.GenerateCode(enrollment.Secret, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, signIn(code))
+	requireTOTPRequired(signIn(code))
+	// The recovery codes are used once.
+	require.NoError(t, signIn(enrollment.RecoveryCodes[0]))
+	requireTOTPRequired(signIn(enrollment.RecoveryCodes[0]))
+}
+
+func TestAuthServiceErrorCodes(t *testing.T) {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &testingServerTransportStream{})
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	archived, _ := createTestingUser(ctx, t, s, "archived", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	archivedStatus := storepb.RowStatus_ARCHIVED
+	for _, update := range []*store.UpdateUser{
+		{ID: user.ID, PasswordHash: &passwordHashStr},
+		{ID: archived.ID, PasswordHash: &passwordHashStr, RowStatus: &archivedStatus},
+	} {
+		_, err = s.Store.UpdateUser(ctx, update)
+		require.NoError(t, err)
+	}
+	signIn := func(email, password string) error {
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: email, Password: password})
+		return err
+	}
+	signUp := func(email, password string) error {
+		_, err := s.SignUp(ctx, &v1pb.SignUpRequest{Email: email, Nickname: email, Password: password})
+		return err
+	}
+	updateSecuritySetting := func(setting *v1pb.WorkspaceSetting, path string) {
+		_, err := s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+			Setting:    setting,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{path}},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = s.GetAuthStatus(ctx, &v1pb.GetAuthStatusRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.GetAuthMethods(ctx, &v1pb.GetAuthMethodsRequest{Email: "not an email"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("unknown@test.com", "password")))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn(user.Email, "wrong")))
+	require.Equal(t, codes.PermissionDenied, status.Code(signIn(archived.Email, "password")))
+	require.Equal(t, codes.InvalidArgument, status.Code(signUp("new@test.com", "short")))
+	_, err = s.VerifyEmail(ctx, &v1pb.VerifyEmailRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.VerifyEmail(ctx, &v1pb.VerifyEmailRequest{Token: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	updateSecuritySetting(&v1pb.WorkspaceSetting{DisallowPasswordAuth: true}, "disallow_password_auth")
+	require.Equal(t, codes.PermissionDenied, status.Code(signIn(user.Email, "password")))
+	updateSecuritySetting(&v1pb.WorkspaceSetting{DisallowUserRegistration: true}, "disallow_user_registration")
+	require.Equal(t, codes.PermissionDenied, status.Code(signUp("new@test.com", "correct-horse")))
+}
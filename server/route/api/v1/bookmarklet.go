@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+)
+
+// BookmarkletPath is the path of the endpoint used by the bookmarklet to create a shortcut of the current page.
+const BookmarkletPath = "/api/v1/bookmarklet"
+
+var bookmarkletTemplate = template.Must(template.New("bookmarklet").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8" />
+<meta name="viewport" content="width=device-width, initial-scale=1" />
+<title>Slash</title>
+</head>
+<body>
+{{if .Shortcut}}<p>Shortcut <a href="/s/{{.Shortcut.Name}}">s/{{.Shortcut.Name}}</a> created for <a href="{{.Shortcut.Link}}">{{.Shortcut.Link}}</a>.</p>
+{{else}}<p>Failed to create shortcut: {{.Message}}</p>
+{{end}}</body>
+</html>
+`))
+
+type bookmarkletPageData struct {
+	Shortcut *v1pb.Shortcut
+	Message  string
+}
+
+func (s *APIV1Service) registerBookmarkletRoutes(e *echo.Echo) {
+	e.GET(BookmarkletPath, s.handleBookmarklet)
+	e.POST(BookmarkletPath, s.handleBookmarklet)
+}
+
+// handleBookmarklet creates a shortcut from the `url`, `title` and `name` query or form params
+// for the user signed in with the access token cookie.
+func (s *APIV1Service) handleBookmarklet(c echo.Context) error {
+	ctx := c.Request().Context()
+	cookie, err := c.Cookie(AccessTokenCookieName)
+	if err != nil {
+		return renderBookmarkletPage(c, http.StatusUnauthorized, &bookmarkletPageData{Message: "please sign in first"})
+	}
+	userID, err := NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, cookie.Value)
+	if err != nil {
+		return renderBookmarkletPage(c, http.StatusUnauthorized, &bookmarkletPageData{Message: status.Convert(err).Message()})
+	}
+
+	name := strings.TrimSpace(c.FormValue("name"))
+	if name == "" {
+		name, err = s.generateShortcutName(ctx)
+		if err != nil {
+			return renderBookmarkletPage(c, http.StatusInternalServerError, &bookmarkletPageData{Message: "failed to generate shortcut name"})
+		}
+	}
+	shortcut, err := s.CreateShortcut(context.WithValue(ctx, userIDContextKey, userID), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{
+			Name:  name,
+			Link:  strings.TrimSpace(c.FormValue("url")),
+			Title: strings.TrimSpace(c.FormValue("title")),
+		},
+	})
+	if err != nil {
+		st := status.Convert(err)
+		return renderBookmarkletPage(c, runtime.HTTPStatusFromCode(st.Code()), &bookmarkletPageData{Message: st.Message()})
+	}
+	return renderBookmarkletPage(c, http.StatusOK, &bookmarkletPageData{Shortcut: shortcut})
+}
+
+func renderBookmarkletPage(c echo.Context, code int, data *bookmarkletPageData) error {
+	var sb strings.Builder
+	if err := bookmarkletTemplate.Execute(&sb, data); err != nil {
+		return err
+	}
+	return c.HTML(code, sb.String())
+}
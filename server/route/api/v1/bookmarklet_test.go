@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func serveBookmarklet(s *APIV1Service, request *http.Request) *httptest.ResponseRecorder {
+	e := echo.New()
+	s.registerBookmarkletRoutes(e)
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestBookmarkletUnauthenticated(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+
+	request := httptest.NewRequest(http.MethodGet, BookmarkletPath+"?url=https://example.com&name=example", nil)
+	recorder := serveBookmarklet(s, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	request = httptest.NewRequest(http.MethodGet, BookmarkletPath+"?url=https://example.com&name=example", nil)
+	request.AddCookie(&http.Cookie{Name: AccessTokenCookieName, Value: "invalid-token"})
+	recorder = serveBookmarklet(s, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+}
+
+func TestBookmarkletAuthenticated(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, accessToken := createTestingUser(ctx, t, s, "test", store.RoleUser)
+
+	// Create with query params.
+	request := httptest.NewRequest(http.MethodGet, BookmarkletPath+"?url=https://example.com/page&title=Example&name=example", nil)
+	request.AddCookie(&http.Cookie{Name: AccessTokenCookieName, Value: accessToken})
+	recorder := serveBookmarklet(s, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "s/example")
+	name := "example"
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{Name: &name})
+	require.NoError(t, err)
+	require.NotNil(t, shortcut)
+	require.Equal(t, user.ID, shortcut.CreatorId)
+	require.Equal(t, "https://example.com/page", shortcut.Link)
+	require.Equal(t, "Example", shortcut.Title)
+
+	// Create with form params and a generated name.
+	form := url.Values{"url": {"https://example.com/form"}}
+	request = httptest.NewRequest(http.MethodPost, BookmarkletPath, strings.NewReader(form.Encode()))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	request.AddCookie(&http.Cookie{Name: AccessTokenCookieName, Value: accessToken})
+	recorder = serveBookmarklet(s, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+
+	// Missing url is rejected the same way as CreateShortcut.
+	request = httptest.NewRequest(http.MethodGet, BookmarkletPath+"?name=nolink", nil)
+	request.AddCookie(&http.Cookie{Name: AccessTokenCookieName, Value: accessToken})
+	recorder = serveBookmarklet(s, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
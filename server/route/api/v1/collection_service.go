@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -9,6 +10,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yourselfhosted/slash/internal/util"
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/service/license"
@@ -16,7 +18,13 @@ import (
 )
 
 func (s *APIV1Service) ListCollections(ctx context.Context, _ *v1pb.ListCollectionsRequest) (*v1pb.ListCollectionsResponse, error) {
-	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{})
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+		VisibleTo: getCollectionVisibilityFilter(user),
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get collection list, err: %v", err)
 	}
@@ -47,7 +55,7 @@ func (s *APIV1Service) GetCollection(ctx context.Context, request *v1pb.GetColle
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user == nil && collection.Visibility != storepb.Visibility_PUBLIC {
+	if !canReadCollection(user, collection) {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 	return convertCollectionFromStore(collection), nil
@@ -68,7 +76,7 @@ func (s *APIV1Service) GetCollectionByName(ctx context.Context, request *v1pb.Ge
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user == nil && collection.Visibility != storepb.Visibility_PUBLIC {
+	if !canReadCollection(user, collection) {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 	return convertCollectionFromStore(collection), nil
@@ -94,6 +102,9 @@ func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.Creat
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
+	if err := s.checkCollectionNameCollision(ctx, request.Collection.Name, 0); err != nil {
+		return nil, err
+	}
 	collectionCreate := &storepb.Collection{
 		CreatorId:   user.ID,
 		Name:        request.Collection.Name,
@@ -102,6 +113,9 @@ func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.Creat
 		ShortcutIds: request.Collection.ShortcutIds,
 		Visibility:  convertVisibilityToStorepb(request.Collection.Visibility),
 	}
+	if err := s.enforceCollectionVisibilityPolicy(ctx, user, collectionCreate.Visibility, collectionCreate.ShortcutIds); err != nil {
+		return nil, err
+	}
 	collection, err := s.Store.CreateCollection(ctx, collectionCreate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create collection, err: %v", err)
@@ -138,6 +152,12 @@ func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.Updat
 	for _, path := range request.UpdateMask.Paths {
 		switch path {
 		case "name":
+			if request.Collection.Name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "name is required")
+			}
+			if err := s.checkCollectionNameCollision(ctx, request.Collection.Name, collection.Id); err != nil {
+				return nil, err
+			}
 			update.Name = &request.Collection.Name
 		case "title":
 			update.Title = &request.Collection.Title
@@ -148,6 +168,24 @@ func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.Updat
 		case "visibility":
 			visibility := convertVisibilityToStorepb(request.Collection.Visibility)
 			update.Visibility = &visibility
+			// The private collections can't be shared.
+			if visibility == storepb.Visibility_PRIVATE && collection.ShareTokenHash != "" {
+				shareTokenHash := ""
+				update.ShareTokenHash = &shareTokenHash
+			}
+		}
+	}
+	if update.ShortcutIDs != nil || update.Visibility != nil {
+		visibility := collection.Visibility
+		if update.Visibility != nil {
+			visibility = *update.Visibility
+		}
+		shortcutIDs := collection.ShortcutIds
+		if update.ShortcutIDs != nil {
+			shortcutIDs = update.ShortcutIDs
+		}
+		if err := s.enforceCollectionVisibilityPolicy(ctx, user, visibility, shortcutIDs); err != nil {
+			return nil, err
 		}
 	}
 	collection, err = s.Store.UpdateCollection(ctx, update)
@@ -185,6 +223,269 @@ func (s *APIV1Service) DeleteCollection(ctx context.Context, request *v1pb.Delet
 	return &emptypb.Empty{}, nil
 }
 
+// AddShortcutToCollection appends the shortcut to the collection, which is a no-op when the collection already has it.
+// The user must manage the collection and read the shortcut, which is subject to the collection visibility policy like
+// the shortcuts of an updated collection.
+func (s *APIV1Service) AddShortcutToCollection(ctx context.Context, request *v1pb.AddShortcutToCollectionRequest) (*v1pb.Collection, error) {
+	user, collection, err := s.getManagedCollection(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.ShortcutId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	access, err := s.getShortcutAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if !access.canRead(shortcut) {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	if slices.Contains(collection.ShortcutIds, shortcut.Id) {
+		return convertCollectionFromStore(collection), nil
+	}
+	if err := s.enforceCollectionVisibilityPolicy(ctx, user, collection.Visibility, []int32{shortcut.Id}); err != nil {
+		return nil, err
+	}
+	collection, err = s.Store.AddCollectionShortcut(ctx, collection.Id, shortcut.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add shortcut to collection, err: %v", err)
+	}
+	if collection == nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	return convertCollectionFromStore(collection), nil
+}
+
+// RemoveShortcutFromCollection removes the shortcut from the collection, which is a no-op when the collection doesn't
+// have it. The shortcut isn't looked up, so that the ids of the deleted shortcuts can be removed too.
+func (s *APIV1Service) RemoveShortcutFromCollection(ctx context.Context, request *v1pb.RemoveShortcutFromCollectionRequest) (*v1pb.Collection, error) {
+	_, collection, err := s.getManagedCollection(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(collection.ShortcutIds, request.ShortcutId) {
+		return convertCollectionFromStore(collection), nil
+	}
+	collection, err = s.Store.RemoveCollectionShortcut(ctx, collection.Id, request.ShortcutId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove shortcut from collection, err: %v", err)
+	}
+	if collection == nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	return convertCollectionFromStore(collection), nil
+}
+
+// CreateCollectionShareToken generates an opaque share token of the collection, which replaces and so revokes the
+// previous one. Only its hash is stored, so the token is only returned here. The private collections can't be shared.
+func (s *APIV1Service) CreateCollectionShareToken(ctx context.Context, request *v1pb.CreateCollectionShareTokenRequest) (*v1pb.CreateCollectionShareTokenResponse, error) {
+	_, collection, err := s.getManagedCollection(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if collection.Visibility == storepb.Visibility_PRIVATE {
+		return nil, status.Errorf(codes.FailedPrecondition, "private collections can't be shared")
+	}
+	shareToken, err := util.RandomString(32)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate share token: %v", err)
+	}
+	shareTokenHash := hashToken(shareToken)
+	if _, err := s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:             collection.Id,
+		ShareTokenHash: &shareTokenHash,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update collection, err: %v", err)
+	}
+	return &v1pb.CreateCollectionShareTokenResponse{
+		ShareToken: shareToken,
+	}, nil
+}
+
+// DeleteCollectionShareToken revokes the share token of the collection, which is kept otherwise.
+func (s *APIV1Service) DeleteCollectionShareToken(ctx context.Context, request *v1pb.DeleteCollectionShareTokenRequest) (*emptypb.Empty, error) {
+	_, collection, err := s.getManagedCollection(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if collection.ShareTokenHash == "" {
+		return nil, status.Errorf(codes.NotFound, "share token not found")
+	}
+	shareTokenHash := ""
+	if _, err := s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:             collection.Id,
+		ShareTokenHash: &shareTokenHash,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update collection, err: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetCollectionByShareToken returns the collection of the share token and its shortcuts to anyone with the token.
+// The shortcuts the token would leak are left out: the private, archived and expired ones, and the non public ones
+// with tags restricted by tag policies.
+func (s *APIV1Service) GetCollectionByShareToken(ctx context.Context, request *v1pb.GetCollectionByShareTokenRequest) (*v1pb.GetCollectionByShareTokenResponse, error) {
+	if request.ShareToken == "" {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	shareTokenHash := hashToken(request.ShareToken)
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ShareTokenHash: &shareTokenHash,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by share token: %v", err)
+	}
+	if collection == nil || collection.Visibility == storepb.Visibility_PRIVATE {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+
+	access, err := s.getShortcutAccess(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	shortcuts := []*v1pb.Shortcut{}
+	for _, shortcutID := range collection.ShortcutIds {
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID: &shortcutID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+		}
+		if shortcut == nil || shortcut.Visibility == storepb.Visibility_PRIVATE || store.IsShortcutExpired(shortcut, now) {
+			continue
+		}
+		if _, restricted := access.getTagPolicyRole(shortcut); restricted && shortcut.Visibility != storepb.Visibility_PUBLIC {
+			continue
+		}
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		shortcuts = append(shortcuts, composedShortcut)
+	}
+	return &v1pb.GetCollectionByShareTokenResponse{
+		Collection: convertCollectionFromStore(collection),
+		Shortcuts:  shortcuts,
+	}, nil
+}
+
+// getManagedCollection returns the current user and the collection with the id, which the user must be able to read
+// and manage.
+func (s *APIV1Service) getManagedCollection(ctx context.Context, id int32) (*store.User, *storepb.Collection, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get collection by id: %v", err)
+	}
+	if collection == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	if user == nil || (collection.CreatorId != user.ID && user.Role != store.RoleAdmin) {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return user, collection, nil
+}
+
+// canReadCollection returns whether the user, nil when anonymous, can read the collection by its visibility, like a
+// shortcut: the creator and the admins read all of them, the signed in users the workspace ones and everyone the
+// public ones.
+func canReadCollection(user *store.User, collection *storepb.Collection) bool {
+	if collection.Visibility == storepb.Visibility_PUBLIC {
+		return true
+	}
+	if user == nil {
+		return false
+	}
+	return collection.Visibility != storepb.Visibility_PRIVATE || collection.CreatorId == user.ID || user.Role == store.RoleAdmin
+}
+
+// getCollectionVisibilityFilter returns the store filter of the collections the user sees, nil for the admins who see
+// all of them.
+func getCollectionVisibilityFilter(user *store.User) *store.ShortcutVisibilityFilter {
+	if user == nil {
+		return &store.ShortcutVisibilityFilter{}
+	}
+	if user.Role == store.RoleAdmin {
+		return nil
+	}
+	return &store.ShortcutVisibilityFilter{UserID: user.ID}
+}
+
+// checkCollectionNameCollision returns an AlreadyExists error when the name is used by another collection than the
+// one with the id, 0 for a new collection. The names are unique within the workspace whatever the visibility.
+func (s *APIV1Service) checkCollectionNameCollision(ctx context.Context, name string, id int32) error {
+	existing, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		Name: &name,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get collection by name: %v", err)
+	}
+	if existing != nil && existing.Id != id {
+		return status.Errorf(codes.AlreadyExists, "collection name %q already exists", name)
+	}
+	return nil
+}
+
+// enforceCollectionVisibilityPolicy applies the workspace collection visibility policy to the shortcuts
+// of a collection, so that they aren't exposed through a collection more visible than them.
+// Shortcuts are only raised if the user is allowed to update them, and nothing is changed on errors.
+func (s *APIV1Service) enforceCollectionVisibilityPolicy(ctx context.Context, user *store.User, visibility storepb.Visibility, shortcutIDs []int32) error {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	policy := shortcutRelatedSetting.GetCollectionVisibilityPolicy()
+	if policy == storepb.WorkspaceSetting_ShortcutRelatedSetting_COLLECTION_VISIBILITY_POLICY_UNSPECIFIED {
+		return nil
+	}
+
+	lessVisibleShortcuts := []*storepb.Shortcut{}
+	for _, shortcutID := range shortcutIDs {
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID:              &shortcutID,
+			IncludeArchived: true,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get shortcut, err: %v", err)
+		}
+		if shortcut == nil {
+			return status.Errorf(codes.InvalidArgument, "shortcut %d not found", shortcutID)
+		}
+		if shortcut.Visibility >= visibility {
+			continue
+		}
+		if policy == storepb.WorkspaceSetting_ShortcutRelatedSetting_REJECT_LESS_VISIBLE_SHORTCUTS {
+			return status.Errorf(codes.FailedPrecondition, "shortcut %q is less visible than the collection", shortcut.Name)
+		}
+		if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+			return status.Errorf(codes.PermissionDenied, "not allowed to raise the visibility of shortcut %q", shortcut.Name)
+		}
+		lessVisibleShortcuts = append(lessVisibleShortcuts, shortcut)
+	}
+	for _, shortcut := range lessVisibleShortcuts {
+		if _, err := s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:         shortcut.Id,
+			Visibility: &visibility,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to update shortcut visibility, err: %v", err)
+		}
+	}
+	return nil
+}
+
 func convertCollectionFromStore(collection *storepb.Collection) *v1pb.Collection {
 	return &v1pb.Collection{
 		Id:          collection.Id,
@@ -196,5 +497,6 @@ func convertCollectionFromStore(collection *storepb.Collection) *v1pb.Collection
 		Description: collection.Description,
 		ShortcutIds: collection.ShortcutIds,
 		Visibility:  convertVisibilityFromStorepb(collection.Visibility),
+		Shared:      collection.ShareTokenHash != "",
 	}
 }
@@ -0,0 +1,338 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestCollectionService(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	createCollection := func(name string, visibility v1pb.Visibility, shortcutIDs ...int32) *v1pb.Collection {
+		collection, err := s.CreateCollection(withUser(ctx, user), &v1pb.CreateCollectionRequest{
+			Collection: &v1pb.Collection{Name: name, Title: name, ShortcutIds: shortcutIDs, Visibility: visibility},
+		})
+		require.NoError(t, err)
+		return collection
+	}
+	listNames := func(ctx context.Context) []string {
+		response, err := s.ListCollections(ctx, &v1pb.ListCollectionsRequest{})
+		require.NoError(t, err)
+		names := []string{}
+		for _, collection := range response.Collections {
+			names = append(names, collection.Name)
+		}
+		return names
+	}
+	private := createCollection("private", v1pb.Visibility_PRIVATE)
+	createCollection("workspace", v1pb.Visibility_WORKSPACE)
+	public := createCollection("public", v1pb.Visibility_PUBLIC, 3, 1, 2)
+	require.Equal(t, []int32{3, 1, 2}, public.ShortcutIds)
+
+	// The collections are seen by their visibility like the shortcuts.
+	require.ElementsMatch(t, []string{"private", "workspace", "public"}, listNames(withUser(ctx, user)))
+	require.ElementsMatch(t, []string{"private", "workspace", "public"}, listNames(withUser(ctx, admin)))
+	require.ElementsMatch(t, []string{"workspace", "public"}, listNames(withUser(ctx, other)))
+	require.ElementsMatch(t, []string{"public"}, listNames(ctx))
+	_, err := s.GetCollection(withUser(ctx, other), &v1pb.GetCollectionRequest{Id: private.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.GetCollectionByName(withUser(ctx, admin), &v1pb.GetCollectionByNameRequest{Name: "private"})
+	require.NoError(t, err)
+	_, err = s.GetCollection(ctx, &v1pb.GetCollectionRequest{Id: public.Id})
+	require.NoError(t, err)
+	_, err = s.GetCollection(withUser(ctx, user), &v1pb.GetCollectionRequest{Id: 404})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The names are unique within the workspace.
+	_, err = s.CreateCollection(withUser(ctx, other), &v1pb.CreateCollectionRequest{
+		Collection: &v1pb.Collection{Name: "private", Title: "private", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = s.UpdateCollection(withUser(ctx, user), &v1pb.UpdateCollectionRequest{
+		Collection: &v1pb.Collection{Id: private.Id, Name: "public"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	renamed, err := s.UpdateCollection(withUser(ctx, user), &v1pb.UpdateCollectionRequest{
+		Collection: &v1pb.Collection{Id: private.Id, Name: "private", Title: "renamed"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "title"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "renamed", renamed.Title)
+
+	// Only the creator and the admins manage the collections.
+	_, err = s.UpdateCollection(withUser(ctx, other), &v1pb.UpdateCollectionRequest{
+		Collection: &v1pb.Collection{Id: public.Id, Title: "other"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.DeleteCollection(withUser(ctx, other), &v1pb.DeleteCollectionRequest{Id: public.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.DeleteCollection(withUser(ctx, admin), &v1pb.DeleteCollectionRequest{Id: public.Id})
+	require.NoError(t, err)
+	_, err = s.GetCollection(withUser(ctx, user), &v1pb.GetCollectionRequest{Id: public.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCollectionShortcuts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	createShortcut := func(user *store.User, name string, visibility v1pb.Visibility) int32 {
+		shortcut, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Visibility: visibility},
+		})
+		require.NoError(t, err)
+		return shortcut.Id
+	}
+	collection, err := s.CreateCollection(withUser(ctx, user), &v1pb.CreateCollectionRequest{
+		Collection: &v1pb.Collection{Name: "docs", Title: "docs", Visibility: v1pb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+	add := func(user *store.User, shortcutID int32) (*v1pb.Collection, error) {
+		return s.AddShortcutToCollection(withUser(ctx, user), &v1pb.AddShortcutToCollectionRequest{Id: collection.Id, ShortcutId: shortcutID})
+	}
+	remove := func(user *store.User, shortcutID int32) (*v1pb.Collection, error) {
+		return s.RemoveShortcutFromCollection(withUser(ctx, user), &v1pb.RemoveShortcutFromCollectionRequest{Id: collection.Id, ShortcutId: shortcutID})
+	}
+	a := createShortcut(user, "a", v1pb.Visibility_WORKSPACE)
+	b := createShortcut(user, "b", v1pb.Visibility_WORKSPACE)
+	c := createShortcut(other, "c", v1pb.Visibility_WORKSPACE)
+	private := createShortcut(other, "private", v1pb.Visibility_PRIVATE)
+
+	// The shortcuts are kept in their insertion order, once.
+	for _, id := range []int32{b, a, b, c} {
+		_, err := add(user, id)
+		require.NoError(t, err)
+	}
+	updated, err := remove(user, a)
+	require.NoError(t, err)
+	require.Equal(t, []int32{b, c}, updated.ShortcutIds)
+	updated, err = remove(user, a)
+	require.NoError(t, err)
+	require.Equal(t, []int32{b, c}, updated.ShortcutIds)
+	updated, err = add(user, a)
+	require.NoError(t, err)
+	require.Equal(t, []int32{b, c, a}, updated.ShortcutIds)
+
+	// Both the collection and the shortcut must exist and be visible to the user.
+	_, err = add(user, 404)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = add(user, private)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = add(other, c)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = remove(other, c)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.AddShortcutToCollection(withUser(ctx, user), &v1pb.AddShortcutToCollectionRequest{Id: 404, ShortcutId: a})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCollectionConcurrentShortcutAdds(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	collection, err := s.CreateCollection(withUser(ctx, user), &v1pb.CreateCollectionRequest{
+		Collection: &v1pb.Collection{Name: "docs", Title: "docs", Visibility: v1pb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+	shortcutIDs := []int32{}
+	for i := 0; i < 10; i++ {
+		shortcut, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: fmt.Sprintf("shortcut-%d", i), Link: "https://example.com", Visibility: v1pb.Visibility_WORKSPACE},
+		})
+		require.NoError(t, err)
+		shortcutIDs = append(shortcutIDs, shortcut.Id)
+	}
+
+	// Each shortcut is added twice concurrently, none is lost nor duplicated.
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*len(shortcutIDs))
+	for _, shortcutID := range append(shortcutIDs, shortcutIDs...) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.AddShortcutToCollection(withUser(ctx, user), &v1pb.AddShortcutToCollectionRequest{Id: collection.Id, ShortcutId: shortcutID})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	collection, err = s.GetCollection(withUser(ctx, user), &v1pb.GetCollectionRequest{Id: collection.Id})
+	require.NoError(t, err)
+	require.ElementsMatch(t, shortcutIDs, collection.ShortcutIds)
+
+	// A later add appends after the concurrent ones.
+	shortcut, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "last", Link: "https://example.com", Visibility: v1pb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+	updated, err := s.AddShortcutToCollection(withUser(ctx, user), &v1pb.AddShortcutToCollectionRequest{Id: collection.Id, ShortcutId: shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, append(collection.ShortcutIds, shortcut.Id), updated.ShortcutIds)
+}
+
+func TestCollectionShareToken(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	createShortcut := func(name string, visibility v1pb.Visibility) int32 {
+		shortcut, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Visibility: visibility},
+		})
+		require.NoError(t, err)
+		return shortcut.Id
+	}
+	workspace := createShortcut("workspace", v1pb.Visibility_WORKSPACE)
+	public := createShortcut("public", v1pb.Visibility_PUBLIC)
+	private := createShortcut("private", v1pb.Visibility_PRIVATE)
+	collection, err := s.CreateCollection(withUser(ctx, user), &v1pb.CreateCollectionRequest{
+		Collection: &v1pb.Collection{Name: "docs", Title: "docs", ShortcutIds: []int32{public, private, workspace}, Visibility: v1pb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+	getShared := func(shareToken string) (*v1pb.GetCollectionByShareTokenResponse, error) {
+		return s.GetCollectionByShareToken(ctx, &v1pb.GetCollectionByShareTokenRequest{ShareToken: shareToken})
+	}
+	createShareToken := func() string {
+		response, err := s.CreateCollectionShareToken(withUser(ctx, user), &v1pb.CreateCollectionShareTokenRequest{Id: collection.Id})
+		require.NoError(t, err)
+		return response.ShareToken
+	}
+
+	// Only the creator and the admins share the collection.
+	_, err = s.CreateCollectionShareToken(withUser(ctx, other), &v1pb.CreateCollectionShareTokenRequest{Id: collection.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	shareToken := createShareToken()
+
+	// The shared collection is read anonymously, without its private shortcuts.
+	response, err := getShared(shareToken)
+	require.NoError(t, err)
+	require.Equal(t, "docs", response.Collection.Name)
+	require.True(t, response.Collection.Shared)
+	names := []string{}
+	for _, shortcut := range response.Shortcuts {
+		names = append(names, shortcut.Name)
+	}
+	require.Equal(t, []string{"public", "workspace"}, names)
+	_, err = getShared("invalid")
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// A new token revokes the previous one.
+	newShareToken := createShareToken()
+	_, err = getShared(shareToken)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = getShared(newShareToken)
+	require.NoError(t, err)
+
+	// The token is revoked without deleting the collection.
+	_, err = s.DeleteCollectionShareToken(withUser(ctx, user), &v1pb.DeleteCollectionShareTokenRequest{Id: collection.Id})
+	require.NoError(t, err)
+	_, err = getShared(newShareToken)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.DeleteCollectionShareToken(withUser(ctx, user), &v1pb.DeleteCollectionShareTokenRequest{Id: collection.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	collection, err = s.GetCollection(withUser(ctx, user), &v1pb.GetCollectionRequest{Id: collection.Id})
+	require.NoError(t, err)
+	require.False(t, collection.Shared)
+
+	// The private collections aren't shared, and making a collection private revokes its token.
+	shareToken = createShareToken()
+	_, err = s.UpdateCollection(withUser(ctx, user), &v1pb.UpdateCollectionRequest{
+		Collection: &v1pb.Collection{Id: collection.Id, Visibility: v1pb.Visibility_PRIVATE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	_, err = getShared(shareToken)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.CreateCollectionShareToken(withUser(ctx, user), &v1pb.CreateCollectionShareTokenRequest{Id: collection.Id})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCollectionVisibilityPolicy(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	setPolicy := func(policy v1pb.WorkspaceSetting_CollectionVisibilityPolicy) {
+		_, err := s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+			Setting:    &v1pb.WorkspaceSetting{CollectionVisibilityPolicy: policy},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"collection_visibility_policy"}},
+		})
+		require.NoError(t, err)
+	}
+	createShortcut := func(user *store.User, name string) *v1pb.Shortcut {
+		shortcut, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Visibility: v1pb.Visibility_WORKSPACE},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	createCollection := func(user *store.User, name string, shortcutIDs ...int32) (*v1pb.Collection, error) {
+		return s.CreateCollection(withUser(ctx, user), &v1pb.CreateCollectionRequest{
+			Collection: &v1pb.Collection{Name: name, Title: name, ShortcutIds: shortcutIDs, Visibility: v1pb.Visibility_PUBLIC},
+		})
+	}
+	getShortcutVisibility := func(id int32) storepb.Visibility {
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &id})
+		require.NoError(t, err)
+		return shortcut.Visibility
+	}
+	internal := createShortcut(user, "internal")
+	others := createShortcut(other, "others")
+
+	// Less visible shortcuts are allowed by default.
+	_, err := createCollection(user, "default", internal.Id, others.Id)
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_WORKSPACE, getShortcutVisibility(internal.Id))
+
+	// Rejected, also when the collection becomes more visible.
+	setPolicy(v1pb.WorkspaceSetting_REJECT_LESS_VISIBLE_SHORTCUTS)
+	_, err = createCollection(user, "rejected", internal.Id)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	collection, err := s.CreateCollection(withUser(ctx, user), &v1pb.CreateCollectionRequest{
+		Collection: &v1pb.Collection{Name: "workspace", Title: "workspace", ShortcutIds: []int32{internal.Id}, Visibility: v1pb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+	_, err = s.UpdateCollection(withUser(ctx, user), &v1pb.UpdateCollectionRequest{
+		Collection: &v1pb.Collection{Id: collection.Id, Visibility: v1pb.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Equal(t, storepb.Visibility_WORKSPACE, getShortcutVisibility(internal.Id))
+
+	// Raised for the shortcuts of the user only.
+	setPolicy(v1pb.WorkspaceSetting_RAISE_SHORTCUT_VISIBILITY)
+	_, err = createCollection(user, "others", internal.Id, others.Id)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Equal(t, storepb.Visibility_WORKSPACE, getShortcutVisibility(internal.Id))
+	_, err = s.UpdateCollection(withUser(ctx, user), &v1pb.UpdateCollectionRequest{
+		Collection: &v1pb.Collection{Id: collection.Id, Visibility: v1pb.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_PUBLIC, getShortcutVisibility(internal.Id))
+	require.Equal(t, storepb.Visibility_WORKSPACE, getShortcutVisibility(others.Id))
+	_, err = createCollection(admin, "admin", others.Id)
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_PUBLIC, getShortcutVisibility(others.Id))
+}
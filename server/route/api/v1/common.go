@@ -51,6 +51,8 @@ func convertVisibilityFromStorepb(visibility storepb.Visibility) v1pb.Visibility
 		return v1pb.Visibility_WORKSPACE
 	case storepb.Visibility_PUBLIC:
 		return v1pb.Visibility_PUBLIC
+	case storepb.Visibility_PRIVATE:
+		return v1pb.Visibility_PRIVATE
 	default:
 		return v1pb.Visibility_VISIBILITY_UNSPECIFIED
 	}
@@ -62,6 +64,8 @@ func convertVisibilityToStorepb(visibility v1pb.Visibility) storepb.Visibility {
 		return storepb.Visibility_WORKSPACE
 	case v1pb.Visibility_PUBLIC:
 		return storepb.Visibility_PUBLIC
+	case v1pb.Visibility_PRIVATE:
+		return storepb.Visibility_PRIVATE
 	default:
 		return storepb.Visibility_VISIBILITY_UNSPECIFIED
 	}
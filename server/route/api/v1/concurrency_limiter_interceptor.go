@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yourselfhosted/slash/server/profile"
+)
+
+// ConcurrencyLimit is the concurrency limit of a method.
+type ConcurrencyLimit struct {
+	// MaxConcurrency is the max number of concurrent executions of the method.
+	MaxConcurrency int
+	// QueueTimeout is how long an over-limit call waits for a free slot.
+	// Zero means failing fast without waiting.
+	QueueTimeout time.Duration
+}
+
+// newMethodConcurrencyLimits returns the concurrency limits of the profile, keyed by full method name.
+// The profile validation rejects the invalid limits, which are skipped.
+func newMethodConcurrencyLimits(serverProfile *profile.Profile) map[string]ConcurrencyLimit {
+	limits := map[string]ConcurrencyLimit{}
+	for _, concurrencyLimit := range serverProfile.ConcurrencyLimits {
+		method, maxConcurrency, queueTimeout, err := profile.ParseConcurrencyLimit(concurrencyLimit)
+		if err != nil {
+			continue
+		}
+		limits[method] = ConcurrencyLimit{MaxConcurrency: maxConcurrency, QueueTimeout: queueTimeout}
+	}
+	return limits
+}
+
+type ConcurrencyLimiterInterceptor struct {
+	limits     map[string]ConcurrencyLimit
+	semaphores map[string]chan struct{}
+}
+
+// NewConcurrencyLimiterInterceptor returns a new ConcurrencyLimiterInterceptor with the given limits.
+func NewConcurrencyLimiterInterceptor(limits map[string]ConcurrencyLimit) *ConcurrencyLimiterInterceptor {
+	semaphores := map[string]chan struct{}{}
+	for method, limit := range limits {
+		if limit.MaxConcurrency > 0 {
+			semaphores[method] = make(chan struct{}, limit.MaxConcurrency)
+		}
+	}
+	return &ConcurrencyLimiterInterceptor{
+		limits:     limits,
+		semaphores: semaphores,
+	}
+}
+
+func (in *ConcurrencyLimiterInterceptor) ConcurrencyLimiterInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	semaphore, ok := in.semaphores[serverInfo.FullMethod]
+	if !ok {
+		return handler(ctx, request)
+	}
+
+	if err := in.acquire(ctx, semaphore, in.limits[serverInfo.FullMethod].QueueTimeout); err != nil {
+		return nil, err
+	}
+	defer func() { <-semaphore }()
+	return handler(ctx, request)
+}
+
+// StreamConcurrencyLimiterInterceptor applies the same limits as ConcurrencyLimiterInterceptor to the streaming methods,
+// whose slot is held until the stream ends.
+func (in *ConcurrencyLimiterInterceptor) StreamConcurrencyLimiterInterceptor(server any, stream grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	semaphore, ok := in.semaphores[serverInfo.FullMethod]
+	if !ok {
+		return handler(server, stream)
+	}
+
+	if err := in.acquire(stream.Context(), semaphore, in.limits[serverInfo.FullMethod].QueueTimeout); err != nil {
+		return err
+	}
+	defer func() { <-semaphore }()
+	return handler(server, stream)
+}
+
+func (*ConcurrencyLimiterInterceptor) acquire(ctx context.Context, semaphore chan struct{}, queueTimeout time.Duration) error {
+	if queueTimeout <= 0 {
+		select {
+		case semaphore <- struct{}{}:
+			return nil
+		default:
+			return status.Errorf(codes.ResourceExhausted, "too many concurrent requests")
+		}
+	}
+
+	timer := time.NewTimer(queueTimeout)
+	defer timer.Stop()
+	select {
+	case semaphore <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return status.Errorf(codes.ResourceExhausted, "too many concurrent requests, timed out after %s", queueTimeout)
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const testLimitedMethod = "/slash.api.v1.ShortcutService/GetShortcutAnalytics"
+
+// runConcurrentCalls starts n calls that block until release is closed and returns their errors.
+func runConcurrentCalls(in *ConcurrencyLimiterInterceptor, method string, n int, release chan struct{}) []error {
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: method}
+	handler := func(context.Context, any) (any, error) {
+		<-release
+		return nil, nil
+	}
+	errs := make([]error, n)
+	wg := sync.WaitGroup{}
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = in.ConcurrencyLimiterInterceptor(context.Background(), nil, serverInfo, handler)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func countCodes(errs []error) map[codes.Code]int {
+	result := map[codes.Code]int{}
+	for _, err := range errs {
+		result[status.Code(err)]++
+	}
+	return result
+}
+
+func TestConcurrencyLimiterFailFast(t *testing.T) {
+	in := NewConcurrencyLimiterInterceptor(map[string]ConcurrencyLimit{
+		testLimitedMethod: {MaxConcurrency: 2},
+	})
+	release := make(chan struct{})
+	time.AfterFunc(200*time.Millisecond, func() { close(release) })
+	errs := runConcurrentCalls(in, testLimitedMethod, 5, release)
+	result := countCodes(errs)
+	require.Equal(t, 2, result[codes.OK])
+	require.Equal(t, 3, result[codes.ResourceExhausted])
+}
+
+func TestConcurrencyLimiterQueueWithTimeout(t *testing.T) {
+	// The queued calls get a slot before timing out once the running calls finish.
+	in := NewConcurrencyLimiterInterceptor(map[string]ConcurrencyLimit{
+		testLimitedMethod: {MaxConcurrency: 2, QueueTimeout: 5 * time.Second},
+	})
+	release := make(chan struct{})
+	time.AfterFunc(100*time.Millisecond, func() { close(release) })
+	errs := runConcurrentCalls(in, testLimitedMethod, 5, release)
+	require.Equal(t, 5, countCodes(errs)[codes.OK])
+
+	// The queued calls time out while the running calls are still in progress.
+	in = NewConcurrencyLimiterInterceptor(map[string]ConcurrencyLimit{
+		testLimitedMethod: {MaxConcurrency: 2, QueueTimeout: 50 * time.Millisecond},
+	})
+	release = make(chan struct{})
+	time.AfterFunc(500*time.Millisecond, func() { close(release) })
+	errs = runConcurrentCalls(in, testLimitedMethod, 5, release)
+	result := countCodes(errs)
+	require.Equal(t, 2, result[codes.OK])
+	require.Equal(t, 3, result[codes.ResourceExhausted])
+}
+
+func TestConcurrencyLimiterUnlimitedMethod(t *testing.T) {
+	in := NewConcurrencyLimiterInterceptor(map[string]ConcurrencyLimit{
+		testLimitedMethod: {MaxConcurrency: 1},
+	})
+	release := make(chan struct{})
+	time.AfterFunc(100*time.Millisecond, func() { close(release) })
+	errs := runConcurrentCalls(in, "/slash.api.v1.ShortcutService/ListShortcuts", 5, release)
+	require.Equal(t, 5, countCodes(errs)[codes.OK])
+}
+
+func TestNewMethodConcurrencyLimits(t *testing.T) {
+	limits := newMethodConcurrencyLimits(&profile.Profile{
+		ConcurrencyLimits: []string{testLimitedMethod + "=4:5s", "/slash.api.v1.ShortcutService/ExportShortcuts=2"},
+	})
+	require.Equal(t, map[string]ConcurrencyLimit{
+		testLimitedMethod: {MaxConcurrency: 4, QueueTimeout: 5 * time.Second},
+		"/slash.api.v1.ShortcutService/ExportShortcuts": {MaxConcurrency: 2},
+	}, limits)
+	require.Empty(t, newMethodConcurrencyLimits(&profile.Profile{}))
+}
+
+func TestStreamConcurrencyLimiter(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	const method = "/slash.api.v1.ShortcutService/ExportShortcuts"
+	in := NewConcurrencyLimiterInterceptor(map[string]ConcurrencyLimit{
+		method: {MaxConcurrency: 1},
+	})
+	serverInfo := &grpc.StreamServerInfo{FullMethod: method, IsServerStream: true}
+	export := func(_ any, stream grpc.ServerStream) error {
+		return s.ExportShortcuts(&v1pb.ExportShortcutsRequest{}, stream.(*testingHTTPBodyStream))
+	}
+
+	// The running export holds the slot of the method until its stream ends.
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- in.StreamConcurrencyLimiterInterceptor(nil, &testingHTTPBodyStream{ctx: withUser(ctx, user)}, serverInfo, func(server any, stream grpc.ServerStream) error {
+			close(started)
+			<-release
+			return export(server, stream)
+		})
+	}()
+	<-started
+	err := in.StreamConcurrencyLimiterInterceptor(nil, &testingHTTPBodyStream{ctx: withUser(ctx, user)}, serverInfo, export)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	close(release)
+	require.NoError(t, <-done)
+	stream := &testingHTTPBodyStream{ctx: withUser(ctx, user)}
+	require.NoError(t, in.StreamConcurrencyLimiterInterceptor(nil, stream, serverInfo, export))
+	require.NotEmpty(t, stream.chunks)
+}
@@ -0,0 +1,261 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// EmbedShortcutsPath is the path of the endpoint returning the public shortcuts for the embed widget.
+const EmbedShortcutsPath = "/api/v1/embed/shortcuts"
+
+// embedShortcut is a shortcut as returned to the embed widget.
+type embedShortcut struct {
+	Name        string   `json:"name"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Link        string   `json:"link"`
+	Tags        []string `json:"tags"`
+}
+
+type embedShortcutsResponse struct {
+	Shortcuts []*embedShortcut `json:"shortcuts"`
+}
+
+type embedErrorResponse struct {
+	Message string `json:"message"`
+}
+
+func (s *APIV1Service) ListEmbedTokens(ctx context.Context, _ *v1pb.ListEmbedTokensRequest) (*v1pb.ListEmbedTokensResponse, error) {
+	embedSetting, err := s.Store.GetWorkspaceEmbedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace embed setting: %v", err)
+	}
+	embedTokens := []*v1pb.EmbedToken{}
+	for _, embedToken := range embedSetting.EmbedTokens {
+		embedTokens = append(embedTokens, convertEmbedTokenFromStorepb(embedToken))
+	}
+	return &v1pb.ListEmbedTokensResponse{EmbedTokens: embedTokens}, nil
+}
+
+func (s *APIV1Service) CreateEmbedToken(ctx context.Context, request *v1pb.CreateEmbedTokenRequest) (*v1pb.EmbedToken, error) {
+	if request.EmbedToken == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "embed token is required")
+	}
+	for _, origin := range request.EmbedToken.AllowedOrigins {
+		if !isValidEmbedOrigin(origin) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid allowed origin %q", origin)
+		}
+	}
+	if request.EmbedToken.CollectionId != 0 {
+		collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+			ID: &request.EmbedToken.CollectionId,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get collection: %v", err)
+		}
+		if collection == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "collection not found")
+		}
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	embedToken := &storepb.WorkspaceSetting_EmbedSetting_EmbedToken{
+		Id:             util.GenUUID(),
+		CreatorId:      user.ID,
+		CreatedTs:      time.Now().Unix(),
+		Description:    request.EmbedToken.Description,
+		Tag:            strings.TrimSpace(request.EmbedToken.Tag),
+		CollectionId:   request.EmbedToken.CollectionId,
+		AllowedOrigins: request.EmbedToken.AllowedOrigins,
+	}
+	token, err := GenerateEmbedToken(embedToken.Id, user.ID, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate embed token: %v", err)
+	}
+	embedSetting, err := s.Store.GetWorkspaceEmbedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace embed setting: %v", err)
+	}
+	embedSetting.EmbedTokens = append(embedSetting.EmbedTokens, embedToken)
+	if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_EMBED,
+		Value: &storepb.WorkspaceSetting_Embed{
+			Embed: embedSetting,
+		},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update workspace embed setting: %v", err)
+	}
+
+	result := convertEmbedTokenFromStorepb(embedToken)
+	result.Token = token
+	return result, nil
+}
+
+func (s *APIV1Service) DeleteEmbedToken(ctx context.Context, request *v1pb.DeleteEmbedTokenRequest) (*emptypb.Empty, error) {
+	embedSetting, err := s.Store.GetWorkspaceEmbedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace embed setting: %v", err)
+	}
+	embedTokens := slices.DeleteFunc(slices.Clone(embedSetting.EmbedTokens), func(embedToken *storepb.WorkspaceSetting_EmbedSetting_EmbedToken) bool {
+		return embedToken.Id == request.Id
+	})
+	if len(embedTokens) == len(embedSetting.EmbedTokens) {
+		return nil, status.Errorf(codes.NotFound, "embed token not found")
+	}
+	if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_EMBED,
+		Value: &storepb.WorkspaceSetting_Embed{
+			Embed: &storepb.WorkspaceSetting_EmbedSetting{
+				EmbedTokens: embedTokens,
+			},
+		},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update workspace embed setting: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) registerEmbedRoutes(e *echo.Echo) {
+	e.GET(EmbedShortcutsPath, s.handleEmbedShortcuts)
+	e.OPTIONS(EmbedShortcutsPath, s.handleEmbedShortcuts)
+}
+
+// handleEmbedShortcuts returns the public shortcuts in the scope of the embed token of the `token` query param.
+// Browsers can only fetch it from the allowed origins of the token.
+func (s *APIV1Service) handleEmbedShortcuts(c echo.Context) error {
+	ctx := c.Request().Context()
+	embedToken, err := s.authenticateEmbedToken(ctx, c.QueryParam("token"))
+	if err != nil {
+		st := status.Convert(err)
+		if st.Code() == codes.Internal {
+			return c.JSON(http.StatusInternalServerError, &embedErrorResponse{Message: st.Message()})
+		}
+		return c.JSON(http.StatusUnauthorized, &embedErrorResponse{Message: st.Message()})
+	}
+
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderOrigin)
+	if origin := c.Request().Header.Get(echo.HeaderOrigin); origin != "" && slices.Contains(embedToken.AllowedOrigins, origin) {
+		c.Response().Header().Set(echo.HeaderAccessControlAllowOrigin, origin)
+		c.Response().Header().Set(echo.HeaderAccessControlAllowMethods, http.MethodGet)
+	}
+	if c.Request().Method == http.MethodOptions {
+		return c.NoContent(http.StatusNoContent)
+	}
+
+	shortcuts, err := s.listEmbedShortcuts(ctx, embedToken)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, &embedErrorResponse{Message: err.Error()})
+	}
+	return c.JSON(http.StatusOK, &embedShortcutsResponse{Shortcuts: shortcuts})
+}
+
+// authenticateEmbedToken verifies the signed embed token and returns its workspace setting,
+// an Unauthenticated error when it is invalid or revoked.
+func (s *APIV1Service) authenticateEmbedToken(ctx context.Context, token string) (*storepb.WorkspaceSetting_EmbedSetting_EmbedToken, error) {
+	if token == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "embed token not found")
+	}
+	claims, err := parseAccessToken(token, s.Secret)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid embed token")
+	}
+	if !audienceContains(claims.Audience, EmbedTokenAudienceName) {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid embed token, audience mismatch")
+	}
+	embedSetting, err := s.Store.GetWorkspaceEmbedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace embed setting: %v", err)
+	}
+	for _, embedToken := range embedSetting.EmbedTokens {
+		if claims.ID != "" && embedToken.Id == claims.ID {
+			return embedToken, nil
+		}
+	}
+	return nil, status.Errorf(codes.Unauthenticated, "embed token has been revoked")
+}
+
+// listEmbedShortcuts returns the public shortcuts with the tag and in the collection of the embed token.
+// A collection that is no longer public embeds no shortcuts.
+func (s *APIV1Service) listEmbedShortcuts(ctx context.Context, embedToken *storepb.WorkspaceSetting_EmbedSetting_EmbedToken) ([]*embedShortcut, error) {
+	rowStatus := storepb.RowStatus_NORMAL
+	find := &store.FindShortcut{
+		RowStatus:      &rowStatus,
+		VisibilityList: []storepb.Visibility{storepb.Visibility_PUBLIC},
+		OrderBy:        []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldName}},
+	}
+	if embedToken.Tag != "" {
+		find.Tags = []string{embedToken.Tag}
+	}
+	var collectionShortcutIDs []int32
+	if embedToken.CollectionId != 0 {
+		collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+			ID: &embedToken.CollectionId,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get collection")
+		}
+		if collection == nil || collection.Visibility != storepb.Visibility_PUBLIC {
+			return []*embedShortcut{}, nil
+		}
+		collectionShortcutIDs = collection.ShortcutIds
+	}
+	shortcuts, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list shortcuts")
+	}
+
+	result := []*embedShortcut{}
+	for _, shortcut := range shortcuts {
+		if embedToken.CollectionId != 0 && !slices.Contains(collectionShortcutIDs, shortcut.Id) {
+			continue
+		}
+		result = append(result, &embedShortcut{
+			Name:        shortcut.Name,
+			Title:       shortcut.Title,
+			Description: shortcut.Description,
+			Link:        shortcut.Link,
+			Tags:        shortcut.Tags,
+		})
+	}
+	return result, nil
+}
+
+// isValidEmbedOrigin returns whether the origin is a scheme and host without a path, e.g. "https://docs.example.com".
+func isValidEmbedOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && u.Path == "" && u.RawQuery == "" && u.Fragment == "" && u.User == nil
+}
+
+func convertEmbedTokenFromStorepb(embedToken *storepb.WorkspaceSetting_EmbedSetting_EmbedToken) *v1pb.EmbedToken {
+	return &v1pb.EmbedToken{
+		Id:             embedToken.Id,
+		CreatorId:      embedToken.CreatorId,
+		CreateTime:     timestamppb.New(time.Unix(embedToken.CreatedTs, 0)),
+		Description:    embedToken.Description,
+		Tag:            embedToken.Tag,
+		CollectionId:   embedToken.CollectionId,
+		AllowedOrigins: embedToken.AllowedOrigins,
+	}
+}
@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func serveEmbedShortcuts(s *APIV1Service, token, origin string) *httptest.ResponseRecorder {
+	e := echo.New()
+	s.registerEmbedRoutes(e)
+	request := httptest.NewRequest(http.MethodGet, EmbedShortcutsPath+"?token="+url.QueryEscape(token), nil)
+	if origin != "" {
+		request.Header.Set(echo.HeaderOrigin, origin)
+	}
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func getEmbedShortcutNames(t *testing.T, recorder *httptest.ResponseRecorder) []string {
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	response := &embedShortcutsResponse{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), response))
+	names := []string{}
+	for _, shortcut := range response.Shortcuts {
+		names = append(names, shortcut.Name)
+	}
+	return names
+}
+
+func TestEmbedShortcuts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	createShortcut := func(name string, visibility v1pb.Visibility, tags ...string) *v1pb.Shortcut {
+		shortcut, err := s.CreateShortcut(withUser(ctx, admin), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Visibility: visibility, Tags: tags},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	createEmbedToken := func(embedToken *v1pb.EmbedToken) *v1pb.EmbedToken {
+		created, err := s.CreateEmbedToken(withUser(ctx, admin), &v1pb.CreateEmbedTokenRequest{EmbedToken: embedToken})
+		require.NoError(t, err)
+		require.NotEmpty(t, created.Token)
+		return created
+	}
+	docs := createShortcut("docs", v1pb.Visibility_PUBLIC, "docs")
+	createShortcut("blog", v1pb.Visibility_PUBLIC, "blog")
+	createShortcut("docs-internal", v1pb.Visibility_WORKSPACE, "docs")
+	createShortcut("docsearch", v1pb.Visibility_PUBLIC, "docsearch")
+	collection, err := s.CreateCollection(withUser(ctx, admin), &v1pb.CreateCollectionRequest{
+		Collection: &v1pb.Collection{Name: "handbook", Title: "Handbook", ShortcutIds: []int32{docs.Id}, Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	// Only the public shortcuts are embedded, in the scope of the token.
+	all := createEmbedToken(&v1pb.EmbedToken{AllowedOrigins: []string{"https://docs.example.com"}})
+	require.Equal(t, []string{"blog", "docs", "docsearch"}, getEmbedShortcutNames(t, serveEmbedShortcuts(s, all.Token, "")))
+	tagged := createEmbedToken(&v1pb.EmbedToken{Tag: "docs"})
+	require.Equal(t, []string{"docs"}, getEmbedShortcutNames(t, serveEmbedShortcuts(s, tagged.Token, "")))
+	collected := createEmbedToken(&v1pb.EmbedToken{CollectionId: collection.Id})
+	require.Equal(t, []string{"docs"}, getEmbedShortcutNames(t, serveEmbedShortcuts(s, collected.Token, "")))
+
+	// CORS is only allowed for the configured origins.
+	recorder := serveEmbedShortcuts(s, all.Token, "https://docs.example.com")
+	require.Equal(t, "https://docs.example.com", recorder.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	recorder = serveEmbedShortcuts(s, all.Token, "https://evil.example.com")
+	require.Empty(t, recorder.Header().Get(echo.HeaderAccessControlAllowOrigin))
+
+	// The token strings are not listed.
+	response, err := s.ListEmbedTokens(withUser(ctx, admin), &v1pb.ListEmbedTokensRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(response.EmbedTokens))
+	for _, embedToken := range response.EmbedTokens {
+		require.Empty(t, embedToken.Token)
+	}
+
+	// Invalid origins are rejected.
+	_, err = s.CreateEmbedToken(withUser(ctx, admin), &v1pb.CreateEmbedTokenRequest{
+		EmbedToken: &v1pb.EmbedToken{AllowedOrigins: []string{"https://docs.example.com/path"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestEmbedTokenValidation(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, accessToken := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	embedToken, err := s.CreateEmbedToken(withUser(ctx, admin), &v1pb.CreateEmbedTokenRequest{EmbedToken: &v1pb.EmbedToken{}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, serveEmbedShortcuts(s, embedToken.Token, "").Code)
+
+	// Missing, malformed, wrongly signed and access tokens are rejected.
+	otherSecretToken, err := GenerateEmbedToken(embedToken.Id, admin.ID, []byte("other-secret"))
+	require.NoError(t, err)
+	for _, token := range []string{"", "invalid", otherSecretToken, accessToken} {
+		require.Equal(t, http.StatusUnauthorized, serveEmbedShortcuts(s, token, "").Code, token)
+	}
+	// And embed tokens are not access tokens.
+	_, err = NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, embedToken.Token)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Revoked tokens are rejected.
+	_, err = s.DeleteEmbedToken(withUser(ctx, admin), &v1pb.DeleteEmbedTokenRequest{Id: embedToken.Id})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, serveEmbedShortcuts(s, embedToken.Token, "").Code)
+	_, err = s.DeleteEmbedToken(withUser(ctx, admin), &v1pb.DeleteEmbedTokenRequest{Id: embedToken.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
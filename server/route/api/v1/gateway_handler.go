@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// newGatewayHandler wraps the gateway to tag the GET responses with an ETag and to serve HEAD requests.
+// HEAD requests are answered as GET without the body, and with 404 instead of 401 and 403
+// so that the existence of shortcuts is not disclosed to unauthorized clients.
+func newGatewayHandler(gateway http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			gateway.ServeHTTP(w, r)
+			return
+		}
+
+		isHead := r.Method == http.MethodHead
+		if isHead {
+			r = r.Clone(r.Context())
+			r.Method = http.MethodGet
+		}
+		response := newBufferedResponseWriter()
+		gateway.ServeHTTP(response, r)
+
+		header := w.Header()
+		for key, values := range response.header {
+			header[key] = values
+		}
+		statusCode := response.statusCode
+		if statusCode == http.StatusOK {
+			etag := computeETag(response.body.Bytes())
+			header.Set("ETag", etag)
+			if matchETag(r.Header.Get("If-None-Match"), etag) {
+				header.Del("Content-Length")
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+		if isHead {
+			if statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden {
+				statusCode = http.StatusNotFound
+			}
+			header.Set("Content-Length", strconv.Itoa(response.body.Len()))
+			w.WriteHeader(statusCode)
+			return
+		}
+		w.WriteHeader(statusCode)
+		_, _ = w.Write(response.body.Bytes())
+	})
+}
+
+// bufferedResponseWriter holds the whole response so that it can be inspected before sending.
+type bufferedResponseWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func newBufferedResponseWriter() *bufferedResponseWriter {
+	return &bufferedResponseWriter{
+		header:     http.Header{},
+		statusCode: http.StatusOK,
+	}
+}
+
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+}
+
+func computeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+func matchETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
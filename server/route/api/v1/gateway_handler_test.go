@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestGatewayHandlerHead(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	gwMux := runtime.NewServeMux()
+	require.NoError(t, v1pb.RegisterShortcutServiceHandlerServer(ctx, gwMux, s))
+	handler := newGatewayHandler(gwMux)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	createShortcut := func(name string, visibility storepb.Visibility) *storepb.Shortcut {
+		shortcut, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: visibility,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	public := createShortcut("public", storepb.Visibility_PUBLIC)
+	workspace := createShortcut("workspace", storepb.Visibility_WORKSPACE)
+	serve := func(method, path string, header http.Header) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, nil)
+		for key, values := range header {
+			request.Header[key] = values
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	for _, path := range []string{fmt.Sprintf("/api/v1/shortcuts/%d", public.Id), "/api/v1/shortcuts"} {
+		get := serve(http.MethodGet, path, nil)
+		require.Equal(t, http.StatusOK, get.Code)
+		require.NotEmpty(t, get.Body.String())
+		require.NotEmpty(t, get.Header().Get("ETag"))
+
+		head := serve(http.MethodHead, path, nil)
+		require.Equal(t, http.StatusOK, head.Code)
+		require.Empty(t, head.Body.String())
+		require.Equal(t, get.Header().Get("ETag"), head.Header().Get("ETag"))
+		require.Equal(t, get.Header().Get("Content-Type"), head.Header().Get("Content-Type"))
+		require.Equal(t, fmt.Sprint(get.Body.Len()), head.Header().Get("Content-Length"))
+
+		notModified := serve(http.MethodHead, path, http.Header{"If-None-Match": {get.Header().Get("ETag")}})
+		require.Equal(t, http.StatusNotModified, notModified.Code)
+		require.Empty(t, notModified.Body.String())
+	}
+
+	// Missing and unauthorized shortcuts are both reported as not found.
+	for _, path := range []string{fmt.Sprintf("/api/v1/shortcuts/%d", workspace.Id+1), fmt.Sprintf("/api/v1/shortcuts/%d", workspace.Id)} {
+		head := serve(http.MethodHead, path, nil)
+		require.Equal(t, http.StatusNotFound, head.Code)
+		require.Empty(t, head.Body.String())
+	}
+	require.Equal(t, http.StatusForbidden, serve(http.MethodGet, fmt.Sprintf("/api/v1/shortcuts/%d", workspace.Id), nil).Code)
+}
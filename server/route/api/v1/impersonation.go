@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// ImpersonateUser issues an access token of the user for the calling admin, expiring after ImpersonationTokenDuration.
+// The token records the admin, so that it is told apart from the tokens of the user and stops working if the admin is
+// demoted. Other admins can't be impersonated.
+func (s *APIV1Service) ImpersonateUser(ctx context.Context, request *v1pb.ImpersonateUserRequest) (*v1pb.ImpersonateUserResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	if request.Id == currentUser.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot impersonate yourself")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID:              &request.Id,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.Role == store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot impersonate an admin")
+	}
+	if user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot impersonate an archived user")
+	}
+
+	expireTime := time.Now().Add(ImpersonationTokenDuration)
+	accessToken, err := GenerateAccessToken(user.Email, user.ID, expireTime, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
+	}
+	if err := s.Store.UpsertUserAccessTokens(ctx, user.ID, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+		AccessToken:    accessToken,
+		Description:    fmt.Sprintf("impersonated by %s (#%d)", currentUser.Email, currentUser.ID),
+		CreatedTs:      time.Now().Unix(),
+		ImpersonatorId: currentUser.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert access token to store: %v", err)
+	}
+	s.createImpersonateActivity(ctx, currentUser, &storepb.ActivityUserImpersonatePayload{
+		UserId: user.ID,
+	})
+	return &v1pb.ImpersonateUserResponse{
+		AccessToken: accessToken,
+		ExpireTime:  timestamppb.New(expireTime),
+	}, nil
+}
+
+func (s *APIV1Service) createImpersonateActivity(ctx context.Context, user *store.User, payload *storepb.ActivityUserImpersonatePayload) {
+	payload.Ip, payload.UserAgent = getClientInfo(ctx)
+	payloadStr, err := protojson.Marshal(payload)
+	if err != nil {
+		slog.Warn("failed to marshal impersonate activity payload", slog.String("error", err.Error()))
+		return
+	}
+	if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityUserImpersonate,
+		Level:     store.ActivityWarn,
+		Payload:   string(payloadStr),
+	}); err != nil {
+		slog.Warn("failed to create impersonate activity", slog.String("error", err.Error()))
+	}
+}
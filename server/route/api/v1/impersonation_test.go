@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestImpersonateUser(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	otherAdmin, _ := createTestingUser(ctx, t, s, "other-admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	interceptor := NewGRPCAuthInterceptor(s.Store, s.Secret)
+	authorize := func(accessToken, method string) error {
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken))
+		_, err := interceptor.authorize(ctx, method)
+		return err
+	}
+
+	// Only the admins impersonate, and only the other users.
+	_, err := s.ImpersonateUser(withUser(ctx, user), &v1pb.ImpersonateUserRequest{Id: admin.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ImpersonateUser(withUser(ctx, admin), &v1pb.ImpersonateUserRequest{Id: admin.ID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.ImpersonateUser(withUser(ctx, admin), &v1pb.ImpersonateUserRequest{Id: otherAdmin.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ImpersonateUser(withUser(ctx, admin), &v1pb.ImpersonateUserRequest{Id: 404})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	response, err := s.ImpersonateUser(withUser(ctx, admin), &v1pb.ImpersonateUserRequest{Id: user.ID})
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(ImpersonationTokenDuration), response.ExpireTime.AsTime(), time.Minute)
+	userID, err := interceptor.authenticate(ctx, response.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, userID)
+	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	var impersonatorID int32
+	for _, userAccessToken := range userAccessTokens {
+		if userAccessToken.AccessToken == response.AccessToken {
+			impersonatorID = userAccessToken.ImpersonatorId
+			require.Equal(t, "impersonated by admin@test.com (#1)", userAccessToken.Description)
+		}
+	}
+	require.Equal(t, admin.ID, impersonatorID)
+	activities, err := s.Store.ListActivities(ctx, &store.FindActivity{Type: store.ActivityUserImpersonate})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	require.Equal(t, admin.ID, activities[0].CreatorID)
+
+	// The impersonation token can't be extended nor read the credentials of the user.
+	require.NoError(t, authorize(response.AccessToken, "/slash.api.v1.ShortcutService/ListShortcuts"))
+	require.Equal(t, codes.PermissionDenied, status.Code(authorize(response.AccessToken, "/slash.api.v1.UserService/CreateUserAccessToken")))
+	require.Equal(t, codes.PermissionDenied, status.Code(authorize(response.AccessToken, "/slash.api.v1.UserService/ListUserAccessTokens")))
+
+	// The destructive actions are blocked on demand.
+	require.NoError(t, authorize(response.AccessToken, "/slash.api.v1.ShortcutService/DeleteShortcut"))
+	_, err = s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{BlockDestructiveActionsWhenImpersonating: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"block_destructive_actions_when_impersonating"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(authorize(response.AccessToken, "/slash.api.v1.ShortcutService/DeleteShortcut")))
+	require.NoError(t, authorize(response.AccessToken, "/slash.api.v1.ShortcutService/ListShortcuts"))
+
+	// The impersonation ends with the admin role of the impersonator.
+	userRole := store.RoleUser
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: admin.ID, Role: &userRole})
+	require.NoError(t, err)
+	require.Equal(t, codes.Unauthenticated, status.Code(authorize(response.AccessToken, "/slash.api.v1.ShortcutService/ListShortcuts")))
+}
@@ -30,7 +30,7 @@ func (*LoggerInterceptor) loggerInterceptorDo(ctx context.Context, fullMethod st
 	case codes.OK:
 		logLevel = slog.LevelInfo
 		logMsg = "OK"
-	case codes.Unauthenticated, codes.OutOfRange, codes.PermissionDenied, codes.NotFound:
+	case codes.Unauthenticated, codes.OutOfRange, codes.PermissionDenied, codes.NotFound, codes.ResourceExhausted:
 		logLevel = slog.LevelInfo
 		logMsg = "client error"
 	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded:
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"context"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// ChangePassword sets the password of the current user once they confirm their current password. The wrong current
+// passwords are throttled as the ones of sign ins. The other sessions of the user are signed out on demand.
+func (s *APIV1Service) ChangePassword(ctx context.Context, request *v1pb.ChangePasswordRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	if request.NewPassword == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "new password is required")
+	}
+	if err := s.signInThrottler.check(store.GetWorkspaceID(ctx), user.Email); err != nil {
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.CurrentPassword)); err != nil {
+		s.signInThrottler.fail(store.GetWorkspaceID(ctx), user.Email)
+		return nil, status.Errorf(codes.Unauthenticated, "incorrect password")
+	}
+	s.signInThrottler.succeed(store.GetWorkspaceID(ctx), user.Email)
+
+	if err := s.checkPasswordPolicy(ctx, request.NewPassword); err != nil {
+		return nil, err
+	}
+	passwordHash, err := s.hashPassword(request.NewPassword)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
+	}
+	if _, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:           user.ID,
+		PasswordHash: &passwordHash,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	}
+	if request.RevokeOtherSessions {
+		if err := s.revokeOtherAccessTokens(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// revokeOtherAccessTokens removes the access tokens of the user but the one of the request.
+func (s *APIV1Service) revokeOtherAccessTokens(ctx context.Context, user *store.User) error {
+	accessToken := getAccessTokenFromContext(ctx)
+	_, err := s.removeUserAccessTokens(ctx, user, func(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken) bool {
+		return accessToken == "" || userAccessToken.AccessToken != accessToken
+	})
+	return err
+}
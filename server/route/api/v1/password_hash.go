@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+// passwordHashCost returns the bcrypt cost of the password hashes of the server, clamped to the bcrypt range.
+func (s *APIV1Service) passwordHashCost() int {
+	cost := s.Profile.PasswordHashCost
+	if cost == 0 {
+		return bcrypt.DefaultCost
+	}
+	return min(max(cost, bcrypt.MinCost), bcrypt.MaxCost)
+}
+
+// hashPassword returns the bcrypt hash of the password with the cost of the server.
+func (s *APIV1Service) hashPassword(password string) (string, error) {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), s.passwordHashCost())
+	if err != nil {
+		return "", err
+	}
+	return string(passwordHash), nil
+}
+
+// rehashPassword hashes the password of the user again when its hash has a lower cost than the one of the server.
+// It is called once the password matched the hash. The failures are logged and leave the previous hash in place.
+func (s *APIV1Service) rehashPassword(ctx context.Context, user *store.User, password string) *store.User {
+	cost, err := bcrypt.Cost([]byte(user.PasswordHash))
+	if err != nil || cost >= s.passwordHashCost() {
+		return user
+	}
+	passwordHash, err := s.hashPassword(password)
+	if err != nil {
+		slog.Warn("failed to rehash password", slog.Int("user_id", int(user.ID)), slog.String("error", err.Error()))
+		return user
+	}
+	updated, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:           user.ID,
+		PasswordHash: &passwordHash,
+	})
+	if err != nil {
+		slog.Warn("failed to rehash password", slog.Int("user_id", int(user.ID)), slog.String("error", err.Error()))
+		return user
+	}
+	return updated
+}
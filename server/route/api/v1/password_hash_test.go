@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestPasswordHashCost(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	tests := []struct {
+		cost int
+		want int
+	}{
+		{cost: 0, want: bcrypt.DefaultCost},
+		{cost: 12, want: 12},
+		{cost: 1, want: bcrypt.MinCost},
+		{cost: -1, want: bcrypt.MinCost},
+		{cost: 99, want: bcrypt.MaxCost},
+	}
+	for _, test := range tests {
+		s.Profile.PasswordHashCost = test.cost
+		require.Equal(t, test.want, s.passwordHashCost(), test.cost)
+	}
+}
+
+func TestSignInRehashesPassword(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	signIn := func() error {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: user.Email, Password: "password"})
+		return err
+	}
+	getHashCost := func() int {
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &user.ID})
+		require.NoError(t, err)
+		cost, err := bcrypt.Cost([]byte(user.PasswordHash))
+		require.NoError(t, err)
+		return cost
+	}
+
+	// The hash is kept while its cost is the one of the server.
+	require.NoError(t, signIn())
+	require.Equal(t, bcrypt.MinCost, getHashCost())
+
+	// It is upgraded once the cost of the server is raised, and the password still signs in.
+	s.Profile.PasswordHashCost = bcrypt.MinCost + 1
+	require.NoError(t, signIn())
+	require.Equal(t, bcrypt.MinCost+1, getHashCost())
+	require.NoError(t, signIn())
+
+	// Lowering the cost doesn't downgrade the hashes.
+	s.Profile.PasswordHashCost = bcrypt.MinCost
+	require.NoError(t, signIn())
+	require.Equal(t, bcrypt.MinCost+1, getHashCost())
+}
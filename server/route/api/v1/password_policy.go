@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	_ "embed"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+const (
+	// defaultPasswordMinLength is the minimum length of the passwords when the workspace doesn't set it.
+	defaultPasswordMinLength = 8
+	// maxPasswordLength is the number of bytes bcrypt hashes, the bytes past it would be ignored.
+	maxPasswordLength = 72
+)
+
+//go:embed common_passwords.txt
+var commonPasswordList string
+
+// commonPasswords are the lowercased passwords rejected whatever the policy of the workspace.
+var commonPasswords = func() map[string]bool {
+	passwords := map[string]bool{}
+	for _, password := range strings.Split(commonPasswordList, "\n") {
+		if password = strings.TrimSpace(password); password != "" {
+			passwords[strings.ToLower(password)] = true
+		}
+	}
+	return passwords
+}()
+
+// validatePassword returns an InvalidArgument error telling the first rule of the password policy of the workspace
+// that the password breaks, if any. It runs before the password is hashed.
+func validatePassword(password string, securitySetting *storepb.WorkspaceSetting_SecuritySetting) error {
+	minLength := int(securitySetting.GetPasswordMinLength())
+	if minLength <= 0 {
+		minLength = defaultPasswordMinLength
+	}
+	if utf8.RuneCountInString(password) < minLength {
+		return status.Errorf(codes.InvalidArgument, "password must be at least %d characters", minLength)
+	}
+	if len(password) > maxPasswordLength {
+		return status.Errorf(codes.InvalidArgument, "password must be at most %d bytes", maxPasswordLength)
+	}
+	var hasUppercase, hasLowercase, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUppercase = true
+		case unicode.IsLower(r):
+			hasLowercase = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r):
+			hasSymbol = true
+		}
+	}
+	if securitySetting.GetPasswordRequireUppercase() && !hasUppercase {
+		return status.Errorf(codes.InvalidArgument, "password must contain an uppercase letter")
+	}
+	if securitySetting.GetPasswordRequireLowercase() && !hasLowercase {
+		return status.Errorf(codes.InvalidArgument, "password must contain a lowercase letter")
+	}
+	if securitySetting.GetPasswordRequireDigit() && !hasDigit {
+		return status.Errorf(codes.InvalidArgument, "password must contain a digit")
+	}
+	if securitySetting.GetPasswordRequireSymbol() && !hasSymbol {
+		return status.Errorf(codes.InvalidArgument, "password must contain a symbol")
+	}
+	if commonPasswords[strings.ToLower(password)] {
+		return status.Errorf(codes.InvalidArgument, "password is too common")
+	}
+	return nil
+}
+
+// checkPasswordPolicy validates the password against the password policy of the workspace.
+func (s *APIV1Service) checkPasswordPolicy(ctx context.Context, password string) error {
+	securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace security setting: %v", err)
+	}
+	return validatePassword(password, securitySetting)
+}
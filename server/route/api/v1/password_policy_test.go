@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestValidatePassword(t *testing.T) {
+	tests := []struct {
+		password        string
+		securitySetting *storepb.WorkspaceSetting_SecuritySetting
+		error           string
+	}{
+		{password: "", error: "password must be at least 8 characters"},
+		{password: "horse", error: "password must be at least 8 characters"},
+		{password: "correct-horse"},
+		{password: "Password", error: "password is too common"},
+		{password: "horse-battery", securitySetting: &storepb.WorkspaceSetting_SecuritySetting{PasswordMinLength: 16}, error: "password must be at least 16 characters"},
+		{password: "ñandú-çà-été", securitySetting: &storepb.WorkspaceSetting_SecuritySetting{PasswordMinLength: 12}},
+		{password: "correct-horse", securitySetting: &storepb.WorkspaceSetting_SecuritySetting{PasswordRequireUppercase: true}, error: "password must contain an uppercase letter"},
+		{password: "CORRECT-HORSE", securitySetting: &storepb.WorkspaceSetting_SecuritySetting{PasswordRequireLowercase: true}, error: "password must contain a lowercase letter"},
+		{password: "correct-horse", securitySetting: &storepb.WorkspaceSetting_SecuritySetting{PasswordRequireDigit: true}, error: "password must contain a digit"},
+		{password: "correcthorse1", securitySetting: &storepb.WorkspaceSetting_SecuritySetting{PasswordRequireSymbol: true}, error: "password must contain a symbol"},
+		{password: "Correct-horse-1", securitySetting: &storepb.WorkspaceSetting_SecuritySetting{
+			PasswordRequireUppercase: true,
+			PasswordRequireLowercase: true,
+			PasswordRequireDigit:     true,
+			PasswordRequireSymbol:    true,
+		}},
+		{password: string(make([]byte, maxPasswordLength+1)), error: "password must be at most 72 bytes"},
+	}
+	for _, test := range tests {
+		err := validatePassword(test.password, test.securitySetting)
+		if test.error == "" {
+			require.NoError(t, err, test.password)
+			continue
+		}
+		require.Equal(t, codes.InvalidArgument, status.Code(err), test.password)
+		require.Equal(t, test.error, status.Convert(err).Message())
+	}
+}
+
+func TestPasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	_, err := s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{PasswordMinLength: 10, PasswordRequireDigit: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password_min_length", "password_require_digit"}},
+	})
+	require.NoError(t, err)
+	_, err = s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{PasswordMinLength: maxPasswordLength + 1},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password_min_length"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	workspaceSetting, err := s.GetWorkspaceSetting(withUser(ctx, admin), &v1pb.GetWorkspaceSettingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(10), workspaceSetting.PasswordMinLength)
+	require.True(t, workspaceSetting.PasswordRequireDigit)
+
+	// Signing up runs the policy of the workspace.
+	signUp := func(password string) (*v1pb.User, error) {
+		return s.SignUp(grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{}), &v1pb.SignUpRequest{
+			Email:    "user@test.com",
+			Nickname: "user",
+			Password: password,
+		})
+	}
+	_, err = signUp("horse-1")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "password must be at least 10 characters", status.Convert(err).Message())
+	_, err = signUp("correct-horse")
+	require.Equal(t, "password must contain a digit", status.Convert(err).Message())
+	user, err := signUp("correct-horse-1")
+	require.NoError(t, err)
+
+	// So does resetting the password.
+	changePassword := func(password string) error {
+		_, err := s.UpdateUser(withUser(ctx, admin), &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Id: user.Id, Password: password},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password"}},
+		})
+		return err
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(changePassword("battery-staple")))
+	require.NoError(t, changePassword("battery-staple-2"))
+	_, err = s.SignIn(grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{}), &v1pb.SignInRequest{
+		Email:    user.Email,
+		Password: "battery-staple-2",
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	"github.com/yourselfhosted/slash/plugin/mail"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const invalidPasswordResetTokenError = "invalid or expired password reset token"
+
+// RequestPasswordReset issues a password reset token for the user of the email and mails it to them.
+// It succeeds whatever the email, so that it doesn't tell which emails have an account.
+func (s *APIV1Service) RequestPasswordReset(ctx context.Context, request *v1pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if request.Email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Email: &request.Email,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return &emptypb.Empty{}, nil
+	}
+	workspaceSecuritySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace security setting: %v", err)
+	}
+	if workspaceSecuritySetting.DisallowPasswordAuth && user.Role == store.RoleUser {
+		return &emptypb.Empty{}, nil
+	}
+
+	token, err := util.RandomString(32)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password reset token: %v", err)
+	}
+	// Only the hash is stored, a leaked database doesn't allow resetting the passwords.
+	if err := s.upsertUserPasswordReset(ctx, user, &storepb.UserSetting_PasswordResetSetting{
+		TokenHash: hashToken(token),
+		ExpiresTs: time.Now().Add(PasswordResetTokenDuration).Unix(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if s.Mailer == nil {
+		slog.Info("password reset requested", slog.Int("user_id", int(user.ID)), slog.String("email", user.Email), slog.String("token", token))
+		return &emptypb.Empty{}, nil
+	}
+	email := mail.NewEmailMsg().
+		SetFrom(s.MailFrom).
+		AddTo(user.Email).
+		SetSubject("Reset your Slash password").
+		SetBody(fmt.Sprintf("<p>Use the token <code>%s</code> to reset your password. It expires in %v.</p>", token, PasswordResetTokenDuration))
+	// The error is not returned, it would tell that the email has an account.
+	if err := s.Mailer.SendMail(email); err != nil {
+		slog.Error("failed to send password reset email", slog.Int("user_id", int(user.ID)), slog.String("error", err.Error()))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ResetPassword sets the password of the user with a password reset token, and signs out all of their sessions.
+func (s *APIV1Service) ResetPassword(ctx context.Context, request *v1pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if request.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "password reset token is required")
+	}
+	if request.Password == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "password is required")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Email: &request.Email,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.InvalidArgument, invalidPasswordResetTokenError)
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PASSWORD_RESET,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user password reset: %v", err)
+	}
+	passwordReset := userSetting.GetPasswordReset()
+	if passwordReset.GetTokenHash() == "" || time.Now().Unix() >= passwordReset.GetExpiresTs() ||
+		subtle.ConstantTimeCompare([]byte(passwordReset.GetTokenHash()), []byte(hashToken(request.Token))) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, invalidPasswordResetTokenError)
+	}
+
+	if err := s.checkPasswordPolicy(ctx, request.Password); err != nil {
+		return nil, err
+	}
+	passwordHash, err := s.hashPassword(request.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
+	}
+	if _, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:           user.ID,
+		PasswordHash: &passwordHash,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	}
+	// The token is single use.
+	if err := s.upsertUserPasswordReset(ctx, user, &storepb.UserSetting_PasswordResetSetting{}); err != nil {
+		return nil, err
+	}
+	// The sessions of whoever knew the old password are revoked.
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.UserSetting_AccessTokensSetting{},
+		},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) upsertUserPasswordReset(ctx context.Context, user *store.User, passwordReset *storepb.UserSetting_PasswordResetSetting) error {
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PASSWORD_RESET,
+		Value: &storepb.UserSetting_PasswordReset{
+			PasswordReset: passwordReset,
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return nil
+}
+
+// hashToken returns the hex encoded SHA-256 hash of the token.
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
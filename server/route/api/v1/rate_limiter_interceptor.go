@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	rateLimitWindow = time.Minute
+
+	headerRateLimitLimit     = "x-ratelimit-limit"
+	headerRateLimitRemaining = "x-ratelimit-remaining"
+	headerRateLimitReset     = "x-ratelimit-reset"
+)
+
+// RateLimiterInterceptor limits the requests per minute of each signed-in user.
+// The general limit applies to all methods and the create limit to the Create* methods.
+// The state of the most restrictive limiter of a call is returned in the X-RateLimit-* headers.
+type RateLimiterInterceptor struct {
+	general *userRateLimiter
+	create  *userRateLimiter
+}
+
+// NewRateLimiterInterceptor returns a new RateLimiterInterceptor, zero limits are unlimited.
+func NewRateLimiterInterceptor(generalLimit, createLimit int) *RateLimiterInterceptor {
+	return &RateLimiterInterceptor{
+		general: newUserRateLimiter(generalLimit),
+		create:  newUserRateLimiter(createLimit),
+	}
+}
+
+func (in *RateLimiterInterceptor) RateLimiterInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	userID, ok := ctx.Value(userIDContextKey).(int32)
+	if !ok {
+		return handler(ctx, request)
+	}
+
+	limiters := []*userRateLimiter{in.general}
+	if isCreateMethod(serverInfo.FullMethod) {
+		limiters = append(limiters, in.create)
+	}
+	var state *rateLimitState
+	allowed := true
+	for _, limiter := range limiters {
+		if limiter.limit <= 0 {
+			continue
+		}
+		limiterState := limiter.allow(userID)
+		if !limiterState.allowed {
+			allowed = false
+		}
+		if state == nil || limiterState.remaining < state.remaining {
+			state = limiterState
+		}
+	}
+	if state == nil {
+		return handler(ctx, request)
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(
+		headerRateLimitLimit, strconv.Itoa(state.limit),
+		headerRateLimitRemaining, strconv.Itoa(state.remaining),
+		headerRateLimitReset, strconv.FormatInt(state.reset.Unix(), 10),
+	)); err != nil {
+		slog.Warn("failed to set rate limit headers", slog.String("error", err.Error()))
+	}
+	if !allowed {
+		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %s", state.reset.Format(time.RFC3339))
+	}
+	return handler(ctx, request)
+}
+
+// isCreateMethod returns whether the full method name, e.g. "/slash.api.v1.ShortcutService/CreateShortcut", creates a resource.
+func isCreateMethod(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	return strings.HasPrefix(method, "Create")
+}
+
+// isRateLimitHeader returns whether the header is one of the X-RateLimit-* headers.
+func isRateLimitHeader(key string) bool {
+	key = strings.ToLower(key)
+	return key == headerRateLimitLimit || key == headerRateLimitRemaining || key == headerRateLimitReset
+}
+
+// userRateLimiter counts the requests of each user in fixed one-minute windows.
+type userRateLimiter struct {
+	limit int
+
+	mu      sync.Mutex
+	windows map[int32]*userRateLimitWindow
+	now     func() time.Time
+}
+
+type userRateLimitWindow struct {
+	start time.Time
+	count int
+}
+
+type rateLimitState struct {
+	allowed   bool
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+func newUserRateLimiter(limit int) *userRateLimiter {
+	return &userRateLimiter{
+		limit:   limit,
+		windows: map[int32]*userRateLimitWindow{},
+		now:     time.Now,
+	}
+}
+
+// allow records a request of the user and returns the state of its window.
+func (l *userRateLimiter) allow(userID int32) *rateLimitState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	window, ok := l.windows[userID]
+	if !ok || now.Sub(window.start) >= rateLimitWindow {
+		l.evictExpired(now)
+		window = &userRateLimitWindow{start: now}
+		l.windows[userID] = window
+	}
+	state := &rateLimitState{
+		allowed: window.count < l.limit,
+		limit:   l.limit,
+		reset:   window.start.Add(rateLimitWindow),
+	}
+	if state.allowed {
+		window.count++
+	}
+	state.remaining = l.limit - window.count
+	return state
+}
+
+func (l *userRateLimiter) evictExpired(now time.Time) {
+	for userID, window := range l.windows {
+		if now.Sub(window.start) >= rateLimitWindow {
+			delete(l.windows, userID)
+		}
+	}
+}
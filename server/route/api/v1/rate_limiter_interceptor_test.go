@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimiterInterceptorHeaders(t *testing.T) {
+	in := NewRateLimiterInterceptor(5, 2)
+	now := time.Unix(1700000000, 0)
+	in.general.now = func() time.Time { return now }
+	in.create.now = func() time.Time { return now }
+	handler := func(context.Context, any) (any, error) {
+		return nil, nil
+	}
+	call := func(userID int32, method string) (metadata.MD, error) {
+		stream := &testingServerTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(context.WithValue(context.Background(), userIDContextKey, userID), stream)
+		_, err := in.RateLimiterInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return stream.header, err
+	}
+	const getMethod = "/slash.api.v1.ShortcutService/GetShortcut"
+	const createMethod = "/slash.api.v1.ShortcutService/CreateShortcut"
+	reset := strconv.FormatInt(now.Add(time.Minute).Unix(), 10)
+
+	header, err := call(1, getMethod)
+	require.NoError(t, err)
+	require.Equal(t, []string{"5"}, header.Get(headerRateLimitLimit))
+	require.Equal(t, []string{"4"}, header.Get(headerRateLimitRemaining))
+	require.Equal(t, []string{reset}, header.Get(headerRateLimitReset))
+
+	// The create limiter is more restrictive, so its state is returned.
+	header, err = call(1, createMethod)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2"}, header.Get(headerRateLimitLimit))
+	require.Equal(t, []string{"1"}, header.Get(headerRateLimitRemaining))
+	_, err = call(1, createMethod)
+	require.NoError(t, err)
+	header, err = call(1, createMethod)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, []string{"0"}, header.Get(headerRateLimitRemaining))
+
+	header, err = call(1, getMethod)
+	require.NoError(t, err)
+	require.Equal(t, []string{"5"}, header.Get(headerRateLimitLimit))
+	require.Equal(t, []string{"0"}, header.Get(headerRateLimitRemaining))
+	_, err = call(1, getMethod)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Users are limited separately.
+	header, err = call(2, getMethod)
+	require.NoError(t, err)
+	require.Equal(t, []string{"4"}, header.Get(headerRateLimitRemaining))
+
+	// The quota is restored when the window resets.
+	now = now.Add(time.Minute)
+	header, err = call(1, getMethod)
+	require.NoError(t, err)
+	require.Equal(t, []string{"4"}, header.Get(headerRateLimitRemaining))
+}
+
+func TestRateLimiterInterceptorSkipsAnonymousAndUnlimited(t *testing.T) {
+	in := NewRateLimiterInterceptor(0, 1)
+	handler := func(context.Context, any) (any, error) {
+		return nil, nil
+	}
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "/slash.api.v1.ShortcutService/GetShortcut"}
+
+	stream := &testingServerTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.WithValue(context.Background(), userIDContextKey, int32(1)), stream)
+	for i := 0; i < 3; i++ {
+		_, err := in.RateLimiterInterceptor(ctx, nil, serverInfo, handler)
+		require.NoError(t, err)
+	}
+	require.Empty(t, stream.header)
+
+	serverInfo.FullMethod = "/slash.api.v1.ShortcutService/CreateShortcut"
+	for i := 0; i < 3; i++ {
+		_, err := in.RateLimiterInterceptor(context.Background(), nil, serverInfo, handler)
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,186 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// sessionTokens is the access token and refresh token of a session, and the access token of the user storing them.
+type sessionTokens struct {
+	accessToken            string
+	accessTokenExpireTime  time.Time
+	refreshToken           string
+	refreshTokenExpireTime time.Time
+	userAccessToken        *storepb.UserSetting_AccessTokensSetting_AccessToken
+}
+
+// generateSessionTokens generates the tokens of a session of the refresh token family.
+// Only the hash of the refresh token is stored, so that the stored tokens can't be used to refresh the session.
+func (s *APIV1Service) generateSessionTokens(user *store.User, accessTokenExpireTime time.Time, familyID string) (*sessionTokens, error) {
+	accessToken, err := GenerateAccessToken(user.Email, user.ID, accessTokenExpireTime, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
+	}
+	refreshTokenExpireTime := time.Now().Add(RefreshTokenDuration)
+	refreshToken, err := GenerateRefreshToken(user.ID, familyID, refreshTokenExpireTime, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate refresh token: %v", err)
+	}
+	return &sessionTokens{
+		accessToken:            accessToken,
+		accessTokenExpireTime:  accessTokenExpireTime,
+		refreshToken:           refreshToken,
+		refreshTokenExpireTime: refreshTokenExpireTime,
+		userAccessToken: &storepb.UserSetting_AccessTokensSetting_AccessToken{
+			AccessToken:           accessToken,
+			Description:           sessionAccessTokenDescription,
+			RefreshTokenHash:      hashToken(refreshToken),
+			RefreshTokenFamilyId:  familyID,
+			RefreshTokenExpiresTs: refreshTokenExpireTime.Unix(),
+			CreatedTs:             time.Now().Unix(),
+		},
+	}, nil
+}
+
+func setSessionCookies(ctx context.Context, tokens *sessionTokens) error {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(
+		"Set-Cookie", buildAccessTokenCookie(ctx, tokens.accessToken, tokens.accessTokenExpireTime),
+		"Set-Cookie", buildRefreshTokenCookie(ctx, tokens.refreshToken, tokens.refreshTokenExpireTime),
+	)); err != nil {
+		return status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
+	}
+	return nil
+}
+
+// isSessionAlive returns whether the session can still be used, with its access token or by refreshing it.
+func isSessionAlive(userAccessToken *storepb.UserSetting_AccessTokensSetting_AccessToken, secret string) bool {
+	if userAccessToken.RefreshTokenExpiresTs > time.Now().Unix() {
+		return true
+	}
+	_, err := parseAccessToken(userAccessToken.AccessToken, secret)
+	return err == nil
+}
+
+// RefreshToken rotates the refresh token of a session: the session gets a new access token and refresh token, and the
+// refresh token of the request can't be used anymore.
+// A refresh token of the session used again, e.g. by whoever stole it, signs out the whole session.
+func (s *APIV1Service) RefreshToken(ctx context.Context, request *v1pb.RefreshTokenRequest) (*v1pb.RefreshTokenResponse, error) {
+	refreshToken := request.RefreshToken
+	if refreshToken == "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		refreshToken = getCookieFromMetadata(md, RefreshTokenCookieName)
+	}
+	if refreshToken == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token not found")
+	}
+	claims, err := parseRefreshToken(refreshToken, s.Secret)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid or expired refresh token")
+	}
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "malformed ID %q in the refresh token", claims.Subject)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, err: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user ID %q not exists or has been deactivated", userID)
+	}
+
+	// The session is looked up and rotated at once, so that the concurrent uses of the same refresh token can't both
+	// rotate it, and the access tokens written meanwhile aren't lost.
+	var tokens *sessionTokens
+	reused := false
+	if err := s.Store.UpdateUserAccessTokens(ctx, user.ID, func(userAccessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) ([]*storepb.UserSetting_AccessTokensSetting_AccessToken, error) {
+		index := -1
+		for i, userAccessToken := range userAccessTokens {
+			if userAccessToken.RefreshTokenFamilyId == claims.FamilyID {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, status.Errorf(codes.Unauthenticated, "refresh token is revoked")
+		}
+		// The refresh tokens of the family are signed by the server, one that isn't the latest was already rotated.
+		if userAccessTokens[index].RefreshTokenHash != hashToken(refreshToken) {
+			reused = true
+			return slices.Delete(userAccessTokens, index, index+1), nil
+		}
+
+		var err error
+		tokens, err = s.generateSessionTokens(user, time.Now().Add(AccessTokenDuration), claims.FamilyID)
+		if err != nil {
+			return nil, err
+		}
+		// The session keeps its creation and last used times across rotations.
+		tokens.userAccessToken.CreatedTs = userAccessTokens[index].CreatedTs
+		tokens.userAccessToken.LastUsedTs = userAccessTokens[index].LastUsedTs
+		userAccessTokens[index] = tokens.userAccessToken
+		return userAccessTokens, nil
+	}); err != nil {
+		return nil, toAccessTokensError(err)
+	}
+	if reused {
+		slog.Warn("rotated refresh token reused, signing out the session", slog.Int("user_id", int(user.ID)))
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token is already used, the session is signed out")
+	}
+	if err := setSessionCookies(ctx, tokens); err != nil {
+		return nil, err
+	}
+	return &v1pb.RefreshTokenResponse{
+		AccessToken:            tokens.accessToken,
+		AccessTokenExpireTime:  timestamppb.New(tokens.accessTokenExpireTime),
+		RefreshToken:           tokens.refreshToken,
+		RefreshTokenExpireTime: timestamppb.New(tokens.refreshTokenExpireTime),
+	}, nil
+}
+
+// removeUserAccessTokens removes the access tokens of the user matching remove, serialized with the other writes of
+// the access tokens of the user. It returns the removed access tokens.
+func (s *APIV1Service) removeUserAccessTokens(ctx context.Context, user *store.User, remove func(*storepb.UserSetting_AccessTokensSetting_AccessToken) bool) ([]*storepb.UserSetting_AccessTokensSetting_AccessToken, error) {
+	removed := []*storepb.UserSetting_AccessTokensSetting_AccessToken{}
+	if err := s.Store.UpdateUserAccessTokens(ctx, user.ID, func(userAccessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) ([]*storepb.UserSetting_AccessTokensSetting_AccessToken, error) {
+		updatedUserAccessTokens := []*storepb.UserSetting_AccessTokensSetting_AccessToken{}
+		for _, userAccessToken := range userAccessTokens {
+			if remove(userAccessToken) {
+				removed = append(removed, userAccessToken)
+				continue
+			}
+			updatedUserAccessTokens = append(updatedUserAccessTokens, userAccessToken)
+		}
+		if len(removed) == 0 {
+			return nil, nil
+		}
+		return updatedUserAccessTokens, nil
+	}); err != nil {
+		return nil, toAccessTokensError(err)
+	}
+	return removed, nil
+}
+
+// toAccessTokensError returns the status errors of the access token updates as they are, and the store errors as
+// internal errors.
+func toAccessTokensError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.Internal, "failed to update access tokens: %v", err)
+}
@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	getCookies := func(stream *testingServerTransportStream) map[string]string {
+		cookies := map[string]string{}
+		for _, cookie := range (&http.Response{Header: http.Header{"Set-Cookie": stream.header.Get("Set-Cookie")}}).Cookies() {
+			cookies[cookie.Name] = cookie.Value
+		}
+		return cookies
+	}
+	refresh := func(refreshToken string) (*v1pb.RefreshTokenResponse, error) {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		return s.RefreshToken(ctx, &v1pb.RefreshTokenRequest{RefreshToken: refreshToken})
+	}
+	authenticate := func(accessToken string) error {
+		_, err := NewGRPCAuthInterceptor(s.Store, s.Secret).authenticate(ctx, accessToken)
+		return err
+	}
+
+	stream := &testingServerTransportStream{}
+	_, err = s.SignIn(grpc.NewContextWithServerTransportStream(ctx, stream), &v1pb.SignInRequest{Email: user.Email, Password: "password"})
+	require.NoError(t, err)
+	cookies := getCookies(stream)
+	refreshToken := cookies[RefreshTokenCookieName]
+	require.NotEmpty(t, refreshToken)
+	// Only the hash of the refresh token is stored.
+	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, userAccessTokens, 1)
+	require.Equal(t, hashToken(refreshToken), userAccessTokens[0].RefreshTokenHash)
+	require.NotContains(t, userAccessTokens[0].String(), refreshToken)
+
+	_, err = refresh("")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = refresh(cookies[AccessTokenCookieName])
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// The refresh token of the cookie is rotated.
+	stream = &testingServerTransportStream{}
+	cookieCtx := metadata.NewIncomingContext(grpc.NewContextWithServerTransportStream(ctx, stream), metadata.Pairs("cookie", RefreshTokenCookieName+"="+refreshToken))
+	response, err := s.RefreshToken(cookieCtx, &v1pb.RefreshTokenRequest{})
+	require.NoError(t, err)
+	require.NotEqual(t, refreshToken, response.RefreshToken)
+	require.Equal(t, response.RefreshToken, getCookies(stream)[RefreshTokenCookieName])
+	require.NoError(t, authenticate(response.AccessToken))
+	response, err = refresh(response.RefreshToken)
+	require.NoError(t, err)
+	require.NoError(t, authenticate(response.AccessToken))
+	userAccessTokens, err = s.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, userAccessTokens, 1)
+
+	// Reusing a rotated refresh token signs out the session, the latest tokens included.
+	_, err = refresh(refreshToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, codes.Unauthenticated, status.Code(authenticate(response.AccessToken)))
+	_, err = refresh(response.RefreshToken)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	userAccessTokens, err = s.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, userAccessTokens)
+}
+
+func TestRefreshTokenConcurrent(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	stream := &testingServerTransportStream{}
+	_, err = s.SignIn(grpc.NewContextWithServerTransportStream(ctx, stream), &v1pb.SignInRequest{Email: user.Email, Password: "password"})
+	require.NoError(t, err)
+	var refreshToken string
+	for _, cookie := range (&http.Response{Header: http.Header{"Set-Cookie": stream.header.Get("Set-Cookie")}}).Cookies() {
+		if cookie.Name == RefreshTokenCookieName {
+			refreshToken = cookie.Value
+		}
+	}
+	require.NotEmpty(t, refreshToken)
+	claims, err := parseRefreshToken(refreshToken, s.Secret)
+	require.NoError(t, err)
+
+	// The same refresh token used concurrently is rotated only once, and the access tokens added meanwhile are kept.
+	const n = 5
+	errs := make([]error, n)
+	wg := sync.WaitGroup{}
+	for i := 0; i < n; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.RefreshToken(grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{}), &v1pb.RefreshTokenRequest{RefreshToken: refreshToken})
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			require.NoError(t, s.Store.UpsertUserAccessTokens(ctx, user.ID, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+				AccessToken: fmt.Sprintf("token-%d", i),
+			}))
+		}(i)
+	}
+	wg.Wait()
+	rotated := 0
+	for _, err := range errs {
+		if err == nil {
+			rotated++
+			continue
+		}
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+	require.Equal(t, 1, rotated)
+
+	// The reuses of the rotated refresh token signed out the session.
+	accessTokens := []string{}
+	updatedUserAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	for _, userAccessToken := range updatedUserAccessTokens {
+		require.NotEqual(t, claims.FamilyID, userAccessToken.RefreshTokenFamilyId)
+		accessTokens = append(accessTokens, userAccessToken.AccessToken)
+	}
+	for i := 0; i < n; i++ {
+		require.Contains(t, accessTokens, fmt.Sprintf("token-%d", i))
+	}
+}
@@ -0,0 +1,379 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	"github.com/yourselfhosted/slash/plugin/gitrepo"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// RepoSyncWebhookPath is the path of the webhook endpoints of the synced repositories, by the id of their sync.
+	RepoSyncWebhookPath = "/api/v1/webhooks/repo-syncs/:id"
+	// maxRepoSyncWebhookPayloadSize bounds the push payloads read, which list at most a few dozen commits.
+	maxRepoSyncWebhookPayloadSize = 5 << 20
+	repoSyncWebhookSecretLength   = 32
+)
+
+// repoSyncWebhookResponse is the result of a webhook delivery, shown in the delivery logs of the providers.
+type repoSyncWebhookResponse struct {
+	Message   string   `json:"message"`
+	Created   []string `json:"created,omitempty"`
+	Updated   []string `json:"updated,omitempty"`
+	Deleted   []string `json:"deleted,omitempty"`
+	Unchanged []string `json:"unchanged,omitempty"`
+	// Failed is the shortcuts of the links file that couldn't be synced, with the reason.
+	Failed map[string]string `json:"failed,omitempty"`
+}
+
+func (s *APIV1Service) ListRepoSyncs(ctx context.Context, _ *v1pb.ListRepoSyncsRequest) (*v1pb.ListRepoSyncsResponse, error) {
+	repoSyncSetting, err := s.Store.GetWorkspaceRepoSyncSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace repo sync setting: %v", err)
+	}
+	repoSyncs := []*v1pb.RepoSync{}
+	for _, repoSync := range repoSyncSetting.RepoSyncs {
+		repoSyncs = append(repoSyncs, convertRepoSyncFromStorepb(repoSync))
+	}
+	return &v1pb.ListRepoSyncsResponse{RepoSyncs: repoSyncs}, nil
+}
+
+func (s *APIV1Service) CreateRepoSync(ctx context.Context, request *v1pb.CreateRepoSyncRequest) (*v1pb.RepoSync, error) {
+	if request.RepoSync == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "repo sync is required")
+	}
+	provider := convertRepoSyncProviderToStorepb(request.RepoSync.Provider)
+	if provider == storepb.WorkspaceSetting_RepoSyncSetting_RepoSync_PROVIDER_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "provider is required")
+	}
+	repository := strings.Trim(strings.TrimSpace(request.RepoSync.Repository), "/")
+	if !strings.Contains(repository, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid repository %q, expected its full name", request.RepoSync.Repository)
+	}
+	if baseURL := request.RepoSync.BaseUrl; baseURL != "" && !util.ValidateURI(baseURL) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid base url %q", baseURL)
+	}
+	syncUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.RepoSync.UserId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if syncUser == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "user not found")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	webhookSecret, err := util.RandomString(repoSyncWebhookSecretLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+	}
+
+	repoSync := &storepb.WorkspaceSetting_RepoSyncSetting_RepoSync{
+		Id:            util.GenUUID(),
+		CreatorId:     user.ID,
+		CreatedTs:     time.Now().Unix(),
+		Provider:      provider,
+		Repository:    repository,
+		BaseUrl:       strings.TrimSuffix(request.RepoSync.BaseUrl, "/"),
+		FilePath:      strings.Trim(request.RepoSync.FilePath, "/"),
+		UserId:        syncUser.ID,
+		WebhookSecret: webhookSecret,
+		AccessToken:   request.RepoSync.AccessToken,
+	}
+	repoSyncSetting, err := s.Store.GetWorkspaceRepoSyncSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace repo sync setting: %v", err)
+	}
+	repoSyncSetting.RepoSyncs = append(repoSyncSetting.RepoSyncs, repoSync)
+	if err := s.upsertRepoSyncSetting(ctx, repoSyncSetting); err != nil {
+		return nil, err
+	}
+
+	result := convertRepoSyncFromStorepb(repoSync)
+	result.WebhookSecret = webhookSecret
+	return result, nil
+}
+
+func (s *APIV1Service) DeleteRepoSync(ctx context.Context, request *v1pb.DeleteRepoSyncRequest) (*emptypb.Empty, error) {
+	repoSyncSetting, err := s.Store.GetWorkspaceRepoSyncSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace repo sync setting: %v", err)
+	}
+	repoSyncs := slices.DeleteFunc(slices.Clone(repoSyncSetting.RepoSyncs), func(repoSync *storepb.WorkspaceSetting_RepoSyncSetting_RepoSync) bool {
+		return repoSync.Id == request.Id
+	})
+	if len(repoSyncs) == len(repoSyncSetting.RepoSyncs) {
+		return nil, status.Errorf(codes.NotFound, "repo sync not found")
+	}
+	if err := s.upsertRepoSyncSetting(ctx, &storepb.WorkspaceSetting_RepoSyncSetting{
+		RepoSyncs: repoSyncs,
+	}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) upsertRepoSyncSetting(ctx context.Context, repoSyncSetting *storepb.WorkspaceSetting_RepoSyncSetting) error {
+	if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REPO_SYNC,
+		Value: &storepb.WorkspaceSetting_RepoSync{
+			RepoSync: repoSyncSetting,
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to update workspace repo sync setting: %v", err)
+	}
+	return nil
+}
+
+func (s *APIV1Service) registerRepoSyncRoutes(e *echo.Echo) {
+	e.POST(RepoSyncWebhookPath, s.handleRepoSyncWebhook)
+}
+
+// handleRepoSyncWebhook syncs the shortcuts with the links file of the repository of the sync when a push to its
+// default branch changes it. The deliveries are authenticated by the webhook secret of the sync.
+func (s *APIV1Service) handleRepoSyncWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+	repoSync, err := s.getRepoSync(ctx, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, &repoSyncWebhookResponse{Message: err.Error()})
+	}
+	if repoSync == nil {
+		return c.JSON(http.StatusNotFound, &repoSyncWebhookResponse{Message: "repo sync not found"})
+	}
+	payload, err := io.ReadAll(io.LimitReader(c.Request().Body, maxRepoSyncWebhookPayloadSize+1))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &repoSyncWebhookResponse{Message: "failed to read payload"})
+	}
+	if len(payload) > maxRepoSyncWebhookPayloadSize {
+		return c.JSON(http.StatusRequestEntityTooLarge, &repoSyncWebhookResponse{Message: "payload is too large"})
+	}
+
+	provider := convertRepoSyncProviderToGitRepo(repoSync.Provider)
+	header := c.Request().Header
+	event := header.Get(gitrepo.GitHubEventHeader)
+	verified := gitrepo.VerifyGitHubSignature(repoSync.WebhookSecret, payload, header.Get(gitrepo.GitHubSignatureHeader))
+	if provider == gitrepo.ProviderGitLab {
+		event = header.Get(gitrepo.GitLabEventHeader)
+		verified = gitrepo.VerifyGitLabToken(repoSync.WebhookSecret, header.Get(gitrepo.GitLabTokenHeader))
+	}
+	if !verified {
+		return c.JSON(http.StatusUnauthorized, &repoSyncWebhookResponse{Message: "invalid webhook signature"})
+	}
+	// Other events, e.g. the ping of new GitHub webhooks, are acknowledged.
+	if !gitrepo.IsPushEvent(provider, event) {
+		return c.JSON(http.StatusOK, &repoSyncWebhookResponse{Message: "event ignored"})
+	}
+	pushEvent, err := gitrepo.ParsePushEvent(provider, payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &repoSyncWebhookResponse{Message: err.Error()})
+	}
+	if !strings.EqualFold(pushEvent.Repository, repoSync.Repository) {
+		return c.JSON(http.StatusBadRequest, &repoSyncWebhookResponse{Message: "repository mismatch"})
+	}
+	filePath := getRepoSyncFilePath(repoSync)
+	if !pushEvent.IsDefaultBranch() || !pushEvent.ChangesFile(filePath) {
+		return c.JSON(http.StatusOK, &repoSyncWebhookResponse{Message: "links file unchanged"})
+	}
+
+	response, err := s.syncRepoShortcuts(ctx, repoSync, pushEvent.Commit)
+	if err != nil {
+		st := status.Convert(err)
+		if st.Code() == codes.Internal {
+			return c.JSON(http.StatusInternalServerError, &repoSyncWebhookResponse{Message: st.Message()})
+		}
+		return c.JSON(http.StatusUnprocessableEntity, &repoSyncWebhookResponse{Message: st.Message()})
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// getRepoSync returns the repo sync with the id, nil when it doesn't exist.
+func (s *APIV1Service) getRepoSync(ctx context.Context, id string) (*storepb.WorkspaceSetting_RepoSyncSetting_RepoSync, error) {
+	repoSyncSetting, err := s.Store.GetWorkspaceRepoSyncSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace repo sync setting")
+	}
+	for _, repoSync := range repoSyncSetting.RepoSyncs {
+		if id != "" && repoSync.Id == id {
+			return repoSync, nil
+		}
+	}
+	return nil, nil
+}
+
+// syncRepoShortcuts creates and updates the shortcuts of the user of the sync to match the links file at the commit,
+// and deletes the shortcuts it created that are no longer in the file. A missing links file deletes all of them.
+// The shortcuts that fail to sync, e.g. because another user has their name, are reported and skipped.
+func (s *APIV1Service) syncRepoShortcuts(ctx context.Context, repoSync *storepb.WorkspaceSetting_RepoSyncSetting_RepoSync, commit string) (*repoSyncWebhookResponse, error) {
+	s.repoSyncMutex.Lock()
+	defer s.repoSyncMutex.Unlock()
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &repoSync.UserId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "the user of the repo sync is not active")
+	}
+	var fetcher gitrepo.FileFetcher = s.RepoFileFetcher
+	if fetcher == nil {
+		fetcher = gitrepo.NewHTTPFetcher(gitrepo.DefaultFetchTimeout, gitrepo.DefaultMaxFileSize)
+	}
+	linksFile := &gitrepo.LinksFile{}
+	content, err := fetcher.FetchFile(ctx, &gitrepo.Repository{
+		Provider:    convertRepoSyncProviderToGitRepo(repoSync.Provider),
+		Name:        repoSync.Repository,
+		BaseURL:     repoSync.BaseUrl,
+		AccessToken: repoSync.AccessToken,
+	}, commit, getRepoSyncFilePath(repoSync))
+	if err != nil && !errors.Is(err, gitrepo.ErrFileNotFound) {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to fetch links file: %v", err)
+	}
+	if err == nil {
+		if linksFile, err = gitrepo.ParseLinksFile(content); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid links file: %v", err)
+		}
+	}
+
+	userCtx := context.WithValue(ctx, userIDContextKey, user.ID)
+	response := &repoSyncWebhookResponse{Message: "links file synced", Failed: map[string]string{}}
+	shortcutNames := []string{}
+	for _, link := range linksFile.Shortcuts {
+		applied, err := s.ApplyShortcut(userCtx, &v1pb.ApplyShortcutRequest{
+			Shortcut: &v1pb.Shortcut{
+				Name:        link.Name,
+				Link:        link.Link,
+				Title:       link.Title,
+				Description: link.Description,
+				Tags:        link.Tags,
+				Visibility:  v1pb.Visibility(v1pb.Visibility_value[strings.ToUpper(link.Visibility)]),
+			},
+		})
+		if err != nil {
+			response.Failed[link.Name] = status.Convert(err).Message()
+			// A shortcut synced before is kept until it is removed from the links file.
+			if slices.Contains(repoSync.ShortcutNames, link.Name) {
+				shortcutNames = append(shortcutNames, link.Name)
+			}
+			continue
+		}
+		shortcutNames = append(shortcutNames, link.Name)
+		switch applied.Action {
+		case v1pb.ApplyShortcutResponse_CREATED:
+			response.Created = append(response.Created, link.Name)
+		case v1pb.ApplyShortcutResponse_UPDATED:
+			response.Updated = append(response.Updated, link.Name)
+		default:
+			response.Unchanged = append(response.Unchanged, link.Name)
+		}
+	}
+	for _, name := range repoSync.ShortcutNames {
+		if slices.Contains(shortcutNames, name) {
+			continue
+		}
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+		}
+		// The shortcuts renamed, deleted or given away since the last sync are left alone.
+		if shortcut == nil || shortcut.CreatorId != user.ID {
+			continue
+		}
+		if _, err := s.DeleteShortcut(userCtx, &v1pb.DeleteShortcutRequest{Id: shortcut.Id}); err != nil {
+			response.Failed[name] = status.Convert(err).Message()
+			shortcutNames = append(shortcutNames, name)
+			continue
+		}
+		response.Deleted = append(response.Deleted, name)
+	}
+
+	// Store the synced names on the latest setting, the other syncs may have changed since it was read.
+	repoSyncSetting, err := s.Store.GetWorkspaceRepoSyncSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace repo sync setting: %v", err)
+	}
+	for _, storedRepoSync := range repoSyncSetting.RepoSyncs {
+		if storedRepoSync.Id == repoSync.Id {
+			storedRepoSync.ShortcutNames = shortcutNames
+		}
+	}
+	if err := s.upsertRepoSyncSetting(ctx, repoSyncSetting); err != nil {
+		return nil, err
+	}
+	if len(response.Failed) != 0 {
+		slog.Warn("failed to sync shortcuts of repository", slog.String("repository", repoSync.Repository), slog.Any("failed", response.Failed))
+	}
+	return response, nil
+}
+
+func getRepoSyncFilePath(repoSync *storepb.WorkspaceSetting_RepoSyncSetting_RepoSync) string {
+	if repoSync.FilePath == "" {
+		return gitrepo.DefaultLinksFilePath
+	}
+	return repoSync.FilePath
+}
+
+func convertRepoSyncFromStorepb(repoSync *storepb.WorkspaceSetting_RepoSyncSetting_RepoSync) *v1pb.RepoSync {
+	return &v1pb.RepoSync{
+		Id:          repoSync.Id,
+		CreatorId:   repoSync.CreatorId,
+		CreateTime:  timestamppb.New(time.Unix(repoSync.CreatedTs, 0)),
+		Provider:    convertRepoSyncProviderFromStorepb(repoSync.Provider),
+		Repository:  repoSync.Repository,
+		BaseUrl:     repoSync.BaseUrl,
+		FilePath:    getRepoSyncFilePath(repoSync),
+		UserId:      repoSync.UserId,
+		WebhookPath: strings.Replace(RepoSyncWebhookPath, ":id", repoSync.Id, 1),
+	}
+}
+
+func convertRepoSyncProviderFromStorepb(provider storepb.WorkspaceSetting_RepoSyncSetting_RepoSync_Provider) v1pb.RepoSync_Provider {
+	switch provider {
+	case storepb.WorkspaceSetting_RepoSyncSetting_RepoSync_GITHUB:
+		return v1pb.RepoSync_GITHUB
+	case storepb.WorkspaceSetting_RepoSyncSetting_RepoSync_GITLAB:
+		return v1pb.RepoSync_GITLAB
+	default:
+		return v1pb.RepoSync_PROVIDER_UNSPECIFIED
+	}
+}
+
+func convertRepoSyncProviderToStorepb(provider v1pb.RepoSync_Provider) storepb.WorkspaceSetting_RepoSyncSetting_RepoSync_Provider {
+	switch provider {
+	case v1pb.RepoSync_GITHUB:
+		return storepb.WorkspaceSetting_RepoSyncSetting_RepoSync_GITHUB
+	case v1pb.RepoSync_GITLAB:
+		return storepb.WorkspaceSetting_RepoSyncSetting_RepoSync_GITLAB
+	default:
+		return storepb.WorkspaceSetting_RepoSyncSetting_RepoSync_PROVIDER_UNSPECIFIED
+	}
+}
+
+func convertRepoSyncProviderToGitRepo(provider storepb.WorkspaceSetting_RepoSyncSetting_RepoSync_Provider) gitrepo.Provider {
+	if provider == storepb.WorkspaceSetting_RepoSyncSetting_RepoSync_GITLAB {
+		return gitrepo.ProviderGitLab
+	}
+	return gitrepo.ProviderGitHub
+}
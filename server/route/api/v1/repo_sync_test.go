@@ -0,0 +1,216 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yourselfhosted/slash/plugin/gitrepo"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func serveRepoSyncWebhook(s *APIV1Service, path string, header http.Header, payload []byte) *httptest.ResponseRecorder {
+	e := echo.New()
+	s.registerRepoSyncRoutes(e)
+	request := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+	request.Header = header
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func getRepoSyncWebhookResponse(t *testing.T, recorder *httptest.ResponseRecorder) *repoSyncWebhookResponse {
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	response := &repoSyncWebhookResponse{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), response))
+	return response
+}
+
+func TestRepoSyncWebhook(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	devops, _ := createTestingUser(ctx, t, s, "devops", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	_, err := s.CreateShortcut(withUser(ctx, other), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "taken", Link: "https://other.example.com"},
+	})
+	require.NoError(t, err)
+
+	// The links files of the repositories by commit, a missing commit is a missing file.
+	linksFiles := map[string]string{
+		"commit-1": `
+shortcuts:
+  - name: docs
+    link: https://docs.example.com
+    title: Docs
+    tags: [docs]
+    visibility: public
+  - name: wiki
+    link: https://wiki.example.com
+`,
+		"commit-2": `
+shortcuts:
+  - name: docs
+    link: https://docs.example.com/v2
+    title: Docs
+    tags: [docs]
+    visibility: public
+  - name: blog
+    link: https://blog.example.com
+  - name: taken
+    link: https://taken.example.com
+`,
+		"commit-4": `
+shortcuts:
+  - name: handbook
+    link: https://handbook.example.com
+`,
+	}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ref := r.URL.Query().Get("ref")
+		if r.URL.EscapedPath() != "/repos/acme/links/contents/links.yaml" && r.URL.EscapedPath() != "/api/v4/projects/acme%2Fhandbook/repository/files/links.yaml/raw" {
+			ref = ""
+		}
+		content, ok := linksFiles[ref]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(content))
+	}))
+	defer api.Close()
+
+	repoSync, err := s.CreateRepoSync(withUser(ctx, admin), &v1pb.CreateRepoSyncRequest{
+		RepoSync: &v1pb.RepoSync{
+			Provider:   v1pb.RepoSync_GITHUB,
+			Repository: "acme/links",
+			BaseUrl:    api.URL,
+			UserId:     devops.ID,
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, repoSync.WebhookSecret)
+	require.Equal(t, "links.yaml", repoSync.FilePath)
+	require.Equal(t, "/api/v1/webhooks/repo-syncs/"+repoSync.Id, repoSync.WebhookPath)
+	// The secrets are only returned on creation.
+	repoSyncs, err := s.ListRepoSyncs(withUser(ctx, admin), &v1pb.ListRepoSyncsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(repoSyncs.RepoSyncs))
+	require.Empty(t, repoSyncs.RepoSyncs[0].WebhookSecret)
+
+	push := func(secret, event, ref, commit string, changedFiles ...string) *httptest.ResponseRecorder {
+		payload, err := json.Marshal(map[string]any{
+			"ref":        ref,
+			"after":      commit,
+			"repository": map[string]any{"full_name": "acme/links", "default_branch": "main"},
+			"commits":    []map[string]any{{"added": []string{}, "modified": changedFiles, "removed": []string{}}},
+		})
+		require.NoError(t, err)
+		header := http.Header{}
+		header.Set(gitrepo.GitHubEventHeader, event)
+		header.Set(gitrepo.GitHubSignatureHeader, gitrepo.SignGitHubPayload(secret, payload))
+		return serveRepoSyncWebhook(s, repoSync.WebhookPath, header, payload)
+	}
+	getShortcut := func(name string) *storepb.Shortcut {
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{Name: &name, IncludeArchived: true})
+		require.NoError(t, err)
+		return shortcut
+	}
+
+	// The deliveries must be signed with the webhook secret.
+	require.Equal(t, http.StatusUnauthorized, push("wrong", "push", "refs/heads/main", "commit-1", "links.yaml").Code)
+	require.Equal(t, "event ignored", getRepoSyncWebhookResponse(t, push(repoSync.WebhookSecret, "ping", "", "")).Message)
+	require.Equal(t, http.StatusNotFound, serveRepoSyncWebhook(s, "/api/v1/webhooks/repo-syncs/unknown", http.Header{}, nil).Code)
+
+	// The pushes to other branches or without changes of the links file are ignored.
+	require.Equal(t, "links file unchanged", getRepoSyncWebhookResponse(t, push(repoSync.WebhookSecret, "push", "refs/heads/dev", "commit-1", "links.yaml")).Message)
+	require.Equal(t, "links file unchanged", getRepoSyncWebhookResponse(t, push(repoSync.WebhookSecret, "push", "refs/heads/main", "commit-1", "README.md")).Message)
+	require.Nil(t, getShortcut("docs"))
+
+	response := getRepoSyncWebhookResponse(t, push(repoSync.WebhookSecret, "push", "refs/heads/main", "commit-1", "links.yaml"))
+	require.Equal(t, []string{"docs", "wiki"}, response.Created)
+	docs := getShortcut("docs")
+	require.Equal(t, devops.ID, docs.CreatorId)
+	require.Equal(t, "https://docs.example.com", docs.Link)
+	require.Equal(t, storepb.Visibility_PUBLIC, docs.Visibility)
+	require.Equal(t, []string{"docs"}, docs.Tags)
+
+	// The shortcuts follow the links file, except the ones of other users.
+	response = getRepoSyncWebhookResponse(t, push(repoSync.WebhookSecret, "push", "refs/heads/main", "commit-2", "links.yaml"))
+	require.Equal(t, []string{"blog"}, response.Created)
+	require.Equal(t, []string{"docs"}, response.Updated)
+	require.Equal(t, []string{"wiki"}, response.Deleted)
+	require.Contains(t, response.Failed, "taken")
+	require.Equal(t, "https://docs.example.com/v2", getShortcut("docs").Link)
+	require.Equal(t, storepb.RowStatus_ARCHIVED, getShortcut("wiki").RowStatus)
+	require.Equal(t, other.ID, getShortcut("taken").CreatorId)
+	require.Equal(t, "https://other.example.com", getShortcut("taken").Link)
+
+	// Removing the links file deletes the synced shortcuts.
+	response = getRepoSyncWebhookResponse(t, push(repoSync.WebhookSecret, "push", "refs/heads/main", "commit-3", "links.yaml"))
+	require.Equal(t, []string{"docs", "blog"}, response.Deleted)
+	require.Equal(t, storepb.RowStatus_ARCHIVED, getShortcut("docs").RowStatus)
+	require.Equal(t, storepb.RowStatus_NORMAL, getShortcut("taken").RowStatus)
+
+	// GitLab webhooks are authenticated by their secret token.
+	gitlabSync, err := s.CreateRepoSync(withUser(ctx, admin), &v1pb.CreateRepoSyncRequest{
+		RepoSync: &v1pb.RepoSync{
+			Provider:   v1pb.RepoSync_GITLAB,
+			Repository: "acme/handbook",
+			BaseUrl:    api.URL,
+			UserId:     devops.ID,
+		},
+	})
+	require.NoError(t, err)
+	payload := []byte(`{
+		"ref": "refs/heads/main",
+		"after": "commit-4",
+		"checkout_sha": "commit-4",
+		"total_commits_count": 1,
+		"project": {"path_with_namespace": "acme/handbook", "default_branch": "main"},
+		"commits": [{"added": ["links.yaml"], "modified": [], "removed": []}]
+	}`)
+	header := http.Header{}
+	header.Set(gitrepo.GitLabEventHeader, "Push Hook")
+	header.Set(gitrepo.GitLabTokenHeader, repoSync.WebhookSecret)
+	require.Equal(t, http.StatusUnauthorized, serveRepoSyncWebhook(s, gitlabSync.WebhookPath, header, payload).Code)
+	header.Set(gitrepo.GitLabTokenHeader, gitlabSync.WebhookSecret)
+	response = getRepoSyncWebhookResponse(t, serveRepoSyncWebhook(s, gitlabSync.WebhookPath, header, payload))
+	require.Equal(t, []string{"handbook"}, response.Created)
+	// The pushes of another repository are rejected.
+	recorder := serveRepoSyncWebhook(s, gitlabSync.WebhookPath, header, []byte(strings.Replace(string(payload), "acme/handbook", "acme/other", 1)))
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	_, err = s.DeleteRepoSync(withUser(ctx, admin), &v1pb.DeleteRepoSyncRequest{Id: gitlabSync.Id})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, serveRepoSyncWebhook(s, gitlabSync.WebhookPath, header, payload).Code)
+	_, err = s.DeleteRepoSync(withUser(ctx, admin), &v1pb.DeleteRepoSyncRequest{Id: gitlabSync.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCreateRepoSyncValidation(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	for _, repoSync := range []*v1pb.RepoSync{
+		{Repository: "acme/links", UserId: admin.ID},
+		{Provider: v1pb.RepoSync_GITHUB, Repository: "links", UserId: admin.ID},
+		{Provider: v1pb.RepoSync_GITHUB, Repository: "acme/links", BaseUrl: "not a url", UserId: admin.ID},
+		{Provider: v1pb.RepoSync_GITHUB, Repository: "acme/links", UserId: admin.ID + 100},
+	} {
+		_, err := s.CreateRepoSync(withUser(ctx, admin), &v1pb.CreateRepoSyncRequest{RepoSync: repoSync})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), repoSync.String())
+	}
+}
@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+type redactionRule struct {
+	// ownerField is the field holding the id of the user owning the message.
+	// Owners always see the full message.
+	ownerField protoreflect.Name
+	// fields are cleared for callers who are neither admin nor owner.
+	fields []protoreflect.Name
+	// anonymousFields are additionally cleared for unauthenticated callers.
+	anonymousFields []protoreflect.Name
+}
+
+// nonAdminRedactionRules is the privacy rules applied to responses, keyed by message full name.
+var nonAdminRedactionRules = map[protoreflect.FullName]redactionRule{
+	"slash.api.v1.User": {
+		ownerField: "id",
+		fields:     []protoreflect.Name{"email"},
+	},
+	// Signed-in users need the creator id to resolve the creator and their own permissions.
+	"slash.api.v1.Shortcut": {
+		ownerField:      "creator_id",
+		anonymousFields: []protoreflect.Name{"creator_id"},
+	},
+}
+
+// ResponseRedactorInterceptor removes the private fields from responses based on the caller's role.
+type ResponseRedactorInterceptor struct {
+	Store *store.Store
+}
+
+func NewResponseRedactorInterceptor(store *store.Store) *ResponseRedactorInterceptor {
+	return &ResponseRedactorInterceptor{
+		Store: store,
+	}
+}
+
+func (in *ResponseRedactorInterceptor) ResponseRedactorInterceptor(ctx context.Context, request any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, request)
+	if err != nil {
+		return resp, err
+	}
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return resp, nil
+	}
+
+	currentUser, err := getCurrentUser(ctx, in.Store)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get current user")
+	}
+	if currentUser != nil && currentUser.Role == store.RoleAdmin {
+		return resp, nil
+	}
+	// Clone the response as it might be shared, e.g. cached.
+	message = proto.Clone(message)
+	redactMessage(message.ProtoReflect(), currentUser)
+	return message, nil
+}
+
+// redactMessage clears the private fields of the message and all of its nested messages in place.
+func redactMessage(message protoreflect.Message, viewer *store.User) {
+	descriptor := message.Descriptor()
+	if rule, ok := nonAdminRedactionRules[descriptor.FullName()]; ok {
+		ownerFieldDescriptor := descriptor.Fields().ByName(rule.ownerField)
+		isOwner := viewer != nil && ownerFieldDescriptor != nil && message.Get(ownerFieldDescriptor).Int() == int64(viewer.ID)
+		if !isOwner {
+			fields := rule.fields
+			if viewer == nil {
+				fields = slices.Concat(rule.fields, rule.anonymousFields)
+			}
+			for _, name := range fields {
+				if fieldDescriptor := descriptor.Fields().ByName(name); fieldDescriptor != nil {
+					message.Clear(fieldDescriptor)
+				}
+			}
+		}
+	}
+
+	message.Range(func(fieldDescriptor protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fieldDescriptor.Message() == nil {
+			return true
+		}
+		switch {
+		case fieldDescriptor.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message(), viewer)
+			}
+		case fieldDescriptor.IsMap():
+			if fieldDescriptor.MapValue().Message() != nil {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					redactMessage(v.Message(), viewer)
+					return true
+				})
+			}
+		default:
+			redactMessage(value.Message(), viewer)
+		}
+		return true
+	})
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestResponseRedactorInterceptor(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	in := NewResponseRedactorInterceptor(s.Store)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	shortcut, err := s.CreateShortcut(withUser(ctx, owner), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{
+			Name:       "test",
+			Link:       "https://example.com",
+			Visibility: v1pb.Visibility_PUBLIC,
+		},
+	})
+	require.NoError(t, err)
+
+	intercept := func(ctx context.Context, handler grpc.UnaryHandler) any {
+		resp, err := in.ResponseRedactorInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+		require.NoError(t, err)
+		return resp
+	}
+	getUser := func(ctx context.Context, id int32) *v1pb.User {
+		return intercept(ctx, func(ctx context.Context, _ any) (any, error) {
+			return s.GetUser(ctx, &v1pb.GetUserRequest{Id: id})
+		}).(*v1pb.User)
+	}
+	listUsers := func(ctx context.Context) map[int32]*v1pb.User {
+		resp := intercept(ctx, func(ctx context.Context, _ any) (any, error) {
+			return s.ListUsers(ctx, &v1pb.ListUsersRequest{})
+		}).(*v1pb.ListUsersResponse)
+		users := map[int32]*v1pb.User{}
+		for _, user := range resp.Users {
+			users[user.Id] = user
+		}
+		return users
+	}
+	getShortcut := func(ctx context.Context) *v1pb.Shortcut {
+		return intercept(ctx, func(ctx context.Context, _ any) (any, error) {
+			return s.GetShortcut(ctx, &v1pb.GetShortcutRequest{Id: shortcut.Id})
+		}).(*v1pb.Shortcut)
+	}
+	listShortcuts := func(ctx context.Context) []*v1pb.Shortcut {
+		return intercept(ctx, func(ctx context.Context, _ any) (any, error) {
+			return s.ListShortcuts(ctx, &v1pb.ListShortcutsRequest{})
+		}).(*v1pb.ListShortcutsResponse).Shortcuts
+	}
+
+	// Admins see full objects.
+	require.Equal(t, owner.Email, getUser(withUser(ctx, admin), owner.ID).Email)
+	for _, user := range listUsers(withUser(ctx, admin)) {
+		require.NotEmpty(t, user.Email)
+	}
+	require.Equal(t, owner.ID, getShortcut(withUser(ctx, admin)).CreatorId)
+
+	// Users see their own email only.
+	require.Equal(t, other.Email, getUser(withUser(ctx, other), other.ID).Email)
+	require.Empty(t, getUser(withUser(ctx, other), owner.ID).Email)
+	users := listUsers(withUser(ctx, other))
+	require.Equal(t, 3, len(users))
+	require.Equal(t, other.Email, users[other.ID].Email)
+	require.Empty(t, users[owner.ID].Email)
+	require.Empty(t, users[admin.ID].Email)
+	require.Equal(t, owner.Nickname, users[owner.ID].Nickname)
+	require.Equal(t, owner.ID, getShortcut(withUser(ctx, other)).CreatorId)
+
+	// Anonymous callers don't see the creator of shortcuts.
+	redactedShortcut := getShortcut(ctx)
+	require.Zero(t, redactedShortcut.CreatorId)
+	require.Equal(t, shortcut.Link, redactedShortcut.Link)
+	shortcuts := listShortcuts(withUser(ctx, owner))
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, owner.ID, shortcuts[0].CreatorId)
+}
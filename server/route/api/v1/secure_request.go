@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/yourselfhosted/slash/server/profile"
+)
+
+const (
+	// secureRequestMetadataKey is set by the gateway and the gRPC-Web proxy to tell the handlers whether the client
+	// connection is https.
+	secureRequestMetadataKey = "slash-secure-request"
+	// clientIPMetadataKey is set by the gateway and the gRPC-Web proxy to tell the handlers the IP of the client.
+	clientIPMetadataKey = "slash-client-ip"
+)
+
+// isSecureRequest returns whether the client reached the server over https, either directly
+// or through a trusted proxy that terminated TLS and set X-Forwarded-Proto.
+func isSecureRequest(profile *profile.Profile, r *http.Request) bool {
+	if r.TLS != nil {
+		return true
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !profile.IsTrustedProxy(net.ParseIP(host)) {
+		return false
+	}
+	// Proxies append their protocol, so the first value is the one of the client.
+	forwardedProto := strings.Split(r.Header.Get("X-Forwarded-Proto"), ",")[0]
+	return strings.EqualFold(strings.TrimSpace(forwardedProto), "https")
+}
+
+// newSecureRequestMetadata returns the gateway metadata telling whether the request is secure.
+func newSecureRequestMetadata(profile *profile.Profile) func(context.Context, *http.Request) metadata.MD {
+	return func(_ context.Context, r *http.Request) metadata.MD {
+		if isSecureRequest(profile, r) {
+			return metadata.Pairs(secureRequestMetadataKey, "true")
+		}
+		return nil
+	}
+}
+
+// setSecureRequestHeader sets the header of the gRPC-Web request telling whether it is secure, in place of the one of
+// the client.
+func setSecureRequestHeader(profile *profile.Profile, r *http.Request) {
+	r.Header.Del(secureRequestMetadataKey)
+	if isSecureRequest(profile, r) {
+		r.Header.Set(secureRequestMetadataKey, "true")
+	}
+}
+
+// getRequestClientIP returns the IP of the client of the request, behind the trusted proxies.
+func getRequestClientIP(profile *profile.Profile, r *http.Request) string {
+	return profile.GetClientIP(r.RemoteAddr, strings.Join(r.Header.Values("X-Forwarded-For"), ","))
+}
+
+// newClientIPMetadata returns the gateway metadata telling the IP of the client.
+func newClientIPMetadata(profile *profile.Profile) func(context.Context, *http.Request) metadata.MD {
+	return func(_ context.Context, r *http.Request) metadata.MD {
+		if ip := getRequestClientIP(profile, r); ip != "" {
+			return metadata.Pairs(clientIPMetadataKey, ip)
+		}
+		return nil
+	}
+}
+
+// setClientIPHeader sets the header of the gRPC-Web request telling the IP of the client, in place of the one of the
+// client.
+func setClientIPHeader(profile *profile.Profile, r *http.Request) {
+	r.Header.Del(clientIPMetadataKey)
+	if ip := getRequestClientIP(profile, r); ip != "" {
+		r.Header.Set(clientIPMetadataKey, ip)
+	}
+}
+
+func isSecureContext(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(secureRequestMetadataKey)
+	return len(values) > 0 && values[0] == "true"
+}
+
+// buildAccessTokenCookie returns the Set-Cookie value of the access token, an empty one expired at the epoch clears it.
+// The cookie is only sent over https when the request is secure.
+func buildAccessTokenCookie(ctx context.Context, accessToken string, expires time.Time) string {
+	return buildTokenCookie(ctx, AccessTokenCookieName, accessToken, expires)
+}
+
+// buildRefreshTokenCookie returns the Set-Cookie value of the refresh token, like buildAccessTokenCookie.
+func buildRefreshTokenCookie(ctx context.Context, refreshToken string, expires time.Time) string {
+	return buildTokenCookie(ctx, RefreshTokenCookieName, refreshToken, expires)
+}
+
+func buildTokenCookie(ctx context.Context, name, token string, expires time.Time) string {
+	cookie := fmt.Sprintf("%s=%s; Path=/; Expires=%s; HttpOnly; SameSite=Strict", name, token, expires.UTC().Format(http.TimeFormat))
+	if isSecureContext(ctx) {
+		cookie += "; Secure"
+	}
+	return cookie
+}
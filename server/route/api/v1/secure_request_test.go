@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/server/profile"
+)
+
+func TestIsSecureRequest(t *testing.T) {
+	serverProfile := &profile.Profile{TrustedProxies: []string{"10.0.0.0/8"}}
+	tests := []struct {
+		remoteAddr     string
+		forwardedProto string
+		tls            bool
+		secure         bool
+	}{
+		{remoteAddr: "10.0.0.1:1234", secure: false},
+		{remoteAddr: "10.0.0.1:1234", forwardedProto: "https", secure: true},
+		{remoteAddr: "10.0.0.1:1234", forwardedProto: "HTTPS, http", secure: true},
+		{remoteAddr: "10.0.0.1:1234", forwardedProto: "http", secure: false},
+		// The header of untrusted sources is ignored.
+		{remoteAddr: "203.0.113.7:1234", forwardedProto: "https", secure: false},
+		{remoteAddr: "203.0.113.7:1234", tls: true, secure: true},
+	}
+	for _, test := range tests {
+		request := httptest.NewRequest("POST", "/api/v1/auth/signin", nil)
+		request.RemoteAddr = test.remoteAddr
+		if test.forwardedProto != "" {
+			request.Header.Set("X-Forwarded-Proto", test.forwardedProto)
+		}
+		if test.tls {
+			request.TLS = &tls.ConnectionState{}
+		}
+		require.Equal(t, test.secure, isSecureRequest(serverProfile, request), test)
+	}
+
+	// No proxy is trusted by default.
+	request := httptest.NewRequest("POST", "/api/v1/auth/signin", nil)
+	request.RemoteAddr = "10.0.0.1:1234"
+	request.Header.Set("X-Forwarded-Proto", "https")
+	require.False(t, isSecureRequest(&profile.Profile{}, request))
+}
+
+func TestBuildAccessTokenCookie(t *testing.T) {
+	expires := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	cookie := buildAccessTokenCookie(context.Background(), "token", expires)
+	require.Equal(t, AccessTokenCookieName+"=token; Path=/; Expires=Tue, 02 Jan 2024 03:04:05 GMT; HttpOnly; SameSite=Strict", cookie)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(secureRequestMetadataKey, "true"))
+	cookie = buildAccessTokenCookie(ctx, "token", expires)
+	require.True(t, strings.HasSuffix(cookie, "; Secure"))
+}
+
+// newGRPCWebRequest returns a gRPC-Web request calling the method with the message.
+func newGRPCWebRequest(t *testing.T, method string, message proto.Message) *http.Request {
+	payload, err := proto.Marshal(message)
+	require.NoError(t, err)
+	// A single uncompressed frame of the message after its length.
+	frame := make([]byte, 5, 5+len(payload))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(payload)))
+	request := httptest.NewRequest(http.MethodPost, method, bytes.NewReader(append(frame, payload...)))
+	request.Header.Set("Content-Type", "application/grpc-web+proto")
+	return request
+}
+
+func TestGRPCWebSecureRequest(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	s.Profile.TrustedProxies = []string{"10.0.0.0/8"}
+	grpcServer := grpc.NewServer()
+	v1pb.RegisterAuthServiceServer(grpcServer, s)
+	handler := newGRPCWebHandler(s.Profile, grpcServer)
+	signOutCookies := func(remoteAddr string, header map[string]string) []string {
+		request := newGRPCWebRequest(t, "/slash.api.v1.AuthService/SignOut", &v1pb.SignOutRequest{})
+		request.RemoteAddr = remoteAddr
+		for key, value := range header {
+			request.Header.Set(key, value)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		cookies := recorder.Header().Values("Set-Cookie")
+		require.Len(t, cookies, 2)
+		return cookies
+	}
+
+	for _, cookie := range signOutCookies("10.0.0.1:1234", map[string]string{"X-Forwarded-Proto": "https"}) {
+		require.True(t, strings.HasSuffix(cookie, "; Secure"), cookie)
+	}
+	// The header telling the handlers that the request is secure can't be sent by the client.
+	for _, cookie := range signOutCookies("203.0.113.7:1234", map[string]string{"X-Forwarded-Proto": "https", secureRequestMetadataKey: "true"}) {
+		require.False(t, strings.HasSuffix(cookie, "; Secure"), cookie)
+	}
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// checkShortcutCreationCooldown returns an error when the user is still in the cooldown of new users
+// of the workspace and can't create more shortcuts: PermissionDenied when the cooldown allows none,
+// and ResourceExhausted when the user reached the quota of the cooldown with the pending shortcuts.
+func (s *APIV1Service) checkShortcutCreationCooldown(ctx context.Context, user *store.User, pending int) error {
+	if user.Role == store.RoleAdmin {
+		return nil
+	}
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace shortcut related setting: %v", err)
+	}
+	cooldown := time.Duration(shortcutRelatedSetting.NewUserShortcutCooldownHours) * time.Hour
+	remaining := getShortcutCreationCooldownRemaining(user.CreatedTs, cooldown, time.Now())
+	if remaining <= 0 {
+		return nil
+	}
+	invitation, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_INVITATION,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user invitation: %v", err)
+	}
+	if invitation != nil {
+		return nil
+	}
+
+	remaining = remaining.Round(time.Second)
+	quota := shortcutRelatedSetting.NewUserShortcutQuota
+	if quota <= 0 {
+		return status.Errorf(codes.PermissionDenied, "new accounts can't create shortcuts, retry after %s", remaining)
+	}
+	// The archived shortcuts count too, so that deleting shortcuts doesn't make room for more.
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		CreatorID:       &user.ID,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list shortcuts: %v", err)
+	}
+	if len(shortcuts)+pending >= int(quota) {
+		return status.Errorf(codes.ResourceExhausted, "new accounts can create at most %d shortcuts, retry after %s", quota, remaining)
+	}
+	return nil
+}
+
+// getShortcutCreationCooldownRemaining returns the time left in the cooldown of a user created at createdTs,
+// zero or less once it is over.
+func getShortcutCreationCooldownRemaining(createdTs int64, cooldown time.Duration, now time.Time) time.Duration {
+	if cooldown <= 0 {
+		return 0
+	}
+	return time.Unix(createdTs, 0).Add(cooldown).Sub(now)
+}
@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestShortcutCreationCooldown(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	invited, err := s.CreateUser(withUser(ctx, admin), &v1pb.CreateUserRequest{
+		User: &v1pb.User{Email: "invited@test.com", Nickname: "invited", Password: "secret"},
+	})
+	require.NoError(t, err)
+	updateSetting := func(cooldownHours, quota int32) {
+		_, err := s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				NewUserShortcutCooldownHours: cooldownHours,
+				NewUserShortcutQuota:         quota,
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"new_user_shortcut_cooldown_hours", "new_user_shortcut_quota"}},
+		})
+		require.NoError(t, err)
+	}
+	createShortcut := func(userID int32, name string) error {
+		_, err := s.CreateShortcut(context.WithValue(ctx, userIDContextKey, userID), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name},
+		})
+		return err
+	}
+
+	// New users can't create shortcuts during the cooldown, admins and invited users can.
+	updateSetting(24, 0)
+	require.Equal(t, codes.PermissionDenied, status.Code(createShortcut(user.ID, "denied")))
+	require.NoError(t, createShortcut(admin.ID, "admin"))
+	require.NoError(t, createShortcut(invited.Id, "invited"))
+
+	// Or up to the quota of the cooldown.
+	updateSetting(24, 1)
+	require.NoError(t, createShortcut(user.ID, "first"))
+	require.Equal(t, codes.ResourceExhausted, status.Code(createShortcut(user.ID, "second")))
+
+	// Without a cooldown, there is no limit.
+	updateSetting(0, 1)
+	require.NoError(t, createShortcut(user.ID, "second"))
+
+	_, err = s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{NewUserShortcutCooldownHours: -1},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"new_user_shortcut_cooldown_hours"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetShortcutCreationCooldownRemaining(t *testing.T) {
+	createdTs := int64(1700000000)
+	createdTime := time.Unix(createdTs, 0)
+	tests := []struct {
+		cooldown time.Duration
+		now      time.Time
+		want     time.Duration
+	}{
+		{time.Hour, createdTime, time.Hour},
+		{time.Hour, createdTime.Add(time.Hour - time.Second), time.Second},
+		// The cooldown is over at its boundary.
+		{time.Hour, createdTime.Add(time.Hour), 0},
+		{time.Hour, createdTime.Add(time.Hour + time.Second), -time.Second},
+		{0, createdTime, 0},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, getShortcutCreationCooldownRemaining(createdTs, test.cooldown, test.now), test.now)
+	}
+}
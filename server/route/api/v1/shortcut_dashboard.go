@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// defaultDashboardLimit is the number of shortcuts of each dashboard section when the request has no limit.
+	defaultDashboardLimit = 10
+	// maxDashboardLimit is the maximum number of shortcuts of each dashboard section.
+	maxDashboardLimit = 50
+)
+
+// GetUserShortcutDashboard returns the active shortcuts of the sections of the caller's dashboard.
+// The shortcuts the caller can't read, e.g. the favorites made private by their creator since, are left out.
+func (s *APIV1Service) GetUserShortcutDashboard(ctx context.Context, request *v1pb.GetUserShortcutDashboardRequest) (*v1pb.GetUserShortcutDashboardResponse, error) {
+	if request.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	limit := min(int(request.Limit), maxDashboardLimit)
+	if limit == 0 {
+		limit = defaultDashboardLimit
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	access, err := s.getShortcutAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	isActive := func(shortcut *storepb.Shortcut) bool {
+		return shortcut.RowStatus == storepb.RowStatus_NORMAL && !store.IsShortcutExpired(shortcut, now)
+	}
+
+	favorites := []*storepb.Shortcut{}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_FAVORITE_SHORTCUTS,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get favorite shortcuts: %v", err)
+	}
+	for _, id := range userSetting.GetFavoriteShortcuts().GetShortcutIds() {
+		if len(favorites) == limit {
+			break
+		}
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID: &id,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+		}
+		if shortcut != nil && isActive(shortcut) && access.canRead(shortcut) {
+			favorites = append(favorites, shortcut)
+		}
+	}
+
+	normalStatus := storepb.RowStatus_NORMAL
+	recentlyVisited, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus:    &normalStatus,
+		NotExpiredAt: &now,
+		OrderBy:      []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldLastViewedTs, Desc: true}},
+		VisibleTo:    access.getVisibilityFilter(),
+		Access:       access.getFilter(),
+		Limit:        &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list recently visited shortcuts: %v", err)
+	}
+	// The shortcuts never visited are ordered last.
+	for i, shortcut := range recentlyVisited {
+		if shortcut.LastViewedTs == 0 {
+			recentlyVisited = recentlyVisited[:i]
+			break
+		}
+	}
+
+	recentlyCreated, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		CreatorID:    &user.ID,
+		RowStatus:    &normalStatus,
+		NotExpiredAt: &now,
+		OrderBy:      []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldCreatedTs, Desc: true}},
+		Limit:        &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list recently created shortcuts: %v", err)
+	}
+
+	convertShortcuts := func(shortcuts []*storepb.Shortcut) ([]*v1pb.Shortcut, error) {
+		list := []*v1pb.Shortcut{}
+		for _, shortcut := range shortcuts {
+			composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+			}
+			applyShortcutView(composedShortcut, request.View)
+			list = append(list, composedShortcut)
+		}
+		return list, nil
+	}
+	response := &v1pb.GetUserShortcutDashboardResponse{}
+	if response.Favorites, err = convertShortcuts(favorites); err != nil {
+		return nil, err
+	}
+	if response.RecentlyVisited, err = convertShortcuts(recentlyVisited); err != nil {
+		return nil, err
+	}
+	if response.RecentlyCreated, err = convertShortcuts(recentlyCreated); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
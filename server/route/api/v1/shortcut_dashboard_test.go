@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestGetUserShortcutDashboard(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	alice, _ := createTestingUser(ctx, t, s, "alice", store.RoleUser)
+	bob, _ := createTestingUser(ctx, t, s, "bob", store.RoleUser)
+	aliceCtx, bobCtx := withUser(ctx, alice), withUser(ctx, bob)
+	createShortcut := func(ctx context.Context, name string, visibility v1pb.Visibility) *v1pb.Shortcut {
+		shortcut, err := s.CreateShortcut(ctx, &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Visibility: visibility},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	setFavorites := func(ctx context.Context, user *store.User, shortcutIDs ...int32) {
+		_, err := s.UpdateUserSetting(ctx, &v1pb.UpdateUserSettingRequest{
+			Id:          user.ID,
+			UserSetting: &v1pb.UserSetting{FavoriteShortcuts: &v1pb.UserSetting_FavoriteShortcutsSetting{ShortcutIds: shortcutIDs}},
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"favorite_shortcuts"}},
+		})
+		require.NoError(t, err)
+	}
+	getDashboard := func(ctx context.Context, limit int32) *v1pb.GetUserShortcutDashboardResponse {
+		response, err := s.GetUserShortcutDashboard(ctx, &v1pb.GetUserShortcutDashboardRequest{Limit: limit})
+		require.NoError(t, err)
+		return response
+	}
+	names := func(shortcuts []*v1pb.Shortcut) []string {
+		list := []string{}
+		for _, shortcut := range shortcuts {
+			list = append(list, shortcut.Name)
+		}
+		return list
+	}
+
+	docs := createShortcut(aliceCtx, "docs", v1pb.Visibility_WORKSPACE)
+	notes := createShortcut(aliceCtx, "notes", v1pb.Visibility_PRIVATE)
+	wiki := createShortcut(bobCtx, "wiki", v1pb.Visibility_WORKSPACE)
+	diary := createShortcut(bobCtx, "diary", v1pb.Visibility_PRIVATE)
+	createShortcut(bobCtx, "unvisited", v1pb.Visibility_WORKSPACE)
+	now := time.Now().Unix()
+	require.NoError(t, s.Store.AddShortcutViews(ctx, []*store.ShortcutViews{
+		{ShortcutID: docs.Id, Count: 1, LastViewedTs: now - 30},
+		{ShortcutID: notes.Id, Count: 1, LastViewedTs: now - 20},
+		{ShortcutID: wiki.Id, Count: 1, LastViewedTs: now - 10},
+		{ShortcutID: diary.Id, Count: 1, LastViewedTs: now},
+	}))
+	setFavorites(aliceCtx, alice, wiki.Id, diary.Id, docs.Id, wiki.Id)
+	setFavorites(bobCtx, bob, diary.Id)
+
+	// The private shortcuts of others are left out, even the favorite ones.
+	dashboard := getDashboard(aliceCtx, 0)
+	require.Equal(t, []string{"wiki", "docs"}, names(dashboard.Favorites))
+	require.Equal(t, []string{"wiki", "notes", "docs"}, names(dashboard.RecentlyVisited))
+	// The shortcuts created in the same second are in any order.
+	require.ElementsMatch(t, []string{"notes", "docs"}, names(dashboard.RecentlyCreated))
+	dashboard = getDashboard(bobCtx, 0)
+	require.Equal(t, []string{"diary"}, names(dashboard.Favorites))
+	require.Equal(t, []string{"diary", "wiki", "docs"}, names(dashboard.RecentlyVisited))
+	require.ElementsMatch(t, []string{"unvisited", "diary", "wiki"}, names(dashboard.RecentlyCreated))
+
+	// Each section is bounded by the limit.
+	dashboard = getDashboard(aliceCtx, 1)
+	require.Equal(t, []string{"wiki"}, names(dashboard.Favorites))
+	require.Equal(t, []string{"wiki"}, names(dashboard.RecentlyVisited))
+	require.Len(t, dashboard.RecentlyCreated, 1)
+	_, err := s.GetUserShortcutDashboard(aliceCtx, &v1pb.GetUserShortcutDashboardRequest{Limit: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The archived shortcuts are left out.
+	_, err = s.DeleteShortcut(bobCtx, &v1pb.DeleteShortcutRequest{Id: wiki.Id})
+	require.NoError(t, err)
+	dashboard = getDashboard(aliceCtx, 0)
+	require.Equal(t, []string{"docs"}, names(dashboard.Favorites))
+	require.Equal(t, []string{"notes", "docs"}, names(dashboard.RecentlyVisited))
+
+	userSetting, err := s.GetUserSetting(aliceCtx, &v1pb.GetUserSettingRequest{Id: alice.ID})
+	require.NoError(t, err)
+	require.Equal(t, []int32{wiki.Id, diary.Id, docs.Id}, userSetting.FavoriteShortcuts.ShortcutIds)
+}
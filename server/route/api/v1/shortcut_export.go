@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// shortcutExportBatchSize is the number of shortcuts read from the store at once while exporting.
+const shortcutExportBatchSize = 500
+
+// shortcutCSVHeader is the header of the CSV export, named after the fields of the CSV import.
+var shortcutCSVHeader = []string{"name", "link", "title", "description", "tags", "visibility", "view_count"}
+
+// shortcutJSONLine is a shortcut as exported in the JSON format.
+type shortcutJSONLine struct {
+	Name        string   `json:"name"`
+	Link        string   `json:"link"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	Visibility  string   `json:"visibility"`
+	ViewCount   int32    `json:"viewCount"`
+}
+
+func (s *APIV1Service) ExportShortcuts(request *v1pb.ExportShortcutsRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	ctx := stream.Context()
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	format := request.Format
+	if format == v1pb.ExportShortcutsRequest_FORMAT_UNSPECIFIED {
+		format = v1pb.ExportShortcutsRequest_CSV
+	}
+	contentType := "text/csv"
+	if format == v1pb.ExportShortcutsRequest_JSON {
+		contentType = "application/x-ndjson"
+	}
+	send := func(data []byte) error {
+		return stream.Send(&httpbody.HttpBody{ContentType: contentType, Data: data})
+	}
+
+	if format == v1pb.ExportShortcutsRequest_CSV {
+		line, err := formatCSVLine(shortcutCSVHeader)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to format shortcut: %v", err)
+		}
+		if err := send(line); err != nil {
+			return err
+		}
+	}
+
+	limit := shortcutExportBatchSize
+	find := &store.FindShortcut{
+		OrderBy: []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldName}},
+		Limit:   &limit,
+	}
+	if user.Role != store.RoleAdmin {
+		find.CreatorID = &user.ID
+	}
+	for {
+		shortcuts, err := s.Store.ListShortcuts(ctx, find)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list shortcuts: %v", err)
+		}
+		for _, shortcut := range shortcuts {
+			line, err := formatShortcutExport(shortcut, format)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to format shortcut: %v", err)
+			}
+			if err := send(line); err != nil {
+				return err
+			}
+		}
+		if len(shortcuts) < shortcutExportBatchSize {
+			return nil
+		}
+		last := shortcuts[len(shortcuts)-1]
+		find.Cursor = &store.ShortcutCursor{
+			ID:     last.Id,
+			Values: []any{last.Name},
+		}
+	}
+}
+
+// formatShortcutExport returns the shortcut as a line of the format without the trailing newline.
+func formatShortcutExport(shortcut *storepb.Shortcut, format v1pb.ExportShortcutsRequest_Format) ([]byte, error) {
+	visibility := convertVisibilityFromStorepb(shortcut.Visibility).String()
+	if format == v1pb.ExportShortcutsRequest_JSON {
+		tags := shortcut.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		// The links are kept as they are instead of escaping their "&" for HTML.
+		buffer := &bytes.Buffer{}
+		encoder := json.NewEncoder(buffer)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(&shortcutJSONLine{
+			Name:        shortcut.Name,
+			Link:        shortcut.Link,
+			Title:       shortcut.Title,
+			Description: shortcut.Description,
+			Tags:        tags,
+			Visibility:  visibility,
+			ViewCount:   shortcut.ViewCount,
+		}); err != nil {
+			return nil, err
+		}
+		return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+	}
+	return formatCSVLine([]string{
+		shortcut.Name,
+		shortcut.Link,
+		shortcut.Title,
+		shortcut.Description,
+		strings.Join(shortcut.Tags, " "),
+		visibility,
+		strconv.Itoa(int(shortcut.ViewCount)),
+	})
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestExportShortcuts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	shortcut, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:   user.ID,
+		Name:        "docs",
+		Link:        "https://example.com/docs?a=1&b=2",
+		Title:       "Docs, \"quoted\"",
+		Description: "The docs",
+		Tags:        []string{"docs", "team"},
+		Visibility:  storepb.Visibility_PUBLIC,
+		OgMetadata:  &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Store.AddShortcutViews(ctx, []*store.ShortcutViews{{ShortcutID: shortcut.Id, Count: 3, LastViewedTs: 1}}))
+	for i := 0; i < shortcutExportBatchSize; i++ {
+		_, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  admin.ID,
+			Name:       fmt.Sprintf("admin-%03d", i),
+			Link:       "https://example.com",
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+	export := func(user *store.User, request *v1pb.ExportShortcutsRequest) ([]string, string) {
+		stream := &testingHTTPBodyStream{ctx: withUser(ctx, user)}
+		require.NoError(t, s.ExportShortcuts(request, stream))
+		lines := []string{}
+		for _, chunk := range stream.chunks {
+			lines = append(lines, string(chunk.Data))
+		}
+		return lines, stream.chunks[0].ContentType
+	}
+
+	// CSV by default, with a header row and only the shortcuts of the user.
+	lines, contentType := export(user, &v1pb.ExportShortcutsRequest{})
+	require.Equal(t, "text/csv", contentType)
+	require.Equal(t, []string{
+		"name,link,title,description,tags,visibility,view_count",
+		`docs,https://example.com/docs?a=1&b=2,"Docs, ""quoted""",The docs,docs team,PUBLIC,3`,
+	}, lines)
+
+	lines, contentType = export(user, &v1pb.ExportShortcutsRequest{Format: v1pb.ExportShortcutsRequest_JSON})
+	require.Equal(t, "application/x-ndjson", contentType)
+	require.Equal(t, []string{
+		`{"name":"docs","link":"https://example.com/docs?a=1&b=2","title":"Docs, \"quoted\"","description":"The docs","tags":["docs","team"],"visibility":"PUBLIC","viewCount":3}`,
+	}, lines)
+
+	// Admins export all the shortcuts across batches in order of name.
+	lines, _ = export(admin, &v1pb.ExportShortcutsRequest{Format: v1pb.ExportShortcutsRequest_JSON})
+	require.Equal(t, shortcutExportBatchSize+1, len(lines))
+	require.Equal(t, `{"name":"admin-000","link":"https://example.com","title":"","description":"","tags":[],"visibility":"WORKSPACE","viewCount":0}`, lines[0])
+	require.Contains(t, lines[shortcutExportBatchSize], `"name":"docs"`)
+}
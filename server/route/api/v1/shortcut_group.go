@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// shortcutGroupFields is the fields ListShortcuts groups by.
+var shortcutGroupFields = map[string]store.ShortcutGroupField{
+	"tag":        store.ShortcutGroupFieldTag,
+	"visibility": store.ShortcutGroupFieldVisibility,
+	"creator":    store.ShortcutGroupFieldCreatorID,
+}
+
+// listShortcutGroups returns the groups of the shortcuts of the find by the field, at most limit of them.
+func (s *APIV1Service) listShortcutGroups(ctx context.Context, groupBy string, find *store.FindShortcut, limit int, view v1pb.ShortcutView) (*v1pb.ListShortcutsResponse, error) {
+	field, ok := shortcutGroupFields[groupBy]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported group by field %q", groupBy)
+	}
+	groups, err := s.Store.ListShortcutGroups(ctx, &store.FindShortcutGroup{
+		Find:  find,
+		Field: field,
+		Limit: &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut groups, err: %v", err)
+	}
+
+	response := &v1pb.ListShortcutsResponse{
+		Shortcuts: []*v1pb.Shortcut{},
+		Groups:    []*v1pb.ListShortcutsResponse_Group{},
+	}
+	for _, group := range groups {
+		key := group.Key
+		if field == store.ShortcutGroupFieldCreatorID {
+			creatorID, err := strconv.Atoi(group.Key)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "invalid creator id %q", group.Key)
+			}
+			key = fmt.Sprintf("%s%d", UserNamePrefix, creatorID)
+		}
+		sample, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID:              &group.SampleID,
+			IncludeArchived: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+		}
+		responseGroup := &v1pb.ListShortcutsResponse_Group{
+			Key:   key,
+			Count: group.Count,
+		}
+		if sample != nil {
+			composedShortcut, err := s.convertShortcutFromStorepb(ctx, sample)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+			}
+			applyShortcutView(composedShortcut, view)
+			responseGroup.Sample = composedShortcut
+		}
+		response.Groups = append(response.Groups, responseGroup)
+	}
+	return response, nil
+}
@@ -0,0 +1,299 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// defaultLinkPorts are the ports removed from the canonical links of their schemes.
+var defaultLinkPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// normalizeShortcutLink returns the link without the query parameters stripped by the workspace,
+// or the link as is when they are preserved.
+func (s *APIV1Service) normalizeShortcutLink(ctx context.Context, link string, preserveQueryParams bool) (string, error) {
+	if preserveQueryParams {
+		return link, nil
+	}
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get workspace shortcut related setting: %v", err)
+	}
+	return stripLinkQueryParams(link, shortcutRelatedSetting.StrippedLinkQueryParams), nil
+}
+
+// stripLinkQueryParams removes the query parameters matching the patterns from the link,
+// keeping the rest of the link, and the order and encoding of the other parameters, as is.
+func stripLinkQueryParams(link string, patterns []string) string {
+	if len(patterns) == 0 {
+		return link
+	}
+	rest, fragment, hasFragment := strings.Cut(link, "#")
+	base, query, hasQuery := strings.Cut(rest, "?")
+	if !hasQuery {
+		return link
+	}
+	kept := []string{}
+	for _, param := range strings.Split(query, "&") {
+		if !matchLinkQueryParam(getLinkQueryParamName(param), patterns) {
+			kept = append(kept, param)
+		}
+	}
+	if len(kept) == 0 {
+		link = base
+	} else {
+		link = base + "?" + strings.Join(kept, "&")
+	}
+	if hasFragment {
+		link += "#" + fragment
+	}
+	return link
+}
+
+// getLinkQueryParamName returns the unescaped name of the "name=value" query parameter.
+func getLinkQueryParamName(param string) string {
+	name, _, _ := strings.Cut(param, "=")
+	if unescaped, err := url.QueryUnescape(name); err == nil {
+		name = unescaped
+	}
+	return name
+}
+
+// matchLinkQueryParam returns whether the name matches one of the patterns, case-insensitively.
+// A pattern ending with "*" matches the names with its prefix.
+func matchLinkQueryParam(name string, patterns []string) bool {
+	name = strings.ToLower(name)
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if prefix != "" && strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if pattern != "" && name == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *APIV1Service) CanonicalizeLink(ctx context.Context, request *v1pb.CanonicalizeLinkRequest) (*v1pb.CanonicalizeLinkResponse, error) {
+	canonicalizer, err := s.getLinkCanonicalizer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	canonicalLink, err := canonicalizer.canonicalize(request.Link)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid link %q: %v", request.Link, err)
+	}
+	response := &v1pb.CanonicalizeLinkResponse{
+		CanonicalLink: canonicalLink,
+	}
+	for _, link := range request.CompareLinks {
+		canonicalCompareLink, err := canonicalizer.canonicalize(link)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid compare link %q: %v", link, err)
+		}
+		response.Comparisons = append(response.Comparisons, &v1pb.CanonicalizeLinkResponse_Comparison{
+			Link:          link,
+			CanonicalLink: canonicalCompareLink,
+			Equivalent:    canonicalCompareLink == canonicalLink,
+		})
+	}
+
+	duplicates, err := s.findDuplicateShortcuts(ctx, canonicalizer, canonicalLink)
+	if err != nil {
+		return nil, err
+	}
+	for _, shortcut := range duplicates {
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		response.DuplicateShortcuts = append(response.DuplicateShortcuts, composedShortcut)
+	}
+	return response, nil
+}
+
+// findDuplicateShortcuts returns the active shortcuts readable by the current user whose link canonicalizes to the given one.
+func (s *APIV1Service) findDuplicateShortcuts(ctx context.Context, canonicalizer *linkCanonicalizer, canonicalLink string) ([]*storepb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	access, err := s.getShortcutAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	normalStatus := storepb.RowStatus_NORMAL
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus: &normalStatus,
+		VisibleTo: access.getVisibilityFilter(),
+		Access:    access.getFilter(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
+	}
+	duplicates := []*storepb.Shortcut{}
+	for _, shortcut := range shortcuts {
+		// The links stored before they were validated may not canonicalize, they are not duplicates of a valid one.
+		if link, err := canonicalizer.canonicalize(shortcut.Link); err == nil && link == canonicalLink && access.canRead(shortcut) {
+			duplicates = append(duplicates, shortcut)
+		}
+	}
+	return duplicates, nil
+}
+
+// linkCanonicalizer canonicalizes links with the stripped query parameters and the canonical query order of the workspace.
+type linkCanonicalizer struct {
+	strippedQueryParams []string
+	sortQuery           bool
+}
+
+func (s *APIV1Service) getLinkCanonicalizer(ctx context.Context) (*linkCanonicalizer, error) {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace shortcut related setting: %v", err)
+	}
+	return &linkCanonicalizer{
+		strippedQueryParams: shortcutRelatedSetting.StrippedLinkQueryParams,
+		sortQuery:           shortcutRelatedSetting.CanonicalLinkQueryOrder != storepb.WorkspaceSetting_ShortcutRelatedSetting_PRESERVE_ORDER,
+	}, nil
+}
+
+// canonicalize returns the canonical form of the absolute link. The scheme and host are lowercased, the default port,
+// the dot segments and the trailing slash of the path are removed, the percent-encodings are normalized,
+// the stripped and empty query parameters are removed and the others are sorted by name, and an empty fragment is removed.
+func (c *linkCanonicalizer) canonicalize(link string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme == "" {
+		return "", errors.New("link must be absolute")
+	}
+	// url.Parse lowercases the scheme.
+	canonicalLink := u.Scheme + ":"
+	if u.Opaque != "" {
+		// The links without a hierarchy, e.g. "mailto:", only have a case-insensitive scheme.
+		canonicalLink += u.Opaque
+	} else {
+		if u.Host == "" && defaultLinkPorts[u.Scheme] != "" {
+			return "", errors.New("link must have a host")
+		}
+		canonicalLink += "//"
+		if u.User != nil {
+			canonicalLink += u.User.String() + "@"
+		}
+		hostname, port := strings.TrimSuffix(strings.ToLower(u.Hostname()), "."), u.Port()
+		if strings.Contains(hostname, ":") {
+			hostname = "[" + hostname + "]"
+		}
+		canonicalLink += hostname
+		if port != "" && port != defaultLinkPorts[u.Scheme] {
+			canonicalLink += ":" + port
+		}
+		path := removeDotSegments(normalizePercentEncoding(u.EscapedPath()))
+		if path == "" {
+			path = "/"
+		} else if path != "/" {
+			path = strings.TrimSuffix(path, "/")
+		}
+		canonicalLink += path
+	}
+
+	params := []string{}
+	for _, param := range strings.Split(u.RawQuery, "&") {
+		if param == "" || matchLinkQueryParam(getLinkQueryParamName(param), c.strippedQueryParams) {
+			continue
+		}
+		params = append(params, normalizePercentEncoding(param))
+	}
+	if c.sortQuery {
+		slices.SortStableFunc(params, func(a, b string) int {
+			return strings.Compare(getLinkQueryParamName(a), getLinkQueryParamName(b))
+		})
+	}
+	if len(params) > 0 {
+		canonicalLink += "?" + strings.Join(params, "&")
+	}
+	if fragment := normalizePercentEncoding(u.EscapedFragment()); fragment != "" {
+		canonicalLink += "#" + fragment
+	}
+	return canonicalLink, nil
+}
+
+// normalizePercentEncoding decodes the percent-encoded unreserved characters and uppercases the other percent-encodings.
+func normalizePercentEncoding(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '%' && i+2 < len(s) && isHexDigit(s[i+1]) && isHexDigit(s[i+2]) {
+			b := unhex(s[i+1])<<4 | unhex(s[i+2])
+			if isUnreservedLinkCharacter(b) {
+				sb.WriteByte(b)
+			} else {
+				sb.WriteString("%" + strings.ToUpper(s[i+1:i+3]))
+			}
+			i += 2
+			continue
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+
+// removeDotSegments resolves the "." and ".." segments of the path, e.g. "/a/./b/../c" is "/a/c".
+func removeDotSegments(path string) string {
+	segments := strings.Split(path, "/")
+	resolved := []string{}
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		switch segment {
+		case ".":
+		case "..":
+			// The leading empty segment before the first slash is never removed.
+			if len(resolved) > 1 {
+				resolved = resolved[:len(resolved)-1]
+			}
+		default:
+			resolved = append(resolved, segment)
+			continue
+		}
+		// A trailing dot segment leaves the directory, e.g. "/a/b/.." is "/a/".
+		if last {
+			resolved = append(resolved, "")
+		}
+	}
+	return strings.Join(resolved, "/")
+}
+
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
+
+// isUnreservedLinkCharacter returns whether the character is unreserved in URLs, which is never percent-encoded in canonical links.
+func isUnreservedLinkCharacter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') || c == '-' || c == '.' || c == '_' || c == '~'
+}
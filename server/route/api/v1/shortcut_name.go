@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	defaultGeneratedNameAlphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	defaultGeneratedNameLength   = 6
+	maxGeneratedNameLength       = 64
+	// maxGeneratedNameAttempts bounds the retries on collisions with existing or reserved names.
+	maxGeneratedNameAttempts = 10
+	// generatedNameAllowedCharacters are the characters that are kept as is in URL paths.
+	generatedNameAllowedCharacters = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-_.~"
+)
+
+// reservedShortcutNames are never generated as they are commonly used by routes and clients.
+var reservedShortcutNames = map[string]bool{
+	"admin":       true,
+	"api":         true,
+	"auth":        true,
+	"bookmarklet": true,
+	"c":           true,
+	"collections": true,
+	"new":         true,
+	"s":           true,
+	"setting":     true,
+	"shortcuts":   true,
+	"signup":      true,
+}
+
+// validateGeneratedNameAlphabet checks that the alphabet has at least two distinct URL safe characters.
+func validateGeneratedNameAlphabet(alphabet string) error {
+	distinct := map[rune]bool{}
+	for _, r := range alphabet {
+		if !strings.ContainsRune(generatedNameAllowedCharacters, r) {
+			return errors.Errorf("character %q is not allowed", r)
+		}
+		distinct[r] = true
+	}
+	if len(distinct) < 2 {
+		return errors.New("at least two distinct characters are required")
+	}
+	return nil
+}
+
+// generateShortcutName returns a random shortcut name with the workspace alphabet and length
+// that is neither reserved nor used by another shortcut.
+func (s *APIV1Service) generateShortcutName(ctx context.Context) (string, error) {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get workspace shortcut related setting")
+	}
+	alphabet := shortcutRelatedSetting.GetGeneratedNameAlphabet()
+	if alphabet == "" {
+		alphabet = defaultGeneratedNameAlphabet
+	}
+	length := int(shortcutRelatedSetting.GetGeneratedNameLength())
+	if length <= 0 {
+		length = defaultGeneratedNameLength
+	}
+
+	for attempt := 0; attempt < maxGeneratedNameAttempts; attempt++ {
+		name, err := util.RandomStringFromAlphabet([]rune(alphabet), length)
+		if err != nil {
+			return "", err
+		}
+		available, err := s.isShortcutNameAvailable(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		if available {
+			return name, nil
+		}
+	}
+	return "", errors.Errorf("no available name after %d attempts", maxGeneratedNameAttempts)
+}
+
+func (s *APIV1Service) isShortcutNameAvailable(ctx context.Context, name string) (bool, error) {
+	if reservedShortcutNames[strings.ToLower(name)] || s.Profile.IsReservedShortcutName(name) {
+		return false, nil
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		Name: &name,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get shortcut")
+	}
+	if shortcut != nil {
+		return false, nil
+	}
+	reservation, err := s.Store.GetShortcutNameReservation(ctx, &store.FindShortcutNameReservation{
+		Namespace: store.GetWorkspaceID(ctx),
+		Name:      name,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get shortcut name reservation")
+	}
+	return reservation == nil, nil
+}
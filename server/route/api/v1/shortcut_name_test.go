@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestGenerateShortcutName(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	updateSetting := func(alphabet string, length int32) error {
+		_, err := s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				GeneratedNameAlphabet: alphabet,
+				GeneratedNameLength:   length,
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"generated_name_alphabet", "generated_name_length"}},
+		})
+		return err
+	}
+
+	// Defaults to six alphanumerics.
+	name, err := s.generateShortcutName(ctx)
+	require.NoError(t, err)
+	require.Equal(t, defaultGeneratedNameLength, len(name))
+	for _, r := range name {
+		require.True(t, strings.ContainsRune(defaultGeneratedNameAlphabet, r))
+	}
+
+	// Custom alphabet and length.
+	require.NoError(t, updateSetting("0123456789abcdef", 12))
+	workspaceSetting, err := s.GetWorkspaceSetting(withUser(ctx, admin), &v1pb.GetWorkspaceSettingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "0123456789abcdef", workspaceSetting.GeneratedNameAlphabet)
+	require.Equal(t, int32(12), workspaceSetting.GeneratedNameLength)
+	name, err = s.generateShortcutName(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 12, len(name))
+	for _, r := range name {
+		require.True(t, strings.ContainsRune("0123456789abcdef", r))
+	}
+
+	// Invalid settings are rejected.
+	for _, alphabet := range []string{"a", "aaaa", "ab/", "äb"} {
+		require.Equal(t, codes.InvalidArgument, status.Code(updateSetting(alphabet, 0)), alphabet)
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(updateSetting("", -1)))
+	require.Equal(t, codes.InvalidArgument, status.Code(updateSetting("", maxGeneratedNameLength+1)))
+}
+
+func TestGenerateShortcutNameCollision(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	setGeneratedNameAlphabet := func(alphabet string) {
+		_, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+			Value: &storepb.WorkspaceSetting_ShortcutRelated{
+				ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+					GeneratedNameAlphabet: alphabet,
+					GeneratedNameLength:   1,
+				},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// Names used by shortcuts and reservations, and reserved words are not available.
+	_, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "x", Link: "https://example.com"},
+	})
+	require.NoError(t, err)
+	_, err = s.Store.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "y", user.ID, time.Hour)
+	require.NoError(t, err)
+	for name, want := range map[string]bool{"x": false, "y": false, "z": true, "API": false} {
+		available, err := s.isShortcutNameAvailable(ctx, name)
+		require.NoError(t, err)
+		require.Equal(t, want, available, name)
+	}
+
+	// Generating fails when all the attempts collide.
+	setGeneratedNameAlphabet("xy")
+	_, err = s.generateShortcutName(ctx)
+	require.Error(t, err)
+	setGeneratedNameAlphabet("cs")
+	_, err = s.generateShortcutName(ctx)
+	require.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// defaultShortcutPageSize is the page size of ListShortcuts when unspecified.
+	defaultShortcutPageSize = 100
+	// maxShortcutPageSize is the largest page size of ListShortcuts.
+	maxShortcutPageSize = 1000
+)
+
+// shortcutPageToken is the position after the last shortcut of a page of ListShortcuts.
+// The values are the values of the last shortcut for the order fields, which make it a keyset cursor
+// that new and deleted shortcuts don't shift.
+type shortcutPageToken struct {
+	OrderBy string   `json:"orderBy"`
+	ID      int32    `json:"id"`
+	Values  []string `json:"values"`
+}
+
+// getShortcutPageSize returns the page size of the requested one, the default when unspecified and capped at the max.
+func getShortcutPageSize(pageSize int32) (int, error) {
+	if pageSize < 0 {
+		return 0, errors.New("page size must not be negative")
+	}
+	if pageSize == 0 {
+		return defaultShortcutPageSize, nil
+	}
+	return min(int(pageSize), maxShortcutPageSize), nil
+}
+
+// encodeShortcutPageToken returns the page token of the shortcuts of the find after the shortcut in its order.
+func encodeShortcutPageToken(find *store.FindShortcut, shortcut *storepb.Shortcut) (string, error) {
+	orderBy := find.GetOrderBy()
+	token := &shortcutPageToken{
+		OrderBy: formatShortcutOrderBy(orderBy),
+		ID:      shortcut.Id,
+		Values:  []string{},
+	}
+	for _, v := range orderBy {
+		switch v.Field {
+		case store.ShortcutOrderFieldKeywordRank:
+			token.Values = append(token.Values, strconv.FormatInt(store.GetShortcutKeywordRank(shortcut, *find.Keyword), 10))
+		case store.ShortcutOrderFieldName:
+			token.Values = append(token.Values, shortcut.Name)
+		case store.ShortcutOrderFieldTitle:
+			token.Values = append(token.Values, shortcut.Title)
+		case store.ShortcutOrderFieldCreatedTs:
+			token.Values = append(token.Values, strconv.FormatInt(shortcut.CreatedTs, 10))
+		case store.ShortcutOrderFieldUpdatedTs:
+			token.Values = append(token.Values, strconv.FormatInt(shortcut.UpdatedTs, 10))
+		default:
+			return "", errors.Errorf("unsupported order field %q", v.Field)
+		}
+	}
+	bytes, err := json.Marshal(token)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal page token")
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// decodeShortcutPageToken returns the store cursor of the page token, which must have been encoded for the same order.
+func decodeShortcutPageToken(pageToken string, find *store.FindShortcut) (*store.ShortcutCursor, error) {
+	orderBy := find.GetOrderBy()
+	bytes, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, errors.New("malformed page token")
+	}
+	token := &shortcutPageToken{}
+	if err := json.Unmarshal(bytes, token); err != nil {
+		return nil, errors.New("malformed page token")
+	}
+	if token.OrderBy != formatShortcutOrderBy(orderBy) {
+		return nil, errors.New("page token was issued for another order")
+	}
+	if len(token.Values) != len(orderBy) {
+		return nil, errors.New("malformed page token")
+	}
+	cursor := &store.ShortcutCursor{
+		ID:     token.ID,
+		Values: []any{},
+	}
+	for i, v := range orderBy {
+		switch v.Field {
+		case store.ShortcutOrderFieldCreatedTs, store.ShortcutOrderFieldUpdatedTs, store.ShortcutOrderFieldKeywordRank:
+			value, err := strconv.ParseInt(token.Values[i], 10, 64)
+			if err != nil {
+				return nil, errors.New("malformed page token")
+			}
+			cursor.Values = append(cursor.Values, value)
+		default:
+			cursor.Values = append(cursor.Values, token.Values[i])
+		}
+	}
+	return cursor, nil
+}
+
+// formatShortcutOrderBy returns the canonical form of the order, e.g. "name asc, created_ts desc".
+func formatShortcutOrderBy(orderBy []*store.ShortcutOrderBy) string {
+	list := []string{}
+	for _, v := range orderBy {
+		direction := "asc"
+		if v.Desc {
+			direction = "desc"
+		}
+		list = append(list, fmt.Sprintf("%s %s", v.Field, direction))
+	}
+	return strings.Join(list, ", ")
+}
@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yourselfhosted/slash/internal/qrcode"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	defaultQRCodeSize = 256
+	minQRCodeSize     = 64
+	maxQRCodeSize     = 2048
+	// maxQRCodeCacheEntries bounds the generated images kept in memory, the oldest are evicted first.
+	maxQRCodeCacheEntries = 512
+)
+
+func (s *APIV1Service) GetShortcutQRCode(ctx context.Context, request *v1pb.GetShortcutQRCodeRequest) (*httpbody.HttpBody, error) {
+	size := int(request.Size)
+	if size == 0 {
+		size = defaultQRCodeSize
+	}
+	if size < minQRCodeSize || size > maxQRCodeSize {
+		return nil, status.Errorf(codes.InvalidArgument, "size must be between %d and %d", minQRCodeSize, maxQRCodeSize)
+	}
+	level, ok := convertQRCodeLevel(request.ErrorCorrectionLevel)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid error correction level %d", request.ErrorCorrectionLevel)
+	}
+	format := request.Format
+	if format == v1pb.GetShortcutQRCodeRequest_FORMAT_UNSPECIFIED {
+		format = v1pb.GetShortcutQRCodeRequest_PNG
+	}
+	contentType := "image/png"
+	if format == v1pb.GetShortcutQRCodeRequest_SVG {
+		contentType = "image/svg+xml"
+	}
+
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID:              &request.Id,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	access, err := s.getShortcutAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if !access.canRead(shortcut) {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	if generalSetting.InstanceUrl == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "instance url is not configured")
+	}
+
+	key := qrCodeCacheKey{
+		link:   getShortcutShortURL(generalSetting.InstanceUrl, shortcut.Name),
+		size:   size,
+		level:  level,
+		format: format,
+	}
+	data, ok := s.qrCodeCache.get(key)
+	if !ok {
+		data, err = generateQRCode(key)
+		if err != nil {
+			if errors.Is(err, qrcode.ErrDataTooLong) {
+				return nil, status.Errorf(codes.FailedPrecondition, "short url is too long for a qr code")
+			}
+			return nil, status.Errorf(codes.InvalidArgument, "failed to generate qr code: %v", err)
+		}
+		s.qrCodeCache.add(key, data)
+	}
+	return &httpbody.HttpBody{
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
+
+// getShortcutShortURL returns the URL redirecting to the shortcut on the instance.
+func getShortcutShortURL(instanceURL, name string) string {
+	return strings.TrimSuffix(instanceURL, "/") + "/s/" + url.PathEscape(name)
+}
+
+func generateQRCode(key qrCodeCacheKey) ([]byte, error) {
+	code, err := qrcode.Encode([]byte(key.link), key.level)
+	if err != nil {
+		return nil, err
+	}
+	if key.format == v1pb.GetShortcutQRCodeRequest_SVG {
+		return code.SVG(key.size)
+	}
+	return code.PNG(key.size)
+}
+
+func convertQRCodeLevel(level v1pb.GetShortcutQRCodeRequest_ErrorCorrectionLevel) (qrcode.Level, bool) {
+	switch level {
+	case v1pb.GetShortcutQRCodeRequest_LOW:
+		return qrcode.Low, true
+	case v1pb.GetShortcutQRCodeRequest_ERROR_CORRECTION_LEVEL_UNSPECIFIED, v1pb.GetShortcutQRCodeRequest_MEDIUM:
+		return qrcode.Medium, true
+	case v1pb.GetShortcutQRCodeRequest_QUARTILE:
+		return qrcode.Quartile, true
+	case v1pb.GetShortcutQRCodeRequest_HIGH:
+		return qrcode.High, true
+	default:
+		return 0, false
+	}
+}
+
+// qrCodeCacheKey identifies a generated image by the short URL, which holds the name of the shortcut, and its options.
+type qrCodeCacheKey struct {
+	link   string
+	size   int
+	level  qrcode.Level
+	format v1pb.GetShortcutQRCodeRequest_Format
+}
+
+// qrCodeCache keeps the recently generated QR code images.
+// A nil qrCodeCache keeps nothing.
+type qrCodeCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[qrCodeCacheKey][]byte
+	// keys are the keys of the entries in order of insertion.
+	keys []qrCodeCacheKey
+}
+
+func newQRCodeCache(maxEntries int) *qrCodeCache {
+	return &qrCodeCache{
+		maxEntries: maxEntries,
+		entries:    map[qrCodeCacheKey][]byte{},
+	}
+}
+
+func (c *qrCodeCache) get(key qrCodeCacheKey) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, ok := c.entries[key]
+	return data, ok
+}
+
+func (c *qrCodeCache) add(key qrCodeCacheKey, data []byte) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+	if len(c.keys) >= c.maxEntries {
+		delete(c.entries, c.keys[0])
+		c.keys = c.keys[1:]
+	}
+	c.entries[key] = data
+	c.keys = append(c.keys, key)
+}
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yourselfhosted/slash/internal/qrcode"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestGetShortcutQRCode(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	s.qrCodeCache = newQRCodeCache(2)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	shortcut, err := s.CreateShortcut(withUser(ctx, owner), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "docs", Link: "https://example.com", Visibility: v1pb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+	getQRCode := func(ctx context.Context, request *v1pb.GetShortcutQRCodeRequest) ([]byte, string, error) {
+		request.Id = shortcut.Id
+		body, err := s.GetShortcutQRCode(ctx, request)
+		if err != nil {
+			return nil, "", err
+		}
+		return body.Data, body.ContentType, nil
+	}
+
+	// The short URL requires the instance URL.
+	_, _, err = getQRCode(withUser(ctx, owner), &v1pb.GetShortcutQRCodeRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{InstanceUrl: "https://slash.example.com/"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"instance_url"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://slash.example.com/s/docs", getShortcutShortURL("https://slash.example.com/", "docs"))
+
+	// A PNG of 256 pixels with the medium level by default.
+	data, contentType, err := getQRCode(withUser(ctx, owner), &v1pb.GetShortcutQRCodeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", contentType)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, 256, img.Bounds().Dx())
+	require.Equal(t, 256, img.Bounds().Dy())
+
+	data, contentType, err = getQRCode(withUser(ctx, owner), &v1pb.GetShortcutQRCodeRequest{
+		Size:                 512,
+		ErrorCorrectionLevel: v1pb.GetShortcutQRCodeRequest_HIGH,
+		Format:               v1pb.GetShortcutQRCodeRequest_SVG,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "image/svg+xml", contentType)
+	require.True(t, bytes.HasPrefix(data, []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="512" height="512"`)))
+
+	// The images are cached by short URL and options, up to the max entries.
+	cached, ok := s.qrCodeCache.get(qrCodeCacheKey{
+		link:   "https://slash.example.com/s/docs",
+		size:   512,
+		level:  qrcode.High,
+		format: v1pb.GetShortcutQRCodeRequest_SVG,
+	})
+	require.True(t, ok)
+	require.Equal(t, data, cached)
+	_, _, err = getQRCode(withUser(ctx, owner), &v1pb.GetShortcutQRCodeRequest{Size: 128})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(s.qrCodeCache.entries))
+
+	for _, size := range []int32{-1, 1, 63, 2049, 100000} {
+		_, _, err := getQRCode(withUser(ctx, owner), &v1pb.GetShortcutQRCodeRequest{Size: size})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), "size %d", size)
+	}
+	_, _, err = getQRCode(withUser(ctx, owner), &v1pb.GetShortcutQRCodeRequest{ErrorCorrectionLevel: 10})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Only the users who can read the shortcut get its QR code.
+	_, _, err = getQRCode(ctx, &v1pb.GetShortcutQRCodeRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.GetShortcutQRCode(withUser(ctx, owner), &v1pb.GetShortcutQRCodeRequest{Id: shortcut.Id + 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
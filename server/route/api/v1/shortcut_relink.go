@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (s *APIV1Service) BatchRelinkShortcuts(ctx context.Context, request *v1pb.BatchRelinkShortcutsRequest) (*v1pb.BatchRelinkShortcutsResponse, error) {
+	if request.Pattern == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "pattern is required")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	normalStatus := storepb.RowStatus_NORMAL
+	find := &store.FindShortcut{
+		RowStatus: &normalStatus,
+		OrderBy:   []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldName}},
+	}
+	if user.Role != store.RoleAdmin {
+		find.CreatorID = &user.ID
+	}
+	shortcuts, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts: %v", err)
+	}
+
+	response := &v1pb.BatchRelinkShortcutsResponse{
+		Changes: []*v1pb.BatchRelinkShortcutsResponse_Change{},
+	}
+	importShortcuts := &store.ImportShortcuts{}
+	for _, shortcut := range shortcuts {
+		newLink, ok := relinkShortcutLink(shortcut.Link, request.Pattern, request.Replacement, request.MatchType)
+		if !ok || newLink == shortcut.Link {
+			continue
+		}
+		change := &v1pb.BatchRelinkShortcutsResponse_Change{
+			ShortcutId: shortcut.Id,
+			Name:       shortcut.Name,
+			Link:       shortcut.Link,
+			NewLink:    newLink,
+		}
+		response.Changes = append(response.Changes, change)
+
+		if !util.ValidateURI(newLink) {
+			change.Error = fmt.Sprintf("invalid link %q", newLink)
+			continue
+		}
+		// The links are replaced as they are, the stripped query parameters of the workspace included.
+		update, err := s.buildShortcutUpdate(ctx, user, shortcut, &v1pb.UpdateShortcutRequest{
+			Shortcut:                &v1pb.Shortcut{Id: shortcut.Id, Link: newLink},
+			UpdateMask:              &fieldmaskpb.FieldMask{Paths: []string{"link"}},
+			PreserveLinkQueryParams: true,
+		})
+		if err != nil {
+			if status.Code(err) == codes.Internal {
+				return nil, err
+			}
+			change.Error = status.Convert(err).Message()
+			continue
+		}
+		importShortcuts.Updates = append(importShortcuts.Updates, update)
+	}
+	if request.DryRun {
+		return response, nil
+	}
+	for _, change := range response.Changes {
+		if change.Error != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to relink shortcut %q: %s", change.Name, change.Error)
+		}
+	}
+	if _, _, err := s.Store.ImportShortcuts(ctx, importShortcuts); err != nil {
+		if errors.Is(err, store.ErrShortcutNameExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "failed to relink shortcuts: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to relink shortcuts, err: %v", err)
+	}
+	return response, nil
+}
+
+// relinkShortcutLink returns the link with the matches of the pattern replaced, and whether the pattern matches the link.
+// A matched host keeps the port of the link unless the replacement has one.
+func relinkShortcutLink(link, pattern, replacement string, matchType v1pb.BatchRelinkShortcutsRequest_MatchType) (string, bool) {
+	if matchType != v1pb.BatchRelinkShortcutsRequest_HOST {
+		if !strings.Contains(link, pattern) {
+			return "", false
+		}
+		return strings.ReplaceAll(link, pattern, replacement), true
+	}
+
+	u, err := url.Parse(link)
+	if err != nil || !strings.EqualFold(u.Hostname(), pattern) {
+		return "", false
+	}
+	host := replacement
+	if port := u.Port(); port != "" && replacement != "" {
+		if _, _, err := net.SplitHostPort(replacement); err != nil {
+			host = net.JoinHostPort(replacement, port)
+		}
+	}
+	u.Host = host
+	return u.String(), true
+}
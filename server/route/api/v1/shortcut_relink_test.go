@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestRelinkShortcutLink(t *testing.T) {
+	tests := []struct {
+		link        string
+		pattern     string
+		replacement string
+		matchType   v1pb.BatchRelinkShortcutsRequest_MatchType
+		want        string
+		ok          bool
+	}{
+		{"https://old.example.com/docs", "old.example.com", "new.example.com", v1pb.BatchRelinkShortcutsRequest_SUBSTRING, "https://new.example.com/docs", true},
+		{"https://example.com/v1/a?ref=v1", "v1", "v2", v1pb.BatchRelinkShortcutsRequest_MATCH_TYPE_UNSPECIFIED, "https://example.com/v2/a?ref=v2", true},
+		{"https://example.com/docs", "other", "new", v1pb.BatchRelinkShortcutsRequest_SUBSTRING, "", false},
+		{"https://OLD.example.com/docs?q=1#top", "old.example.com", "new.example.com", v1pb.BatchRelinkShortcutsRequest_HOST, "https://new.example.com/docs?q=1#top", true},
+		{"https://old.example.com:8443/docs", "old.example.com", "new.example.com", v1pb.BatchRelinkShortcutsRequest_HOST, "https://new.example.com:8443/docs", true},
+		{"https://old.example.com:8443/docs", "old.example.com", "new.example.com:9443", v1pb.BatchRelinkShortcutsRequest_HOST, "https://new.example.com:9443/docs", true},
+		{"https://example.com/old.example.com", "old.example.com", "new.example.com", v1pb.BatchRelinkShortcutsRequest_HOST, "", false},
+	}
+	for _, test := range tests {
+		got, ok := relinkShortcutLink(test.link, test.pattern, test.replacement, test.matchType)
+		require.Equal(t, test.ok, ok, test.link)
+		require.Equal(t, test.want, got, test.link)
+	}
+}
+
+func TestBatchRelinkShortcuts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	adminCtx, userCtx := withUser(ctx, admin), withUser(ctx, user)
+	createShortcut := func(ctx context.Context, name, link string) *v1pb.Shortcut {
+		shortcut, err := s.CreateShortcut(ctx, &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: link},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	getLink := func(id int32) string {
+		shortcut, err := s.GetShortcut(adminCtx, &v1pb.GetShortcutRequest{Id: id})
+		require.NoError(t, err)
+		return shortcut.Link
+	}
+	docs := createShortcut(adminCtx, "docs", "https://wiki.example.com/docs")
+	wiki := createShortcut(userCtx, "wiki", "https://wiki.example.com:8080/home")
+	other := createShortcut(adminCtx, "other", "https://example.com/wiki.example.com")
+
+	_, err := s.BatchRelinkShortcuts(adminCtx, &v1pb.BatchRelinkShortcutsRequest{Replacement: "x"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	request := &v1pb.BatchRelinkShortcutsRequest{
+		Pattern:     "WIKI.example.com",
+		Replacement: "docs.example.org",
+		MatchType:   v1pb.BatchRelinkShortcutsRequest_HOST,
+		DryRun:      true,
+	}
+	response, err := s.BatchRelinkShortcuts(adminCtx, request)
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 2)
+	require.Equal(t, "docs", response.Changes[0].Name)
+	require.Equal(t, "https://wiki.example.com/docs", response.Changes[0].Link)
+	require.Equal(t, "https://docs.example.org/docs", response.Changes[0].NewLink)
+	require.Equal(t, "wiki", response.Changes[1].Name)
+	require.Equal(t, "https://docs.example.org:8080/home", response.Changes[1].NewLink)
+	require.Empty(t, response.Changes[1].Error)
+	// The dry run doesn't write anything.
+	require.Equal(t, "https://wiki.example.com/docs", getLink(docs.Id))
+
+	// Users only relink their own shortcuts.
+	request.DryRun = false
+	response, err = s.BatchRelinkShortcuts(userCtx, request)
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 1)
+	require.Equal(t, wiki.Id, response.Changes[0].ShortcutId)
+	require.Equal(t, "https://docs.example.org:8080/home", getLink(wiki.Id))
+	require.Equal(t, "https://wiki.example.com/docs", getLink(docs.Id))
+
+	response, err = s.BatchRelinkShortcuts(adminCtx, request)
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 1)
+	require.Equal(t, "https://docs.example.org/docs", getLink(docs.Id))
+	require.Equal(t, "https://example.com/wiki.example.com", getLink(other.Id))
+
+	// An invalid resulting link fails the whole relink.
+	invalid := &v1pb.BatchRelinkShortcutsRequest{
+		Pattern:     "https://",
+		Replacement: "",
+		DryRun:      true,
+	}
+	response, err = s.BatchRelinkShortcuts(adminCtx, invalid)
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 3)
+	for _, change := range response.Changes {
+		require.Contains(t, change.Error, "invalid link")
+	}
+	invalid.DryRun = false
+	_, err = s.BatchRelinkShortcuts(adminCtx, invalid)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "https://docs.example.org/docs", getLink(docs.Id))
+
+	// A single invalid link fails the valid ones too.
+	createShortcut(adminCtx, "root", "https://docs.example.org/")
+	invalid = &v1pb.BatchRelinkShortcutsRequest{
+		Pattern:     "docs.example.org/",
+		Replacement: "",
+		DryRun:      true,
+	}
+	response, err = s.BatchRelinkShortcuts(adminCtx, invalid)
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 2)
+	require.Equal(t, "https://docs", response.Changes[0].NewLink)
+	require.Empty(t, response.Changes[0].Error)
+	require.Equal(t, "root", response.Changes[1].Name)
+	require.NotEmpty(t, response.Changes[1].Error)
+	invalid.DryRun = false
+	_, err = s.BatchRelinkShortcuts(adminCtx, invalid)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "https://docs.example.org/docs", getLink(docs.Id))
+}
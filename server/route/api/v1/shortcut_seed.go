@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// SeedWorkspaceShortcuts creates the seed shortcuts of the profile as the first admin, once the workspace has one.
+// The seeded names are recorded so that restarts don't create them again, even once they are deleted.
+func (s *APIV1Service) SeedWorkspaceShortcuts(ctx context.Context) error {
+	if len(s.Profile.SeedShortcuts) == 0 {
+		return nil
+	}
+	adminRole, normalStatus := store.RoleAdmin, storepb.RowStatus_NORMAL
+	admins, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Role:      &adminRole,
+		RowStatus: &normalStatus,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list admins")
+	}
+	if len(admins) == 0 {
+		// The workspace is not initialized yet, the first sign up seeds the shortcuts.
+		return nil
+	}
+	admin := slices.MinFunc(admins, func(a, b *store.User) int {
+		return int(a.ID - b.ID)
+	})
+
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace shortcut related setting")
+	}
+	seededNames := slices.Clone(shortcutRelatedSetting.SeededShortcutNames)
+	adminCtx := context.WithValue(ctx, userIDContextKey, admin.ID)
+	for _, seedShortcut := range s.Profile.SeedShortcuts {
+		name, link, err := profile.ParseSeedShortcut(seedShortcut)
+		if err != nil {
+			return errors.Wrapf(err, "invalid seed shortcut %q", seedShortcut)
+		}
+		if slices.Contains(seededNames, name) {
+			continue
+		}
+		// A shortcut may already hold the name, e.g. one created before the name was seeded.
+		existing, err := s.Store.GetShortcut(ctx, &store.FindShortcut{Name: &name})
+		if err != nil {
+			return errors.Wrap(err, "failed to get shortcut")
+		}
+		if existing == nil {
+			if _, err := s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+				Shortcut: &v1pb.Shortcut{Name: name, Link: link},
+			}); err != nil {
+				if status.Code(err) == codes.Internal {
+					return errors.Wrapf(err, "failed to seed shortcut %q", name)
+				}
+				slog.Warn("failed to seed shortcut", slog.String("name", name), slog.String("error", err.Error()))
+				continue
+			}
+		}
+		seededNames = append(seededNames, name)
+	}
+	if len(seededNames) == len(shortcutRelatedSetting.SeededShortcutNames) {
+		return nil
+	}
+
+	// The setting is read again as it may have been updated while seeding.
+	shortcutRelatedSetting, err = s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace shortcut related setting")
+	}
+	shortcutRelatedSetting.SeededShortcutNames = seededNames
+	if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: shortcutRelatedSetting,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to update workspace shortcut related setting")
+	}
+	return nil
+}
+
+// checkReservedShortcutName returns a PermissionDenied error when the name is reserved by the profile and the user is not an admin.
+func (s *APIV1Service) checkReservedShortcutName(name string, user *store.User) error {
+	if user.Role != store.RoleAdmin && s.Profile.IsReservedShortcutName(name) {
+		return status.Errorf(codes.PermissionDenied, "shortcut name %q is reserved", name)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestSeedWorkspaceShortcuts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	s.Profile.SeedShortcuts = []string{"help=https://help.example.com", "docs=https://docs.example.com"}
+	listShortcutNames := func() []string {
+		shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+		require.NoError(t, err)
+		names := []string{}
+		for _, shortcut := range shortcuts {
+			names = append(names, shortcut.Name)
+		}
+		return names
+	}
+
+	// The workspace is not initialized until it has an admin.
+	require.NoError(t, s.SeedWorkspaceShortcuts(ctx))
+	require.Empty(t, listShortcutNames())
+
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	require.NoError(t, s.SeedWorkspaceShortcuts(ctx))
+	require.ElementsMatch(t, []string{"help", "docs"}, listShortcutNames())
+	name := "docs"
+	docs, err := s.Store.GetShortcut(ctx, &store.FindShortcut{Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, admin.ID, docs.CreatorId)
+	require.Equal(t, "https://docs.example.com", docs.Link)
+	setting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"help", "docs"}, setting.SeededShortcutNames)
+
+	// Seeding again, as on restarts, neither duplicates nor recreates the deleted seeds.
+	require.NoError(t, s.SeedWorkspaceShortcuts(ctx))
+	require.Equal(t, 2, len(listShortcutNames()))
+	require.NoError(t, s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{ID: docs.Id}))
+	require.NoError(t, s.SeedWorkspaceShortcuts(ctx))
+	require.Equal(t, []string{"help"}, listShortcutNames())
+
+	// A new seed is created, and an existing shortcut with its name is kept.
+	_, err = s.CreateShortcut(withUser(ctx, admin), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "blog", Link: "https://blog.example.com"},
+	})
+	require.NoError(t, err)
+	s.Profile.SeedShortcuts = append(s.Profile.SeedShortcuts, "wiki=https://wiki.example.com", "blog=https://seed.example.com")
+	require.NoError(t, s.SeedWorkspaceShortcuts(ctx))
+	require.ElementsMatch(t, []string{"help", "blog", "wiki"}, listShortcutNames())
+	name = "blog"
+	blog, err := s.Store.GetShortcut(ctx, &store.FindShortcut{Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, "https://blog.example.com", blog.Link)
+}
+
+func TestCreateShortcutWithReservedWorkspaceName(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	s.Profile.ReservedShortcutNames = []string{"admin-only"}
+	s.Profile.SeedShortcuts = []string{"help=https://help.example.com"}
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+
+	for _, name := range []string{"admin-only", "help", "HELP"} {
+		_, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com"},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err), name)
+	}
+	shortcut, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "mine", Link: "https://example.com"},
+	})
+	require.NoError(t, err)
+	_, err = s.UpdateShortcut(withUser(ctx, user), &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Name: "admin-only"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Admins create the reserved shortcuts.
+	_, err = s.CreateShortcut(withUser(ctx, admin), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "admin-only", Link: "https://example.com"},
+	})
+	require.NoError(t, err)
+	available, err := s.isShortcutNameAvailable(ctx, "help")
+	require.NoError(t, err)
+	require.False(t, available)
+}
@@ -1,31 +1,120 @@
 package v1
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"math"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/mssola/useragent"
 	"github.com/pkg/errors"
 	"golang.org/x/exp/slices"
+	"golang.org/x/text/language"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yourselfhosted/slash/internal/util"
+	"github.com/yourselfhosted/slash/plugin/httpgetter"
+	"github.com/yourselfhosted/slash/plugin/shortener"
+	"github.com/yourselfhosted/slash/plugin/summarizer"
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/service/license"
 	"github.com/yourselfhosted/slash/store"
 )
 
-func (s *APIV1Service) ListShortcuts(ctx context.Context, _ *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
-	shortcutList, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+const (
+	// summarizedDescriptionLength is the length above which descriptions are summarized.
+	summarizedDescriptionLength = 280
+	// maxSummaryLength is the maximum length of the summaries.
+	maxSummaryLength = 140
+)
+
+func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
+	orderBy := request.GetOrderBy()
+	if orderBy == "" {
+		shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
+		}
+		orderBy = shortcutRelatedSetting.DefaultShortcutOrder
+	}
+	shortcutOrderBy, err := parseShortcutOrderBy(orderBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order by: %v", err)
+	}
+	pageSize, err := getShortcutPageSize(request.PageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page size: %v", err)
+	}
+	// Fetch one more shortcut to know whether there is a next page.
+	limit := pageSize + 1
+	find := &store.FindShortcut{
+		OrderBy:         shortcutOrderBy,
+		TagsMatchAny:    request.TagMatchMode == v1pb.ListShortcutsRequest_MATCH_ANY_TAG,
+		Limit:           &limit,
+		IncludeArchived: request.IncludeArchived,
+	}
+	for _, tag := range request.Tags {
+		if tag == "" || strings.ContainsFunc(tag, unicode.IsSpace) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tag %q", tag)
+		}
+		find.Tags = append(find.Tags, tag)
+	}
+	if filter := strings.TrimSpace(request.Filter); filter != "" {
+		find.Keyword = &filter
+	}
+	if !request.IncludeExpired {
+		now := time.Now().Unix()
+		find.NotExpiredAt = &now
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	access, err := s.getShortcutAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	find.VisibleTo = access.getVisibilityFilter()
+	find.Access = access.getFilter()
+	if request.GroupBy != "" {
+		return s.listShortcutGroups(ctx, request.GroupBy, find, pageSize, request.View)
+	}
+	if request.PageToken != "" {
+		cursor, err := decodeShortcutPageToken(request.PageToken, find)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		find.Cursor = cursor
+	}
+	shortcutList, err := s.Store.ListShortcuts(ctx, find)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
 	}
+	nextPageToken := ""
+	if len(shortcutList) > pageSize {
+		shortcutList = shortcutList[:pageSize]
+		nextPageToken, err = encodeShortcutPageToken(find, shortcutList[pageSize-1])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode page token, err: %v", err)
+		}
+	}
 
 	shortcutMessageList := []*v1pb.Shortcut{}
 	for _, shortcut := range shortcutList {
@@ -33,18 +122,31 @@ func (s *APIV1Service) ListShortcuts(ctx context.Context, _ *v1pb.ListShortcutsR
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
 		}
+		applyShortcutView(composedShortcut, request.View)
 		shortcutMessageList = append(shortcutMessageList, composedShortcut)
 	}
 
 	response := &v1pb.ListShortcutsResponse{
-		Shortcuts: shortcutMessageList,
+		Shortcuts:     shortcutMessageList,
+		NextPageToken: nextPageToken,
 	}
 	return response, nil
 }
 
+// applyShortcutView removes the fields of the shortcut that are not in the view.
+func applyShortcutView(shortcut *v1pb.Shortcut, view v1pb.ShortcutView) {
+	if view == v1pb.ShortcutView_SHORTCUT_VIEW_BASIC {
+		shortcut.OgMetadata = nil
+		if shortcut.Summary != "" {
+			shortcut.Description = ""
+		}
+	}
+}
+
 func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcutRequest) (*v1pb.Shortcut, error) {
 	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-		ID: &request.Id,
+		ID:              &request.Id,
+		IncludeArchived: true,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
@@ -57,7 +159,11 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user == nil && shortcut.Visibility != storepb.Visibility_PUBLIC {
+	access, err := s.getShortcutAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if !access.canRead(shortcut) {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -68,22 +174,27 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 	return composedShortcut, nil
 }
 
+// GetShortcutByName returns the shortcut the name resolves to for the caller: their private shortcut first, then the
+// workspace one and then the public one.
 func (s *APIV1Service) GetShortcutByName(ctx context.Context, request *v1pb.GetShortcutByNameRequest) (*v1pb.Shortcut, error) {
-	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-		Name: &request.Name,
-	})
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.resolveShortcutName(ctx, request.Name, user)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
 	}
-	if shortcut == nil {
+	// The expired shortcuts no longer resolve by name.
+	if shortcut == nil || store.IsShortcutExpired(shortcut, time.Now().Unix()) {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
 
-	user, err := getCurrentUser(ctx, s.Store)
+	access, err := s.getShortcutAccess(ctx, user)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		return nil, err
 	}
-	if user == nil && shortcut.Visibility != storepb.Visibility_PUBLIC {
+	if !access.canRead(shortcut) {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -94,46 +205,147 @@ func (s *APIV1Service) GetShortcutByName(ctx context.Context, request *v1pb.GetS
 	return composedShortcut, nil
 }
 
+// resolveShortcutName returns the shortcut the name resolves to for the user, who is nil when anonymous.
+func (s *APIV1Service) resolveShortcutName(ctx context.Context, name string, user *store.User) (*storepb.Shortcut, error) {
+	userID := int32(0)
+	if user != nil {
+		userID = user.ID
+	}
+	return s.Store.ResolveShortcutName(ctx, name, userID)
+}
+
 func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateShortcutRequest) (*v1pb.Shortcut, error) {
-	if request.Shortcut.Name == "" || request.Shortcut.Link == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "name and link are required")
+	if err := validateShortcutCreate(request.Shortcut); err != nil {
+		return nil, err
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkShortcutCreationLimits(ctx, user, 0); err != nil {
+		return nil, err
+	}
+	shortcutCreate, reservation, err := s.prepareShortcutCreate(ctx, user, request)
+	if err != nil {
+		return nil, err
+	}
+	shortcut, err := s.Store.CreateShortcut(ctx, shortcutCreate)
+	if err != nil {
+		// Another create of the name may have been stored since the check.
+		if errors.Is(err, store.ErrShortcutNameExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "shortcut name %q already exists", shortcutCreate.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create shortcut, err: %v", err)
+	}
+	if err := s.completeShortcutCreate(ctx, shortcut, reservation); err != nil {
+		return nil, err
 	}
 
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return composedShortcut, nil
+}
+
+// validateShortcutCreate returns an InvalidArgument error when the fields of the shortcut to create are invalid.
+func validateShortcutCreate(shortcut *v1pb.Shortcut) error {
+	if shortcut.Name == "" || shortcut.Link == "" {
+		return status.Errorf(codes.InvalidArgument, "name and link are required")
+	}
+	if shortcut.RedirectRateLimit < 0 {
+		return status.Errorf(codes.InvalidArgument, "redirect rate limit must not be negative")
+	}
+	if err := validateShortcutExpireTime(shortcut.ExpireTime); err != nil {
+		return err
+	}
+	if err := validateShortcutLocalizations(shortcut.Localizations); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid localizations: %v", err)
+	}
+	return nil
+}
+
+// checkShortcutCreationLimits returns an error when the user can't create a shortcut on top of the pending ones,
+// by the shortcuts limit of the subscription or the cooldown of new users.
+func (s *APIV1Service) checkShortcutCreationLimits(ctx context.Context, user *store.User, pending int) error {
 	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeUnlimitedShortcuts) {
 		shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get shortcut list, err: %v", err)
+			return status.Errorf(codes.Internal, "failed to get shortcut list, err: %v", err)
 		}
 		shortcutsLimit := int(s.LicenseService.GetSubscription().ShortcutsLimit)
-		if len(shortcuts) >= shortcutsLimit {
-			return nil, status.Errorf(codes.PermissionDenied, "Maximum number of shortcuts %d reached", shortcutsLimit)
+		if len(shortcuts)+pending >= shortcutsLimit {
+			return status.Errorf(codes.PermissionDenied, "Maximum number of shortcuts %d reached", shortcutsLimit)
 		}
 	}
+	return s.checkShortcutCreationCooldown(ctx, user, pending)
+}
 
-	user, err := getCurrentUser(ctx, s.Store)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+// prepareShortcutCreate returns the shortcut to store for the request, and the reservation of its name by the user to release once it is created.
+func (s *APIV1Service) prepareShortcutCreate(ctx context.Context, user *store.User, request *v1pb.CreateShortcutRequest) (*storepb.Shortcut, *store.ShortcutNameReservation, error) {
+	if err := s.checkReservedShortcutName(request.Shortcut.Name, user); err != nil {
+		return nil, nil, err
 	}
-	shortcutCreate := &storepb.Shortcut{
-		CreatorId:   user.ID,
-		Name:        request.Shortcut.Name,
-		Link:        request.Shortcut.Link,
-		Title:       request.Shortcut.Title,
-		Tags:        request.Shortcut.Tags,
-		Description: request.Shortcut.Description,
-		Visibility:  convertVisibilityToStorepb(request.Shortcut.Visibility),
-		OgMetadata:  &storepb.OpenGraphMetadata{},
-	}
-	if shortcutCreate.Visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED {
-		workspaceSetting, err := s.GetWorkspaceSetting(ctx, nil)
+	visibility := convertVisibilityToStorepb(request.Shortcut.Visibility)
+	if visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED {
+		shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
+			return nil, nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
 		}
-		visibility := v1pb.Visibility_WORKSPACE
-		if workspaceSetting.DefaultVisibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
-			visibility = workspaceSetting.DefaultVisibility
+		visibility = storepb.Visibility_WORKSPACE
+		if shortcutRelatedSetting.DefaultVisibility != storepb.Visibility_VISIBILITY_UNSPECIFIED {
+			visibility = shortcutRelatedSetting.DefaultVisibility
 		}
-		shortcutCreate.Visibility = convertVisibilityToStorepb(visibility)
+	}
+	// The insert fails on the unique name too, this check only reports it before any other work.
+	existing, err := s.Store.GetShortcutInNameScope(ctx, request.Shortcut.Name, store.GetShortcutNameScope(visibility, user.ID))
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+	}
+	if existing != nil {
+		return nil, nil, status.Errorf(codes.AlreadyExists, "shortcut name %q already exists", request.Shortcut.Name)
+	}
+	reservation, err := s.Store.GetShortcutNameReservation(ctx, &store.FindShortcutNameReservation{
+		Namespace: store.GetWorkspaceID(ctx),
+		Name:      request.Shortcut.Name,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get shortcut name reservation, err: %v", err)
+	}
+	if reservation != nil && reservation.UserID != user.ID {
+		return nil, nil, status.Errorf(codes.AlreadyExists, "shortcut name %q is reserved", request.Shortcut.Name)
+	}
+	link, err := s.normalizeShortcutLink(ctx, request.Shortcut.Link, request.PreserveLinkQueryParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.Store.CheckShortcutRedirectLoop(ctx, request.Shortcut.Name, link); err != nil {
+		if errors.Is(err, store.ErrShortcutRedirectLoop) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid link: %v", err)
+		}
+		return nil, nil, status.Errorf(codes.Internal, "failed to check redirect loop, err: %v", err)
+	}
+	title, faviconURL := request.Shortcut.Title, ""
+	if request.FetchLinkMetadata && title == "" {
+		title, faviconURL = s.fetchLinkMetadata(ctx, link)
+	}
+	shortcutCreate := &storepb.Shortcut{
+		CreatorId:           user.ID,
+		Name:                request.Shortcut.Name,
+		Link:                link,
+		Title:               title,
+		FaviconUrl:          faviconURL,
+		Tags:                request.Shortcut.Tags,
+		Description:         request.Shortcut.Description,
+		Visibility:          visibility,
+		OgMetadata:          &storepb.OpenGraphMetadata{},
+		RedirectRateLimit:   request.Shortcut.RedirectRateLimit,
+		Summary:             s.summarizeDescription(ctx, request.Shortcut.Description),
+		MetaRefreshRedirect: request.Shortcut.MetaRefreshRedirect,
+		Localizations:       convertShortcutLocalizationsToStorepb(request.Shortcut.Localizations),
+	}
+	if request.Shortcut.ExpireTime != nil {
+		shortcutCreate.ExpireTs = request.Shortcut.ExpireTime.AsTime().Unix()
 	}
 	if request.Shortcut.OgMetadata != nil {
 		shortcutCreate.OgMetadata = &storepb.OpenGraphMetadata{
@@ -142,19 +354,95 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 			Image:       request.Shortcut.OgMetadata.Image,
 		}
 	}
-	shortcut, err := s.Store.CreateShortcut(ctx, shortcutCreate)
+	linkShortener, err := s.getLinkShortener(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create shortcut, err: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to get link shortener, err: %v", err)
+	}
+	// Shortener failures fall back to the original link.
+	if link, err := linkShortener.Shorten(ctx, shortcutCreate.Link); err != nil {
+		slog.Warn("failed to shorten link", slog.String("link", shortcutCreate.Link), slog.String("error", err.Error()))
+	} else {
+		shortcutCreate.Link = link
+	}
+	return shortcutCreate, reservation, nil
+}
+
+// completeShortcutCreate releases the reservation of the name of the created shortcut and records its creation.
+func (s *APIV1Service) completeShortcutCreate(ctx context.Context, shortcut *storepb.Shortcut, reservation *store.ShortcutNameReservation) error {
+	if reservation != nil {
+		if err := s.Store.DeleteShortcutNameReservation(ctx, &store.DeleteShortcutNameReservation{
+			Namespace: reservation.Namespace,
+			Name:      reservation.Name,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete shortcut name reservation, err: %v", err)
+		}
 	}
 	if err := s.createShortcutCreateActivity(ctx, shortcut); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create activity, err: %v", err)
+		return status.Errorf(codes.Internal, "failed to create activity, err: %v", err)
 	}
+	s.generateShortcutThumbnail(ctx, shortcut)
+	return nil
+}
 
-	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+func (s *APIV1Service) ApplyShortcut(ctx context.Context, request *v1pb.ApplyShortcutRequest) (*v1pb.ApplyShortcutResponse, error) {
+	if request.Shortcut == nil || request.Shortcut.Name == "" || request.Shortcut.Link == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name and link are required")
+	}
+
+	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	return composedShortcut, nil
+	shortcut, err := s.resolveShortcutName(ctx, request.Shortcut.Name, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+	}
+	if shortcut == nil {
+		created, err := s.CreateShortcut(ctx, &v1pb.CreateShortcutRequest{
+			Shortcut:                request.Shortcut,
+			PreserveLinkQueryParams: request.PreserveLinkQueryParams,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &v1pb.ApplyShortcutResponse{
+			Shortcut: created,
+			Action:   v1pb.ApplyShortcutResponse_CREATED,
+		}, nil
+	}
+	if user == nil || shortcut.CreatorId != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "shortcut %q belongs to another user", shortcut.Name)
+	}
+
+	// Compare the link as it would be stored so that the stripped query parameters aren't a change.
+	applied := proto.Clone(request.Shortcut).(*v1pb.Shortcut)
+	applied.Id = shortcut.Id
+	if applied.Link, err = s.normalizeShortcutLink(ctx, applied.Link, request.PreserveLinkQueryParams); err != nil {
+		return nil, err
+	}
+	paths := diffShortcut(shortcut, applied)
+	if len(paths) == 0 {
+		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+		return &v1pb.ApplyShortcutResponse{
+			Shortcut: composedShortcut,
+			Action:   v1pb.ApplyShortcutResponse_UNCHANGED,
+		}, nil
+	}
+	updated, err := s.UpdateShortcut(ctx, &v1pb.UpdateShortcutRequest{
+		Shortcut:                applied,
+		UpdateMask:              &fieldmaskpb.FieldMask{Paths: paths},
+		PreserveLinkQueryParams: request.PreserveLinkQueryParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ApplyShortcutResponse{
+		Shortcut: updated,
+		Action:   v1pb.ApplyShortcutResponse_UPDATED,
+	}, nil
 }
 
 func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateShortcutRequest) (*v1pb.Shortcut, error) {
@@ -167,7 +455,8 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
 	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-		ID: &request.Shortcut.Id,
+		ID:              &request.Shortcut.Id,
+		IncludeArchived: true,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
@@ -175,29 +464,98 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
-	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	access, err := s.getShortcutAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if !access.canManage(shortcut) {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
+	update, err := s.buildShortcutUpdate(ctx, user, shortcut, request)
+	if err != nil {
+		return nil, err
+	}
+	name := shortcut.Name
+	if update.Name != nil {
+		name = *update.Name
+	}
+	shortcut, err = s.Store.UpdateShortcut(ctx, update)
+	if err != nil {
+		// Another shortcut may have taken the name since the collision check.
+		if errors.Is(err, store.ErrShortcutNameExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "shortcut name %q already exists", name)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
+	}
+	if update.Link != nil {
+		s.generateShortcutThumbnail(ctx, shortcut)
+	}
+
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return composedShortcut, nil
+}
+
+// buildShortcutUpdate returns the store update of the shortcut for the paths of the update mask of the request.
+func (s *APIV1Service) buildShortcutUpdate(ctx context.Context, user *store.User, shortcut *storepb.Shortcut, request *v1pb.UpdateShortcutRequest) (*store.UpdateShortcut, error) {
 	update := &store.UpdateShortcut{
 		ID: shortcut.Id,
 	}
 	for _, path := range request.UpdateMask.Paths {
 		switch path {
+		case "state":
+			rowStatus := ConvertStateToRowStatus(request.Shortcut.State)
+			update.RowStatus = &rowStatus
 		case "name":
+			if request.Shortcut.Name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "name is required")
+			}
 			update.Name = &request.Shortcut.Name
 		case "link":
-			update.Link = &request.Shortcut.Link
+			link, err := s.normalizeShortcutLink(ctx, request.Shortcut.Link, request.PreserveLinkQueryParams)
+			if err != nil {
+				return nil, err
+			}
+			update.Link = &link
 		case "title":
 			update.Title = &request.Shortcut.Title
 		case "description":
 			update.Description = &request.Shortcut.Description
+			summary := s.summarizeDescription(ctx, request.Shortcut.Description)
+			update.Summary = &summary
 		case "tags":
 			tag := strings.Join(request.Shortcut.Tags, " ")
 			update.Tag = &tag
 		case "visibility":
 			visibility := convertVisibilityToStorepb(request.Shortcut.Visibility)
 			update.Visibility = &visibility
+		case "redirect_rate_limit":
+			if request.Shortcut.RedirectRateLimit < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "redirect rate limit must not be negative")
+			}
+			update.RedirectRateLimit = &request.Shortcut.RedirectRateLimit
+		case "meta_refresh_redirect":
+			update.MetaRefreshRedirect = &request.Shortcut.MetaRefreshRedirect
+		case "expire_time":
+			if err := validateShortcutExpireTime(request.Shortcut.ExpireTime); err != nil {
+				return nil, err
+			}
+			expireTs := int64(0)
+			if request.Shortcut.ExpireTime != nil {
+				expireTs = request.Shortcut.ExpireTime.AsTime().Unix()
+			}
+			update.ExpireTs = &expireTs
+		case "localizations":
+			if err := validateShortcutLocalizations(request.Shortcut.Localizations); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid localizations: %v", err)
+			}
+			update.Localizations = convertShortcutLocalizationsToStorepb(request.Shortcut.Localizations)
+			if update.Localizations == nil {
+				update.Localizations = map[string]*storepb.ShortcutLocalization{}
+			}
 		case "og_metadata":
 			if request.Shortcut.OgMetadata != nil {
 				update.OpenGraphMetadata = &storepb.OpenGraphMetadata{
@@ -208,37 +566,114 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 			}
 		}
 	}
-	shortcut, err = s.Store.UpdateShortcut(ctx, update)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
+	// Restoring an archived shortcut takes its name back, which an active shortcut may use by now.
+	restored := shortcut.RowStatus == storepb.RowStatus_ARCHIVED && update.RowStatus != nil && *update.RowStatus == storepb.RowStatus_NORMAL
+	// Changing the visibility from or to private moves the name of an active shortcut to another scope.
+	scope := store.GetShortcutNameScope(shortcut.Visibility, shortcut.CreatorId)
+	if update.Visibility != nil {
+		scope = store.GetShortcutNameScope(*update.Visibility, shortcut.CreatorId)
+	}
+	rescoped := shortcut.RowStatus == storepb.RowStatus_NORMAL && scope != store.GetShortcutNameScope(shortcut.Visibility, shortcut.CreatorId)
+	if update.Name != nil && *update.Name != shortcut.Name {
+		if err := s.checkReservedShortcutName(*update.Name, user); err != nil {
+			return nil, err
+		}
+		if err := s.checkShortcutNameCollision(ctx, *update.Name, scope, user); err != nil {
+			return nil, err
+		}
+	} else if restored || rescoped {
+		if err := s.checkShortcutNameCollision(ctx, shortcut.Name, scope, user); err != nil {
+			return nil, err
+		}
+	}
+	if update.Name != nil || update.Link != nil {
+		name, link := shortcut.Name, shortcut.Link
+		if update.Name != nil {
+			name = *update.Name
+		}
+		if update.Link != nil {
+			link = *update.Link
+		}
+		if err := s.Store.CheckShortcutRedirectLoop(ctx, name, link); err != nil {
+			if errors.Is(err, store.ErrShortcutRedirectLoop) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid link: %v", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to check redirect loop, err: %v", err)
+		}
 	}
+	return update, nil
+}
 
-	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+// validateShortcutExpireTime returns an InvalidArgument error when the expire time is set but not in the future.
+func validateShortcutExpireTime(expireTime *timestamppb.Timestamp) error {
+	if expireTime != nil && !expireTime.AsTime().After(time.Now()) {
+		return status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+	}
+	return nil
+}
+
+// checkShortcutNameCollision returns an AlreadyExists error when the name is used by another active shortcut of the
+// name scope, or reserved by another user than the given one. Archived shortcuts don't hold their name.
+func (s *APIV1Service) checkShortcutNameCollision(ctx context.Context, name string, scope int32, user *store.User) error {
+	existing, err := s.Store.GetShortcutInNameScope(ctx, name, scope)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		return status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
 	}
-	return composedShortcut, nil
+	if existing != nil {
+		return status.Errorf(codes.AlreadyExists, "shortcut name %q already exists", name)
+	}
+	reservation, err := s.Store.GetShortcutNameReservation(ctx, &store.FindShortcutNameReservation{
+		Namespace: store.GetWorkspaceID(ctx),
+		Name:      name,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get shortcut name reservation, err: %v", err)
+	}
+	if reservation != nil && reservation.UserID != user.ID {
+		return status.Errorf(codes.AlreadyExists, "shortcut name %q is reserved", name)
+	}
+	return nil
 }
 
 func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteShortcutRequest) (*emptypb.Empty, error) {
+	if request.Id == 0 && request.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id or name is required")
+	}
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-		ID: &request.Id,
-	})
+	var shortcut *storepb.Shortcut
+	if request.Id != 0 {
+		shortcut, err = s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID:              &request.Id,
+			IncludeArchived: true,
+		})
+	} else {
+		shortcut, err = s.resolveShortcutName(ctx, request.Name, user)
+	}
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
 	}
-	if shortcut == nil {
+	// Archived shortcuts are already deleted unless they are removed permanently.
+	if shortcut == nil || (shortcut.RowStatus == storepb.RowStatus_ARCHIVED && !request.Permanent) {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
-	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	access, err := s.getShortcutAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if !access.canManage(shortcut) {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
-	err = s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
+	if !request.Permanent {
+		if _, err := s.Store.ArchiveShortcut(ctx, shortcut.Id); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to archive shortcut, err: %v", err)
+		}
+		return &emptypb.Empty{}, nil
+	}
+	err = s.Store.SoftDeleteShortcut(ctx, &store.DeleteShortcut{
 		ID: shortcut.Id,
 	})
 	if err != nil {
@@ -247,9 +682,105 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 	return &emptypb.Empty{}, nil
 }
 
+// maxRestoredNameSuffix bounds the suffixes tried when renaming a restored shortcut whose name is taken.
+const maxRestoredNameSuffix = 100
+
+func (s *APIV1Service) RestoreShortcut(ctx context.Context, request *v1pb.RestoreShortcutRequest) (*v1pb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID:              &request.Id,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	if shortcut.RowStatus != storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.FailedPrecondition, "shortcut is not archived")
+	}
+	access, err := s.getShortcutAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if !access.canManage(shortcut) {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	restore := &store.RestoreShortcut{
+		ID: shortcut.Id,
+	}
+	scope := store.GetShortcutNameScope(shortcut.Visibility, shortcut.CreatorId)
+	if collisionErr := s.checkShortcutNameCollision(ctx, shortcut.Name, scope, user); collisionErr != nil {
+		if status.Code(collisionErr) != codes.AlreadyExists {
+			return nil, collisionErr
+		}
+		switch request.NameConflictResolution {
+		case v1pb.RestoreShortcutRequest_RENAME:
+			name, err := s.findRestoredShortcutName(ctx, shortcut.Name, scope, user)
+			if err != nil {
+				return nil, err
+			}
+			restore.Name = &name
+		case v1pb.RestoreShortcutRequest_MERGE:
+			active, err := s.Store.GetShortcutInNameScope(ctx, shortcut.Name, scope)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+			}
+			// The name is only reserved by another user, there is no shortcut to merge into.
+			if active == nil {
+				return nil, collisionErr
+			}
+			if !access.canManage(active) {
+				return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+			}
+			restore.MergeIntoID = &active.Id
+		default:
+			return nil, collisionErr
+		}
+	}
+	name := shortcut.Name
+	if restore.Name != nil {
+		name = *restore.Name
+	}
+	shortcut, err = s.Store.RestoreShortcut(ctx, restore)
+	if err != nil {
+		if errors.Is(err, store.ErrShortcutNameExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "shortcut name %q already exists", name)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to restore shortcut, err: %v", err)
+	}
+
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return composedShortcut, nil
+}
+
+// findRestoredShortcutName returns the name with the first numbered suffix that collides with no active shortcut of the scope.
+func (s *APIV1Service) findRestoredShortcutName(ctx context.Context, name string, scope int32, user *store.User) (string, error) {
+	for suffix := 2; suffix <= maxRestoredNameSuffix; suffix++ {
+		candidate := fmt.Sprintf("%s-%d", name, suffix)
+		err := s.checkShortcutNameCollision(ctx, candidate, scope, user)
+		if err == nil {
+			return candidate, nil
+		}
+		if status.Code(err) != codes.AlreadyExists {
+			return "", err
+		}
+	}
+	return "", status.Errorf(codes.AlreadyExists, "no available name for shortcut %q", name)
+}
+
 func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.GetShortcutAnalyticsRequest) (*v1pb.GetShortcutAnalyticsResponse, error) {
 	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-		ID: &request.Id,
+		ID:              &request.Id,
+		IncludeArchived: true,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
@@ -258,56 +789,371 @@ func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.G
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
 
-	activityFind := &store.FindActivity{
-		Type:              store.ActivityShortcutView,
-		PayloadShortcutID: &shortcut.Id,
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil || (shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "shortcut analytics are only available to the creator and admins")
+	}
+
+	find := &store.FindShortcutViewStats{
+		ShortcutID: shortcut.Id,
+	}
+	if request.StartTime != nil {
+		createdTsAfter := request.StartTime.AsTime().Unix()
+		find.CreatedTsAfter = &createdTsAfter
+	}
+	if request.EndTime != nil {
+		createdTsBefore := request.EndTime.AsTime().Unix()
+		if find.CreatedTsAfter != nil && createdTsBefore <= *find.CreatedTsAfter {
+			return nil, status.Errorf(codes.InvalidArgument, "end time must be after start time")
+		}
+		find.CreatedTsBefore = &createdTsBefore
 	}
 	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeAdvancedAnalytics) {
 		createdTsAfter := time.Now().AddDate(0, 0, -14).Unix()
-		activityFind.CreatedTsAfter = &createdTsAfter
+		if find.CreatedTsAfter == nil || *find.CreatedTsAfter < createdTsAfter {
+			find.CreatedTsAfter = &createdTsAfter
+		}
 	}
-	activities, err := s.Store.ListActivities(ctx, activityFind)
+	stats, err := s.Store.GetShortcutViewStats(ctx, find)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get activities, err: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut view stats: %v", err)
 	}
 
 	referenceMap := make(map[string]int32)
+	for _, referer := range stats.Referers {
+		referenceMap[referer.Key] += referer.Count
+	}
+	// The user agents are aggregated in SQL, so each distinct one is parsed once.
 	deviceMap := make(map[string]int32)
 	browserMap := make(map[string]int32)
-	for _, activity := range activities {
-		payload := &storepb.ActivityShorcutViewPayload{}
-		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
-			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal payload, err: %v", err))
-		}
+	for _, userAgent := range stats.UserAgents {
+		ua := useragent.New(userAgent.Key)
+		deviceName := ua.OSInfo().Name
+		browserName, _ := ua.Browser()
+		deviceMap[deviceName] += userAgent.Count
+		browserMap[browserName] += userAgent.Count
+	}
+	dailyCounts := []*v1pb.GetShortcutAnalyticsResponse_DailyCount{}
+	for _, day := range stats.Days {
+		dailyCounts = append(dailyCounts, &v1pb.GetShortcutAnalyticsResponse_DailyCount{
+			Date:  day.Key,
+			Count: day.Count,
+		})
+	}
+
+	response := &v1pb.GetShortcutAnalyticsResponse{
+		References:  mapToAnalyticsSlice(referenceMap),
+		Devices:     mapToAnalyticsSlice(deviceMap),
+		Browsers:    mapToAnalyticsSlice(browserMap),
+		TotalClicks: stats.Total,
+		DailyCounts: dailyCounts,
+	}
+	return response, nil
+}
 
-		if _, ok := referenceMap[payload.Referer]; !ok {
-			referenceMap[payload.Referer] = 0
+func (s *APIV1Service) ListShortcutAccess(ctx context.Context, request *v1pb.ListShortcutAccessRequest) (*v1pb.ListShortcutAccessResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID:              &request.Id,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	if user == nil || (shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	response := &v1pb.ListShortcutAccessResponse{}
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID:              &shortcut.CreatorId,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get creator: %v", err)
+	}
+	if creator != nil {
+		response.Accesses = append(response.Accesses, &v1pb.ListShortcutAccessResponse_Access{
+			User:   convertUserFromStore(creator),
+			Reason: v1pb.ListShortcutAccessResponse_OWNER,
+		})
+	}
+	adminRole, normalStatus := store.RoleAdmin, storepb.RowStatus_NORMAL
+	admins, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Role:      &adminRole,
+		RowStatus: &normalStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list admins: %v", err)
+	}
+	listed := map[int32]bool{shortcut.CreatorId: true}
+	for _, admin := range admins {
+		if listed[admin.ID] {
+			continue
+		}
+		listed[admin.ID] = true
+		response.Accesses = append(response.Accesses, &v1pb.ListShortcutAccessResponse_Access{
+			User:   convertUserFromStore(admin),
+			Reason: v1pb.ListShortcutAccessResponse_ADMIN,
+		})
+	}
+	tagPolicies, mostRestrictive, err := s.getTagPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	restricted := false
+	for _, tagPolicy := range tagPolicies {
+		if !slices.Contains(shortcut.Tags, tagPolicy.Tag) {
+			continue
+		}
+		restricted = true
+		if listed[tagPolicy.UserID] {
+			continue
+		}
+		listed[tagPolicy.UserID] = true
+		grantedUser, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &tagPolicy.UserID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if grantedUser == nil {
+			continue
+		}
+		if newShortcutAccess(grantedUser, tagPolicies, mostRestrictive).canRead(shortcut) {
+			response.Accesses = append(response.Accesses, &v1pb.ListShortcutAccessResponse_Access{
+				User:   convertUserFromStore(grantedUser),
+				Reason: v1pb.ListShortcutAccessResponse_TAG_POLICY,
+			})
+		}
+	}
+	switch shortcut.Visibility {
+	case storepb.Visibility_WORKSPACE:
+		if !restricted {
+			response.Audience = v1pb.ListShortcutAccessResponse_WORKSPACE_USERS
 		}
-		referenceMap[payload.Referer]++
+	case storepb.Visibility_PUBLIC:
+		response.Audience = v1pb.ListShortcutAccessResponse_EVERYONE
+	}
+	return response, nil
+}
 
-		ua := useragent.New(payload.UserAgent)
-		deviceName := ua.OSInfo().Name
-		browserName, _ := ua.Browser()
+func (s *APIV1Service) ImportShortcutsCSV(ctx context.Context, request *v1pb.ImportShortcutsCSVRequest) (*v1pb.ImportShortcutsCSVResponse, error) {
+	plans, err := s.planShortcutsImport(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
 
-		if _, ok := deviceMap[deviceName]; !ok {
-			deviceMap[deviceName] = 0
+	response := &v1pb.ImportShortcutsCSVResponse{
+		Results: []*v1pb.ImportShortcutsCSVResponse_Result{},
+	}
+	importShortcuts := &store.ImportShortcuts{}
+	createResults, updateResults := []*v1pb.ImportShortcutsCSVResponse_Result{}, []*v1pb.ImportShortcutsCSVResponse_Result{}
+	reservations := []*store.ShortcutNameReservation{}
+	// The limits only get stricter with each create, so the first failure fails the rest of the creates.
+	var limitErr error
+	for _, plan := range plans {
+		result := &v1pb.ImportShortcutsCSVResponse_Result{
+			Row:    plan.row.line,
+			Error:  plan.err,
+			Action: plan.action,
 		}
-		deviceMap[deviceName]++
+		response.Results = append(response.Results, result)
 
-		if _, ok := browserMap[browserName]; !ok {
-			browserMap[browserName] = 0
+		var err error
+		switch plan.action {
+		case v1pb.ImportAction_IMPORT_ACTION_CREATE:
+			if err = validateShortcutCreate(plan.row.shortcut); err != nil {
+				break
+			}
+			if limitErr == nil {
+				limitErr = s.checkShortcutCreationLimits(ctx, user, len(importShortcuts.Creates))
+			}
+			if err = limitErr; err != nil {
+				break
+			}
+			var shortcutCreate *storepb.Shortcut
+			var reservation *store.ShortcutNameReservation
+			if shortcutCreate, reservation, err = s.prepareShortcutCreate(ctx, user, &v1pb.CreateShortcutRequest{
+				Shortcut: plan.row.shortcut,
+			}); err != nil {
+				break
+			}
+			importShortcuts.Creates = append(importShortcuts.Creates, shortcutCreate)
+			createResults = append(createResults, result)
+			reservations = append(reservations, reservation)
+		case v1pb.ImportAction_IMPORT_ACTION_UPDATE:
+			plan.row.shortcut.Id = plan.existing.Id
+			var update *store.UpdateShortcut
+			if update, err = s.buildShortcutUpdate(ctx, user, plan.existing, &v1pb.UpdateShortcutRequest{
+				Shortcut:   plan.row.shortcut,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: plan.updatePaths},
+			}); err != nil {
+				break
+			}
+			importShortcuts.Updates = append(importShortcuts.Updates, update)
+			updateResults = append(updateResults, result)
+		}
+		if err != nil {
+			if status.Code(err) == codes.Internal {
+				return nil, err
+			}
+			result.Action = v1pb.ImportAction_IMPORT_ACTION_ERROR
+			result.Error = status.Convert(err).Message()
 		}
-		browserMap[browserName]++
 	}
 
-	response := &v1pb.GetShortcutAnalyticsResponse{
-		References: mapToAnalyticsSlice(referenceMap),
-		Devices:    mapToAnalyticsSlice(deviceMap),
-		Browsers:   mapToAnalyticsSlice(browserMap),
+	created, updated, err := s.Store.ImportShortcuts(ctx, importShortcuts)
+	if err != nil {
+		if errors.Is(err, store.ErrShortcutNameExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "failed to import shortcuts: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to import shortcuts, err: %v", err)
+	}
+	for i, shortcut := range created {
+		if err := s.completeShortcutCreate(ctx, shortcut, reservations[i]); err != nil {
+			return nil, err
+		}
+		if createResults[i].Shortcut, err = s.convertShortcutFromStorepb(ctx, shortcut); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+	}
+	for i, shortcut := range updated {
+		if updateResults[i].Shortcut, err = s.convertShortcutFromStorepb(ctx, shortcut); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+		}
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) PreviewImport(ctx context.Context, request *v1pb.PreviewImportRequest) (*v1pb.PreviewImportResponse, error) {
+	if request.Request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "import request is required")
+	}
+	plans, err := s.planShortcutsImport(ctx, request.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.PreviewImportResponse{
+		Entries: []*v1pb.PreviewImportResponse_Entry{},
+	}
+	for _, plan := range plans {
+		entry := &v1pb.PreviewImportResponse_Entry{
+			Row:         plan.row.line,
+			Action:      plan.action,
+			UpdatePaths: plan.updatePaths,
+			Error:       plan.err,
+		}
+		if plan.row.shortcut != nil {
+			entry.Name = plan.row.shortcut.Name
+		}
+		response.Entries = append(response.Entries, entry)
 	}
 	return response, nil
 }
 
+// shortcutImportPlan is what importing a CSV row does against the current shortcuts.
+type shortcutImportPlan struct {
+	row    *shortcutCSVRow
+	action v1pb.ImportAction
+	// existing is the shortcut with the name of the row, if any.
+	existing    *storepb.Shortcut
+	updatePaths []string
+	err         string
+}
+
+// planShortcutsImport classifies the rows of the CSV import by the collision strategy, without writing anything.
+func (s *APIV1Service) planShortcutsImport(ctx context.Context, request *v1pb.ImportShortcutsCSVRequest) ([]*shortcutImportPlan, error) {
+	rows, err := parseShortcutsCSV(request.Content, request.Delimiter, request.HasHeader, request.ColumnMapping)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse csv: %v", err)
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	mappedFields := map[string]bool{}
+	for _, field := range request.ColumnMapping {
+		mappedFields[field] = true
+	}
+
+	plans := []*shortcutImportPlan{}
+	names := map[string]bool{}
+	for _, row := range rows {
+		plan := &shortcutImportPlan{
+			row:    row,
+			action: v1pb.ImportAction_IMPORT_ACTION_ERROR,
+			err:    row.err,
+		}
+		plans = append(plans, plan)
+		if row.err != "" {
+			continue
+		}
+		if row.shortcut.Name == "" || row.shortcut.Link == "" {
+			plan.err = "name and link are required"
+			continue
+		}
+		if !util.ValidateURI(row.shortcut.Link) {
+			plan.err = fmt.Sprintf("invalid link %q", row.shortcut.Link)
+			continue
+		}
+		if names[row.shortcut.Name] {
+			plan.err = fmt.Sprintf("duplicate shortcut name %q in the file", row.shortcut.Name)
+			continue
+		}
+		names[row.shortcut.Name] = true
+		if row.shortcut.Link, err = s.normalizeShortcutLink(ctx, row.shortcut.Link, false); err != nil {
+			return nil, err
+		}
+
+		existing, err := s.resolveShortcutName(ctx, row.shortcut.Name, user)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+		}
+		if existing == nil {
+			plan.action = v1pb.ImportAction_IMPORT_ACTION_CREATE
+			continue
+		}
+		plan.existing = existing
+		switch request.CollisionStrategy {
+		case v1pb.ImportShortcutsCSVRequest_SKIP:
+			plan.action = v1pb.ImportAction_IMPORT_ACTION_SKIP
+		case v1pb.ImportShortcutsCSVRequest_OVERWRITE:
+			if user == nil || (existing.CreatorId != user.ID && user.Role != store.RoleAdmin) {
+				plan.err = fmt.Sprintf("shortcut %q belongs to another user", existing.Name)
+				continue
+			}
+			for _, path := range diffShortcut(existing, row.shortcut) {
+				if mappedFields[path] {
+					plan.updatePaths = append(plan.updatePaths, path)
+				}
+			}
+			if len(plan.updatePaths) == 0 {
+				plan.action = v1pb.ImportAction_IMPORT_ACTION_SKIP
+			} else {
+				plan.action = v1pb.ImportAction_IMPORT_ACTION_UPDATE
+			}
+		default:
+			plan.err = fmt.Sprintf("shortcut %q already exists", existing.Name)
+		}
+	}
+	return plans, nil
+}
+
 func mapToAnalyticsSlice(m map[string]int32) []*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem {
 	analyticsSlice := make([]*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem, 0)
 	for key, value := range m {
@@ -343,12 +1189,61 @@ func (s *APIV1Service) createShortcutCreateActivity(ctx context.Context, shortcu
 	return nil
 }
 
+// summarizeDescription returns the summary of long descriptions, and an empty one for short descriptions.
+// Summarizer failures fall back to truncating the description.
+func (s *APIV1Service) summarizeDescription(ctx context.Context, description string) string {
+	if utf8.RuneCountInString(description) <= summarizedDescriptionLength {
+		return ""
+	}
+	var descriptionSummarizer summarizer.Summarizer = summarizer.NoopSummarizer{}
+	if s.Summarizer != nil {
+		descriptionSummarizer = s.Summarizer
+	}
+	summary, err := descriptionSummarizer.Summarize(ctx, description, maxSummaryLength)
+	if err != nil {
+		slog.Warn("failed to summarize description", slog.String("error", err.Error()))
+		summary = description
+	}
+	return summarizer.Truncate(summary, maxSummaryLength)
+}
+
+// fetchLinkMetadata returns the title and favicon of the page of the link, empty when the fetch fails.
+func (s *APIV1Service) fetchLinkMetadata(ctx context.Context, link string) (string, string) {
+	var fetcher httpgetter.LinkMetadataFetcher = s.LinkMetadataFetcher
+	if fetcher == nil {
+		fetcher = httpgetter.NewSafeFetcher(httpgetter.DefaultFetchTimeout, httpgetter.DefaultMaxBodySize)
+	}
+	linkMetadata, err := fetcher.FetchLinkMetadata(ctx, link)
+	if err != nil {
+		slog.Warn("failed to fetch link metadata", slog.String("link", link), slog.String("error", err.Error()))
+		return "", ""
+	}
+	return linkMetadata.Title, linkMetadata.FaviconURL
+}
+
+// getLinkShortener returns the link shortener of the workspace, a no-op one when it isn't configured.
+func (s *APIV1Service) getLinkShortener(ctx context.Context) (shortener.LinkShortener, error) {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := shortcutRelatedSetting.GetLinkShortenerUrl()
+	if endpoint == "" {
+		return shortener.NoopShortener{}, nil
+	}
+	if s.LinkShortener != nil {
+		return s.LinkShortener, nil
+	}
+	return shortener.NewHTTPShortener(endpoint), nil
+}
+
 func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut *storepb.Shortcut) (*v1pb.Shortcut, error) {
 	composedShortcut := &v1pb.Shortcut{
 		Id:          shortcut.Id,
 		CreatorId:   shortcut.CreatorId,
 		CreatedTime: timestamppb.New(time.Unix(shortcut.CreatedTs, 0)),
 		UpdatedTime: timestamppb.New(time.Unix(shortcut.UpdatedTs, 0)),
+		State:       convertStateFromRowStatus(shortcut.RowStatus),
 		Name:        shortcut.Name,
 		Link:        shortcut.Link,
 		Title:       shortcut.Title,
@@ -360,17 +1255,344 @@ func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut
 			Description: shortcut.OgMetadata.Description,
 			Image:       shortcut.OgMetadata.Image,
 		},
+		RedirectRateLimit:   shortcut.RedirectRateLimit,
+		Summary:             shortcut.Summary,
+		MetaRefreshRedirect: shortcut.MetaRefreshRedirect,
+		Localizations:       convertShortcutLocalizationsFromStorepb(shortcut.Localizations),
+		FaviconUrl:          shortcut.FaviconUrl,
 	}
 
-	activityList, err := s.Store.ListActivities(ctx, &store.FindActivity{
-		Type:              store.ActivityShortcutView,
-		Level:             store.ActivityInfo,
-		PayloadShortcutID: &composedShortcut.Id,
-	})
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to list activities")
+	composedShortcut.ViewCount = shortcut.ViewCount
+	if shortcut.LastViewedTs > 0 {
+		composedShortcut.LastViewTime = timestamppb.New(time.Unix(shortcut.LastViewedTs, 0))
+	}
+	if shortcut.ExpireTs > 0 {
+		composedShortcut.ExpireTime = timestamppb.New(time.Unix(shortcut.ExpireTs, 0))
 	}
-	composedShortcut.ViewCount = int32(len(activityList))
+	if err := s.applyViewCountPrivacy(ctx, shortcut, composedShortcut); err != nil {
+		return nil, err
+	}
+	localizeShortcut(composedShortcut, getAcceptLanguage(ctx))
 
 	return composedShortcut, nil
 }
+
+// applyViewCountPrivacy buckets or hides the view count with the workspace view_count_privacy
+// unless the caller is the creator of the shortcut or an admin.
+func (s *APIV1Service) applyViewCountPrivacy(ctx context.Context, shortcut *storepb.Shortcut, composedShortcut *v1pb.Shortcut) error {
+	privacy, err := s.getViewCountPrivacy(ctx, shortcut)
+	if err != nil {
+		return err
+	}
+	switch privacy {
+	case storepb.WorkspaceSetting_ShortcutRelatedSetting_BUCKET_VIEW_COUNT:
+		composedShortcut.ViewCount, composedShortcut.ViewCountRange = bucketViewCount(composedShortcut.ViewCount)
+		composedShortcut.LastViewTime = nil
+	case storepb.WorkspaceSetting_ShortcutRelatedSetting_HIDE_VIEW_COUNT:
+		composedShortcut.ViewCount = 0
+		composedShortcut.ViewCountHidden = true
+		composedShortcut.LastViewTime = nil
+	}
+	return nil
+}
+
+// getViewCountPrivacy returns the view count privacy applied to the caller for the shortcut.
+func (s *APIV1Service) getViewCountPrivacy(ctx context.Context, shortcut *storepb.Shortcut) (storepb.WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy, error) {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to get workspace shortcut related setting")
+	}
+	privacy := shortcutRelatedSetting.GetViewCountPrivacy()
+	if privacy == storepb.WorkspaceSetting_ShortcutRelatedSetting_VIEW_COUNT_PRIVACY_UNSPECIFIED {
+		return privacy, nil
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to get current user")
+	}
+	if user != nil && (user.ID == shortcut.CreatorId || user.Role == store.RoleAdmin) {
+		return storepb.WorkspaceSetting_ShortcutRelatedSetting_VIEW_COUNT_PRIVACY_UNSPECIFIED, nil
+	}
+	return privacy, nil
+}
+
+// localizeShortcut replaces the title and description of the shortcut with the localization
+// best matching the Accept-Language, keeping the default ones for the empty fields of the localization.
+func localizeShortcut(shortcut *v1pb.Shortcut, acceptLanguage string) {
+	localization := matchShortcutLocalization(acceptLanguage, shortcut.Localizations)
+	if localization == nil {
+		return
+	}
+	if localization.Title != "" {
+		shortcut.Title = localization.Title
+	}
+	if localization.Description != "" {
+		shortcut.Description = localization.Description
+	}
+}
+
+func matchShortcutLocalization(acceptLanguage string, localizations map[string]*v1pb.Shortcut_Localization) *v1pb.Shortcut_Localization {
+	if len(localizations) == 0 {
+		return nil
+	}
+	locales := make([]string, 0, len(localizations))
+	for locale := range localizations {
+		locales = append(locales, locale)
+	}
+	locale, ok := util.MatchLocale(acceptLanguage, locales)
+	if !ok {
+		return nil
+	}
+	return localizations[locale]
+}
+
+// getAcceptLanguage returns the Accept-Language of the request from the request metadata.
+func getAcceptLanguage(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := append(md.Get("grpcgateway-accept-language"), md.Get("accept-language")...); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func validateShortcutLocalizations(localizations map[string]*v1pb.Shortcut_Localization) error {
+	for locale := range localizations {
+		if _, err := language.Parse(locale); err != nil {
+			return errors.Errorf("invalid locale %q", locale)
+		}
+	}
+	return nil
+}
+
+func convertShortcutLocalizationsToStorepb(localizations map[string]*v1pb.Shortcut_Localization) map[string]*storepb.ShortcutLocalization {
+	if len(localizations) == 0 {
+		return nil
+	}
+	result := map[string]*storepb.ShortcutLocalization{}
+	for locale, localization := range localizations {
+		result[locale] = &storepb.ShortcutLocalization{
+			Title:       localization.GetTitle(),
+			Description: localization.GetDescription(),
+		}
+	}
+	return result
+}
+
+func convertShortcutLocalizationsFromStorepb(localizations map[string]*storepb.ShortcutLocalization) map[string]*v1pb.Shortcut_Localization {
+	if len(localizations) == 0 {
+		return nil
+	}
+	result := map[string]*v1pb.Shortcut_Localization{}
+	for locale, localization := range localizations {
+		result[locale] = &v1pb.Shortcut_Localization{
+			Title:       localization.Title,
+			Description: localization.Description,
+		}
+	}
+	return result
+}
+
+// bucketViewCount returns the lower bound and the label of the power of ten range of the view count,
+// e.g. 0 and "<10" for 7, and 100 and "100+" for 345.
+func bucketViewCount(viewCount int32) (int32, string) {
+	if viewCount < 10 {
+		return 0, "<10"
+	}
+	bound := int32(10)
+	for bound <= math.MaxInt32/10 && bound*10 <= viewCount {
+		bound *= 10
+	}
+	return bound, fmt.Sprintf("%d+", bound)
+}
+
+var importableShortcutFields = []string{"name", "link", "title", "description", "tags"}
+
+type shortcutCSVRow struct {
+	// line is the one-based line number of the row.
+	line     int32
+	shortcut *v1pb.Shortcut
+	err      string
+}
+
+// parseShortcutsCSV parses the CSV content into shortcuts with the column to field mapping.
+// Malformed rows are returned with an error instead of failing the whole file.
+func parseShortcutsCSV(content []byte, delimiter string, hasHeader bool, columnMapping map[string]string) ([]*shortcutCSVRow, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if delimiter != "" {
+		runes := []rune(delimiter)
+		if len(runes) != 1 || runes[0] == '"' || runes[0] == '\r' || runes[0] == '\n' {
+			return nil, errors.Errorf("invalid delimiter %q", delimiter)
+		}
+		reader.Comma = runes[0]
+	}
+
+	mappedFields := map[string]bool{}
+	for column, field := range columnMapping {
+		if !slices.Contains(importableShortcutFields, field) {
+			return nil, errors.Errorf("invalid field %q for column %q", field, column)
+		}
+		if mappedFields[field] {
+			return nil, errors.Errorf("field %q is mapped more than once", field)
+		}
+		mappedFields[field] = true
+	}
+	if !mappedFields["name"] || !mappedFields["link"] {
+		return nil, errors.New("name and link columns are required")
+	}
+
+	// fieldIndexes maps the shortcut fields to the column indexes.
+	fieldIndexes := map[string]int{}
+	if hasHeader {
+		header, err := reader.Read()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read header row")
+		}
+		for index, column := range header {
+			if field, ok := columnMapping[strings.TrimSpace(column)]; ok {
+				fieldIndexes[field] = index
+			}
+		}
+	} else {
+		for column, field := range columnMapping {
+			index, err := strconv.Atoi(column)
+			if err != nil || index < 0 {
+				return nil, errors.Errorf("invalid column index %q", column)
+			}
+			fieldIndexes[field] = index
+		}
+	}
+	for field := range mappedFields {
+		if _, ok := fieldIndexes[field]; !ok {
+			return nil, errors.Errorf("column for field %q not found in header", field)
+		}
+	}
+
+	rows := []*shortcutCSVRow{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			parseError := &csv.ParseError{}
+			if !errors.As(err, &parseError) {
+				return nil, err
+			}
+			rows = append(rows, &shortcutCSVRow{
+				line: int32(parseError.StartLine),
+				err:  parseError.Err.Error(),
+			})
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &shortcutCSVRow{
+			line:     int32(line),
+			shortcut: &v1pb.Shortcut{},
+		}
+		for field, index := range fieldIndexes {
+			if index >= len(record) {
+				row.err = fmt.Sprintf("missing column %d for field %q", index, field)
+				break
+			}
+			value := strings.TrimSpace(record[index])
+			switch field {
+			case "name":
+				row.shortcut.Name = value
+			case "link":
+				row.shortcut.Link = value
+			case "title":
+				row.shortcut.Title = value
+			case "description":
+				row.shortcut.Description = value
+			case "tags":
+				row.shortcut.Tags = strings.Fields(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// shortcutOrderFields is the sortable fields of ListShortcuts.
+var shortcutOrderFields = map[string]store.ShortcutOrderField{
+	"name":       store.ShortcutOrderFieldName,
+	"title":      store.ShortcutOrderFieldTitle,
+	"created_ts": store.ShortcutOrderFieldCreatedTs,
+	"updated_ts": store.ShortcutOrderFieldUpdatedTs,
+}
+
+// parseShortcutOrderBy parses a comma separated list of fields, each optionally followed by "asc" or "desc".
+// An empty string means the store default order.
+func parseShortcutOrderBy(orderBy string) ([]*store.ShortcutOrderBy, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+	list := []*store.ShortcutOrderBy{}
+	for _, item := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(item)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, errors.Errorf("invalid order %q", strings.TrimSpace(item))
+		}
+		field, ok := shortcutOrderFields[parts[0]]
+		if !ok {
+			return nil, errors.Errorf("unsupported order field %q", parts[0])
+		}
+		if slices.ContainsFunc(list, func(v *store.ShortcutOrderBy) bool { return v.Field == field }) {
+			return nil, errors.Errorf("duplicate order field %q", parts[0])
+		}
+		shortcutOrderBy := &store.ShortcutOrderBy{Field: field}
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				shortcutOrderBy.Desc = true
+			default:
+				return nil, errors.Errorf("invalid order direction %q", parts[1])
+			}
+		}
+		list = append(list, shortcutOrderBy)
+	}
+	return list, nil
+}
+
+// diffShortcut returns the update mask paths of the fields in the desired shortcut that differ from the current one.
+// Unspecified visibility, and absent og_metadata and localizations keep the current values.
+func diffShortcut(current *storepb.Shortcut, desired *v1pb.Shortcut) []string {
+	paths := []string{}
+	if current.Link != desired.Link {
+		paths = append(paths, "link")
+	}
+	if current.Title != desired.Title {
+		paths = append(paths, "title")
+	}
+	if current.Description != desired.Description {
+		paths = append(paths, "description")
+	}
+	if strings.Join(current.Tags, " ") != strings.Join(desired.Tags, " ") {
+		paths = append(paths, "tags")
+	}
+	if desired.Visibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED && current.Visibility != convertVisibilityToStorepb(desired.Visibility) {
+		paths = append(paths, "visibility")
+	}
+	if current.RedirectRateLimit != desired.RedirectRateLimit {
+		paths = append(paths, "redirect_rate_limit")
+	}
+	if current.MetaRefreshRedirect != desired.MetaRefreshRedirect {
+		paths = append(paths, "meta_refresh_redirect")
+	}
+	if len(desired.Localizations) > 0 && !maps.EqualFunc(current.Localizations, desired.Localizations, func(c *storepb.ShortcutLocalization, d *v1pb.Shortcut_Localization) bool {
+		return c.GetTitle() == d.GetTitle() && c.GetDescription() == d.GetDescription()
+	}) {
+		paths = append(paths, "localizations")
+	}
+	if desired.OgMetadata != nil {
+		currentOgMetadata := current.GetOgMetadata()
+		if currentOgMetadata.GetTitle() != desired.OgMetadata.Title || currentOgMetadata.GetDescription() != desired.OgMetadata.Description || currentOgMetadata.GetImage() != desired.OgMetadata.Image {
+			paths = append(paths, "og_metadata")
+		}
+	}
+	return paths
+}
@@ -0,0 +1,1439 @@
+package v1
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yourselfhosted/slash/plugin/httpgetter"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestParseShortcutsCSV(t *testing.T) {
+	content := "Name,URL,Title,Labels\n" +
+		"docs,https://example.com/docs,\"Docs, and more\",\"work docs\"\n" +
+		"\"quoted\",\"https://example.com/?a=1,2\",\"He said \"\"hi\"\"\",\n"
+	rows, err := parseShortcutsCSV([]byte(content), "", true, map[string]string{
+		"Name":   "name",
+		"URL":    "link",
+		"Title":  "title",
+		"Labels": "tags",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rows))
+	require.Equal(t, int32(2), rows[0].line)
+	require.Empty(t, rows[0].err)
+	require.Equal(t, "docs", rows[0].shortcut.Name)
+	require.Equal(t, "Docs, and more", rows[0].shortcut.Title)
+	require.Equal(t, []string{"work", "docs"}, rows[0].shortcut.Tags)
+	require.Equal(t, int32(3), rows[1].line)
+	require.Equal(t, "https://example.com/?a=1,2", rows[1].shortcut.Link)
+	require.Equal(t, `He said "hi"`, rows[1].shortcut.Title)
+
+	// Custom delimiter without a header row.
+	rows, err = parseShortcutsCSV([]byte("https://example.com;example;An example\n"), ";", false, map[string]string{
+		"0": "link",
+		"1": "name",
+		"2": "description",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows))
+	require.Equal(t, "example", rows[0].shortcut.Name)
+	require.Equal(t, "https://example.com", rows[0].shortcut.Link)
+	require.Equal(t, "An example", rows[0].shortcut.Description)
+}
+
+func TestParseShortcutsCSVMalformed(t *testing.T) {
+	mapping := map[string]string{"name": "name", "link": "link"}
+	content := "name,link\n" +
+		"ok,https://example.com\n" +
+		"short\n" +
+		"bad \"quote,https://example.com\n" +
+		"ok2,https://example.com/2\n"
+	rows, err := parseShortcutsCSV([]byte(content), "", true, mapping)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(rows))
+	require.Empty(t, rows[0].err)
+	require.Equal(t, int32(3), rows[1].line)
+	require.NotEmpty(t, rows[1].err)
+	require.Equal(t, int32(4), rows[2].line)
+	require.NotEmpty(t, rows[2].err)
+	require.Equal(t, int32(5), rows[3].line)
+	require.Empty(t, rows[3].err)
+
+	_, err = parseShortcutsCSV([]byte(content), "", true, map[string]string{"name": "name"})
+	require.Error(t, err)
+	_, err = parseShortcutsCSV([]byte(content), "", true, map[string]string{"name": "name", "link": "link", "x": "unknown"})
+	require.Error(t, err)
+	_, err = parseShortcutsCSV([]byte(content), "", true, map[string]string{"name": "name", "url": "link"})
+	require.Error(t, err)
+	_, err = parseShortcutsCSV([]byte(content), "\n", true, mapping)
+	require.Error(t, err)
+	_, err = parseShortcutsCSV([]byte(content), "", false, mapping)
+	require.Error(t, err)
+}
+
+func TestImportShortcutsCSV(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	content := "name,link\n" +
+		"first,https://example.com/1\n" +
+		"no-link,\n" +
+		"first,https://example.com/duplicate\n" +
+		"second,https://example.com/2\n" +
+		"malformed,example.com/3\n"
+	response, err := s.ImportShortcutsCSV(withUser(ctx, user), &v1pb.ImportShortcutsCSVRequest{
+		Content:       []byte(content),
+		HasHeader:     true,
+		ColumnMapping: map[string]string{"name": "name", "link": "link"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, len(response.Results))
+	require.NotNil(t, response.Results[0].Shortcut)
+	require.Equal(t, int32(3), response.Results[1].Row)
+	require.Equal(t, "name and link are required", response.Results[1].Error)
+	require.Equal(t, int32(4), response.Results[2].Row)
+	require.NotEmpty(t, response.Results[2].Error)
+	require.Equal(t, "second", response.Results[3].Shortcut.Name)
+	require.Equal(t, v1pb.ImportAction_IMPORT_ACTION_ERROR, response.Results[4].Action)
+	require.Equal(t, `invalid link "example.com/3"`, response.Results[4].Error)
+
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+}
+
+func TestPreviewImport(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	for _, shortcut := range []*v1pb.Shortcut{
+		{Name: "changed", Link: "https://example.com/old", Title: "Kept"},
+		{Name: "unchanged", Link: "https://example.com/unchanged"},
+	} {
+		_, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{Shortcut: shortcut})
+		require.NoError(t, err)
+	}
+	_, err := s.CreateShortcut(withUser(ctx, other), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "others", Link: "https://example.com/others"},
+	})
+	require.NoError(t, err)
+	content := "name,link\n" +
+		"new,https://example.com/new\n" +
+		"changed,https://example.com/new\n" +
+		"unchanged,https://example.com/unchanged\n" +
+		"others,https://example.com/mine\n" +
+		"new,https://example.com/duplicate\n" +
+		"no-link,\n"
+	newRequest := func(strategy v1pb.ImportShortcutsCSVRequest_CollisionStrategy) *v1pb.ImportShortcutsCSVRequest {
+		return &v1pb.ImportShortcutsCSVRequest{
+			Content:           []byte(content),
+			HasHeader:         true,
+			ColumnMapping:     map[string]string{"name": "name", "link": "link"},
+			CollisionStrategy: strategy,
+		}
+	}
+	preview := func(strategy v1pb.ImportShortcutsCSVRequest_CollisionStrategy) []v1pb.ImportAction {
+		response, err := s.PreviewImport(userCtx, &v1pb.PreviewImportRequest{Request: newRequest(strategy)})
+		require.NoError(t, err)
+		actions := []v1pb.ImportAction{}
+		for _, entry := range response.Entries {
+			actions = append(actions, entry.Action)
+		}
+		return actions
+	}
+	const (
+		create  = v1pb.ImportAction_IMPORT_ACTION_CREATE
+		update  = v1pb.ImportAction_IMPORT_ACTION_UPDATE
+		skip    = v1pb.ImportAction_IMPORT_ACTION_SKIP
+		errored = v1pb.ImportAction_IMPORT_ACTION_ERROR
+	)
+
+	require.Equal(t, []v1pb.ImportAction{create, errored, errored, errored, errored, errored}, preview(v1pb.ImportShortcutsCSVRequest_COLLISION_STRATEGY_UNSPECIFIED))
+	require.Equal(t, []v1pb.ImportAction{create, errored, errored, errored, errored, errored}, preview(v1pb.ImportShortcutsCSVRequest_FAIL))
+	require.Equal(t, []v1pb.ImportAction{create, skip, skip, skip, errored, errored}, preview(v1pb.ImportShortcutsCSVRequest_SKIP))
+	require.Equal(t, []v1pb.ImportAction{create, update, skip, errored, errored, errored}, preview(v1pb.ImportShortcutsCSVRequest_OVERWRITE))
+	response, err := s.PreviewImport(userCtx, &v1pb.PreviewImportRequest{Request: newRequest(v1pb.ImportShortcutsCSVRequest_OVERWRITE)})
+	require.NoError(t, err)
+	require.Equal(t, "changed", response.Entries[1].Name)
+	require.Equal(t, []string{"link"}, response.Entries[1].UpdatePaths)
+
+	// Previewing writes nothing.
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(shortcuts))
+
+	// The import does what was previewed.
+	importResponse, err := s.ImportShortcutsCSV(userCtx, newRequest(v1pb.ImportShortcutsCSVRequest_OVERWRITE))
+	require.NoError(t, err)
+	for i, result := range importResponse.Results {
+		require.Equal(t, response.Entries[i].Action, result.Action)
+	}
+	require.Equal(t, "https://example.com/new", importResponse.Results[1].Shortcut.Link)
+	require.Equal(t, "Kept", importResponse.Results[1].Shortcut.Title)
+}
+
+func TestCreateShortcutWithReservedName(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	_, err := s.Store.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "reserved", owner.ID, time.Hour)
+	require.NoError(t, err)
+
+	request := &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{
+			Name: "reserved",
+			Link: "https://example.com",
+		},
+	}
+	_, err = s.CreateShortcut(withUser(ctx, other), request)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// The holder finalizes the reservation by creating the shortcut.
+	_, err = s.CreateShortcut(withUser(ctx, owner), request)
+	require.NoError(t, err)
+	reservation, err := s.Store.GetShortcutNameReservation(ctx, &store.FindShortcutNameReservation{
+		Namespace: store.DefaultShortcutNamespace,
+		Name:      "reserved",
+	})
+	require.NoError(t, err)
+	require.Nil(t, reservation)
+}
+
+func TestCreateShortcutWithExistingName(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	request := &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{
+			Name: "taken",
+			Link: "https://example.com",
+		},
+	}
+	_, err := s.CreateShortcut(withUser(ctx, user), request)
+	require.NoError(t, err)
+	_, err = s.CreateShortcut(withUser(ctx, user), request)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), `"taken"`)
+
+	// Of the concurrent creates passing the check before any insert, only one is stored.
+	var wg sync.WaitGroup
+	codeList := make([]codes.Code, 8)
+	for i := range codeList {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+				Shortcut: &v1pb.Shortcut{
+					Name: "raced",
+					Link: "https://example.com",
+				},
+			})
+			codeList[i] = status.Code(err)
+		}()
+	}
+	wg.Wait()
+	okCount := 0
+	for _, code := range codeList {
+		if code == codes.OK {
+			okCount++
+		} else {
+			require.Equal(t, codes.AlreadyExists, code)
+		}
+	}
+	require.Equal(t, 1, okCount)
+}
+
+func TestShortcutNameScopes(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	otherUser, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	create := func(user *store.User, visibility v1pb.Visibility, link string) (*v1pb.Shortcut, error) {
+		return s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{
+				Name:       "docs",
+				Link:       link,
+				Visibility: visibility,
+			},
+		})
+	}
+
+	// A private shortcut can take the name of the workspace shortcut, once for each user.
+	workspace, err := create(otherUser, v1pb.Visibility_WORKSPACE, "https://example.com/workspace")
+	require.NoError(t, err)
+	private, err := create(user, v1pb.Visibility_PRIVATE, "https://example.com/private")
+	require.NoError(t, err)
+	_, err = create(user, v1pb.Visibility_PRIVATE, "https://example.com/private")
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = create(user, v1pb.Visibility_PUBLIC, "https://example.com/public")
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// The name resolves to the private shortcut of the caller first.
+	shortcut, err := s.GetShortcutByName(withUser(ctx, user), &v1pb.GetShortcutByNameRequest{Name: "docs"})
+	require.NoError(t, err)
+	require.Equal(t, private.Id, shortcut.Id)
+	shortcut, err = s.GetShortcutByName(withUser(ctx, otherUser), &v1pb.GetShortcutByNameRequest{Name: "docs"})
+	require.NoError(t, err)
+	require.Equal(t, workspace.Id, shortcut.Id)
+
+	// Sharing the private shortcut moves its name to the scope of the workspace shortcut.
+	_, err = s.UpdateShortcut(withUser(ctx, user), &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: private.Id, Visibility: v1pb.Visibility_WORKSPACE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Deleting by name deletes the shortcut the name resolves to.
+	_, err = s.DeleteShortcut(withUser(ctx, user), &v1pb.DeleteShortcutRequest{Name: "docs"})
+	require.NoError(t, err)
+	shortcut, err = s.GetShortcutByName(withUser(ctx, user), &v1pb.GetShortcutByNameRequest{Name: "docs"})
+	require.NoError(t, err)
+	require.Equal(t, workspace.Id, shortcut.Id)
+}
+
+func TestParseShortcutOrderBy(t *testing.T) {
+	orderBy, err := parseShortcutOrderBy("")
+	require.NoError(t, err)
+	require.Empty(t, orderBy)
+	orderBy, err = parseShortcutOrderBy("name")
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldName}}, orderBy)
+	orderBy, err = parseShortcutOrderBy(" created_ts DESC, title asc ")
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutOrderBy{
+		{Field: store.ShortcutOrderFieldCreatedTs, Desc: true},
+		{Field: store.ShortcutOrderFieldTitle},
+	}, orderBy)
+
+	for _, invalid := range []string{"link", "name sideways", "name desc extra", "name,", "name, name desc", "name; DROP TABLE shortcut"} {
+		_, err := parseShortcutOrderBy(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestListShortcutsDefaultOrder(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	adminCtx := withUser(ctx, admin)
+	for name, title := range map[string]string{"b": "x", "a": "z", "c": "y"} {
+		_, err := s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{
+				Name:  name,
+				Title: title,
+				Link:  "https://example.com/" + name,
+			},
+		})
+		require.NoError(t, err)
+	}
+	listNamesAs := func(ctx context.Context, orderBy string) []string {
+		response, err := s.ListShortcuts(ctx, &v1pb.ListShortcutsRequest{OrderBy: orderBy})
+		require.NoError(t, err)
+		names := []string{}
+		for _, shortcut := range response.Shortcuts {
+			names = append(names, shortcut.Name)
+		}
+		return names
+	}
+	listNames := func(orderBy string) []string {
+		return listNamesAs(adminCtx, orderBy)
+	}
+
+	require.Equal(t, []string{"c", "b", "a"}, listNames("name desc"))
+	_, err := s.ListShortcuts(adminCtx, &v1pb.ListShortcutsRequest{OrderBy: "link"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{DefaultShortcutOrder: "link desc"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"default_shortcut_order"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	workspaceSetting, err := s.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{DefaultShortcutOrder: "name"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"default_shortcut_order"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "name", workspaceSetting.DefaultShortcutOrder)
+
+	// The workspace default is only used when the request has no order.
+	require.Equal(t, []string{"a", "b", "c"}, listNames(""))
+	require.Equal(t, []string{"b", "c", "a"}, listNames("title"))
+	require.Equal(t, []string{"c", "b", "a"}, listNames("name desc"))
+
+	// The users who aren't admins list in the workspace default order too.
+	_, err = s.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{DefaultShortcutOrder: "name desc"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"default_shortcut_order"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "b", "a"}, listNamesAs(withUser(ctx, user), ""))
+}
+
+func TestCreateShortcutDefaultVisibility(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	createShortcut := func(user *store.User, name string) *v1pb.Shortcut {
+		shortcut, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{
+				Name: name,
+				Link: "https://example.com/" + name,
+			},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+
+	require.Equal(t, v1pb.Visibility_WORKSPACE, createShortcut(user, "a").Visibility)
+	_, err := s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{DefaultVisibility: v1pb.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"default_visibility"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PUBLIC, createShortcut(admin, "b").Visibility)
+	require.Equal(t, v1pb.Visibility_PUBLIC, createShortcut(user, "c").Visibility)
+}
+
+func TestListShortcutAccess(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	shortcut, err := s.CreateShortcut(withUser(ctx, owner), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{
+			Name:       "test",
+			Link:       "https://example.com",
+			Visibility: v1pb.Visibility_WORKSPACE,
+		},
+	})
+	require.NoError(t, err)
+
+	// Workspace shortcuts are readable by every signed-in user.
+	response, err := s.ListShortcutAccess(withUser(ctx, owner), &v1pb.ListShortcutAccessRequest{Id: shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.ListShortcutAccessResponse_WORKSPACE_USERS, response.Audience)
+	require.Equal(t, 2, len(response.Accesses))
+	require.Equal(t, owner.ID, response.Accesses[0].User.Id)
+	require.Equal(t, v1pb.ListShortcutAccessResponse_OWNER, response.Accesses[0].Reason)
+	require.Equal(t, admin.ID, response.Accesses[1].User.Id)
+	require.Equal(t, v1pb.ListShortcutAccessResponse_ADMIN, response.Accesses[1].Reason)
+
+	// Public shortcuts are readable by everyone.
+	_, err = s.UpdateShortcut(withUser(ctx, owner), &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Visibility: v1pb.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	response, err = s.ListShortcutAccess(withUser(ctx, admin), &v1pb.ListShortcutAccessRequest{Id: shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.ListShortcutAccessResponse_EVERYONE, response.Audience)
+
+	// Only the owner and admins can list the access.
+	_, err = s.ListShortcutAccess(withUser(ctx, other), &v1pb.ListShortcutAccessRequest{Id: shortcut.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ListShortcutAccess(ctx, &v1pb.ListShortcutAccessRequest{Id: shortcut.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ListShortcutAccess(withUser(ctx, owner), &v1pb.ListShortcutAccessRequest{Id: shortcut.Id + 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestShortcutRedirectLoop(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	adminCtx := withUser(ctx, admin)
+	_, err := s.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{InstanceUrl: "https://slash.example.com"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"instance_url"}},
+	})
+	require.NoError(t, err)
+
+	// Self-loop.
+	_, err = s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "self", Link: "https://slash.example.com/s/self"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// 2-hop loop: a -> b -> a.
+	_, err = s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "a", Link: "https://slash.example.com/s/b"},
+	})
+	require.NoError(t, err)
+	b, err := s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "b", Link: "https://example.com"},
+	})
+	require.NoError(t, err)
+	_, err = s.UpdateShortcut(adminCtx, &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: b.Id, Link: "https://slash.example.com/s/a"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"link"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "c", Link: "https://slash.example.com/s/a"},
+	})
+	require.NoError(t, err)
+
+	_, err = s.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{InstanceUrl: "not a url"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"instance_url"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestApplyShortcut(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	ownerCtx := withUser(ctx, owner)
+	desired := &v1pb.Shortcut{
+		Name:       "docs",
+		Link:       "https://example.com/docs",
+		Title:      "Docs",
+		Tags:       []string{"work", "docs"},
+		Visibility: v1pb.Visibility_PUBLIC,
+	}
+
+	response, err := s.ApplyShortcut(ownerCtx, &v1pb.ApplyShortcutRequest{Shortcut: desired})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.ApplyShortcutResponse_CREATED, response.Action)
+	id := response.Shortcut.Id
+
+	// Re-applying the same declaration is a no-op.
+	response, err = s.ApplyShortcut(ownerCtx, &v1pb.ApplyShortcutRequest{Shortcut: desired})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.ApplyShortcutResponse_UNCHANGED, response.Action)
+	require.Equal(t, id, response.Shortcut.Id)
+
+	desired.Link = "https://example.com/new-docs"
+	response, err = s.ApplyShortcut(ownerCtx, &v1pb.ApplyShortcutRequest{Shortcut: desired})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.ApplyShortcutResponse_UPDATED, response.Action)
+	require.Equal(t, id, response.Shortcut.Id)
+	require.Equal(t, "https://example.com/new-docs", response.Shortcut.Link)
+	require.Equal(t, v1pb.Visibility_PUBLIC, response.Shortcut.Visibility)
+	response, err = s.ApplyShortcut(ownerCtx, &v1pb.ApplyShortcutRequest{Shortcut: desired})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.ApplyShortcutResponse_UNCHANGED, response.Action)
+
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+
+	// Shortcuts of other users can't be taken over.
+	_, err = s.ApplyShortcut(withUser(ctx, other), &v1pb.ApplyShortcutRequest{Shortcut: desired})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ApplyShortcut(ownerCtx, &v1pb.ApplyShortcutRequest{Shortcut: &v1pb.Shortcut{Name: "docs"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+type stubLinkShortener struct {
+	err error
+}
+
+func (s *stubLinkShortener) Shorten(_ context.Context, link string) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	return "https://sho.rt/" + strings.TrimPrefix(link, "https://example.com/"), nil
+}
+
+func TestCreateShortcutWithLinkShortener(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	linkShortener := &stubLinkShortener{}
+	s.LinkShortener = linkShortener
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	adminCtx := withUser(ctx, admin)
+
+	// The shortener isn't used until it is configured.
+	shortcut, err := s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "disabled", Link: "https://example.com/disabled"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/disabled", shortcut.Link)
+
+	_, err = s.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{LinkShortenerUrl: "not a url"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"link_shortener_url"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	workspaceSetting, err := s.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{LinkShortenerUrl: "https://shortener.example.com/api"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"link_shortener_url"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://shortener.example.com/api", workspaceSetting.LinkShortenerUrl)
+
+	shortcut, err = s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "enabled", Link: "https://example.com/enabled"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://sho.rt/enabled", shortcut.Link)
+
+	// Shortener failures fall back to the original link.
+	linkShortener.err = errors.New("shortener is down")
+	shortcut, err = s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "failed", Link: "https://example.com/failed"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/failed", shortcut.Link)
+}
+
+type stubLinkMetadataFetcher struct {
+	links []string
+	err   error
+}
+
+func (f *stubLinkMetadataFetcher) FetchLinkMetadata(_ context.Context, link string) (*httpgetter.LinkMetadata, error) {
+	f.links = append(f.links, link)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &httpgetter.LinkMetadata{Title: "Title of " + link, FaviconURL: link + "/favicon.ico"}, nil
+}
+
+func TestCreateShortcutFetchLinkMetadata(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	fetcher := &stubLinkMetadataFetcher{}
+	s.LinkMetadataFetcher = fetcher
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	create := func(name, title string, fetch bool) *v1pb.Shortcut {
+		shortcut, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+			Shortcut:          &v1pb.Shortcut{Name: name, Title: title, Link: "https://example.com/" + name},
+			FetchLinkMetadata: fetch,
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+
+	// The metadata is only fetched on request for shortcuts without a title.
+	shortcut := create("plain", "", false)
+	require.Equal(t, "", shortcut.Title)
+	shortcut = create("titled", "Mine", true)
+	require.Equal(t, "Mine", shortcut.Title)
+	require.Equal(t, "", shortcut.FaviconUrl)
+	require.Empty(t, fetcher.links)
+
+	shortcut = create("fetched", "", true)
+	require.Equal(t, "Title of https://example.com/fetched", shortcut.Title)
+	require.Equal(t, "https://example.com/fetched/favicon.ico", shortcut.FaviconUrl)
+	shortcut, err := s.GetShortcut(userCtx, &v1pb.GetShortcutRequest{Id: shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/fetched/favicon.ico", shortcut.FaviconUrl)
+
+	// Fetch failures leave them empty.
+	fetcher.err = errors.New("timeout")
+	shortcut = create("failed", "", true)
+	require.Equal(t, "", shortcut.Title)
+	require.Equal(t, "", shortcut.FaviconUrl)
+	require.Equal(t, []string{"https://example.com/fetched", "https://example.com/failed"}, fetcher.links)
+}
+
+type stubSummarizer struct {
+	err error
+}
+
+func (s *stubSummarizer) Summarize(_ context.Context, text string, _ int) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	return "Summary of " + strings.Fields(text)[0], nil
+}
+
+func TestShortcutDescriptionSummary(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	descriptionSummarizer := &stubSummarizer{}
+	s.Summarizer = descriptionSummarizer
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	longDescription := "Onboarding " + strings.Repeat("covers the tools and the processes of the team ", 10)
+
+	long, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "long", Link: "https://example.com/long", Description: longDescription},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Summary of Onboarding", long.Summary)
+	short, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "short", Link: "https://example.com/short", Description: "Short description"},
+	})
+	require.NoError(t, err)
+	require.Empty(t, short.Summary)
+
+	// Summarizer failures fall back to a truncation.
+	descriptionSummarizer.err = errors.New("summarizer is down")
+	long, err = s.UpdateShortcut(userCtx, &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: long.Id, Description: longDescription},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(long.Summary, "Onboarding covers the tools"))
+	require.True(t, strings.HasSuffix(long.Summary, "…"))
+	require.LessOrEqual(t, len([]rune(long.Summary)), maxSummaryLength)
+
+	// Basic views have the summary instead of long descriptions.
+	response, err := s.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{OrderBy: "name", View: v1pb.ShortcutView_SHORTCUT_VIEW_BASIC})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Shortcuts))
+	require.Empty(t, response.Shortcuts[0].Description)
+	require.Equal(t, long.Summary, response.Shortcuts[0].Summary)
+	require.Nil(t, response.Shortcuts[0].OgMetadata)
+	require.Equal(t, "Short description", response.Shortcuts[1].Description)
+	response, err = s.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{OrderBy: "name"})
+	require.NoError(t, err)
+	require.Equal(t, longDescription, response.Shortcuts[0].Description)
+	require.Equal(t, long.Summary, response.Shortcuts[0].Summary)
+
+	// Without a summarizer, long descriptions are truncated.
+	s.Summarizer = nil
+	truncated, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "truncated", Link: "https://example.com/truncated", Description: longDescription},
+	})
+	require.NoError(t, err)
+	require.Equal(t, long.Summary, truncated.Summary)
+}
+
+func TestShortcutViewCountPrivacy(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	shortcut, err := s.CreateShortcut(withUser(ctx, owner), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "test", Link: "https://example.com", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Store.AddShortcutViews(ctx, []*store.ShortcutViews{{ShortcutID: shortcut.Id, Count: 23, LastViewedTs: time.Now().Unix()}}))
+	setPrivacy := func(privacy v1pb.WorkspaceSetting_ViewCountPrivacy) {
+		_, err := s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+			Setting:    &v1pb.WorkspaceSetting{ViewCountPrivacy: privacy},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"view_count_privacy"}},
+		})
+		require.NoError(t, err)
+	}
+	getShortcut := func(ctx context.Context) *v1pb.Shortcut {
+		shortcut, err := s.GetShortcut(ctx, &v1pb.GetShortcutRequest{Id: shortcut.Id})
+		require.NoError(t, err)
+		return shortcut
+	}
+
+	// Exact counts by default.
+	require.Equal(t, int32(23), getShortcut(ctx).ViewCount)
+
+	// Bucketed for anonymous and other users.
+	setPrivacy(v1pb.WorkspaceSetting_BUCKET_VIEW_COUNT)
+	workspaceSetting, err := s.GetWorkspaceSetting(withUser(ctx, admin), &v1pb.GetWorkspaceSettingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.WorkspaceSetting_BUCKET_VIEW_COUNT, workspaceSetting.ViewCountPrivacy)
+	for _, ctx := range []context.Context{ctx, withUser(ctx, other)} {
+		bucketed := getShortcut(ctx)
+		require.Equal(t, int32(10), bucketed.ViewCount)
+		require.Equal(t, "10+", bucketed.ViewCountRange)
+		require.False(t, bucketed.ViewCountHidden)
+		_, err = s.GetShortcutAnalytics(ctx, &v1pb.GetShortcutAnalyticsRequest{Id: shortcut.Id})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+
+	// Hidden for anonymous and other users.
+	setPrivacy(v1pb.WorkspaceSetting_HIDE_VIEW_COUNT)
+	for _, ctx := range []context.Context{ctx, withUser(ctx, other)} {
+		hidden := getShortcut(ctx)
+		require.Equal(t, int32(0), hidden.ViewCount)
+		require.Empty(t, hidden.ViewCountRange)
+		require.True(t, hidden.ViewCountHidden)
+	}
+
+	// Exact for the owner and admins.
+	for _, ctx := range []context.Context{withUser(ctx, owner), withUser(ctx, admin)} {
+		exact := getShortcut(ctx)
+		require.Equal(t, int32(23), exact.ViewCount)
+		require.False(t, exact.ViewCountHidden)
+		_, err = s.GetShortcutAnalytics(ctx, &v1pb.GetShortcutAnalyticsRequest{Id: shortcut.Id})
+		require.NoError(t, err)
+	}
+}
+
+func TestGetShortcutAnalytics(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	shortcut, err := s.CreateShortcut(withUser(ctx, owner), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "test", Link: "https://example.com", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	getAnalytics := func(ctx context.Context, request *v1pb.GetShortcutAnalyticsRequest) (*v1pb.GetShortcutAnalyticsResponse, error) {
+		request.Id = shortcut.Id
+		return s.GetShortcutAnalytics(ctx, request)
+	}
+
+	// A shortcut without visits has an empty series.
+	analytics, err := getAnalytics(withUser(ctx, owner), &v1pb.GetShortcutAnalyticsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), analytics.TotalClicks)
+	require.Empty(t, analytics.DailyCounts)
+	require.Empty(t, analytics.References)
+
+	const chromeUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	for _, referer := range []string{"https://a.com", "https://a.com", "https://b.com"} {
+		payload, err := protojson.Marshal(&storepb.ActivityShorcutViewPayload{ShortcutId: shortcut.Id, Referer: referer, UserAgent: chromeUserAgent})
+		require.NoError(t, err)
+		_, err = s.Store.CreateActivity(ctx, &store.Activity{
+			Type:    store.ActivityShortcutView,
+			Level:   store.ActivityInfo,
+			Payload: string(payload),
+		})
+		require.NoError(t, err)
+	}
+	for _, ctx := range []context.Context{withUser(ctx, owner), withUser(ctx, admin)} {
+		analytics, err := getAnalytics(ctx, &v1pb.GetShortcutAnalyticsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int32(3), analytics.TotalClicks)
+		require.Equal(t, []*v1pb.GetShortcutAnalyticsResponse_DailyCount{{Date: time.Now().UTC().Format(time.DateOnly), Count: 3}}, analytics.DailyCounts)
+		require.Equal(t, []*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem{{Name: "https://b.com", Count: 1}, {Name: "https://a.com", Count: 2}}, analytics.References)
+		require.Equal(t, []*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem{{Name: "Chrome", Count: 3}}, analytics.Browsers)
+	}
+
+	// The visits outside of the range aren't counted.
+	analytics, err = getAnalytics(withUser(ctx, owner), &v1pb.GetShortcutAnalyticsRequest{EndTime: timestamppb.New(time.Now().Add(-time.Hour))})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), analytics.TotalClicks)
+	require.Empty(t, analytics.DailyCounts)
+	_, err = getAnalytics(withUser(ctx, owner), &v1pb.GetShortcutAnalyticsRequest{StartTime: timestamppb.Now(), EndTime: timestamppb.New(time.Now().Add(-time.Hour))})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Only the creator and admins can get the analytics.
+	for _, ctx := range []context.Context{ctx, withUser(ctx, other)} {
+		_, err := getAnalytics(ctx, &v1pb.GetShortcutAnalyticsRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+}
+
+func TestBucketViewCount(t *testing.T) {
+	tests := []struct {
+		viewCount int32
+		bound     int32
+		label     string
+	}{
+		{0, 0, "<10"},
+		{9, 0, "<10"},
+		{10, 10, "10+"},
+		{99, 10, "10+"},
+		{345, 100, "100+"},
+		{math.MaxInt32, 1000000000, "1000000000+"},
+	}
+	for _, test := range tests {
+		bound, label := bucketViewCount(test.viewCount)
+		require.Equal(t, test.bound, bound, test.viewCount)
+		require.Equal(t, test.label, label, test.viewCount)
+	}
+}
+
+func TestShortcutLocalizations(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	withAcceptLanguage := func(ctx context.Context, acceptLanguage string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("grpcgateway-accept-language", acceptLanguage))
+	}
+	shortcut, err := s.CreateShortcut(withUser(ctx, user), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{
+			Name:        "docs",
+			Link:        "https://example.com",
+			Title:       "Docs",
+			Description: "The docs",
+			Localizations: map[string]*v1pb.Shortcut_Localization{
+				"fr":    {Title: "Documentation", Description: "La documentation"},
+				"pt-BR": {Title: "Documentação"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Docs", shortcut.Title)
+	require.Equal(t, 2, len(shortcut.Localizations))
+
+	tests := []struct {
+		acceptLanguage string
+		title          string
+		description    string
+	}{
+		{"fr-CH, fr;q=0.9, en;q=0.8", "Documentation", "La documentation"},
+		// The empty fields of the localization fall back to the default ones.
+		{"pt-BR", "Documentação", "The docs"},
+		{"de, en;q=0.5", "Docs", "The docs"},
+		{"", "Docs", "The docs"},
+	}
+	for _, test := range tests {
+		ctx := withAcceptLanguage(withUser(ctx, user), test.acceptLanguage)
+		got, err := s.GetShortcut(ctx, &v1pb.GetShortcutRequest{Id: shortcut.Id})
+		require.NoError(t, err)
+		require.Equal(t, test.title, got.Title, test.acceptLanguage)
+		require.Equal(t, test.description, got.Description, test.acceptLanguage)
+		response, err := s.ListShortcuts(ctx, &v1pb.ListShortcutsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(response.Shortcuts))
+		require.Equal(t, test.title, response.Shortcuts[0].Title, test.acceptLanguage)
+	}
+
+	// Invalid locales are rejected, and an empty update removes the localizations.
+	_, err = s.UpdateShortcut(withUser(ctx, user), &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Localizations: map[string]*v1pb.Shortcut_Localization{"not a locale": {Title: "x"}}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"localizations"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	updated, err := s.UpdateShortcut(withAcceptLanguage(withUser(ctx, user), "fr"), &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"localizations"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, updated.Localizations)
+	require.Equal(t, "Docs", updated.Title)
+}
+
+func TestUpdateShortcut(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	createShortcut := func(name string) *v1pb.Shortcut {
+		shortcut, err := s.CreateShortcut(withUser(ctx, owner), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Visibility: v1pb.Visibility_WORKSPACE},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	updateName := func(user *store.User, id int32, name string) (*v1pb.Shortcut, error) {
+		return s.UpdateShortcut(withUser(ctx, user), &v1pb.UpdateShortcutRequest{
+			Shortcut:   &v1pb.Shortcut{Id: id, Name: name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+		})
+	}
+	shortcut := createShortcut("docs")
+	createShortcut("taken")
+	_, err := s.Store.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "reserved", other.ID, time.Hour)
+	require.NoError(t, err)
+
+	// The fields in the mask are patched in place.
+	updated, err := s.UpdateShortcut(withUser(ctx, owner), &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Link: "https://example.com/new", Title: "Docs", Tags: []string{"a", "b"}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"link", "title", "tags"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Id, updated.Id)
+	require.Equal(t, "docs", updated.Name)
+	require.Equal(t, "https://example.com/new", updated.Link)
+	require.Equal(t, "Docs", updated.Title)
+	require.Equal(t, []string{"a", "b"}, updated.Tags)
+	require.Equal(t, v1pb.Visibility_WORKSPACE, updated.Visibility)
+
+	// Names of other shortcuts and reservations of other users collide.
+	_, err = updateName(owner, shortcut.Id, "taken")
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = updateName(owner, shortcut.Id, "reserved")
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = updateName(owner, shortcut.Id, "")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	updated, err = updateName(admin, shortcut.Id, "handbook")
+	require.NoError(t, err)
+	require.Equal(t, "handbook", updated.Name)
+	_, err = updateName(owner, shortcut.Id, "handbook")
+	require.NoError(t, err)
+
+	// Only the owner and admins can update, and unknown ids are not found.
+	_, err = updateName(other, shortcut.Id, "others")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = updateName(owner, shortcut.Id+100, "missing")
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDeleteShortcut(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	shortcut, err := s.CreateShortcut(withUser(ctx, owner), &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "docs", Link: "https://example.com", Visibility: v1pb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+
+	// Only the owner and admins can delete.
+	_, err = s.DeleteShortcut(withUser(ctx, other), &v1pb.DeleteShortcutRequest{Id: shortcut.Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.DeleteShortcut(withUser(ctx, owner), &v1pb.DeleteShortcutRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Archived by default, by id or by name.
+	_, err = s.DeleteShortcut(withUser(ctx, owner), &v1pb.DeleteShortcutRequest{Name: "docs"})
+	require.NoError(t, err)
+	archived, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id, IncludeArchived: true})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_ARCHIVED, archived.RowStatus)
+	_, err = s.DeleteShortcut(withUser(ctx, owner), &v1pb.DeleteShortcutRequest{Id: shortcut.Id})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	// The archived shortcut no longer resolves by name.
+	_, err = s.GetShortcutByName(withUser(ctx, owner), &v1pb.GetShortcutByNameRequest{Name: "docs"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.DeleteShortcut(withUser(ctx, owner), &v1pb.DeleteShortcutRequest{Name: "docs"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Removed when permanent.
+	_, err = s.DeleteShortcut(withUser(ctx, owner), &v1pb.DeleteShortcutRequest{Id: shortcut.Id, Permanent: true})
+	require.NoError(t, err)
+	removed, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id, IncludeArchived: true})
+	require.NoError(t, err)
+	require.Nil(t, removed)
+	_, err = s.DeleteShortcut(withUser(ctx, owner), &v1pb.DeleteShortcutRequest{Id: shortcut.Id, Permanent: true})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.DeleteShortcut(withUser(ctx, owner), &v1pb.DeleteShortcutRequest{Name: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRestoreShortcut(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	ownerCtx := withUser(ctx, owner)
+	createArchived := func() *v1pb.Shortcut {
+		shortcut, err := s.CreateShortcut(ownerCtx, &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: "docs", Link: "https://example.com/old", Visibility: v1pb.Visibility_WORKSPACE},
+		})
+		require.NoError(t, err)
+		_, err = s.DeleteShortcut(ownerCtx, &v1pb.DeleteShortcutRequest{Id: shortcut.Id})
+		require.NoError(t, err)
+		return shortcut
+	}
+	restore := func(ctx context.Context, id int32, resolution v1pb.RestoreShortcutRequest_NameConflictResolution) (*v1pb.Shortcut, error) {
+		return s.RestoreShortcut(ctx, &v1pb.RestoreShortcutRequest{Id: id, NameConflictResolution: resolution})
+	}
+
+	// A shortcut whose name is free is restored as is.
+	archived := createArchived()
+	_, err := restore(withUser(ctx, other), archived.Id, v1pb.RestoreShortcutRequest_NAME_CONFLICT_RESOLUTION_UNSPECIFIED)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	restored, err := restore(ownerCtx, archived.Id, v1pb.RestoreShortcutRequest_NAME_CONFLICT_RESOLUTION_UNSPECIFIED)
+	require.NoError(t, err)
+	require.Equal(t, "docs", restored.Name)
+	require.Equal(t, v1pb.State_ACTIVE, restored.State)
+	_, err = restore(ownerCtx, archived.Id, v1pb.RestoreShortcutRequest_NAME_CONFLICT_RESOLUTION_UNSPECIFIED)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.DeleteShortcut(ownerCtx, &v1pb.DeleteShortcutRequest{Id: archived.Id})
+	require.NoError(t, err)
+	merged := createArchived()
+
+	// The name of archived shortcuts can be taken by a new one, which blocks restoring them by default.
+	active, err := s.CreateShortcut(ownerCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "docs", Link: "https://example.com/new", Visibility: v1pb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+	_, err = restore(ownerCtx, archived.Id, v1pb.RestoreShortcutRequest_NAME_CONFLICT_RESOLUTION_UNSPECIFIED)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = s.UpdateShortcut(ownerCtx, &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: archived.Id, State: v1pb.State_ACTIVE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Renaming restores the shortcut under the first free suffix.
+	_, err = s.CreateShortcut(ownerCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "docs-2", Link: "https://example.com/2", Visibility: v1pb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+	restored, err = restore(ownerCtx, archived.Id, v1pb.RestoreShortcutRequest_RENAME)
+	require.NoError(t, err)
+	require.Equal(t, archived.Id, restored.Id)
+	require.Equal(t, "docs-3", restored.Name)
+	require.Equal(t, v1pb.State_ACTIVE, restored.State)
+
+	// Merging adds the views of the archived shortcut to the active one and deletes it.
+	require.NoError(t, s.Store.AddShortcutViews(ctx, []*store.ShortcutViews{
+		{ShortcutID: merged.Id, Count: 3, LastViewedTs: 10},
+		{ShortcutID: active.Id, Count: 2, LastViewedTs: 5},
+	}))
+	payload, err := protojson.Marshal(&storepb.ActivityShorcutViewPayload{ShortcutId: merged.Id})
+	require.NoError(t, err)
+	_, err = s.Store.CreateActivity(ctx, &store.Activity{
+		Type:    store.ActivityShortcutView,
+		Level:   store.ActivityInfo,
+		Payload: string(payload),
+	})
+	require.NoError(t, err)
+	result, err := restore(ownerCtx, merged.Id, v1pb.RestoreShortcutRequest_MERGE)
+	require.NoError(t, err)
+	require.Equal(t, active.Id, result.Id)
+	require.Equal(t, "https://example.com/new", result.Link)
+	require.Equal(t, int32(5), result.ViewCount)
+	removed, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &merged.Id})
+	require.NoError(t, err)
+	require.Nil(t, removed)
+	tombstones, err := s.Store.ListShortcutTombstones(ctx, &store.FindShortcutTombstone{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tombstones))
+	require.Equal(t, merged.Id, tombstones[0].ShortcutID)
+	analytics, err := s.GetShortcutAnalytics(ownerCtx, &v1pb.GetShortcutAnalyticsRequest{Id: active.Id})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), analytics.TotalClicks)
+}
+
+func TestListShortcutsPagination(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleAdmin)
+	userCtx := withUser(ctx, user)
+	createShortcut := func(name string) {
+		_, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Visibility: v1pb.Visibility_WORKSPACE},
+		})
+		require.NoError(t, err)
+	}
+	for _, name := range []string{"b", "d", "a", "e", "c"} {
+		createShortcut(name)
+	}
+
+	names := []string{}
+	request := &v1pb.ListShortcutsRequest{OrderBy: "name", PageSize: 2}
+	for page := 0; ; page++ {
+		response, err := s.ListShortcuts(userCtx, request)
+		require.NoError(t, err)
+		for _, shortcut := range response.Shortcuts {
+			names = append(names, shortcut.Name)
+		}
+		if page == 0 {
+			// A shortcut created before the cursor doesn't shift the next pages.
+			createShortcut("aa")
+		}
+		if response.NextPageToken == "" {
+			break
+		}
+		request.PageToken = response.NextPageToken
+	}
+	require.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+
+	response, err := s.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{PageSize: 1000000})
+	require.NoError(t, err)
+	require.Equal(t, 6, len(response.Shortcuts))
+	require.Empty(t, response.NextPageToken)
+
+	response, err = s.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{OrderBy: "name", PageSize: 1})
+	require.NoError(t, err)
+	_, err = s.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{OrderBy: "title", PageSize: 1, PageToken: response.NextPageToken})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{PageToken: "not a token"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{PageSize: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListShortcutsVisibility(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	member, _ := createTestingUser(ctx, t, s, "member", store.RoleUser)
+	shortcuts := map[string]*v1pb.Shortcut{}
+	for name, visibility := range map[string]v1pb.Visibility{
+		"a-public":    v1pb.Visibility_PUBLIC,
+		"b-workspace": v1pb.Visibility_WORKSPACE,
+		"c-private":   v1pb.Visibility_PRIVATE,
+	} {
+		shortcut, err := s.CreateShortcut(withUser(ctx, owner), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Visibility: visibility},
+		})
+		require.NoError(t, err)
+		require.Equal(t, visibility, shortcut.Visibility)
+		shortcuts[name] = shortcut
+	}
+	// Lists the names page by page, so the filter must be applied before the limit of each page.
+	listNames := func(ctx context.Context) []string {
+		names := []string{}
+		request := &v1pb.ListShortcutsRequest{OrderBy: "name", PageSize: 1}
+		for {
+			response, err := s.ListShortcuts(ctx, request)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(response.Shortcuts), 1)
+			for _, shortcut := range response.Shortcuts {
+				names = append(names, shortcut.Name)
+			}
+			if response.NextPageToken == "" {
+				return names
+			}
+			request.PageToken = response.NextPageToken
+		}
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		want     []string
+		canRead  []string
+		cantRead []string
+	}{
+		{"anonymous", ctx, []string{"a-public"}, []string{"a-public"}, []string{"b-workspace", "c-private"}},
+		{"member", withUser(ctx, member), []string{"a-public", "b-workspace"}, []string{"a-public", "b-workspace"}, []string{"c-private"}},
+		{"owner", withUser(ctx, owner), []string{"a-public", "b-workspace", "c-private"}, []string{"a-public", "b-workspace", "c-private"}, nil},
+		{"admin", withUser(ctx, admin), []string{"a-public", "b-workspace", "c-private"}, []string{"a-public", "b-workspace", "c-private"}, nil},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, listNames(test.ctx), test.name)
+		for _, name := range test.canRead {
+			_, err := s.GetShortcut(test.ctx, &v1pb.GetShortcutRequest{Id: shortcuts[name].Id})
+			require.NoError(t, err, "%s reads %s", test.name, name)
+		}
+		for _, name := range test.cantRead {
+			_, err := s.GetShortcut(test.ctx, &v1pb.GetShortcutRequest{Id: shortcuts[name].Id})
+			require.Equal(t, codes.PermissionDenied, status.Code(err), "%s reads %s", test.name, name)
+		}
+	}
+
+	// The tag policies don't open the private shortcuts to other users.
+	_, err := s.UpdateShortcut(withUser(ctx, owner), &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: shortcuts["c-private"].Id, Tags: []string{"team"}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+	})
+	require.NoError(t, err)
+	_, err = s.UpsertTagPolicy(withUser(ctx, admin), &v1pb.UpsertTagPolicyRequest{
+		TagPolicy: &v1pb.TagPolicy{Tag: "team", UserId: member.ID, Role: v1pb.TagPolicy_MANAGE},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a-public", "b-workspace"}, listNames(withUser(ctx, member)))
+	_, err = s.DeleteShortcut(withUser(ctx, member), &v1pb.DeleteShortcutRequest{Id: shortcuts["c-private"].Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetShortcutPageSize(t *testing.T) {
+	tests := []struct {
+		pageSize int32
+		want     int
+	}{
+		{0, defaultShortcutPageSize},
+		{10, 10},
+		{maxShortcutPageSize + 1, maxShortcutPageSize},
+	}
+	for _, test := range tests {
+		got, err := getShortcutPageSize(test.pageSize)
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.pageSize)
+	}
+}
+
+func TestListShortcutsByTags(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleAdmin)
+	userCtx := withUser(ctx, user)
+	for name, tags := range map[string][]string{
+		"go":       {"dev", "go"},
+		"rust":     {"dev", "rust"},
+		"handbook": {"docs"},
+		"untagged": nil,
+	} {
+		_, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Tags: tags},
+		})
+		require.NoError(t, err)
+	}
+	listNames := func(request *v1pb.ListShortcutsRequest) []string {
+		request.OrderBy = "name"
+		response, err := s.ListShortcuts(userCtx, request)
+		require.NoError(t, err)
+		names := []string{}
+		for _, shortcut := range response.Shortcuts {
+			names = append(names, shortcut.Name)
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"go", "handbook", "rust", "untagged"}, listNames(&v1pb.ListShortcutsRequest{}))
+	require.Equal(t, []string{"go", "rust"}, listNames(&v1pb.ListShortcutsRequest{Tags: []string{"dev"}}))
+	require.Equal(t, []string{"go"}, listNames(&v1pb.ListShortcutsRequest{Tags: []string{"dev", "go"}}))
+	require.Equal(t, []string{"go", "handbook"}, listNames(&v1pb.ListShortcutsRequest{
+		Tags:         []string{"go", "docs"},
+		TagMatchMode: v1pb.ListShortcutsRequest_MATCH_ANY_TAG,
+	}))
+	require.Equal(t, []string{}, listNames(&v1pb.ListShortcutsRequest{Tags: []string{"docs", "dev"}}))
+
+	_, err := s.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{Tags: []string{"two words"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListShortcutsGroupBy(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	createShortcut := func(creator *store.User, name string, tags []string, visibility v1pb.Visibility) {
+		_, err := s.CreateShortcut(withUser(ctx, creator), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Tags: tags, Visibility: visibility},
+		})
+		require.NoError(t, err)
+	}
+	createShortcut(admin, "go", []string{"dev", "go"}, v1pb.Visibility_PUBLIC)
+	createShortcut(admin, "rust", []string{"dev"}, v1pb.Visibility_WORKSPACE)
+	createShortcut(user, "handbook", []string{"docs"}, v1pb.Visibility_WORKSPACE)
+	createShortcut(user, "untagged", nil, v1pb.Visibility_WORKSPACE)
+	listGroups := func(request *v1pb.ListShortcutsRequest) []*v1pb.ListShortcutsResponse_Group {
+		response, err := s.ListShortcuts(withUser(ctx, admin), request)
+		require.NoError(t, err)
+		require.Empty(t, response.Shortcuts)
+		return response.Groups
+	}
+	groupKeys := func(groups []*v1pb.ListShortcutsResponse_Group) map[string]int32 {
+		counts := map[string]int32{}
+		for _, group := range groups {
+			counts[group.Key] = group.Count
+		}
+		return counts
+	}
+
+	groups := listGroups(&v1pb.ListShortcutsRequest{GroupBy: "tag"})
+	require.Equal(t, 3, len(groups))
+	require.Equal(t, "dev", groups[0].Key)
+	require.Equal(t, int32(2), groups[0].Count)
+	require.Equal(t, "go", groups[0].Sample.Name)
+	require.Equal(t, map[string]int32{"dev": 2, "docs": 1, "go": 1}, groupKeys(groups))
+
+	groups = listGroups(&v1pb.ListShortcutsRequest{GroupBy: "visibility"})
+	require.Equal(t, map[string]int32{"WORKSPACE": 3, "PUBLIC": 1}, groupKeys(groups))
+	require.Equal(t, "WORKSPACE", groups[0].Key)
+
+	groups = listGroups(&v1pb.ListShortcutsRequest{GroupBy: "creator", Tags: []string{"dev"}})
+	require.Equal(t, map[string]int32{UserNamePrefix + strconv.Itoa(int(admin.ID)): 2}, groupKeys(groups))
+
+	// The number of groups is bounded by the page size.
+	groups = listGroups(&v1pb.ListShortcutsRequest{GroupBy: "tag", PageSize: 1})
+	require.Equal(t, 1, len(groups))
+
+	_, err := s.ListShortcuts(withUser(ctx, admin), &v1pb.ListShortcutsRequest{GroupBy: "link"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListShortcutsFilter(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleAdmin)
+	userCtx := withUser(ctx, user)
+	for _, shortcut := range []*v1pb.Shortcut{
+		{Name: "roadmap", Title: "Product plans", Description: "What we build next"},
+		{Name: "plans", Title: "Pricing"},
+		{Name: "wiki", Title: "Wiki", Description: "Team PLANS and notes"},
+		{Name: "q3", Title: "Q3", Tags: []string{"plans"}},
+		{Name: "discount", Title: "50% off"},
+	} {
+		shortcut.Link = "https://example.com/" + shortcut.Name
+		_, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{Shortcut: shortcut})
+		require.NoError(t, err)
+	}
+	listNames := func(request *v1pb.ListShortcutsRequest) ([]string, string) {
+		request.OrderBy = "name"
+		response, err := s.ListShortcuts(userCtx, request)
+		require.NoError(t, err)
+		names := []string{}
+		for _, shortcut := range response.Shortcuts {
+			names = append(names, shortcut.Name)
+		}
+		return names, response.NextPageToken
+	}
+
+	names, _ := listNames(&v1pb.ListShortcutsRequest{Filter: "Plans"})
+	require.Equal(t, []string{"plans", "roadmap", "wiki", "q3"}, names)
+	names, _ = listNames(&v1pb.ListShortcutsRequest{Filter: " "})
+	require.Equal(t, []string{"discount", "plans", "q3", "roadmap", "wiki"}, names)
+	names, _ = listNames(&v1pb.ListShortcutsRequest{Filter: "%"})
+	require.Equal(t, []string{"discount"}, names)
+	names, _ = listNames(&v1pb.ListShortcutsRequest{Filter: "_"})
+	require.Equal(t, []string{}, names)
+
+	// The ranked results page through the same way.
+	names, pageToken := listNames(&v1pb.ListShortcutsRequest{Filter: "plans", PageSize: 2})
+	require.Equal(t, []string{"plans", "roadmap"}, names)
+	names, pageToken = listNames(&v1pb.ListShortcutsRequest{Filter: "plans", PageSize: 2, PageToken: pageToken})
+	require.Equal(t, []string{"wiki", "q3"}, names)
+	require.Empty(t, pageToken)
+}
+
+func TestShortcutExpiration(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	userCtx := withUser(ctx, user)
+	_, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "past", Link: "https://example.com/past", ExpireTime: timestamppb.New(time.Now().Add(-time.Hour))},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	expireTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	shortcut, err := s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "campaign", Link: "https://example.com/campaign", ExpireTime: timestamppb.New(expireTime)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expireTime.Unix(), shortcut.ExpireTime.AsTime().Unix())
+	_, err = s.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "docs", Link: "https://example.com/docs"},
+	})
+	require.NoError(t, err)
+	list := func(includeExpired bool) []string {
+		response, err := s.ListShortcuts(userCtx, &v1pb.ListShortcutsRequest{OrderBy: "name", IncludeExpired: includeExpired})
+		require.NoError(t, err)
+		names := []string{}
+		for _, shortcut := range response.Shortcuts {
+			names = append(names, shortcut.Name)
+		}
+		return names
+	}
+	_, err = s.GetShortcutByName(userCtx, &v1pb.GetShortcutByNameRequest{Name: "campaign"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"campaign", "docs"}, list(false))
+
+	// Expired shortcuts don't resolve and are only listed on demand.
+	expireTs := time.Now().Add(-time.Minute).Unix()
+	_, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{ID: shortcut.Id, ExpireTs: &expireTs})
+	require.NoError(t, err)
+	_, err = s.GetShortcutByName(userCtx, &v1pb.GetShortcutByNameRequest{Name: "campaign"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, []string{"docs"}, list(false))
+	require.Equal(t, []string{"campaign", "docs"}, list(true))
+	_, err = s.GetShortcut(userCtx, &v1pb.GetShortcutRequest{Id: shortcut.Id})
+	require.NoError(t, err)
+
+	// The expiration can't be moved into the past, and clearing it makes the shortcut resolve again.
+	updateExpireTime := func(expireTime *timestamppb.Timestamp) (*v1pb.Shortcut, error) {
+		return s.UpdateShortcut(userCtx, &v1pb.UpdateShortcutRequest{
+			Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, ExpireTime: expireTime},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"expire_time"}},
+		})
+	}
+	_, err = updateExpireTime(timestamppb.New(time.Now().Add(-time.Hour)))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	updated, err := updateExpireTime(nil)
+	require.NoError(t, err)
+	require.Nil(t, updated.ExpireTime)
+	_, err = s.GetShortcutByName(userCtx, &v1pb.GetShortcutByNameRequest{Name: "campaign"})
+	require.NoError(t, err)
+}
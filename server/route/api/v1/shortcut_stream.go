@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+)
+
+// StreamShortcuts streams the shortcuts ListShortcuts lists page by page, so that clients syncing all the shortcuts
+// don't hold them in a single message. It stops once the client is gone, before reading the next page.
+func (s *APIV1Service) StreamShortcuts(request *v1pb.StreamShortcutsRequest, stream grpc.ServerStreamingServer[v1pb.StreamShortcutsResponse]) error {
+	ctx := stream.Context()
+	listRequest := &v1pb.ListShortcutsRequest{
+		OrderBy:         request.OrderBy,
+		View:            request.View,
+		PageSize:        request.PageSize,
+		Tags:            request.Tags,
+		TagMatchMode:    request.TagMatchMode,
+		Filter:          request.Filter,
+		IncludeExpired:  request.IncludeExpired,
+		IncludeArchived: request.IncludeArchived,
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		response, err := s.ListShortcuts(ctx, listRequest)
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return err
+		}
+		if len(response.Shortcuts) > 0 {
+			if err := stream.Send(&v1pb.StreamShortcutsResponse{
+				Shortcuts: response.Shortcuts,
+			}); err != nil {
+				return err
+			}
+		}
+		if response.NextPageToken == "" {
+			return nil
+		}
+		listRequest.PageToken = response.NextPageToken
+	}
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// testingShortcutStream collects the messages of StreamShortcuts, and cancels its context after cancelAfter messages
+// when set.
+type testingShortcutStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	cancel      context.CancelFunc
+	cancelAfter int
+	responses   []*v1pb.StreamShortcutsResponse
+}
+
+func (s *testingShortcutStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testingShortcutStream) Send(response *v1pb.StreamShortcutsResponse) error {
+	s.responses = append(s.responses, response)
+	if s.cancel != nil && len(s.responses) == s.cancelAfter {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestStreamShortcuts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	member, _ := createTestingUser(ctx, t, s, "member", store.RoleUser)
+	for name, visibility := range map[string]v1pb.Visibility{
+		"a": v1pb.Visibility_WORKSPACE,
+		"b": v1pb.Visibility_PRIVATE,
+		"c": v1pb.Visibility_PUBLIC,
+		"d": v1pb.Visibility_WORKSPACE,
+		"e": v1pb.Visibility_WORKSPACE,
+	} {
+		_, err := s.CreateShortcut(withUser(ctx, owner), &v1pb.CreateShortcutRequest{
+			Shortcut: &v1pb.Shortcut{Name: name, Link: "https://example.com/" + name, Visibility: visibility, Tags: []string{"team"}},
+		})
+		require.NoError(t, err)
+	}
+	streamNames := func(user *store.User, request *v1pb.StreamShortcutsRequest) [][]string {
+		stream := &testingShortcutStream{ctx: withUser(ctx, user)}
+		require.NoError(t, s.StreamShortcuts(request, stream))
+		pages := [][]string{}
+		for _, response := range stream.responses {
+			names := []string{}
+			for _, shortcut := range response.Shortcuts {
+				names = append(names, shortcut.Name)
+			}
+			pages = append(pages, names)
+		}
+		return pages
+	}
+
+	require.Equal(t, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, streamNames(owner, &v1pb.StreamShortcutsRequest{OrderBy: "name", PageSize: 2}))
+	// The private shortcuts of others are filtered out before the pages are cut, as in ListShortcuts.
+	require.Equal(t, [][]string{{"a", "c"}, {"d", "e"}}, streamNames(member, &v1pb.StreamShortcutsRequest{OrderBy: "name", PageSize: 2}))
+	require.Equal(t, [][]string{{"e", "d", "c", "a"}}, streamNames(member, &v1pb.StreamShortcutsRequest{OrderBy: "name desc", Tags: []string{"team"}}))
+	require.Equal(t, [][]string{}, streamNames(member, &v1pb.StreamShortcutsRequest{Tags: []string{"other"}}))
+
+	err := s.StreamShortcuts(&v1pb.StreamShortcutsRequest{OrderBy: "link"}, &testingShortcutStream{ctx: withUser(ctx, member)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The stream stops once the client is gone.
+	streamCtx, cancel := context.WithCancel(withUser(ctx, owner))
+	defer cancel()
+	stream := &testingShortcutStream{ctx: streamCtx, cancel: cancel, cancelAfter: 1}
+	err = s.StreamShortcuts(&v1pb.StreamShortcutsRequest{OrderBy: "name", PageSize: 2}, stream)
+	require.Equal(t, codes.Canceled, status.Code(err))
+	require.Equal(t, 1, len(stream.responses))
+}
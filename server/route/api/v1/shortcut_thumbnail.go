@@ -0,0 +1,157 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/netip"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yourselfhosted/slash/plugin/httpgetter"
+	"github.com/yourselfhosted/slash/plugin/thumbnailer"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// thumbnailTimeout bounds the rendering and the storing of a thumbnail.
+	thumbnailTimeout = time.Minute
+	// maxThumbnailsPerMinute bounds the thumbnails rendered for the shortcuts of each user, the others are skipped.
+	maxThumbnailsPerMinute = 10
+)
+
+func (s *APIV1Service) GetShortcutThumbnail(ctx context.Context, request *v1pb.GetShortcutThumbnailRequest) (*httpbody.HttpBody, error) {
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID:              &request.Id,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	access, err := s.getShortcutAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if !access.canRead(shortcut) {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	thumbnail, err := s.Store.GetShortcutThumbnail(ctx, &store.FindShortcutThumbnail{
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut thumbnail: %v", err)
+	}
+	// The thumbnail of a previous link is stale until the one of the current link is rendered.
+	if thumbnail == nil || thumbnail.Link != shortcut.Link {
+		return nil, status.Errorf(codes.NotFound, "thumbnail not found")
+	}
+	return &httpbody.HttpBody{
+		ContentType: thumbnail.ContentType,
+		Data:        thumbnail.Content,
+	}, nil
+}
+
+// getThumbnailer returns the thumbnailer of the workspace, nil when the thumbnails are disabled.
+func (s *APIV1Service) getThumbnailer(ctx context.Context) (thumbnailer.Thumbnailer, error) {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := shortcutRelatedSetting.GetThumbnailRendererUrl()
+	if endpoint == "" {
+		return nil, nil
+	}
+	if s.Thumbnailer != nil {
+		return s.Thumbnailer, nil
+	}
+	return thumbnailer.NewHTTPThumbnailer(endpoint), nil
+}
+
+// generateShortcutThumbnail renders and stores the thumbnail of the link of the shortcut in the background,
+// when the thumbnails are enabled and the creator of the shortcut is under the rate limit. The failures are logged.
+func (s *APIV1Service) generateShortcutThumbnail(ctx context.Context, shortcut *storepb.Shortcut) {
+	renderer, err := s.getThumbnailer(ctx)
+	if err != nil {
+		slog.Warn("failed to get thumbnailer", slog.String("error", err.Error()))
+		return
+	}
+	if renderer == nil {
+		return
+	}
+	if !s.thumbnailRateLimiter.allow(shortcut.CreatorId).allowed {
+		slog.Warn("thumbnail rate limit exceeded", slog.Int("shortcutId", int(shortcut.Id)), slog.Int("creatorId", int(shortcut.CreatorId)))
+		return
+	}
+	// The rendering outlives the request, in the workspace of the request.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), thumbnailTimeout)
+	go func() {
+		defer cancel()
+		if err := s.renderShortcutThumbnail(ctx, renderer, shortcut.Id, shortcut.Link); err != nil {
+			slog.Warn("failed to generate shortcut thumbnail", slog.Int("shortcutId", int(shortcut.Id)), slog.String("error", err.Error()))
+		}
+	}()
+}
+
+func (s *APIV1Service) renderShortcutThumbnail(ctx context.Context, renderer thumbnailer.Thumbnailer, shortcutID int32, link string) error {
+	if err := checkPublicLink(ctx, link); err != nil {
+		return err
+	}
+	thumbnail, err := renderer.Thumbnail(ctx, link)
+	if err != nil {
+		return errors.Wrap(err, "failed to render thumbnail")
+	}
+	if !thumbnailer.IsImageContentType(thumbnail.ContentType) {
+		return errors.Errorf("unsupported content type %q", thumbnail.ContentType)
+	}
+	if len(thumbnail.Content) > thumbnailer.DefaultMaxSize {
+		return errors.Errorf("thumbnail is larger than %d bytes", thumbnailer.DefaultMaxSize)
+	}
+	if _, err := s.Store.UpsertShortcutThumbnail(ctx, &store.ShortcutThumbnail{
+		ShortcutID:  shortcutID,
+		Link:        link,
+		ContentType: thumbnail.ContentType,
+		Content:     thumbnail.Content,
+	}); err != nil {
+		return errors.Wrap(err, "failed to upsert shortcut thumbnail")
+	}
+	return nil
+}
+
+// checkPublicLink returns an error unless the link is an http(s) URL whose host resolves to public addresses only,
+// so that the renderer isn't made to reach the private networks of the server.
+func checkPublicLink(ctx context.Context, link string) error {
+	u, err := url.Parse(link)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	var addrs []netip.Addr
+	if addr, err := netip.ParseAddr(u.Hostname()); err == nil {
+		addrs = []netip.Addr{addr}
+	} else if addrs, err = net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname()); err != nil {
+		return errors.Wrap(err, "failed to resolve host")
+	}
+	for _, addr := range addrs {
+		if !httpgetter.IsPublicIP(addr) {
+			return errors.Wrap(httpgetter.ErrNonPublicAddress, addr.String())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yourselfhosted/slash/plugin/httpgetter"
+	"github.com/yourselfhosted/slash/plugin/thumbnailer"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// stubThumbnailer renders the link itself as the thumbnail, or the thumbnail when set.
+type stubThumbnailer struct {
+	thumbnail *thumbnailer.Thumbnail
+
+	mu    sync.Mutex
+	links []string
+}
+
+func (t *stubThumbnailer) Thumbnail(_ context.Context, link string) (*thumbnailer.Thumbnail, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.links = append(t.links, link)
+	if t.thumbnail != nil {
+		return t.thumbnail, nil
+	}
+	return &thumbnailer.Thumbnail{ContentType: "image/png", Content: []byte(link)}, nil
+}
+
+func TestShortcutThumbnail(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	stub := &stubThumbnailer{}
+	s.Thumbnailer = stub
+	s.thumbnailRateLimiter = newUserRateLimiter(2)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	adminCtx := withUser(ctx, admin)
+	getThumbnail := func(id int32) ([]byte, error) {
+		body, err := s.GetShortcutThumbnail(adminCtx, &v1pb.GetShortcutThumbnailRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return body.Data, nil
+	}
+
+	// The thumbnails aren't rendered until the renderer is configured.
+	disabled, err := s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "disabled", Link: "https://93.184.215.14/disabled"},
+	})
+	require.NoError(t, err)
+	_, err = getThumbnail(disabled.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{ThumbnailRendererUrl: "not a url"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"thumbnail_renderer_url"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	workspaceSetting, err := s.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{ThumbnailRendererUrl: "https://renderer.example.com/api"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"thumbnail_renderer_url"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://renderer.example.com/api", workspaceSetting.ThumbnailRendererUrl)
+
+	// The thumbnail is rendered in the background after the shortcut is created, and again after its link changes.
+	shortcut, err := s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "enabled", Link: "https://93.184.215.14/enabled"},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		data, err := getThumbnail(shortcut.Id)
+		return err == nil && string(data) == "https://93.184.215.14/enabled"
+	}, 5*time.Second, 10*time.Millisecond)
+	body, err := s.GetShortcutThumbnail(adminCtx, &v1pb.GetShortcutThumbnailRequest{Id: shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, "image/png", body.ContentType)
+	_, err = s.UpdateShortcut(adminCtx, &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Link: "https://93.184.215.14/updated"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"link"}},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		data, err := getThumbnail(shortcut.Id)
+		return err == nil && string(data) == "https://93.184.215.14/updated"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The rendering of the shortcuts of a user is rate limited.
+	limited, err := s.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+		Shortcut: &v1pb.Shortcut{Name: "limited", Link: "https://93.184.215.14/limited"},
+	})
+	require.NoError(t, err)
+	_, err = getThumbnail(limited.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	stub.mu.Lock()
+	require.Equal(t, []string{"https://93.184.215.14/enabled", "https://93.184.215.14/updated"}, stub.links)
+	stub.mu.Unlock()
+
+	// The thumbnail of a previous link is stale.
+	_, err = s.Store.UpsertShortcutThumbnail(ctx, &store.ShortcutThumbnail{
+		ShortcutID:  limited.Id,
+		Link:        "https://93.184.215.14/previous",
+		ContentType: "image/png",
+		Content:     []byte("png"),
+	})
+	require.NoError(t, err)
+	_, err = getThumbnail(limited.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The links to private networks and the unsupported or oversized images aren't stored.
+	err = s.renderShortcutThumbnail(ctx, stub, limited.Id, "http://127.0.0.1/admin")
+	require.ErrorIs(t, err, httpgetter.ErrNonPublicAddress)
+	err = s.renderShortcutThumbnail(ctx, stub, limited.Id, "file:///etc/passwd")
+	require.Error(t, err)
+	stub.thumbnail = &thumbnailer.Thumbnail{ContentType: "text/html", Content: []byte("<html></html>")}
+	require.Error(t, s.renderShortcutThumbnail(ctx, stub, limited.Id, "https://93.184.215.14/limited"))
+	stub.thumbnail = &thumbnailer.Thumbnail{ContentType: "image/png", Content: make([]byte, thumbnailer.DefaultMaxSize+1)}
+	require.Error(t, s.renderShortcutThumbnail(ctx, stub, limited.Id, "https://93.184.215.14/limited"))
+	_, err = getThumbnail(limited.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"sync"
+	"time"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+const (
+	// defaultFailedSignInWindow is the sliding window of the failed sign ins when the workspace doesn't set one.
+	defaultFailedSignInWindow = 15 * time.Minute
+	// maxFailedSignInWindowMinutes bounds the window, and so how long the failures are kept in memory.
+	maxFailedSignInWindowMinutes = 24 * 60
+)
+
+// signInRateLimiter rejects the password sign ins of a client IP or an email once they failed the limit of times
+// within the sliding window of the workspace, until the oldest of these failures leaves the window.
+// Only the last failures up to the limit are kept, and the keys without failures in their window are evicted.
+// A nil signInRateLimiter never rejects.
+type signInRateLimiter struct {
+	mu       sync.Mutex
+	failures map[string]*signInFailures
+	now      func() time.Time
+}
+
+type signInFailures struct {
+	window time.Duration
+	// times is the times of the last failures, from the oldest.
+	times []time.Time
+}
+
+func newSignInRateLimiter() *signInRateLimiter {
+	return &signInRateLimiter{
+		failures: map[string]*signInFailures{},
+		now:      time.Now,
+	}
+}
+
+// signInRateLimit is the limit of the failed sign ins of the workspace security setting.
+type signInRateLimit struct {
+	limit  int
+	window time.Duration
+}
+
+func getSignInRateLimit(securitySetting *storepb.WorkspaceSetting_SecuritySetting) *signInRateLimit {
+	window := time.Duration(securitySetting.FailedSignInWindowMinutes) * time.Minute
+	if window <= 0 {
+		window = defaultFailedSignInWindow
+	}
+	return &signInRateLimit{
+		limit:  int(securitySetting.MaxFailedSignIns),
+		window: window,
+	}
+}
+
+// signInRateLimitKeys returns the keys of the client IP and the email of a sign in to the workspace.
+// The workspaces are limited separately as they have their own limits.
+func signInRateLimitKeys(workspaceID, ip, email string) []string {
+	keys := []string{"email:" + workspaceID + ":" + normalizeSignInEmail(email)}
+	if ip != "" {
+		keys = append(keys, "ip:"+workspaceID+":"+ip)
+	}
+	return keys
+}
+
+// check returns a ResourceExhausted error with the retry delay while any of the keys is over the limit.
+func (l *signInRateLimiter) check(rateLimit *signInRateLimit, keys ...string) error {
+	if l == nil || rateLimit.limit <= 0 {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	var retryAfter time.Duration
+	for _, key := range keys {
+		failures, ok := l.failures[key]
+		if !ok {
+			continue
+		}
+		times := failures.inWindow(now, rateLimit.window)
+		if len(times) < rateLimit.limit {
+			continue
+		}
+		// The sign ins are allowed again once the failure making the count reach the limit leaves the window.
+		retryAfter = max(retryAfter, times[len(times)-rateLimit.limit].Add(rateLimit.window).Sub(now))
+	}
+	if retryAfter <= 0 {
+		return nil
+	}
+	return newSignInRetryError(retryAfter)
+}
+
+// fail records a failed sign in of the keys.
+func (l *signInRateLimiter) fail(rateLimit *signInRateLimit, keys ...string) {
+	if l == nil || rateLimit.limit <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.evictExpired(now)
+	for _, key := range keys {
+		failures, ok := l.failures[key]
+		if !ok {
+			failures = &signInFailures{}
+			l.failures[key] = failures
+		}
+		failures.window = rateLimit.window
+		times := append(failures.inWindow(now, rateLimit.window), now)
+		if len(times) > rateLimit.limit {
+			times = times[len(times)-rateLimit.limit:]
+		}
+		failures.times = times
+	}
+}
+
+// succeed resets the failed sign ins of the key.
+func (l *signInRateLimiter) succeed(key string) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.failures, key)
+}
+
+// evictExpired forgets the keys whose last failure left their window.
+func (l *signInRateLimiter) evictExpired(now time.Time) {
+	for key, failures := range l.failures {
+		if len(failures.inWindow(now, failures.window)) == 0 {
+			delete(l.failures, key)
+		}
+	}
+}
+
+// inWindow returns the times of the failures within the window before now.
+func (f *signInFailures) inWindow(now time.Time, window time.Duration) []time.Time {
+	for i, t := range f.times {
+		if now.Sub(t) < window {
+			return f.times[i:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestSignInRateLimiterSlidingWindow(t *testing.T) {
+	limiter := newSignInRateLimiter()
+	now := time.Unix(1700000000, 0)
+	limiter.now = func() time.Time { return now }
+	rateLimit := &signInRateLimit{limit: 3, window: time.Minute}
+	keys := signInRateLimitKeys("", "10.0.0.1", "test@test.com")
+
+	// The failures are counted in the sliding window, the first one leaves it a minute after it.
+	start := now
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.check(rateLimit, keys...))
+		limiter.fail(rateLimit, keys...)
+		now = now.Add(20 * time.Second)
+	}
+	now = start.Add(50 * time.Second)
+	require.Equal(t, 10*time.Second, retryDelay(t, limiter.check(rateLimit, keys...)))
+	require.Equal(t, 10*time.Second, retryDelay(t, limiter.check(rateLimit, signInRateLimitKeys("", "10.0.0.2", "TEST@test.com")...)))
+	require.Equal(t, 10*time.Second, retryDelay(t, limiter.check(rateLimit, signInRateLimitKeys("", "10.0.0.1", "other@test.com")...)))
+	require.NoError(t, limiter.check(rateLimit, signInRateLimitKeys("other", "10.0.0.1", "test@test.com")...))
+	now = now.Add(10 * time.Second)
+	require.NoError(t, limiter.check(rateLimit, keys...))
+
+	// Only the failures of the email are reset by signing in.
+	limiter.fail(rateLimit, keys...)
+	require.Error(t, limiter.check(rateLimit, keys...))
+	limiter.succeed(keys[0])
+	require.NoError(t, limiter.check(rateLimit, keys[0]))
+	require.Error(t, limiter.check(rateLimit, keys[1]))
+
+	// The keys are evicted once their failures left the window, and no more than the limit of failures are kept.
+	require.Len(t, limiter.failures[keys[1]].times, 3)
+	now = now.Add(time.Minute)
+	limiter.fail(rateLimit, "email::new@test.com")
+	require.Len(t, limiter.failures, 1)
+
+	require.NoError(t, limiter.check(&signInRateLimit{window: time.Minute}, "email::new@test.com"))
+}
+
+func TestSignInRateLimit(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	_, err = s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{MaxFailedSignIns: 2, FailedSignInWindowMinutes: 5},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"max_failed_sign_ins", "failed_sign_in_window_minutes"}},
+	})
+	require.NoError(t, err)
+	_, err = s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{FailedSignInWindowMinutes: maxFailedSignInWindowMinutes + 1},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"failed_sign_in_window_minutes"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	signIn := func(ip, email, password string) error {
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs(clientIPMetadataKey, ip))
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: email, Password: password})
+		return err
+	}
+
+	// A successful sign in resets the failures of the account.
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("10.0.0.1", user.Email, "wrong")))
+	require.NoError(t, signIn("10.0.0.2", user.Email, "password"))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("10.0.0.3", user.Email, "wrong")))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("10.0.0.4", user.Email, "wrong")))
+	// The account is limited from any IP, even with the right password.
+	require.Equal(t, 5*time.Minute, retryDelay(t, signIn("10.0.0.5", user.Email, "password")))
+
+	// The IP is limited for any account, the unknown ones included.
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("10.0.0.6", "unknown@test.com", "wrong")))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("10.0.0.6", "other@test.com", "wrong")))
+	require.Equal(t, codes.ResourceExhausted, status.Code(signIn("10.0.0.6", admin.Email, "password")))
+}
+
+func TestSignInRateLimitSpoofedForwardedFor(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	s.Profile.TrustedProxies = []string{"10.0.0.1"}
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	_, err := s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{MaxFailedSignIns: 2, FailedSignInWindowMinutes: 5},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"max_failed_sign_ins", "failed_sign_in_window_minutes"}},
+	})
+	require.NoError(t, err)
+	// Signs in through the gateway, which tells the IP of the client in the metadata.
+	signIn := func(remoteAddr, forwardedFor, email string) error {
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/auth/signin", nil)
+		request.RemoteAddr = remoteAddr
+		request.Header.Set("X-Forwarded-For", forwardedFor)
+		md := newClientIPMetadata(s.Profile)(ctx, request)
+		ctx := peer.NewContext(metadata.NewIncomingContext(ctx, md), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}})
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: email, Password: "wrong"})
+		return err
+	}
+
+	// A new X-Forwarded-For on each attempt doesn't reset the limit of the client.
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("203.0.113.7:1234", "198.51.100.1", "a@test.com")))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("203.0.113.7:1234", "198.51.100.2", "b@test.com")))
+	require.Equal(t, codes.ResourceExhausted, status.Code(signIn("203.0.113.7:1234", "198.51.100.3", "c@test.com")))
+	// Nor prepending entries to the one of the trusted proxy.
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("10.0.0.1:1234", "198.51.100.4, 203.0.113.8", "d@test.com")))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("10.0.0.1:1234", "198.51.100.5, 203.0.113.8", "e@test.com")))
+	require.Equal(t, codes.ResourceExhausted, status.Code(signIn("10.0.0.1:1234", "198.51.100.6, 203.0.113.8", "f@test.com")))
+
+	// The gRPC-Web clients can't tell their IP in the header the gateway sets, even from the loopback.
+	grpcServer := grpc.NewServer()
+	v1pb.RegisterAuthServiceServer(grpcServer, s)
+	handler := newGRPCWebHandler(s.Profile, grpcServer)
+	signInWithGRPCWeb := func(ip string) codes.Code {
+		request := newGRPCWebRequest(t, "/slash.api.v1.AuthService/SignIn", &v1pb.SignInRequest{Email: ip + "@test.com", Password: "wrong"})
+		request.RemoteAddr = "127.0.0.1:1234"
+		request.Header.Set(clientIPMetadataKey, ip)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		code, err := strconv.Atoi(recorder.Header().Get("Grpc-Status"))
+		require.NoError(t, err)
+		return codes.Code(code)
+	}
+	require.Equal(t, codes.InvalidArgument, signInWithGRPCWeb("198.51.100.7"))
+	require.Equal(t, codes.InvalidArgument, signInWithGRPCWeb("198.51.100.8"))
+	require.Equal(t, codes.ResourceExhausted, signInWithGRPCWeb("198.51.100.9"))
+}
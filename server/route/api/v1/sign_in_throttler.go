@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"container/list"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// signInThrottler locks out the password sign in of an email of a workspace after failed attempts.
+// The lockout window starts at the base delay and grows by the multiplier with each successive failure,
+// up to the max window. A successful sign in resets it.
+// A nil signInThrottler never locks out.
+type signInThrottler struct {
+	base       time.Duration
+	multiplier float64
+	max        time.Duration
+
+	mu     sync.Mutex
+	states map[signInThrottleKey]*list.Element
+	order  *list.List // of *signInBackoffState, the least recently failed first
+	now    func() time.Time
+}
+
+type signInThrottleKey struct {
+	workspaceID string
+	email       string
+}
+
+type signInBackoffState struct {
+	key         signInThrottleKey
+	failures    int
+	lockedUntil time.Time
+}
+
+// newSignInThrottler returns a new signInThrottler, or nil when the base delay is zero.
+func newSignInThrottler(base time.Duration, multiplier float64, max time.Duration) *signInThrottler {
+	if base <= 0 {
+		return nil
+	}
+	return &signInThrottler{
+		base:       base,
+		multiplier: multiplier,
+		max:        max,
+		states:     map[signInThrottleKey]*list.Element{},
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// check returns a ResourceExhausted error with the retry delay while the email of the workspace is locked out.
+func (t *signInThrottler) check(workspaceID, email string) error {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	element, ok := t.states[newSignInThrottleKey(workspaceID, email)]
+	if !ok {
+		return nil
+	}
+	retryAfter := element.Value.(*signInBackoffState).lockedUntil.Sub(t.now())
+	if retryAfter <= 0 {
+		return nil
+	}
+	return newSignInRetryError(retryAfter)
+}
+
+// newSignInRetryError returns the ResourceExhausted error of a sign in to retry after the delay, rounded up to seconds.
+func newSignInRetryError(retryAfter time.Duration) error {
+	retryAfter = time.Duration(math.Ceil(retryAfter.Seconds())) * time.Second
+	st := status.Newf(codes.ResourceExhausted, "too many failed sign in attempts, retry after %s", retryAfter)
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// fail records a failed attempt of the email of the workspace and returns the lockout window it starts.
+func (t *signInThrottler) fail(workspaceID, email string) time.Duration {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	t.evictExpired(now)
+	key := newSignInThrottleKey(workspaceID, email)
+	var state *signInBackoffState
+	if element, ok := t.states[key]; ok && !t.isExpired(element.Value.(*signInBackoffState), now) {
+		state = element.Value.(*signInBackoffState)
+		t.order.MoveToBack(element)
+	} else {
+		if ok {
+			t.order.Remove(element)
+		}
+		state = &signInBackoffState{key: key}
+		t.states[key] = t.order.PushBack(state)
+	}
+	state.failures++
+	window := t.window(state.failures)
+	state.lockedUntil = now.Add(window)
+	return window
+}
+
+// succeed resets the failed attempts of the email of the workspace.
+func (t *signInThrottler) succeed(workspaceID, email string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := newSignInThrottleKey(workspaceID, email)
+	if element, ok := t.states[key]; ok {
+		t.order.Remove(element)
+		delete(t.states, key)
+	}
+}
+
+// window returns the lockout window after the given number of successive failures.
+func (t *signInThrottler) window(failures int) time.Duration {
+	window := float64(t.base) * math.Pow(t.multiplier, float64(failures-1))
+	if t.max > 0 && window > float64(t.max) {
+		return t.max
+	}
+	return time.Duration(window)
+}
+
+// isExpired returns whether the failures of the state are forgotten, without attempts for longer than the max window
+// after their lockout, so that the backoff starts over from the base delay.
+func (t *signInThrottler) isExpired(state *signInBackoffState, now time.Time) bool {
+	return now.Sub(state.lockedUntil) >= t.max
+}
+
+// evictExpired forgets the expired failures from the least recently failed one, up to the first that isn't expired.
+// The ones failed after it may be expired already, fail doesn't reuse them and they are evicted within a max window.
+func (t *signInThrottler) evictExpired(now time.Time) {
+	for element := t.order.Front(); element != nil; element = t.order.Front() {
+		state := element.Value.(*signInBackoffState)
+		if !t.isExpired(state, now) {
+			return
+		}
+		t.order.Remove(element)
+		delete(t.states, state.key)
+	}
+}
+
+func newSignInThrottleKey(workspaceID, email string) signInThrottleKey {
+	return signInThrottleKey{workspaceID: workspaceID, email: normalizeSignInEmail(email)}
+}
+
+func normalizeSignInEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
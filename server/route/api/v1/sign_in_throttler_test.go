@@ -0,0 +1,141 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// retryDelay returns the retry delay in the RetryInfo of the error.
+func retryDelay(t *testing.T, err error) time.Duration {
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	for _, detail := range st.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			return retryInfo.RetryDelay.AsDuration()
+		}
+	}
+	require.Fail(t, "no retry info", err)
+	return 0
+}
+
+func TestSignInThrottlerBackoff(t *testing.T) {
+	throttler := newSignInThrottler(time.Second, 3, time.Minute)
+	now := time.Unix(1700000000, 0)
+	throttler.now = func() time.Time { return now }
+
+	// The window grows with each successive failure up to the max.
+	windows := []time.Duration{}
+	for i := 0; i < 6; i++ {
+		require.NoError(t, throttler.check("", "test@test.com"))
+		windows = append(windows, throttler.fail("", "test@test.com"))
+		require.Equal(t, windows[i], retryDelay(t, throttler.check("", "TEST@test.com")))
+		now = now.Add(windows[i])
+	}
+	require.Equal(t, []time.Duration{time.Second, 3 * time.Second, 9 * time.Second, 27 * time.Second, time.Minute, time.Minute}, windows)
+
+	// The retry delay is rounded up to seconds.
+	throttler.fail("", "test@test.com")
+	now = now.Add(time.Minute - 1500*time.Millisecond)
+	require.Equal(t, 2*time.Second, retryDelay(t, throttler.check("", "test@test.com")))
+	require.NoError(t, throttler.check("", "other@test.com"))
+
+	// The backoff starts over after a success, or after a quiet max window.
+	throttler.succeed("", "test@test.com")
+	require.NoError(t, throttler.check("", "test@test.com"))
+	require.Equal(t, time.Second, throttler.fail("", "test@test.com"))
+	require.Equal(t, 3*time.Second, throttler.fail("", "test@test.com"))
+	now = now.Add(3*time.Second + time.Minute)
+	require.Equal(t, time.Second, throttler.fail("", "test@test.com"))
+
+	// A nil throttler never locks out.
+	require.Nil(t, newSignInThrottler(0, 2, time.Minute))
+	var disabled *signInThrottler
+	disabled.fail("", "test@test.com")
+	require.NoError(t, disabled.check("", "test@test.com"))
+}
+
+func TestSignInThrottlerWorkspaces(t *testing.T) {
+	throttler := newSignInThrottler(time.Second, 2, time.Minute)
+	now := time.Unix(1700000000, 0)
+	throttler.now = func() time.Time { return now }
+
+	// The same email is throttled separately in each workspace.
+	throttler.fail("acme", "test@test.com")
+	require.Equal(t, time.Second, retryDelay(t, throttler.check("acme", "test@test.com")))
+	require.NoError(t, throttler.check("", "test@test.com"))
+	require.NoError(t, throttler.check("other", "test@test.com"))
+	require.Equal(t, time.Second, throttler.fail("", "test@test.com"))
+	require.Equal(t, 2*time.Second, throttler.fail("acme", "test@test.com"))
+	throttler.succeed("", "test@test.com")
+	require.Equal(t, 2*time.Second, retryDelay(t, throttler.check("acme", "test@test.com")))
+}
+
+func TestSignInThrottlerEviction(t *testing.T) {
+	throttler := newSignInThrottler(time.Second, 2, time.Minute)
+	now := time.Unix(1700000000, 0)
+	throttler.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		throttler.fail("", "old@test.com")
+	}
+	now = now.Add(30 * time.Second)
+	throttler.fail("", "recent@test.com")
+	require.Len(t, throttler.states, 2)
+
+	// The failures quiet for a max window after their lockout are evicted from the least recently failed.
+	now = now.Add(35 * time.Second)
+	throttler.fail("", "new@test.com")
+	require.Len(t, throttler.states, 2)
+	require.Equal(t, throttler.order.Len(), len(throttler.states))
+	require.NotContains(t, throttler.states, newSignInThrottleKey("", "old@test.com"))
+	require.Equal(t, time.Second, throttler.fail("", "old@test.com"))
+
+	now = now.Add(2 * time.Minute)
+	throttler.fail("", "new@test.com")
+	require.Len(t, throttler.states, 1)
+	require.Equal(t, 1, throttler.order.Len())
+}
+
+func TestSignInBackoff(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	s.signInThrottler = newSignInThrottler(time.Second, 2, time.Minute)
+	now := time.Now()
+	s.signInThrottler.now = func() time.Time { return now }
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	passwordHashStr := string(passwordHash)
+	_, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, PasswordHash: &passwordHashStr})
+	require.NoError(t, err)
+	signIn := func(password string) error {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		_, err := s.SignIn(ctx, &v1pb.SignInRequest{Email: user.Email, Password: password})
+		return err
+	}
+
+	// Each failure locks out the sign in for longer, even with the right password.
+	for _, window := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		require.Equal(t, codes.InvalidArgument, status.Code(signIn("wrong")))
+		err := signIn("password")
+		require.Equal(t, window, retryDelay(t, err))
+		require.Contains(t, status.Convert(err).Message(), "retry after "+window.String())
+		now = now.Add(window)
+	}
+
+	// A success resets the backoff.
+	require.NoError(t, signIn("password"))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("wrong")))
+	require.Equal(t, time.Second, retryDelay(t, signIn("password")))
+}
@@ -0,0 +1,208 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	"github.com/yourselfhosted/slash/plugin/idp"
+	"github.com/yourselfhosted/slash/plugin/idp/oauth2"
+	"github.com/yourselfhosted/slash/plugin/idp/oidc"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/license"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (s *APIV1Service) SignInWithSSO(ctx context.Context, request *v1pb.SignInWithSSORequest) (*v1pb.User, error) {
+	return s.OAuthSignIn(ctx, &v1pb.OAuthSignInRequest{
+		IdpId:       request.IdpId,
+		Code:        request.Code,
+		RedirectUri: request.RedirectUri,
+	})
+}
+
+// OAuthSignIn signs in the user of the email of the identity provider, and links the identity to them.
+// A user is created for the email when none has it.
+func (s *APIV1Service) OAuthSignIn(ctx context.Context, request *v1pb.OAuthSignInRequest) (*v1pb.User, error) {
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
+		return nil, status.Errorf(codes.PermissionDenied, "SSO is not available in the current plan")
+	}
+	if request.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code is required")
+	}
+	identityProvider, err := s.getIdentityProvider(ctx, request.IdpId)
+	if err != nil {
+		return nil, err
+	}
+
+	var userInfo *idp.IdentityProviderUserInfo
+	var email string
+	switch identityProvider.Type {
+	case storepb.IdentityProvider_OAUTH2:
+		oauth2IdentityProvider, err := oauth2.NewIdentityProvider(identityProvider.Config.GetOauth2())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create oauth2 identity provider, err: %s", err)
+		}
+		token, err := oauth2IdentityProvider.ExchangeToken(ctx, request.RedirectUri, request.Code)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to exchange token, err: %s", err)
+		}
+		userInfo, err = oauth2IdentityProvider.UserInfo(token)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user info, err: %s", err)
+		}
+		// The identifier of the field mapping is the email.
+		email = userInfo.Identifier
+	case storepb.IdentityProvider_OIDC:
+		oidcIdentityProvider, err := oidc.NewIdentityProvider(identityProvider.Config.GetOidc())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create oidc identity provider, err: %s", err)
+		}
+		// An invalid code or ID token is the failure of the client, not of the server.
+		if userInfo, err = oidcIdentityProvider.ExchangeToken(ctx, request.RedirectUri, request.Code); err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "failed to sign in with the identity provider, err: %s", err)
+		}
+		email = userInfo.Email
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported identity provider type %q", identityProvider.Type)
+	}
+
+	if !util.ValidateEmail(email) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email address")
+	}
+	if !isIdentityProviderEmail(identityProvider, email) {
+		return nil, status.Errorf(codes.PermissionDenied, "the email domain is not allowed by the identity provider")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Email:           &email,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, err: %s", err)
+	}
+	if user == nil {
+		if user, err = s.createIdentityProviderUser(ctx, email, userInfo.DisplayName); err != nil {
+			return nil, err
+		}
+	}
+	if user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.PermissionDenied, "user has been archived")
+	}
+	// The users who signed up with a password keep it, the identity is linked to their account.
+	if err := s.linkUserIdentity(ctx, user, identityProvider.Id, userInfo.Identifier); err != nil {
+		return nil, err
+	}
+
+	if err := s.enforceSessionLimit(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign in, err: %s", err)
+	}
+	s.createUserSignInActivity(ctx, user, &storepb.ActivityUserSignInPayload{
+		Method: signInMethodSSO,
+		IdpId:  identityProvider.Id,
+	})
+	return convertUserFromStore(user), nil
+}
+
+// getIdentityProvider returns the identity provider of the workspace with the id.
+func (s *APIV1Service) getIdentityProvider(ctx context.Context, id string) (*storepb.IdentityProvider, error) {
+	identityProviderSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %s", err)
+	}
+	for _, identityProvider := range identityProviderSetting.GetIdentityProvider().GetIdentityProviders() {
+		if identityProvider.Id == id {
+			return identityProvider, nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
+}
+
+// isIdentityProviderEmail returns whether the email is in the email domains of the identity provider, if it has any.
+func isIdentityProviderEmail(identityProvider *storepb.IdentityProvider, email string) bool {
+	if len(identityProvider.EmailDomains) == 0 {
+		return true
+	}
+	domain := email[strings.LastIndex(email, "@")+1:]
+	for _, emailDomain := range identityProvider.EmailDomains {
+		if strings.EqualFold(emailDomain, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// createIdentityProviderUser provisions a normal user for the email, with a random password they don't know.
+func (s *APIV1Service) createIdentityProviderUser(ctx context.Context, email, nickname string) (*store.User, error) {
+	if err := s.checkSeatAvailability(ctx); err != nil {
+		return nil, err
+	}
+	password, err := util.RandomString(20)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate random password, err: %s", err)
+	}
+	passwordHash, err := s.hashPassword(password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password hash, err: %s", err)
+	}
+	user, err := s.Store.CreateUser(ctx, &store.User{
+		Email:        email,
+		Nickname:     nickname,
+		PasswordHash: passwordHash,
+		// The new signup user should be normal user by default.
+		Role: store.RoleUser,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create user, err: %s", err)
+	}
+	return user, nil
+}
+
+// linkUserIdentity links the subject of the identity provider to the user, unless it is linked already.
+// The user is not signed in when another subject of the identity provider is linked to them, e.g. when the provider
+// gave their email to someone else.
+func (s *APIV1Service) linkUserIdentity(ctx context.Context, user *store.User, idpID, subject string) error {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_IDENTITY_LINKS,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user identity links: %v", err)
+	}
+	identityLinks := userSetting.GetIdentityLinks()
+	if identityLinks == nil {
+		identityLinks = &storepb.UserSetting_IdentityLinksSetting{}
+	}
+	for _, identityLink := range identityLinks.IdentityLinks {
+		if identityLink.IdpId != idpID {
+			continue
+		}
+		if identityLink.Subject != subject {
+			return status.Errorf(codes.PermissionDenied, "the user is linked to another identity of the identity provider")
+		}
+		return nil
+	}
+	identityLinks.IdentityLinks = append(identityLinks.IdentityLinks, &storepb.UserSetting_IdentityLinksSetting_IdentityLink{
+		IdpId:   idpID,
+		Subject: subject,
+	})
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_IDENTITY_LINKS,
+		Value: &storepb.UserSetting_IdentityLinks{
+			IdentityLinks: identityLinks,
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return nil
+}
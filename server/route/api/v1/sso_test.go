@@ -0,0 +1,310 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yourselfhosted/slash/internal/totp"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// newTestingOIDCProvider returns an OpenID provider whose token endpoint returns an ID token for the client
+// with the claims of the code.
+func newTestingOIDCProvider(t *testing.T, clientID string, codeClaims map[string]jwt.MapClaims) *httptest.Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":                 s.URL,
+			"authorization_endpoint": s.URL + "/authorize",
+			"token_endpoint":         s.URL + "/token",
+			"jwks_uri":               s.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]any{
+			"keys": []map[string]any{{
+				"kty": "RSA",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		claims, ok := codeClaims[r.PostForm.Get("code")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		claims["iss"], claims["aud"], claims["exp"] = s.URL, clientID, time.Now().Add(time.Hour).Unix()
+		rawIDToken, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+		require.NoError(t, err)
+		writeJSON(w, map[string]any{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"id_token":     rawIDToken,
+		})
+	})
+	return s
+}
+
+func TestOAuthSignIn(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	provider := newTestingOIDCProvider(t, "client-id", map[string]jwt.MapClaims{
+		"existing":   {"sub": "alice-id", "email": "alice@example.com", "email_verified": true, "name": "Alice"},
+		"new":        {"sub": "bob-id", "email": "bob@example.com", "email_verified": true, "name": "Bob"},
+		"reassigned": {"sub": "mallory-id", "email": "alice@example.com", "email_verified": true},
+		"unverified": {"sub": "carol-id", "email": "carol@example.com", "email_verified": false},
+	})
+	_, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
+		Value: &storepb.WorkspaceSetting_IdentityProvider{
+			IdentityProvider: &storepb.WorkspaceSetting_IdentityProviderSetting{
+				IdentityProviders: []*storepb.IdentityProvider{
+					{
+						Id:    "okta",
+						Title: "Okta",
+						Type:  storepb.IdentityProvider_OIDC,
+						Config: &storepb.IdentityProviderConfig{
+							Config: &storepb.IdentityProviderConfig_Oidc{
+								Oidc: &storepb.IdentityProviderConfig_OIDCConfig{
+									Issuer:       provider.URL,
+									ClientId:     "client-id",
+									ClientSecret: "client-secret",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	alice, err := s.Store.CreateUser(ctx, &store.User{
+		Email:        "alice@example.com",
+		Nickname:     "alice",
+		PasswordHash: string(passwordHash),
+		Role:         store.RoleAdmin,
+	})
+	require.NoError(t, err)
+	signIn := func(idpID, code string) (*v1pb.User, error) {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		return s.OAuthSignIn(ctx, &v1pb.OAuthSignInRequest{
+			IdpId:       idpID,
+			Code:        code,
+			RedirectUri: "https://slash.example.com/auth/callback",
+		})
+	}
+
+	_, err = signIn("okta", "")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = signIn("github", "existing")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = signIn("okta", "unknown")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = signIn("okta", "unverified")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// The registered email is linked rather than provisioned again, and keeps its password.
+	user, err := signIn("okta", "existing")
+	require.NoError(t, err)
+	require.Equal(t, alice.ID, user.Id)
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &alice.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_IDENTITY_LINKS,
+	})
+	require.NoError(t, err)
+	require.Len(t, userSetting.GetIdentityLinks().IdentityLinks, 1)
+	require.Equal(t, "okta", userSetting.GetIdentityLinks().IdentityLinks[0].IdpId)
+	require.Equal(t, "alice-id", userSetting.GetIdentityLinks().IdentityLinks[0].Subject)
+	_, err = s.SignIn(grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{}), &v1pb.SignInRequest{
+		Email:    "alice@example.com",
+		Password: "password",
+	})
+	require.NoError(t, err)
+	user, err = signIn("okta", "existing")
+	require.NoError(t, err)
+	require.Equal(t, alice.ID, user.Id)
+
+	// Another identity of the provider with the email doesn't take over the account.
+	_, err = signIn("okta", "reassigned")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	user, err = signIn("okta", "new")
+	require.NoError(t, err)
+	require.NotEqual(t, alice.ID, user.Id)
+	require.Equal(t, "bob@example.com", user.Email)
+	require.Equal(t, "Bob", user.Nickname)
+	require.Equal(t, v1pb.Role_USER, user.Role)
+}
+
+func TestGetAuthMethods(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	_, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
+		Value: &storepb.WorkspaceSetting_IdentityProvider{
+			IdentityProvider: &storepb.WorkspaceSetting_IdentityProviderSetting{
+				IdentityProviders: []*storepb.IdentityProvider{
+					{
+						Id:    "okta",
+						Title: "Okta",
+						Type:  storepb.IdentityProvider_OIDC,
+						Config: &storepb.IdentityProviderConfig{
+							Config: &storepb.IdentityProviderConfig_Oidc{
+								Oidc: &storepb.IdentityProviderConfig_OIDCConfig{
+									Issuer:       "https://okta.example.com",
+									ClientId:     "client-id",
+									ClientSecret: "client-secret",
+								},
+							},
+						},
+						EmailDomains: []string{"Test.com"},
+					},
+					{
+						Id:    "github",
+						Title: "GitHub",
+						Type:  storepb.IdentityProvider_OAUTH2,
+						Config: &storepb.IdentityProviderConfig{
+							Config: &storepb.IdentityProviderConfig_Oauth2{
+								Oauth2: &storepb.IdentityProviderConfig_OAuth2Config{
+									ClientId:     "client-id",
+									ClientSecret: "client-secret",
+									AuthUrl:      "https://github.com/login/oauth/authorize",
+									TokenUrl:     "https://github.com/login/oauth/access_token",
+									UserInfoUrl:  "https://api.github.com/user",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	enrollment, err := s.EnrollTOTP(withUser(ctx, user), &v1pb.EnrollTOTPRequest{})
+	require.NoError(t, err)
+	code, err := totp.GenerateCode(enrollment.Secret, time.Now())
+	require.NoError(t, err)
+	_, err = s.ActivateTOTP(withUser(ctx, user), &v1pb.ActivateTOTPRequest{Code: code})
+	require.NoError(t, err)
+	getAuthMethods := func(email string) *v1pb.GetAuthMethodsResponse {
+		response, err := s.GetAuthMethods(ctx, &v1pb.GetAuthMethodsRequest{Email: email})
+		require.NoError(t, err)
+		return response
+	}
+	providerIDs := func(response *v1pb.GetAuthMethodsResponse) []string {
+		ids := []string{}
+		for _, provider := range response.OauthProviders {
+			ids = append(ids, provider.Id)
+		}
+		return ids
+	}
+
+	_, err = s.GetAuthMethods(ctx, &v1pb.GetAuthMethodsRequest{Email: "user"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The methods of the domain don't tell the emails with an account, or with two-factor authentication, apart.
+	response := getAuthMethods(user.Email)
+	require.True(t, response.Password)
+	require.True(t, response.MfaAvailable)
+	require.Equal(t, []string{"okta", "github"}, providerIDs(response))
+	require.True(t, proto.Equal(response, getAuthMethods(admin.Email)))
+	require.True(t, proto.Equal(response, getAuthMethods("unknown@test.com")))
+	require.Equal(t, []string{"github"}, providerIDs(getAuthMethods("unknown@example.com")))
+
+	_, err = s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+		Value: &storepb.WorkspaceSetting_Security{
+			Security: &storepb.WorkspaceSetting_SecuritySetting{
+				DisallowPasswordAuth: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	response = getAuthMethods(admin.Email)
+	require.False(t, response.Password)
+	require.True(t, proto.Equal(response, getAuthMethods("unknown@test.com")))
+}
+
+func TestOAuthSignInEmailDomains(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	provider := newTestingOIDCProvider(t, "client-id", map[string]jwt.MapClaims{
+		"member":   {"sub": "alice-id", "email": "alice@EXAMPLE.com", "email_verified": true},
+		"outsider": {"sub": "bob-id", "email": "bob@example.org", "email_verified": true},
+	})
+	_, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
+		Value: &storepb.WorkspaceSetting_IdentityProvider{
+			IdentityProvider: &storepb.WorkspaceSetting_IdentityProviderSetting{
+				IdentityProviders: []*storepb.IdentityProvider{
+					{
+						Id:    "okta",
+						Title: "Okta",
+						Type:  storepb.IdentityProvider_OIDC,
+						Config: &storepb.IdentityProviderConfig{
+							Config: &storepb.IdentityProviderConfig_Oidc{
+								Oidc: &storepb.IdentityProviderConfig_OIDCConfig{
+									Issuer:       provider.URL,
+									ClientId:     "client-id",
+									ClientSecret: "client-secret",
+								},
+							},
+						},
+						EmailDomains: []string{"example.com"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	signIn := func(code string) (*v1pb.User, error) {
+		ctx := grpc.NewContextWithServerTransportStream(ctx, &testingServerTransportStream{})
+		return s.OAuthSignIn(ctx, &v1pb.OAuthSignInRequest{
+			IdpId:       "okta",
+			Code:        code,
+			RedirectUri: "https://slash.example.com/auth/callback",
+		})
+	}
+
+	_, err = signIn("member")
+	require.NoError(t, err)
+	_, err = signIn("outsider")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	email := "bob@example.org"
+	user, err := s.Store.GetUser(ctx, &store.FindUser{Email: &email})
+	require.NoError(t, err)
+	require.Nil(t, user)
+}
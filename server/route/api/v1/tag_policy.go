@@ -0,0 +1,258 @@
+package v1
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (s *APIV1Service) ListTagPolicies(ctx context.Context, _ *v1pb.ListTagPoliciesRequest) (*v1pb.ListTagPoliciesResponse, error) {
+	tagPolicies, err := s.Store.ListTagPolicies(ctx, &store.FindTagPolicy{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tag policies: %v", err)
+	}
+	response := &v1pb.ListTagPoliciesResponse{
+		TagPolicies: []*v1pb.TagPolicy{},
+	}
+	for _, tagPolicy := range tagPolicies {
+		response.TagPolicies = append(response.TagPolicies, convertTagPolicyFromStore(tagPolicy))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) UpsertTagPolicy(ctx context.Context, request *v1pb.UpsertTagPolicyRequest) (*v1pb.TagPolicy, error) {
+	if request.TagPolicy == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag policy is required")
+	}
+	if tag := request.TagPolicy.Tag; tag == "" || strings.ContainsFunc(tag, unicode.IsSpace) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag %q", tag)
+	}
+	role := convertTagPolicyRoleToStore(request.TagPolicy.Role)
+	if role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "role is required")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.TagPolicy.UserId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "user not found")
+	}
+
+	tagPolicy, err := s.Store.UpsertTagPolicy(ctx, &store.TagPolicy{
+		Tag:    request.TagPolicy.Tag,
+		UserID: user.ID,
+		Role:   role,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert tag policy: %v", err)
+	}
+	return convertTagPolicyFromStore(tagPolicy), nil
+}
+
+func (s *APIV1Service) DeleteTagPolicy(ctx context.Context, request *v1pb.DeleteTagPolicyRequest) (*emptypb.Empty, error) {
+	tagPolicy, err := s.Store.GetTagPolicy(ctx, &store.FindTagPolicy{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag policy: %v", err)
+	}
+	if tagPolicy == nil {
+		return nil, status.Errorf(codes.NotFound, "tag policy not found")
+	}
+	if err := s.Store.DeleteTagPolicy(ctx, &store.DeleteTagPolicy{
+		ID: tagPolicy.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete tag policy: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// shortcutAccess is the access of a user to the shortcuts, by their creator, the role of the user and the tag policies.
+// The shortcuts with a tag that has policies can only be read by the users granted by them, unless they are public.
+// The private shortcuts can only be read by their creator and the admins, whatever the tag policies.
+type shortcutAccess struct {
+	// user is nil for anonymous visitors.
+	user *store.User
+	// tagRoles is the role of the user for each tag with policies, empty when none of the policies of the tag is for the user.
+	tagRoles map[string]store.TagPolicyRole
+	// mostRestrictive combines the roles of the tags of a shortcut by their minimum instead of their maximum.
+	mostRestrictive bool
+}
+
+// getShortcutAccess returns the access of the user to the shortcuts by the tag policies of the workspace.
+func (s *APIV1Service) getShortcutAccess(ctx context.Context, user *store.User) (*shortcutAccess, error) {
+	tagPolicies, mostRestrictive, err := s.getTagPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newShortcutAccess(user, tagPolicies, mostRestrictive), nil
+}
+
+// getTagPolicies returns the tag policies of the workspace and whether their conflicts resolve to the most restrictive role.
+func (s *APIV1Service) getTagPolicies(ctx context.Context) ([]*store.TagPolicy, bool, error) {
+	tagPolicies, err := s.Store.ListTagPolicies(ctx, &store.FindTagPolicy{})
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "failed to list tag policies: %v", err)
+	}
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "failed to get workspace shortcut related setting: %v", err)
+	}
+	mostRestrictive := shortcutRelatedSetting.TagPolicyConflictResolution == storepb.WorkspaceSetting_ShortcutRelatedSetting_MOST_RESTRICTIVE
+	return tagPolicies, mostRestrictive, nil
+}
+
+func newShortcutAccess(user *store.User, tagPolicies []*store.TagPolicy, mostRestrictive bool) *shortcutAccess {
+	access := &shortcutAccess{
+		user:            user,
+		tagRoles:        map[string]store.TagPolicyRole{},
+		mostRestrictive: mostRestrictive,
+	}
+	for _, tagPolicy := range tagPolicies {
+		if user != nil && tagPolicy.UserID == user.ID {
+			access.tagRoles[tagPolicy.Tag] = tagPolicy.Role
+		} else if _, ok := access.tagRoles[tagPolicy.Tag]; !ok {
+			access.tagRoles[tagPolicy.Tag] = ""
+		}
+	}
+	return access
+}
+
+// isOwnerOrAdmin returns whether the user created the shortcut or is an admin, who have all the rights over it.
+func (a *shortcutAccess) isOwnerOrAdmin(shortcut *storepb.Shortcut) bool {
+	return a.user != nil && (a.user.ID == shortcut.CreatorId || a.user.Role == store.RoleAdmin)
+}
+
+// getTagPolicyRole returns the role the tag policies of the tags of the shortcut grant the user, combined by the
+// conflict resolution, and whether any of the tags has policies.
+func (a *shortcutAccess) getTagPolicyRole(shortcut *storepb.Shortcut) (store.TagPolicyRole, bool) {
+	var role store.TagPolicyRole
+	restricted := false
+	for _, tag := range shortcut.Tags {
+		tagRole, ok := a.tagRoles[tag]
+		if !ok {
+			continue
+		}
+		if !restricted {
+			role, restricted = tagRole, true
+			continue
+		}
+		rank, tagRank := getTagPolicyRoleRank(role), getTagPolicyRoleRank(tagRole)
+		if (a.mostRestrictive && tagRank < rank) || (!a.mostRestrictive && tagRank > rank) {
+			role = tagRole
+		}
+	}
+	return role, restricted
+}
+
+// canRead returns whether the user can read the shortcut.
+func (a *shortcutAccess) canRead(shortcut *storepb.Shortcut) bool {
+	if a.isOwnerOrAdmin(shortcut) || shortcut.Visibility == storepb.Visibility_PUBLIC {
+		return true
+	}
+	if a.user == nil || shortcut.Visibility == storepb.Visibility_PRIVATE {
+		return false
+	}
+	role, restricted := a.getTagPolicyRole(shortcut)
+	return !restricted || role != ""
+}
+
+// canManage returns whether the user can update and delete the shortcut.
+func (a *shortcutAccess) canManage(shortcut *storepb.Shortcut) bool {
+	if a.isOwnerOrAdmin(shortcut) {
+		return true
+	}
+	if a.user == nil || shortcut.Visibility == storepb.Visibility_PRIVATE {
+		return false
+	}
+	role, restricted := a.getTagPolicyRole(shortcut)
+	return restricted && role == store.TagPolicyRoleManage
+}
+
+// getVisibilityFilter returns the store filter of the shortcuts the user sees by their visibility, nil for the admins
+// who see all of them.
+func (a *shortcutAccess) getVisibilityFilter() *store.ShortcutVisibilityFilter {
+	if a.user == nil {
+		return &store.ShortcutVisibilityFilter{}
+	}
+	if a.user.Role == store.RoleAdmin {
+		return nil
+	}
+	return &store.ShortcutVisibilityFilter{UserID: a.user.ID}
+}
+
+// getFilter returns the store filter of the shortcuts the user can read, nil when the user can read all of them.
+func (a *shortcutAccess) getFilter() *store.ShortcutAccessFilter {
+	if len(a.tagRoles) == 0 || (a.user != nil && a.user.Role == store.RoleAdmin) {
+		return nil
+	}
+	filter := &store.ShortcutAccessFilter{
+		RestrictedTags:    slices.Sorted(maps.Keys(a.tagRoles)),
+		GrantedTags:       []string{},
+		RequireAllGranted: a.mostRestrictive,
+	}
+	if a.user != nil {
+		filter.UserID = a.user.ID
+	}
+	for _, tag := range filter.RestrictedTags {
+		if a.tagRoles[tag] != "" {
+			filter.GrantedTags = append(filter.GrantedTags, tag)
+		}
+	}
+	return filter
+}
+
+// getTagPolicyRoleRank returns the rank of the role, higher for more permissive roles.
+func getTagPolicyRoleRank(role store.TagPolicyRole) int {
+	switch role {
+	case store.TagPolicyRoleRead:
+		return 1
+	case store.TagPolicyRoleManage:
+		return 2
+	default:
+		return 0
+	}
+}
+
+func convertTagPolicyFromStore(tagPolicy *store.TagPolicy) *v1pb.TagPolicy {
+	role := v1pb.TagPolicy_ROLE_UNSPECIFIED
+	switch tagPolicy.Role {
+	case store.TagPolicyRoleRead:
+		role = v1pb.TagPolicy_READ
+	case store.TagPolicyRoleManage:
+		role = v1pb.TagPolicy_MANAGE
+	}
+	return &v1pb.TagPolicy{
+		Id:         tagPolicy.ID,
+		CreateTime: timestamppb.New(time.Unix(tagPolicy.CreatedTs, 0)),
+		Tag:        tagPolicy.Tag,
+		UserId:     tagPolicy.UserID,
+		Role:       role,
+	}
+}
+
+func convertTagPolicyRoleToStore(role v1pb.TagPolicy_Role) store.TagPolicyRole {
+	switch role {
+	case v1pb.TagPolicy_READ:
+		return store.TagPolicyRoleRead
+	case v1pb.TagPolicy_MANAGE:
+		return store.TagPolicyRoleManage
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestTagPolicyAccess(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	reader, _ := createTestingUser(ctx, t, s, "reader", store.RoleUser)
+	manager, _ := createTestingUser(ctx, t, s, "manager", store.RoleUser)
+	outsider, _ := createTestingUser(ctx, t, s, "outsider", store.RoleUser)
+	adminCtx, ownerCtx, readerCtx, managerCtx, outsiderCtx := withUser(ctx, admin), withUser(ctx, owner), withUser(ctx, reader), withUser(ctx, manager), withUser(ctx, outsider)
+
+	shortcuts := map[string]*v1pb.Shortcut{}
+	for _, shortcut := range []*v1pb.Shortcut{
+		{Name: "open", Link: "https://example.com/open", Tags: []string{"docs"}},
+		{Name: "team", Link: "https://example.com/team", Tags: []string{"team"}},
+		{Name: "mixed", Link: "https://example.com/mixed", Tags: []string{"team", "secret"}},
+	} {
+		created, err := s.CreateShortcut(ownerCtx, &v1pb.CreateShortcutRequest{Shortcut: shortcut})
+		require.NoError(t, err)
+		shortcuts[shortcut.Name] = created
+	}
+	upsertTagPolicy := func(tag string, user *store.User, role v1pb.TagPolicy_Role) error {
+		_, err := s.UpsertTagPolicy(adminCtx, &v1pb.UpsertTagPolicyRequest{
+			TagPolicy: &v1pb.TagPolicy{Tag: tag, UserId: user.ID, Role: role},
+		})
+		return err
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(upsertTagPolicy("a b", reader, v1pb.TagPolicy_READ)))
+	require.Equal(t, codes.InvalidArgument, status.Code(upsertTagPolicy("team", reader, v1pb.TagPolicy_ROLE_UNSPECIFIED)))
+	require.NoError(t, upsertTagPolicy("team", reader, v1pb.TagPolicy_READ))
+	require.NoError(t, upsertTagPolicy("team", manager, v1pb.TagPolicy_MANAGE))
+	require.NoError(t, upsertTagPolicy("secret", manager, v1pb.TagPolicy_READ))
+	tagPolicies, err := s.ListTagPolicies(adminCtx, &v1pb.ListTagPoliciesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(tagPolicies.TagPolicies))
+
+	canRead := func(ctx context.Context, name string) bool {
+		_, err := s.GetShortcut(ctx, &v1pb.GetShortcutRequest{Id: shortcuts[name].Id})
+		if status.Code(err) == codes.PermissionDenied {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+	canManage := func(ctx context.Context, name string) bool {
+		_, err := s.UpdateShortcut(ctx, &v1pb.UpdateShortcutRequest{
+			Shortcut:   &v1pb.Shortcut{Id: shortcuts[name].Id, Title: name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		})
+		if status.Code(err) == codes.PermissionDenied {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+	list := func(ctx context.Context) []string {
+		response, err := s.ListShortcuts(ctx, &v1pb.ListShortcutsRequest{OrderBy: "name"})
+		require.NoError(t, err)
+		names := []string{}
+		for _, shortcut := range response.Shortcuts {
+			names = append(names, shortcut.Name)
+		}
+		return names
+	}
+
+	// The tags with policies restrict their shortcuts to the granted users.
+	require.True(t, canRead(outsiderCtx, "open"))
+	require.False(t, canRead(outsiderCtx, "team"))
+	require.False(t, canManage(outsiderCtx, "open"))
+	require.Equal(t, []string{"open"}, list(outsiderCtx))
+	require.True(t, canRead(readerCtx, "team"))
+	require.False(t, canManage(readerCtx, "team"))
+	require.True(t, canManage(managerCtx, "team"))
+	require.Equal(t, []string{"mixed", "open", "team"}, list(ownerCtx))
+	require.Equal(t, []string{"mixed", "open", "team"}, list(adminCtx))
+
+	// The most permissive role of the tags of a shortcut applies by default.
+	require.Equal(t, []string{"mixed", "open", "team"}, list(readerCtx))
+	require.True(t, canRead(readerCtx, "mixed"))
+	require.True(t, canManage(managerCtx, "mixed"))
+
+	access, err := s.ListShortcutAccess(ownerCtx, &v1pb.ListShortcutAccessRequest{Id: shortcuts["team"].Id})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.ListShortcutAccessResponse_AUDIENCE_UNSPECIFIED, access.Audience)
+	reasons := map[string]v1pb.ListShortcutAccessResponse_Reason{}
+	for _, access := range access.Accesses {
+		reasons[access.User.Nickname] = access.Reason
+	}
+	require.Equal(t, map[string]v1pb.ListShortcutAccessResponse_Reason{
+		"owner":   v1pb.ListShortcutAccessResponse_OWNER,
+		"admin":   v1pb.ListShortcutAccessResponse_ADMIN,
+		"reader":  v1pb.ListShortcutAccessResponse_TAG_POLICY,
+		"manager": v1pb.ListShortcutAccessResponse_TAG_POLICY,
+	}, reasons)
+
+	// The most restrictive role requires every tag with policies to grant the user.
+	_, err = s.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{TagPolicyConflictResolution: v1pb.WorkspaceSetting_MOST_RESTRICTIVE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tag_policy_conflict_resolution"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"open", "team"}, list(readerCtx))
+	require.False(t, canRead(readerCtx, "mixed"))
+	require.True(t, canRead(managerCtx, "mixed"))
+	require.False(t, canManage(managerCtx, "mixed"))
+	require.True(t, canManage(managerCtx, "team"))
+
+	// Deleting the policies of a tag lifts its restriction.
+	for _, tagPolicy := range tagPolicies.TagPolicies {
+		if tagPolicy.Tag == "team" {
+			_, err := s.DeleteTagPolicy(adminCtx, &v1pb.DeleteTagPolicyRequest{Id: tagPolicy.Id})
+			require.NoError(t, err)
+		}
+	}
+	require.True(t, canRead(outsiderCtx, "team"))
+	require.False(t, canManage(managerCtx, "team"))
+	_, err = s.DeleteShortcut(managerCtx, &v1pb.DeleteShortcutRequest{Id: shortcuts["team"].Id})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.NoError(t, upsertTagPolicy("secret", manager, v1pb.TagPolicy_MANAGE))
+	_, err = s.DeleteShortcut(managerCtx, &v1pb.DeleteShortcutRequest{Id: shortcuts["mixed"].Id})
+	require.NoError(t, err)
+}
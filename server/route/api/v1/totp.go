@@ -0,0 +1,158 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/yourselfhosted/slash/internal/totp"
+	"github.com/yourselfhosted/slash/internal/util"
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// totpRequiredErrorReason is the ErrorInfo reason of the sign ins without a correct two-factor authentication code.
+	totpRequiredErrorReason = "TOTP_REQUIRED"
+	// totpIssuer is the account issuer shown by the authenticator apps.
+	totpIssuer = "Slash"
+	// recoveryCodeCount is the number of recovery codes generated at enrollment.
+	recoveryCodeCount = 10
+	// recoveryCodeLength is the length of the recovery codes.
+	recoveryCodeLength = 12
+)
+
+func (s *APIV1Service) EnrollTOTP(ctx context.Context, _ *v1pb.EnrollTOTPRequest) (*v1pb.EnrollTOTPResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	totpSetting, err := s.getUserTOTP(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if totpSetting.GetEnabled() {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate two-factor authentication secret: %v", err)
+	}
+	recoveryCodes, recoveryCodeHashes := []string{}, []string{}
+	for range recoveryCodeCount {
+		recoveryCode, err := util.RandomString(recoveryCodeLength)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate recovery code: %v", err)
+		}
+		// Only the hashes are stored, as the password reset tokens.
+		recoveryCodes, recoveryCodeHashes = append(recoveryCodes, recoveryCode), append(recoveryCodeHashes, hashToken(recoveryCode))
+	}
+	// The previous enrollment is replaced until the secret is activated.
+	if err := s.upsertUserTOTP(ctx, user, &storepb.UserSetting_TotpSetting{
+		Secret:             secret,
+		RecoveryCodeHashes: recoveryCodeHashes,
+	}); err != nil {
+		return nil, err
+	}
+	return &v1pb.EnrollTOTPResponse{
+		Uri:           totp.URI(totpIssuer, user.Email, secret),
+		Secret:        secret,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (s *APIV1Service) ActivateTOTP(ctx context.Context, request *v1pb.ActivateTOTPRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	totpSetting, err := s.getUserTOTP(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if totpSetting.GetSecret() == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not enrolled")
+	}
+	if totpSetting.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+	step, ok := totp.Validate(totpSetting.Secret, request.Code, time.Now())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor authentication code")
+	}
+	totpSetting.Enabled, totpSetting.LastUsedStep = true, step
+	if err := s.upsertUserTOTP(ctx, user, totpSetting); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkSignInTOTP returns an Unauthenticated error with the totpRequiredErrorReason when the user has two-factor
+// authentication and the code is neither a code of the authenticator app nor an unused recovery code.
+// The codes are single use, the accepted ones are recorded.
+func (s *APIV1Service) checkSignInTOTP(ctx context.Context, user *store.User, code string) error {
+	totpSetting, err := s.getUserTOTP(ctx, user)
+	if err != nil {
+		return err
+	}
+	if !totpSetting.GetEnabled() {
+		return nil
+	}
+	if code == "" {
+		return newTOTPRequiredError("two-factor authentication code is required")
+	}
+	if step, ok := totp.Validate(totpSetting.Secret, code, time.Now()); ok && step > totpSetting.LastUsedStep {
+		totpSetting.LastUsedStep = step
+		return s.upsertUserTOTP(ctx, user, totpSetting)
+	}
+	if i := slices.Index(totpSetting.RecoveryCodeHashes, hashToken(code)); i >= 0 {
+		totpSetting.RecoveryCodeHashes = slices.Delete(totpSetting.RecoveryCodeHashes, i, i+1)
+		return s.upsertUserTOTP(ctx, user, totpSetting)
+	}
+	return newTOTPRequiredError("invalid two-factor authentication code")
+}
+
+func newTOTPRequiredError(message string) error {
+	st := status.New(codes.Unauthenticated, message)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: totpRequiredErrorReason, Domain: Issuer}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func (s *APIV1Service) getUserTOTP(ctx context.Context, user *store.User) (*storepb.UserSetting_TotpSetting, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_TOTP,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user two-factor authentication: %v", err)
+	}
+	return userSetting.GetTotp(), nil
+}
+
+func (s *APIV1Service) upsertUserTOTP(ctx context.Context, user *store.User, totpSetting *storepb.UserSetting_TotpSetting) error {
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_TOTP,
+		Value: &storepb.UserSetting_Totp{
+			Totp: totpSetting,
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return nil
+}
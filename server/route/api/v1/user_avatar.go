@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// maxUserAvatarSize is the maximum size in bytes of the uploaded avatars.
+	maxUserAvatarSize = 1 << 20
+	// maxUserAvatarDimension is the width and height the avatars are downscaled to fit in.
+	maxUserAvatarDimension = 256
+	// maxUserAvatarPixels bounds the decoded size of the uploaded avatars, which compress well beyond their byte size.
+	maxUserAvatarPixels = 4096 * 4096
+)
+
+// SetUserAvatar sets the avatar of the user, the users set their own and the admins anyone's. The image is decoded
+// and encoded again, which drops its metadata, e.g. the location of a photo.
+func (s *APIV1Service) SetUserAvatar(ctx context.Context, request *v1pb.SetUserAvatarRequest) (*emptypb.Empty, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || (currentUser.ID != request.Id && currentUser.Role != store.RoleAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	if len(request.Avatar) == 0 {
+		if err := s.Store.DeleteUserAvatar(ctx, &store.DeleteUserAvatar{UserID: user.ID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete user avatar: %v", err)
+		}
+		return &emptypb.Empty{}, nil
+	}
+	if len(request.Avatar) > maxUserAvatarSize {
+		return nil, status.Errorf(codes.InvalidArgument, "avatar must be at most %d bytes", maxUserAvatarSize)
+	}
+	contentType, content, err := processUserAvatar(request.Avatar)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid avatar: %v", err)
+	}
+	if _, err := s.Store.UpsertUserAvatar(ctx, &store.UserAvatar{
+		UserID:      user.ID,
+		ContentType: contentType,
+		Content:     content,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user avatar: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) GetUserAvatar(ctx context.Context, request *v1pb.GetUserAvatarRequest) (*httpbody.HttpBody, error) {
+	avatar, err := s.Store.GetUserAvatar(ctx, &store.FindUserAvatar{
+		UserID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user avatar: %v", err)
+	}
+	if avatar == nil {
+		return nil, status.Errorf(codes.NotFound, "avatar not found")
+	}
+	return &httpbody.HttpBody{
+		ContentType: avatar.ContentType,
+		Data:        avatar.Content,
+	}, nil
+}
+
+// processUserAvatar sniffs the content type of the avatar from its magic bytes, and returns it downscaled to fit in
+// maxUserAvatarDimension in the same format.
+func processUserAvatar(data []byte) (string, []byte, error) {
+	contentType := http.DetectContentType(data)
+	if contentType != "image/png" && contentType != "image/jpeg" {
+		return "", nil, errors.Errorf("unsupported content type %q, expect a PNG or JPEG image", contentType)
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, err
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxUserAvatarPixels {
+		return "", nil, errors.Errorf("image of %dx%d pixels is too large", config.Width, config.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, err
+	}
+	img = downscaleImage(img, maxUserAvatarDimension)
+
+	var buf bytes.Buffer
+	if contentType == "image/png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90})
+	}
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to encode image")
+	}
+	return contentType, buf.Bytes(), nil
+}
+
+// downscaleImage returns the image scaled down to fit in maxDimension, keeping its aspect ratio. Each pixel is the
+// average of the pixels of the image it covers.
+func downscaleImage(img image.Image, maxDimension int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxDimension && height <= maxDimension {
+		return img
+	}
+	dstWidth, dstHeight := maxDimension, maxDimension
+	if width > height {
+		dstHeight = max(1, height*maxDimension/width)
+	} else {
+		dstWidth = max(1, width*maxDimension/height)
+	}
+
+	dst := image.NewRGBA64(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		y0, y1 := bounds.Min.Y+y*height/dstHeight, bounds.Min.Y+(y+1)*height/dstHeight
+		for x := 0; x < dstWidth; x++ {
+			x0, x1 := bounds.Min.X+x*width/dstWidth, bounds.Min.X+(x+1)*width/dstWidth
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := img.At(sx, sy).RGBA()
+					r, g, b, a, n = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca), n+1
+				}
+			}
+			dst.SetRGBA64(x, y, color.RGBA64{R: uint16(r / n), G: uint16(g / n), B: uint16(b / n), A: uint16(a / n)})
+		}
+	}
+	return dst
+}
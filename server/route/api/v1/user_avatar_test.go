@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestUserAvatar(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	encode := func(encoder func(*bytes.Buffer, image.Image) error, width, height int) []byte {
+		img := image.NewRGBA(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+			}
+		}
+		var buf bytes.Buffer
+		require.NoError(t, encoder(&buf, img))
+		return buf.Bytes()
+	}
+	encodePNG := func(buf *bytes.Buffer, img image.Image) error { return png.Encode(buf, img) }
+	encodeJPEG := func(buf *bytes.Buffer, img image.Image) error { return jpeg.Encode(buf, img, nil) }
+	encodeGIF := func(buf *bytes.Buffer, img image.Image) error { return gif.Encode(buf, img, nil) }
+	setAvatar := func(currentUser, user *store.User, avatar []byte) error {
+		_, err := s.SetUserAvatar(withUser(ctx, currentUser), &v1pb.SetUserAvatarRequest{Id: user.ID, Avatar: avatar})
+		return err
+	}
+	getAvatar := func(user *store.User) (string, image.Config) {
+		body, err := s.GetUserAvatar(withUser(ctx, other), &v1pb.GetUserAvatarRequest{Id: user.ID})
+		require.NoError(t, err)
+		config, _, err := image.DecodeConfig(bytes.NewReader(body.Data))
+		require.NoError(t, err)
+		return body.ContentType, config
+	}
+
+	// The users set their own avatar only, the admins anyone's.
+	require.Equal(t, codes.PermissionDenied, status.Code(setAvatar(user, other, encode(encodePNG, 32, 32))))
+	require.NoError(t, setAvatar(admin, other, encode(encodePNG, 32, 32)))
+
+	// The avatars are downscaled to fit, in their format.
+	require.NoError(t, setAvatar(user, user, encode(encodePNG, 600, 300)))
+	contentType, config := getAvatar(user)
+	require.Equal(t, "image/png", contentType)
+	require.Equal(t, []int{256, 128}, []int{config.Width, config.Height})
+	require.NoError(t, setAvatar(user, user, encode(encodeJPEG, 100, 200)))
+	contentType, config = getAvatar(user)
+	require.Equal(t, "image/jpeg", contentType)
+	require.Equal(t, []int{100, 200}, []int{config.Width, config.Height})
+
+	// The other images, the payloads that aren't images and the oversized ones are rejected.
+	require.Equal(t, codes.InvalidArgument, status.Code(setAvatar(user, user, encode(encodeGIF, 32, 32))))
+	require.Equal(t, codes.InvalidArgument, status.Code(setAvatar(user, user, []byte("<html></html>"))))
+	require.Equal(t, codes.InvalidArgument, status.Code(setAvatar(user, user, encode(encodePNG, 32, 32)[:64])))
+	require.Equal(t, codes.InvalidArgument, status.Code(setAvatar(user, user, make([]byte, maxUserAvatarSize+1))))
+
+	// An empty avatar removes it.
+	require.NoError(t, setAvatar(user, user, nil))
+	_, err := s.GetUserAvatar(withUser(ctx, other), &v1pb.GetUserAvatarRequest{Id: user.ID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultUserPageSize is the page size of ListUsers when unspecified.
+	defaultUserPageSize = 100
+	// maxUserPageSize is the largest page size of ListUsers.
+	maxUserPageSize = 1000
+)
+
+// userPageToken is the position after the last user of a page of ListUsers, which orders the users by id.
+type userPageToken struct {
+	ID int32 `json:"id"`
+}
+
+// getUserPageSize returns the page size of the requested one, the default when unspecified and capped at the max.
+func getUserPageSize(pageSize int32) (int, error) {
+	if pageSize < 0 {
+		return 0, errors.New("page size must not be negative")
+	}
+	if pageSize == 0 {
+		return defaultUserPageSize, nil
+	}
+	return min(int(pageSize), maxUserPageSize), nil
+}
+
+// encodeUserPageToken returns the page token of the users after the one with the id.
+func encodeUserPageToken(id int32) (string, error) {
+	bytes, err := json.Marshal(&userPageToken{ID: id})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal page token")
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// decodeUserPageToken returns the id of the last user of the previous page.
+func decodeUserPageToken(pageToken string) (int32, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, errors.New("malformed page token")
+	}
+	token := &userPageToken{}
+	if err := json.Unmarshal(bytes, token); err != nil {
+		return 0, errors.New("malformed page token")
+	}
+	return token.ID, nil
+}
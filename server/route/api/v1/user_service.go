@@ -1,42 +1,86 @@
 package v1
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
-	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yourselfhosted/slash/internal/util"
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
 )
 
-func (s *APIV1Service) ListUsers(ctx context.Context, _ *v1pb.ListUsersRequest) (*v1pb.ListUsersResponse, error) {
-	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+// ListUsers returns a page of the users ordered by id, filtered by role and state in the store.
+func (s *APIV1Service) ListUsers(ctx context.Context, request *v1pb.ListUsersRequest) (*v1pb.ListUsersResponse, error) {
+	pageSize, err := getUserPageSize(request.PageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page size: %v", err)
+	}
+	// Fetch one more user to know whether there is a next page.
+	limit := pageSize + 1
+	find := &store.FindUser{
+		IncludeArchived: request.IncludeArchived,
+		Limit:           &limit,
+	}
+	if request.PageToken != "" {
+		idAfter, err := decodeUserPageToken(request.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		find.IDAfter = &idAfter
+	}
+	switch request.Role {
+	case v1pb.Role_ADMIN:
+		role := store.RoleAdmin
+		find.Role = &role
+	case v1pb.Role_USER:
+		role := store.RoleUser
+		find.Role = &role
+	}
+	if request.State != v1pb.State_STATE_UNSPECIFIED {
+		rowStatus := ConvertStateToRowStatus(request.State)
+		find.RowStatus = &rowStatus
+	}
+	users, err := s.Store.ListUsers(ctx, find)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
 	}
+	nextPageToken := ""
+	if len(users) > pageSize {
+		users = users[:pageSize]
+		nextPageToken, err = encodeUserPageToken(users[pageSize-1].ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode page token, err: %v", err)
+		}
+	}
 
 	userMessages := []*v1pb.User{}
 	for _, user := range users {
 		userMessages = append(userMessages, convertUserFromStore(user))
 	}
 	response := &v1pb.ListUsersResponse{
-		Users: userMessages,
+		Users:         userMessages,
+		NextPageToken: nextPageToken,
 	}
 	return response, nil
 }
 
 func (s *APIV1Service) GetUser(ctx context.Context, request *v1pb.GetUserRequest) (*v1pb.User, error) {
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
-		ID: &request.Id,
+		ID:              &request.Id,
+		IncludeArchived: true,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
@@ -48,7 +92,7 @@ func (s *APIV1Service) GetUser(ctx context.Context, request *v1pb.GetUserRequest
 }
 
 func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserRequest) (*v1pb.User, error) {
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.User.Password), bcrypt.DefaultCost)
+	passwordHash, err := s.hashPassword(request.User.Password)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
 	}
@@ -61,58 +105,441 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 		Email:        request.User.Email,
 		Nickname:     request.User.Nickname,
 		Role:         store.RoleUser,
-		PasswordHash: string(passwordHash),
+		PasswordHash: passwordHash,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
+	if err := s.createUserInvitations(ctx, user); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create user invitation: %v", err)
+	}
 	return convertUserFromStore(user), nil
 }
 
+// BatchCreateUsers creates the valid users of the request in a transaction, each with a generated password.
+// The invalid and duplicated users are skipped and reported in their results.
+func (s *APIV1Service) BatchCreateUsers(ctx context.Context, request *v1pb.BatchCreateUsersRequest) (*v1pb.BatchCreateUsersResponse, error) {
+	if len(request.Users) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "users are required")
+	}
+	existingUsers, err := s.Store.ListUsers(ctx, &store.FindUser{
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	existingEmails := map[string]bool{}
+	for _, user := range existingUsers {
+		existingEmails[strings.ToLower(user.Email)] = true
+	}
+	availableSeats := -1
+	maxUsers := s.LicenseService.MaxUsers()
+	if maxUsers > 0 {
+		activeUsers, err := s.listActiveUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		availableSeats = max(maxUsers-len(activeUsers), 0)
+	}
+
+	results := make([]*v1pb.BatchCreateUsersResponse_Result, len(request.Users))
+	creates, createResults := []*store.User{}, []*v1pb.BatchCreateUsersResponse_Result{}
+	for i, user := range request.Users {
+		email := strings.TrimSpace(user.Email)
+		result := &v1pb.BatchCreateUsersResponse_Result{
+			Index: int32(i),
+			Email: email,
+		}
+		results[i] = result
+		if email == "" || strings.TrimSpace(user.Nickname) == "" {
+			result.Error = "email and nickname are required"
+			continue
+		}
+		if !util.ValidateEmail(email) {
+			result.Error = "invalid email"
+			continue
+		}
+		if existingEmails[strings.ToLower(email)] {
+			result.Error = "email already exists"
+			continue
+		}
+		if availableSeats == 0 {
+			result.Error = fmt.Sprintf("the workspace reached its maximum of %d users", maxUsers)
+			continue
+		}
+		role := store.RoleUser
+		if user.Role == v1pb.Role_ADMIN {
+			role = store.RoleAdmin
+		}
+		password, err := util.RandomString(20)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate password: %v", err)
+		}
+		passwordHash, err := s.hashPassword(password)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
+		}
+		// Later users with the same email are duplicates.
+		existingEmails[strings.ToLower(email)] = true
+		availableSeats--
+		result.TemporaryPassword = password
+		creates = append(creates, &store.User{
+			Email:        email,
+			Nickname:     strings.TrimSpace(user.Nickname),
+			Role:         role,
+			PasswordHash: passwordHash,
+		})
+		createResults = append(createResults, result)
+	}
+
+	if len(creates) > 0 {
+		users, err := s.Store.CreateUsers(ctx, creates)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create users: %v", err)
+		}
+		for i, user := range users {
+			createResults[i].User = convertUserFromStore(user)
+		}
+		if err := s.createUserInvitations(ctx, users...); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create user invitations: %v", err)
+		}
+	}
+	return &v1pb.BatchCreateUsersResponse{Results: results}, nil
+}
+
+// createUserInvitations records that the users were created by the current user,
+// which exempts them from the shortcut cooldown of new users.
+func (s *APIV1Service) createUserInvitations(ctx context.Context, users ...*store.User) error {
+	inviter, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return errors.Wrap(err, "failed to get current user")
+	}
+	if inviter == nil {
+		return nil
+	}
+	for _, user := range users {
+		if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+			UserId: user.ID,
+			Key:    storepb.UserSettingKey_USER_SETTING_INVITATION,
+			Value: &storepb.UserSetting_Invitation{
+				Invitation: &storepb.UserSetting_InvitationSetting{
+					InviterId: inviter.ID,
+				},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateUser updates the fields of the update mask. The users update their own nickname and email, the admins update
+// any user and their role too, and reset the password of the other users. The workspace is never left without an
+// active admin.
 func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserRequest) (*v1pb.User, error) {
-	user, err := getCurrentUser(ctx, s.Store)
+	currentUser, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user.ID != request.User.Id {
+	if currentUser == nil || (currentUser.ID != request.User.Id && currentUser.Role != store.RoleAdmin) {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "UpdateMask is empty")
 	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID:              &request.User.Id,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
 
 	userUpdate := &store.UpdateUser{
-		ID: request.User.Id,
+		ID: user.ID,
 	}
 	for _, path := range request.UpdateMask.Paths {
 		if path == "email" {
-			userUpdate.Email = &request.User.Email
+			email := strings.TrimSpace(request.User.Email)
+			if !util.ValidateEmail(email) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid email")
+			}
+			if email == user.Email {
+				continue
+			}
+			// The emails are unique regardless of their case, as in BatchCreateUsers.
+			existingUsers, err := s.Store.ListUsers(ctx, &store.FindUser{
+				IncludeArchived: true,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+			}
+			for _, existingUser := range existingUsers {
+				if existingUser.ID != user.ID && strings.EqualFold(existingUser.Email, email) {
+					return nil, status.Errorf(codes.AlreadyExists, "email already exists")
+				}
+			}
+			userUpdate.Email = &email
 		} else if path == "nickname" {
-			userUpdate.Nickname = &request.User.Nickname
+			nickname := strings.TrimSpace(request.User.Nickname)
+			if nickname == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "nickname is required")
+			}
+			userUpdate.Nickname = &nickname
+		} else if path == "password" {
+			// Setting a password without the current one would let anyone holding an access token take the account over.
+			if currentUser.ID == user.ID {
+				return nil, status.Errorf(codes.PermissionDenied, "use ChangePassword to change your own password")
+			}
+			if currentUser.Role != store.RoleAdmin {
+				return nil, status.Errorf(codes.PermissionDenied, "only admins can reset the password of another user")
+			}
+			if err := s.checkPasswordPolicy(ctx, request.User.Password); err != nil {
+				return nil, err
+			}
+			passwordHash, err := s.hashPassword(request.User.Password)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
+			}
+			userUpdate.PasswordHash = &passwordHash
+		} else if path == "role" {
+			if currentUser.Role != store.RoleAdmin {
+				return nil, status.Errorf(codes.PermissionDenied, "only admins can change the role of a user")
+			}
+			role, err := convertUserRoleToStore(request.User.Role)
+			if err != nil {
+				return nil, err
+			}
+			if user.Role == store.RoleAdmin && role != store.RoleAdmin {
+				if err := s.checkNotLastAdmin(ctx, user); err != nil {
+					return nil, err
+				}
+			}
+			userUpdate.Role = &role
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid path: %s", path)
 		}
 	}
 	user, err = s.Store.UpdateUser(ctx, userUpdate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
+	// The new email is verified as the one of a sign up, the admins excepted so that they aren't locked out.
+	if userUpdate.Email != nil && user.Role != store.RoleAdmin {
+		securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace security setting: %v", err)
+		}
+		if securitySetting.RequireEmailVerification {
+			if err := s.requestEmailVerification(ctx, user); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return convertUserFromStore(user), nil
 }
 
+// checkNotLastAdmin rejects the demotion of the admin when no other active admin would be left.
+func (s *APIV1Service) checkNotLastAdmin(ctx context.Context, admin *store.User) error {
+	adminRole := store.RoleAdmin
+	admins, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Role: &adminRole,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	for _, user := range admins {
+		if user.ID != admin.ID {
+			return nil
+		}
+	}
+	return status.Errorf(codes.FailedPrecondition, "cannot demote the last admin, the workspace would have no active admin")
+}
+
+// DeleteUser deletes or archives the user. Their shortcuts and collections are reassigned to the shortcut owner when
+// set and deleted otherwise, all in a single transaction. The last admin is never removed.
 func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserRequest) (*emptypb.Empty, error) {
-	user, err := getCurrentUser(ctx, s.Store)
+	currentUser, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user.ID == request.Id {
+	if currentUser == nil || currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	if currentUser.ID == request.Id {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot delete yourself")
 	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID:              &request.Id,
+		IncludeArchived: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.Role == store.RoleAdmin {
+		if err := s.checkNotLastAdmin(ctx, user); err != nil {
+			return nil, err
+		}
+	}
 
-	if err := s.Store.DeleteUser(ctx, &store.DeleteUser{ID: request.Id}); err != nil {
+	userDelete := &store.DeleteUser{
+		ID:      user.ID,
+		Archive: request.Archive,
+	}
+	if request.ShortcutOwnerId != 0 {
+		if request.ShortcutOwnerId == user.ID {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot reassign the shortcuts to the deleted user")
+		}
+		owner, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &request.ShortcutOwnerId,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if owner == nil {
+			return nil, status.Errorf(codes.NotFound, "shortcut owner not found")
+		}
+		userDelete.ShortcutOwnerID = &owner.ID
+	}
+	if err := s.Store.DeleteUser(ctx, userDelete); err != nil {
+		if errors.Is(err, store.ErrShortcutNameExists) {
+			return nil, status.Errorf(codes.FailedPrecondition, "a private shortcut of the user has the name of one of the shortcut owner")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
+const (
+	defaultUserLoginsLimit = 20
+	maxUserLoginsLimit     = 100
+)
+
+func (s *APIV1Service) ListUserLogins(ctx context.Context, request *v1pb.ListUserLoginsRequest) (*v1pb.ListUserLoginsResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || (currentUser.ID != request.Id && currentUser.Role != store.RoleAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultUserLoginsLimit
+	} else if limit > maxUserLoginsLimit {
+		limit = maxUserLoginsLimit
+	}
+
+	activities, err := s.Store.ListActivities(ctx, &store.FindActivity{
+		CreatorID: &request.Id,
+		Type:      store.ActivityUserSignIn,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list activities: %v", err)
+	}
+	// Activity ids increase with time, and unlike created_ts they break ties within the same second.
+	slices.SortFunc(activities, func(a, b *store.Activity) int {
+		return cmp.Compare(b.ID, a.ID)
+	})
+	if len(activities) > limit {
+		activities = activities[:limit]
+	}
+
+	logins := []*v1pb.UserLogin{}
+	for _, activity := range activities {
+		payload := &storepb.ActivityUserSignInPayload{}
+		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal payload: %v", err)
+		}
+		logins = append(logins, &v1pb.UserLogin{
+			CreatedTime:  timestamppb.New(time.Unix(activity.CreatedTs, 0)),
+			Method:       payload.Method,
+			IdpId:        payload.IdpId,
+			Ip:           payload.Ip,
+			UserAgent:    payload.UserAgent,
+			Browser:      payload.Browser,
+			Os:           payload.Os,
+			Country:      payload.GetGeoLocation().GetCountry(),
+			City:         payload.GetGeoLocation().GetCity(),
+			Asn:          payload.GetGeoLocation().GetAsn(),
+			Organization: payload.GetGeoLocation().GetOrganization(),
+		})
+	}
+	return &v1pb.ListUserLoginsResponse{
+		Logins: logins,
+	}, nil
+}
+
+const (
+	defaultUserArchiveNoticesLimit = 20
+	maxUserArchiveNoticesLimit     = 100
+)
+
+// ListUserArchiveNotices returns the notices the archive runner recorded for the owner of the shortcuts going unused,
+// skipping the shortcuts deleted since.
+func (s *APIV1Service) ListUserArchiveNotices(ctx context.Context, request *v1pb.ListUserArchiveNoticesRequest) (*v1pb.ListUserArchiveNoticesResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || (currentUser.ID != request.Id && currentUser.Role != store.RoleAdmin) {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultUserArchiveNoticesLimit
+	} else if limit > maxUserArchiveNoticesLimit {
+		limit = maxUserArchiveNoticesLimit
+	}
+
+	activities, err := s.Store.ListActivities(ctx, &store.FindActivity{
+		CreatorID: &request.Id,
+		Type:      store.ActivityShortcutArchiveNotice,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list activities: %v", err)
+	}
+	slices.SortFunc(activities, func(a, b *store.Activity) int {
+		return cmp.Compare(b.ID, a.ID)
+	})
+
+	notices := []*v1pb.ShortcutArchiveNotice{}
+	for _, activity := range activities {
+		if len(notices) == limit {
+			break
+		}
+		payload := &storepb.ActivityShortcutArchivePayload{}
+		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal payload: %v", err)
+		}
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID:              &payload.ShortcutId,
+			IncludeArchived: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+		}
+		if shortcut == nil {
+			continue
+		}
+		notices = append(notices, &v1pb.ShortcutArchiveNotice{
+			CreatedTime:  timestamppb.New(time.Unix(activity.CreatedTs, 0)),
+			ShortcutId:   shortcut.Id,
+			ShortcutName: shortcut.Name,
+			ArchiveTime:  timestamppb.New(time.Unix(payload.ArchivedTs, 0)),
+		})
+	}
+	return &v1pb.ListUserArchiveNoticesResponse{
+		Notices: notices,
+	}, nil
+}
+
 func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.ListUserAccessTokensRequest) (*v1pb.ListUserAccessTokensResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
@@ -249,31 +676,18 @@ func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.
 }
 
 func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, accessToken, description string) error {
-	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
-	if err != nil {
-		return errors.Wrap(err, "failed to get user access tokens")
-	}
-	userAccessToken := storepb.UserSetting_AccessTokensSetting_AccessToken{
+	if err := s.Store.UpsertUserAccessTokens(ctx, user.ID, &storepb.UserSetting_AccessTokensSetting_AccessToken{
 		AccessToken: accessToken,
 		Description: description,
-	}
-	userAccessTokens = append(userAccessTokens, &userAccessToken)
-	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-		UserId: user.ID,
-		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
-		Value: &storepb.UserSetting_AccessTokens{
-			AccessTokens: &storepb.UserSetting_AccessTokensSetting{
-				AccessTokens: userAccessTokens,
-			},
-		},
+		CreatedTs:   time.Now().Unix(),
 	}); err != nil {
-		return errors.Wrap(err, "failed to upsert user setting")
+		return errors.Wrap(err, "failed to upsert user access tokens")
 	}
 	return nil
 }
 
 func convertUserFromStore(user *store.User) *v1pb.User {
-	return &v1pb.User{
+	userpb := &v1pb.User{
 		Id:          int32(user.ID),
 		State:       convertStateFromRowStatus(user.RowStatus),
 		CreatedTime: timestamppb.New(time.Unix(user.CreatedTs, 0)),
@@ -282,6 +696,10 @@ func convertUserFromStore(user *store.User) *v1pb.User {
 		Email:       user.Email,
 		Nickname:    user.Nickname,
 	}
+	if isUserLocked(user, time.Now()) {
+		userpb.LockExpireTime = timestamppb.New(time.Unix(user.LockedUntilTs, 0))
+	}
+	return userpb
 }
 
 func convertUserRoleFromStore(role store.Role) v1pb.Role {
@@ -294,3 +712,14 @@ func convertUserRoleFromStore(role store.Role) v1pb.Role {
 		return v1pb.Role_ROLE_UNSPECIFIED
 	}
 }
+
+func convertUserRoleToStore(role v1pb.Role) (store.Role, error) {
+	switch role {
+	case v1pb.Role_ADMIN:
+		return store.RoleAdmin, nil
+	case v1pb.Role_USER:
+		return store.RoleUser, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid role: %v", role)
+	}
+}
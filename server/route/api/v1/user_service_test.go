@@ -0,0 +1,317 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/runner/archive"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestBatchCreateUsers(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+
+	response, err := s.BatchCreateUsers(withUser(ctx, admin), &v1pb.BatchCreateUsersRequest{
+		Users: []*v1pb.User{
+			{Email: "alice@test.com", Nickname: "alice"},
+			{Email: "bob@test.com", Nickname: "bob", Role: v1pb.Role_ADMIN},
+			{Email: "not-an-email", Nickname: "invalid"},
+			{Email: "carol@test.com"},
+			{Email: "Admin@test.com", Nickname: "existing"},
+			{Email: "alice@test.com", Nickname: "duplicate"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 6, len(response.Results))
+	for i, result := range response.Results {
+		require.Equal(t, int32(i), result.Index)
+	}
+	reasons := []string{}
+	for _, result := range response.Results {
+		reasons = append(reasons, result.Error)
+	}
+	require.Equal(t, []string{"", "", "invalid email", "email and nickname are required", "email already exists", "email already exists"}, reasons)
+
+	alice, bob := response.Results[0], response.Results[1]
+	require.Equal(t, "alice@test.com", alice.User.Email)
+	require.Equal(t, v1pb.Role_USER, alice.User.Role)
+	require.Equal(t, v1pb.Role_ADMIN, bob.User.Role)
+	require.NotEqual(t, alice.TemporaryPassword, bob.TemporaryPassword)
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &alice.User.Id})
+	require.NoError(t, err)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(alice.TemporaryPassword)))
+	for _, result := range response.Results[2:] {
+		require.Nil(t, result.User)
+		require.Empty(t, result.TemporaryPassword)
+	}
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(users))
+
+	_, err = s.BatchCreateUsers(withUser(ctx, admin), &v1pb.BatchCreateUsersRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListUsers(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	for _, nickname := range []string{"a", "b", "c", "d"} {
+		createTestingUser(ctx, t, s, nickname, store.RoleUser)
+	}
+	archived, _ := createTestingUser(ctx, t, s, "archived", store.RoleUser)
+	_, err := s.Store.ArchiveUser(ctx, archived.ID)
+	require.NoError(t, err)
+	listNicknames := func(request *v1pb.ListUsersRequest) []string {
+		nicknames := []string{}
+		for {
+			response, err := s.ListUsers(withUser(ctx, admin), request)
+			require.NoError(t, err)
+			for _, user := range response.Users {
+				require.Empty(t, user.Password)
+				nicknames = append(nicknames, user.Nickname)
+			}
+			if response.NextPageToken == "" {
+				return nicknames
+			}
+			request.PageToken = response.NextPageToken
+		}
+	}
+
+	// The users are paged through by id, without the archived ones by default.
+	response, err := s.ListUsers(withUser(ctx, admin), &v1pb.ListUsersRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, response.Users, 2)
+	require.NotEmpty(t, response.NextPageToken)
+	require.Equal(t, []string{"admin", "a", "b", "c", "d"}, listNicknames(&v1pb.ListUsersRequest{PageSize: 2}))
+	require.Equal(t, []string{"admin", "a", "b", "c", "d", "archived"}, listNicknames(&v1pb.ListUsersRequest{PageSize: 2, IncludeArchived: true}))
+
+	// The users are filtered by role and state.
+	require.Equal(t, []string{"admin"}, listNicknames(&v1pb.ListUsersRequest{Role: v1pb.Role_ADMIN}))
+	require.Equal(t, []string{"archived"}, listNicknames(&v1pb.ListUsersRequest{State: v1pb.State_INACTIVE}))
+	require.Equal(t, []string{"a", "b", "c", "d"}, listNicknames(&v1pb.ListUsersRequest{Role: v1pb.Role_USER, PageSize: 3}))
+
+	_, err = s.ListUsers(withUser(ctx, admin), &v1pb.ListUsersRequest{PageToken: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.True(t, isOnlyForAdminAllowedMethod(v1pb.UserService_ListUsers_FullMethodName))
+}
+
+func TestUpdateUser(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	updateUser := func(currentUser *store.User, userUpdate *v1pb.User, paths ...string) (*v1pb.User, error) {
+		return s.UpdateUser(withUser(ctx, currentUser), &v1pb.UpdateUserRequest{
+			User:       userUpdate,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+	}
+
+	// The users update their own profile only, and not their role.
+	updated, err := updateUser(user, &v1pb.User{Id: user.ID, Nickname: "renamed"}, "nickname")
+	require.NoError(t, err)
+	require.Equal(t, "renamed", updated.Nickname)
+	_, err = updateUser(user, &v1pb.User{Id: other.ID, Nickname: "renamed"}, "nickname")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = updateUser(user, &v1pb.User{Id: user.ID, Role: v1pb.Role_ADMIN}, "role")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The emails are unique regardless of their case.
+	_, err = updateUser(user, &v1pb.User{Id: user.ID, Email: "Other@test.com"}, "email")
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = updateUser(user, &v1pb.User{Id: user.ID, Email: "not-an-email"}, "email")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	updated, err = updateUser(user, &v1pb.User{Id: user.ID, Email: "renamed@test.com"}, "email")
+	require.NoError(t, err)
+	require.Equal(t, "renamed@test.com", updated.Email)
+
+	// A changed email is verified again when the workspace requires it.
+	_, err = s.UpdateWorkspaceSetting(withUser(ctx, admin), &v1pb.UpdateWorkspaceSettingRequest{
+		Setting:    &v1pb.WorkspaceSetting{RequireEmailVerification: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"require_email_verification"}},
+	})
+	require.NoError(t, err)
+	_, err = updateUser(admin, &v1pb.User{Id: other.ID, Email: "verify@test.com"}, "email")
+	require.NoError(t, err)
+	emailVerification, err := s.getUserEmailVerification(ctx, other)
+	require.NoError(t, err)
+	require.NotNil(t, emailVerification)
+	require.False(t, emailVerification.Verified)
+
+	// The users change their own password with ChangePassword, which confirms the current one.
+	for _, currentUser := range []*store.User{user, admin} {
+		_, err = updateUser(currentUser, &v1pb.User{Id: currentUser.ID, Password: "battery-staple"}, "password")
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "ChangePassword")
+	}
+	_, err = updateUser(user, &v1pb.User{Id: user.ID, Nickname: "renamed", Password: "battery-staple"}, "nickname", "password")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	userStore, err := s.Store.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, user.PasswordHash, userStore.PasswordHash)
+
+	// The admins reset the password of the other users.
+	_, err = updateUser(user, &v1pb.User{Id: other.ID, Password: "battery-staple"}, "password")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = updateUser(admin, &v1pb.User{Id: other.ID, Password: "battery-staple"}, "password")
+	require.NoError(t, err)
+	otherUser, err := s.Store.GetUser(ctx, &store.FindUser{ID: &other.ID})
+	require.NoError(t, err)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(otherUser.PasswordHash), []byte("battery-staple")))
+
+	// The admins change the roles, but the last admin isn't demoted.
+	_, err = updateUser(admin, &v1pb.User{Id: admin.ID, Role: v1pb.Role_USER}, "role")
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	updated, err = updateUser(admin, &v1pb.User{Id: user.ID, Role: v1pb.Role_ADMIN}, "role")
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Role_ADMIN, updated.Role)
+	updated, err = updateUser(admin, &v1pb.User{Id: admin.ID, Role: v1pb.Role_USER}, "role")
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Role_USER, updated.Role)
+	_, err = updateUser(user, &v1pb.User{Id: user.ID, Role: v1pb.Role_ROLE_UNSPECIFIED}, "role")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = updateUser(user, &v1pb.User{Id: user.ID}, "state")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestDeleteUser(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "test", store.RoleUser)
+	owner, _ := createTestingUser(ctx, t, s, "owner", store.RoleUser)
+	createShortcut := func(creator *store.User, name string, visibility storepb.Visibility) *storepb.Shortcut {
+		shortcut, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  creator.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: visibility,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	listShortcuts := func(creator *store.User) []*storepb.Shortcut {
+		shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{CreatorID: &creator.ID})
+		require.NoError(t, err)
+		return shortcuts
+	}
+
+	_, err := s.DeleteUser(withUser(ctx, user), &v1pb.DeleteUserRequest{Id: owner.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.DeleteUser(withUser(ctx, admin), &v1pb.DeleteUserRequest{Id: user.ID, ShortcutOwnerId: user.ID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.DeleteUser(withUser(ctx, admin), &v1pb.DeleteUserRequest{Id: user.ID, ShortcutOwnerId: 404})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// A failed reassignment leaves the user and their shortcuts as they were.
+	createShortcut(user, "docs", storepb.Visibility_WORKSPACE)
+	createShortcut(user, "notes", storepb.Visibility_PRIVATE)
+	createShortcut(owner, "notes", storepb.Visibility_PRIVATE)
+	_, err = s.DeleteUser(withUser(ctx, admin), &v1pb.DeleteUserRequest{Id: user.ID, ShortcutOwnerId: owner.ID})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Len(t, listShortcuts(user), 2)
+	require.Len(t, listShortcuts(owner), 1)
+
+	// Archiving the user reassigns their shortcuts and revokes their access tokens.
+	_, err = s.DeleteUser(withUser(ctx, admin), &v1pb.DeleteUserRequest{Id: owner.ID, Archive: true, ShortcutOwnerId: admin.ID})
+	require.NoError(t, err)
+	require.Len(t, listShortcuts(owner), 0)
+	require.Len(t, listShortcuts(admin), 1)
+	archived, err := s.Store.GetUser(ctx, &store.FindUser{ID: &owner.ID, IncludeArchived: true})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_ARCHIVED, archived.RowStatus)
+	accessTokens, err := s.Store.GetUserAccessTokens(ctx, owner.ID)
+	require.NoError(t, err)
+	require.Empty(t, accessTokens)
+
+	// Archiving the user without a shortcut owner archives their shortcuts, which can be restored.
+	archivedUser, _ := createTestingUser(ctx, t, s, "archived", store.RoleUser)
+	kept := createShortcut(archivedUser, "kept", storepb.Visibility_WORKSPACE)
+	_, err = s.DeleteUser(withUser(ctx, admin), &v1pb.DeleteUserRequest{Id: archivedUser.ID, Archive: true})
+	require.NoError(t, err)
+	require.Len(t, listShortcuts(archivedUser), 0)
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &kept.Id, IncludeArchived: true})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_ARCHIVED, shortcut.RowStatus)
+	tombstones, err := s.Store.ListShortcutTombstones(ctx, &store.FindShortcutTombstone{})
+	require.NoError(t, err)
+	require.Empty(t, tombstones)
+
+	// Deleting the user without a shortcut owner deletes their shortcuts, leaving their tombstones.
+	userShortcuts := listShortcuts(user)
+	require.Len(t, userShortcuts, 2)
+	_, err = s.DeleteUser(withUser(ctx, admin), &v1pb.DeleteUserRequest{Id: user.ID})
+	require.NoError(t, err)
+	require.Len(t, listShortcuts(user), 0)
+	tombstones, err = s.Store.ListShortcutTombstones(ctx, &store.FindShortcutTombstone{})
+	require.NoError(t, err)
+	require.Len(t, tombstones, 2)
+	for i, tombstone := range tombstones {
+		require.Equal(t, user.ID, tombstone.CreatorID)
+		require.Contains(t, []int32{userShortcuts[0].Id, userShortcuts[1].Id}, tombstone.ShortcutID, i)
+	}
+	deleted, err := s.Store.GetUser(ctx, &store.FindUser{ID: &user.ID, IncludeArchived: true})
+	require.NoError(t, err)
+	require.Nil(t, deleted)
+	_, err = s.DeleteUser(withUser(ctx, admin), &v1pb.DeleteUserRequest{Id: user.ID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListUserArchiveNotices(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	admin, _ := createTestingUser(ctx, t, s, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	other, _ := createTestingUser(ctx, t, s, "other", store.RoleUser)
+	shortcut, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "docs",
+		Link:       "https://example.com/docs",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+				AutoArchiveUnusedDays: 30,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// The shortcut is noticed a week before it goes unused for 30 days.
+	require.NoError(t, archive.NewRunner(s.Store).ArchiveUnusedShortcuts(ctx, time.Now().Add(25*24*time.Hour)))
+	response, err := s.ListUserArchiveNotices(withUser(ctx, user), &v1pb.ListUserArchiveNoticesRequest{Id: user.ID})
+	require.NoError(t, err)
+	require.Len(t, response.Notices, 1)
+	notice := response.Notices[0]
+	require.Equal(t, shortcut.Id, notice.ShortcutId)
+	require.Equal(t, "docs", notice.ShortcutName)
+	require.Equal(t, shortcut.CreatedTs+30*24*60*60, notice.ArchiveTime.AsTime().Unix())
+
+	// Admins can list the notices of the users, the other users can't.
+	response, err = s.ListUserArchiveNotices(withUser(ctx, admin), &v1pb.ListUserArchiveNoticesRequest{Id: user.ID})
+	require.NoError(t, err)
+	require.Len(t, response.Notices, 1)
+	_, err = s.ListUserArchiveNotices(withUser(ctx, other), &v1pb.ListUserArchiveNoticesRequest{Id: user.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	response, err = s.ListUserArchiveNotices(withUser(ctx, other), &v1pb.ListUserArchiveNoticesRequest{Id: other.ID})
+	require.NoError(t, err)
+	require.Empty(t, response.Notices)
+}
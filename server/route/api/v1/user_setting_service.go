@@ -43,6 +43,22 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update user setting: %v", err)
 			}
+		} else if path == "favorite_shortcuts" {
+			shortcutIDs, err := normalizeFavoriteShortcutIDs(request.UserSetting.GetFavoriteShortcuts().GetShortcutIds())
+			if err != nil {
+				return nil, err
+			}
+			if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+				UserId: user.ID,
+				Key:    storepb.UserSettingKey_USER_SETTING_FAVORITE_SHORTCUTS,
+				Value: &storepb.UserSetting_FavoriteShortcuts{
+					FavoriteShortcuts: &storepb.UserSetting_FavoriteShortcutsSetting{
+						ShortcutIds: shortcutIDs,
+					},
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update user setting: %v", err)
+			}
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid path: %s", path)
 		}
@@ -69,6 +85,9 @@ func getUserSetting(ctx context.Context, s *store.Store, userID int32) (*v1pb.Us
 			Locale:     "EN",
 			ColorTheme: "SYSTEM",
 		},
+		FavoriteShortcuts: &v1pb.UserSetting_FavoriteShortcutsSetting{
+			ShortcutIds: []int32{},
+		},
 	}
 	for _, setting := range userSettings {
 		if setting.Key == storepb.UserSettingKey_USER_SETTING_GENERAL {
@@ -76,7 +95,34 @@ func getUserSetting(ctx context.Context, s *store.Store, userID int32) (*v1pb.Us
 				Locale:     setting.GetGeneral().Locale,
 				ColorTheme: setting.GetGeneral().ColorTheme,
 			}
+		} else if setting.Key == storepb.UserSettingKey_USER_SETTING_FAVORITE_SHORTCUTS {
+			userSetting.FavoriteShortcuts = &v1pb.UserSetting_FavoriteShortcutsSetting{
+				ShortcutIds: setting.GetFavoriteShortcuts().ShortcutIds,
+			}
 		}
 	}
 	return userSetting, nil
 }
+
+// maxFavoriteShortcuts is the maximum number of favorite shortcuts of a user.
+const maxFavoriteShortcuts = 100
+
+// normalizeFavoriteShortcutIDs returns the favorite shortcut ids without duplicates, keeping the first of each.
+func normalizeFavoriteShortcutIDs(shortcutIDs []int32) ([]int32, error) {
+	seen := map[int32]bool{}
+	list := []int32{}
+	for _, id := range shortcutIDs {
+		if id <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid shortcut id %d", id)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		list = append(list, id)
+	}
+	if len(list) > maxFavoriteShortcuts {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d favorite shortcuts are allowed", maxFavoriteShortcuts)
+	}
+	return list, nil
+}
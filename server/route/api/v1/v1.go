@@ -3,6 +3,9 @@ package v1
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
+	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -11,6 +14,13 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/yourselfhosted/slash/plugin/geoip"
+	"github.com/yourselfhosted/slash/plugin/gitrepo"
+	"github.com/yourselfhosted/slash/plugin/httpgetter"
+	"github.com/yourselfhosted/slash/plugin/mail"
+	"github.com/yourselfhosted/slash/plugin/shortener"
+	"github.com/yourselfhosted/slash/plugin/summarizer"
+	"github.com/yourselfhosted/slash/plugin/thumbnailer"
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/server/service/license"
@@ -30,26 +40,69 @@ type APIV1Service struct {
 	Profile        *profile.Profile
 	Store          *store.Store
 	LicenseService *license.LicenseService
+	// GeoIPResolver enriches the sign-in activities with the client geolocation when set.
+	GeoIPResolver geoip.Resolver
+	// LinkShortener replaces the HTTP shortener of the link_shortener_url workspace setting when set.
+	// It is only used while the setting is configured.
+	LinkShortener shortener.LinkShortener
+	// Summarizer summarizes the long descriptions of shortcuts, they are truncated when it isn't set.
+	Summarizer summarizer.Summarizer
+	// LinkMetadataFetcher fetches the title and favicon of the links of new shortcuts on request.
+	// A SafeFetcher with the default limits is used when it isn't set.
+	LinkMetadataFetcher httpgetter.LinkMetadataFetcher
+	// RepoFileFetcher reads the links files of the synced repositories.
+	// An HTTPFetcher with the default limits is used when it isn't set.
+	RepoFileFetcher gitrepo.FileFetcher
+	// Thumbnailer replaces the HTTP renderer of the thumbnail_renderer_url workspace setting when set.
+	// It is only used while the setting is configured.
+	Thumbnailer thumbnailer.Thumbnailer
+	// Mailer sends the password reset emails from MailFrom, e.g. "Slash <slash@example.com>".
+	// The emails are logged when it isn't set.
+	Mailer   mail.Mailer
+	MailFrom string
 
-	grpcServer     *grpc.Server
-	grpcServerPort int
+	signInThrottler   *signInThrottler
+	signInRateLimiter *signInRateLimiter
+	qrCodeCache       *qrCodeCache
+	// thumbnailRateLimiter counts the thumbnails rendered for the shortcuts of each creator.
+	thumbnailRateLimiter *userRateLimiter
+	grpcServer           *grpc.Server
+	grpcServerPort       int
+
+	// repoSyncMutex serializes the syncs of repositories, which update the same workspace setting.
+	repoSyncMutex sync.Mutex
 }
 
 func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, grpcServerPort int) *APIV1Service {
 	authProvider := NewGRPCAuthInterceptor(store, secret)
+	workspaceInterceptor := NewWorkspaceInterceptor(profile)
+	concurrencyLimiter := NewConcurrencyLimiterInterceptor(newMethodConcurrencyLimits(profile))
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			workspaceInterceptor.WorkspaceInterceptor,
 			NewLoggerInterceptor().LoggerInterceptor,
 			authProvider.AuthenticationInterceptor,
+			NewRateLimiterInterceptor(profile.APIRateLimit, profile.APICreateRateLimit).RateLimiterInterceptor,
+			NewResponseRedactorInterceptor(store).ResponseRedactorInterceptor,
+			concurrencyLimiter.ConcurrencyLimiterInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			workspaceInterceptor.StreamWorkspaceInterceptor,
+			authProvider.StreamAuthenticationInterceptor,
+			concurrencyLimiter.StreamConcurrencyLimiterInterceptor,
 		),
 	)
 	apiV1Service := &APIV1Service{
-		Secret:         secret,
-		Profile:        profile,
-		Store:          store,
-		LicenseService: licenseService,
-		grpcServer:     grpcServer,
-		grpcServerPort: grpcServerPort,
+		Secret:               secret,
+		Profile:              profile,
+		Store:                store,
+		LicenseService:       licenseService,
+		signInThrottler:      newSignInThrottler(profile.SignInBackoffBase, profile.SignInBackoffMultiplier, profile.SignInBackoffMax),
+		signInRateLimiter:    newSignInRateLimiter(),
+		qrCodeCache:          newQRCodeCache(maxQRCodeCacheEntries),
+		thumbnailRateLimiter: newUserRateLimiter(maxThumbnailsPerMinute),
+		grpcServer:           grpcServer,
+		grpcServerPort:       grpcServerPort,
 	}
 
 	v1pb.RegisterSubscriptionServiceServer(grpcServer, apiV1Service)
@@ -73,14 +126,33 @@ func (s *APIV1Service) RegisterGateway(_ context.Context, e *echo.Echo) error {
 	// Create a client connection to the gRPC Server we just started.
 	// This is where the gRPC-Gateway proxies the requests.
 	conn, err := grpc.NewClient(
-		fmt.Sprintf(":%d", s.grpcServerPort),
+		fmt.Sprintf("127.0.0.1:%d", s.grpcServerPort),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		// Pass the rate limit headers through as is for clients to self-throttle.
+		runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+			if isRateLimitHeader(key) {
+				return key, true
+			}
+			return runtime.MetadataHeaderPrefix + key, true
+		}),
+		// Only the gateway tells whether the request is secure, the IP of the client and the workspace.
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			metadataKey, ok := runtime.DefaultHeaderMatcher(key)
+			if ok && (strings.EqualFold(metadataKey, secureRequestMetadataKey) || strings.EqualFold(metadataKey, clientIPMetadataKey) || strings.EqualFold(metadataKey, workspaceMetadataKey)) {
+				return "", false
+			}
+			return metadataKey, ok
+		}),
+		runtime.WithMetadata(newSecureRequestMetadata(s.Profile)),
+		runtime.WithMetadata(newClientIPMetadata(s.Profile)),
+		runtime.WithMetadata(newWorkspaceMetadata),
+	)
 	if err := v1pb.RegisterSubscriptionServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
@@ -102,17 +174,31 @@ func (s *APIV1Service) RegisterGateway(_ context.Context, e *echo.Echo) error {
 	if err := v1pb.RegisterCollectionServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
-	e.Any("/api/v1/*", echo.WrapHandler(gwMux))
+	e.Any("/api/v1/*", echo.WrapHandler(newGatewayHandler(gwMux)))
+	s.registerBookmarkletRoutes(e)
+	s.registerEmbedRoutes(e)
+	s.registerRepoSyncRoutes(e)
 
 	// GRPC web proxy.
+	e.Any("/slash.api.v1.*", echo.WrapHandler(newGRPCWebHandler(s.Profile, s.grpcServer)))
+
+	return nil
+}
+
+// newGRPCWebHandler returns the gRPC-Web proxy of the server. Like the gateway, it tells the handlers the workspace,
+// whether the request is secure and the IP of the client in place of the headers the client sent.
+func newGRPCWebHandler(serverProfile *profile.Profile, grpcServer *grpc.Server) http.Handler {
 	options := []grpcweb.Option{
 		grpcweb.WithCorsForRegisteredEndpointsOnly(false),
 		grpcweb.WithOriginFunc(func(_ string) bool {
 			return true
 		}),
 	}
-	wrappedGrpc := grpcweb.WrapServer(s.grpcServer, options...)
-	e.Any("/slash.api.v1.*", echo.WrapHandler(wrappedGrpc))
-
-	return nil
+	wrappedGrpc := grpcweb.WrapServer(grpcServer, options...)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		setWorkspaceHeader(r)
+		setSecureRequestHeader(serverProfile, r)
+		setClientIPHeader(serverProfile, r)
+		wrappedGrpc.ServeHTTP(w, r)
+	})
 }
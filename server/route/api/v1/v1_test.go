@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/server/service/license"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+	teststore "github.com/yourselfhosted/slash/test/store"
+)
+
+const testingSecret = "testing-secret"
+
+func newTestingService(ctx context.Context, t *testing.T) *APIV1Service {
+	ts := teststore.NewTestingStore(ctx, t)
+	profile := test.GetTestingProfile(t)
+	return &APIV1Service{
+		Secret:               testingSecret,
+		Profile:              profile,
+		Store:                ts,
+		LicenseService:       license.NewLicenseService(profile, ts),
+		signInRateLimiter:    newSignInRateLimiter(),
+		thumbnailRateLimiter: newUserRateLimiter(maxThumbnailsPerMinute),
+	}
+}
+
+// createTestingUser creates a user with the given role and returns it with a valid access token.
+func createTestingUser(ctx context.Context, t *testing.T, s *APIV1Service, nickname string, role store.Role) (*store.User, string) {
+	user, err := s.Store.CreateUser(ctx, &store.User{
+		Role:     role,
+		Email:    fmt.Sprintf("%s@test.com", nickname),
+		Nickname: nickname,
+	})
+	require.NoError(t, err)
+	accessToken, err := GenerateAccessToken(user.Email, user.ID, time.Now().Add(AccessTokenDuration), []byte(s.Secret))
+	require.NoError(t, err)
+	require.NoError(t, s.UpsertAccessTokenToStore(ctx, user, accessToken, "testing"))
+	return user, accessToken
+}
+
+// withUser returns a context as if the request is authenticated as the given user.
+func withUser(ctx context.Context, user *store.User) context.Context {
+	return context.WithValue(ctx, userIDContextKey, user.ID)
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// workspaceMetadataKey is set by the gateway and the gRPC web proxy to the workspace resolved from the request.
+const workspaceMetadataKey = "slash-workspace"
+
+// NewWorkspaceMiddleware returns the middleware resolving the workspace of the requests from their host or path,
+// which scopes the store operations of the request and strips the workspace path prefix before the routing.
+func NewWorkspaceMiddleware(profile *profile.Profile) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r := c.Request()
+			workspaceID, path := profile.ResolveWorkspace(r.Host, r.URL.Path)
+			if path != r.URL.Path {
+				r.URL.Path, r.URL.RawPath = path, ""
+			}
+			c.SetRequest(r.WithContext(store.WithWorkspaceID(r.Context(), workspaceID)))
+			return next(c)
+		}
+	}
+}
+
+// newWorkspaceMetadata returns the gateway metadata of the workspace of the request.
+func newWorkspaceMetadata(_ context.Context, r *http.Request) metadata.MD {
+	if workspaceID := store.GetWorkspaceID(r.Context()); workspaceID != store.DefaultWorkspaceID {
+		return metadata.Pairs(workspaceMetadataKey, workspaceID)
+	}
+	return nil
+}
+
+// setWorkspaceHeader overwrites the workspace header of the gRPC web requests, as the proxy passes
+// the headers through as metadata.
+func setWorkspaceHeader(r *http.Request) {
+	r.Header.Del(workspaceMetadataKey)
+	if workspaceID := store.GetWorkspaceID(r.Context()); workspaceID != store.DefaultWorkspaceID {
+		r.Header.Set(workspaceMetadataKey, workspaceID)
+	}
+}
+
+type WorkspaceInterceptor struct {
+	profile *profile.Profile
+}
+
+// NewWorkspaceInterceptor returns a new WorkspaceInterceptor of the workspaces of the profile.
+func NewWorkspaceInterceptor(profile *profile.Profile) *WorkspaceInterceptor {
+	return &WorkspaceInterceptor{
+		profile: profile,
+	}
+}
+
+// WorkspaceInterceptor scopes the store operations of the call to the workspace of its metadata.
+func (in *WorkspaceInterceptor) WorkspaceInterceptor(ctx context.Context, request any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	childCtx, err := in.withWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(childCtx, request)
+}
+
+// StreamWorkspaceInterceptor applies the same scoping as WorkspaceInterceptor to the streaming methods.
+func (in *WorkspaceInterceptor) StreamWorkspaceInterceptor(server any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	childCtx, err := in.withWorkspace(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(server, &authenticatedServerStream{ServerStream: stream, ctx: childCtx})
+}
+
+func (in *WorkspaceInterceptor) withWorkspace(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	workspaceID := store.DefaultWorkspaceID
+	if values := md.Get(workspaceMetadataKey); len(values) > 0 {
+		workspaceID = values[0]
+	}
+	if !in.profile.IsWorkspace(workspaceID) {
+		return nil, status.Errorf(codes.NotFound, "workspace %q not found", workspaceID)
+	}
+	return store.WithWorkspaceID(ctx, workspaceID), nil
+}
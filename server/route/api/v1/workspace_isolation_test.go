@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestWorkspaceMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Pre(NewWorkspaceMiddleware(&profile.Profile{WorkspaceIsolation: profile.WorkspaceIsolationPath, Workspaces: []string{"acme"}}))
+	e.GET("/s/:name", func(c echo.Context) error {
+		return c.String(http.StatusOK, store.GetWorkspaceID(c.Request().Context())+":"+c.Param("name"))
+	})
+
+	for path, body := range map[string]string{
+		"/s/docs":        ":docs",
+		"/w/acme/s/docs": "acme:docs",
+	} {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, recorder.Code, path)
+		require.Equal(t, body, recorder.Body.String(), path)
+	}
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/w/other/s/docs", nil))
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestWorkspaceInterceptor(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingService(ctx, t)
+	s.Profile.WorkspaceIsolation, s.Profile.Workspaces = profile.WorkspaceIsolationPath, []string{"acme"}
+	acmeCtx := store.WithWorkspaceID(ctx, "acme")
+	user, accessToken := createTestingUser(ctx, t, s, "user", store.RoleUser)
+	acmeUser, acmeAccessToken := createTestingUser(acmeCtx, t, s, "user", store.RoleUser)
+	shortcut, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{CreatorId: user.ID, Name: "docs", Link: "https://docs.example.com", Visibility: storepb.Visibility_WORKSPACE, OgMetadata: &storepb.OpenGraphMetadata{}})
+	require.NoError(t, err)
+	acmeShortcut, err := s.Store.CreateShortcut(acmeCtx, &storepb.Shortcut{CreatorId: acmeUser.ID, Name: "docs", Link: "https://docs.acme.com", Visibility: storepb.Visibility_WORKSPACE, OgMetadata: &storepb.OpenGraphMetadata{}})
+	require.NoError(t, err)
+
+	workspaceInterceptor, authInterceptor := NewWorkspaceInterceptor(s.Profile), NewGRPCAuthInterceptor(s.Store, s.Secret)
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "/slash.api.v1.ShortcutService/GetShortcut"}
+	getShortcut := func(workspaceID, accessToken string, id int32) (*v1pb.Shortcut, error) {
+		md := metadata.Pairs("Authorization", "Bearer "+accessToken)
+		if workspaceID != "" {
+			md.Set(workspaceMetadataKey, workspaceID)
+		}
+		response, err := workspaceInterceptor.WorkspaceInterceptor(metadata.NewIncomingContext(ctx, md), &v1pb.GetShortcutRequest{Id: id}, serverInfo, func(ctx context.Context, request any) (any, error) {
+			return authInterceptor.AuthenticationInterceptor(ctx, request, serverInfo, func(ctx context.Context, request any) (any, error) {
+				return s.GetShortcut(ctx, request.(*v1pb.GetShortcutRequest))
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.(*v1pb.Shortcut), nil
+	}
+
+	got, err := getShortcut("", accessToken, shortcut.Id)
+	require.NoError(t, err)
+	require.Equal(t, "https://docs.example.com", got.Link)
+	got, err = getShortcut("acme", acmeAccessToken, acmeShortcut.Id)
+	require.NoError(t, err)
+	require.Equal(t, "https://docs.acme.com", got.Link)
+
+	// The shortcuts of the other workspaces are not found.
+	_, err = getShortcut("acme", acmeAccessToken, shortcut.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	// The users of a workspace are not authenticated in the others, they are anonymous for the public methods.
+	_, err = getShortcut("acme", accessToken, acmeShortcut.Id)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = getShortcut("", acmeAccessToken, shortcut.Id)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	md := metadata.Pairs("Authorization", "Bearer "+accessToken)
+	_, err = authInterceptor.authorize(metadata.NewIncomingContext(acmeCtx, md), "/slash.api.v1.ShortcutService/ListShortcuts")
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	// The workspaces are the ones of the profile.
+	_, err = getShortcut("other", acmeAccessToken, acmeShortcut.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
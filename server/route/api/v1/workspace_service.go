@@ -3,16 +3,31 @@ package v1
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/server/service/license"
 	"github.com/yourselfhosted/slash/store"
 )
 
+// maxWorkspaceTitleLength is the maximum number of characters of the title of the instance.
+const maxWorkspaceTitleLength = 64
+
+// themeColorRegexp matches the hex colors of 3 or 6 digits.
+var themeColorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
 func (s *APIV1Service) GetWorkspaceProfile(ctx context.Context, _ *v1pb.GetWorkspaceProfileRequest) (*v1pb.WorkspaceProfile, error) {
 	workspaceProfile := &v1pb.WorkspaceProfile{
 		Mode:    s.Profile.Mode,
@@ -36,10 +51,61 @@ func (s *APIV1Service) GetWorkspaceProfile(ctx context.Context, _ *v1pb.GetWorks
 		return nil, errors.Wrap(err, "failed to get workspace general setting")
 	}
 	workspaceProfile.Branding = workspaceGeneralSetting.GetBranding()
+	workspaceProfile.Title = workspaceGeneralSetting.GetTitle()
+	workspaceProfile.LogoUrl = workspaceGeneralSetting.GetLogoUrl()
+	workspaceProfile.ThemeColor = workspaceGeneralSetting.GetThemeColor()
+	capabilities, err := s.getWorkspaceCapabilities(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace capabilities: %v", err)
+	}
+	workspaceProfile.Capabilities = capabilities
 
 	return workspaceProfile, nil
 }
 
+// getWorkspaceCapabilities derives the features available to clients from the settings and the license.
+// Only the id and title of the identity providers are exposed.
+func (s *APIV1Service) getWorkspaceCapabilities(ctx context.Context) (*v1pb.WorkspaceProfile_Capabilities, error) {
+	securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace security setting")
+	}
+	capabilities := &v1pb.WorkspaceProfile_Capabilities{
+		SignupEnabled:  !securitySetting.DisallowUserRegistration,
+		MfaAvailable:   true,
+		OauthProviders: []*v1pb.WorkspaceProfile_OAuthProvider{},
+	}
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
+		return capabilities, nil
+	}
+	identityProviderSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace identity provider setting")
+	}
+	capabilities.OauthProviders = convertOAuthProvidersFromStore(identityProviderSetting.GetIdentityProvider().GetIdentityProviders())
+	capabilities.SsoEnabled = len(capabilities.OauthProviders) > 0
+	return capabilities, nil
+}
+
+// convertOAuthProvidersFromStore returns the OAuth2 and OIDC identity providers without their config.
+func convertOAuthProvidersFromStore(identityProviders []*storepb.IdentityProvider) []*v1pb.WorkspaceProfile_OAuthProvider {
+	oauthProviders := []*v1pb.WorkspaceProfile_OAuthProvider{}
+	for _, identityProvider := range identityProviders {
+		if identityProvider.Type != storepb.IdentityProvider_OAUTH2 && identityProvider.Type != storepb.IdentityProvider_OIDC {
+			continue
+		}
+		oauthProviders = append(oauthProviders, &v1pb.WorkspaceProfile_OAuthProvider{
+			Id:    identityProvider.Id,
+			Title: identityProvider.Title,
+		})
+	}
+	return oauthProviders
+}
+
+// GetWorkspaceSetting returns all the workspace settings to the admins. The other users, and the callers who aren't
+// signed in, only get the ones the pages of the web app need, without the secrets of the identity providers.
 func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, _ *v1pb.GetWorkspaceSettingRequest) (*v1pb.WorkspaceSetting, error) {
 	currentUser, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
@@ -53,33 +119,94 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, _ *v1pb.GetWorks
 	for _, v := range workspaceSettings {
 		if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL {
 			generalSetting := v.GetGeneral()
+			workspaceSetting.InstanceUrl = generalSetting.GetInstanceUrl()
 			workspaceSetting.Branding = generalSetting.GetBranding()
 			workspaceSetting.CustomStyle = generalSetting.GetCustomStyle()
+			workspaceSetting.Title = generalSetting.GetTitle()
+			workspaceSetting.LogoUrl = generalSetting.GetLogoUrl()
+			workspaceSetting.ThemeColor = generalSetting.GetThemeColor()
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY {
 			securitySetting := v.GetSecurity()
 			workspaceSetting.DisallowUserRegistration = securitySetting.GetDisallowUserRegistration()
 			workspaceSetting.DisallowPasswordAuth = securitySetting.GetDisallowPasswordAuth()
+			workspaceSetting.MaxSessionsPerUser = securitySetting.GetMaxSessionsPerUser()
+			workspaceSetting.SessionLimitPolicy = v1pb.WorkspaceSetting_SessionLimitPolicy(securitySetting.GetSessionLimitPolicy())
+			workspaceSetting.AuditLogRetentionDays = securitySetting.GetAuditLogRetentionDays()
+			workspaceSetting.RequireEmailVerification = securitySetting.GetRequireEmailVerification()
+			workspaceSetting.MaxFailedSignIns = securitySetting.GetMaxFailedSignIns()
+			workspaceSetting.FailedSignInWindowMinutes = securitySetting.GetFailedSignInWindowMinutes()
+			workspaceSetting.AccountLockoutThreshold = securitySetting.GetAccountLockoutThreshold()
+			workspaceSetting.AccountLockoutMinutes = securitySetting.GetAccountLockoutMinutes()
+			workspaceSetting.PasswordMinLength = securitySetting.GetPasswordMinLength()
+			workspaceSetting.PasswordRequireUppercase = securitySetting.GetPasswordRequireUppercase()
+			workspaceSetting.PasswordRequireLowercase = securitySetting.GetPasswordRequireLowercase()
+			workspaceSetting.PasswordRequireDigit = securitySetting.GetPasswordRequireDigit()
+			workspaceSetting.PasswordRequireSymbol = securitySetting.GetPasswordRequireSymbol()
+			workspaceSetting.UnverifiedUserTtlDays = securitySetting.GetUnverifiedUserTtlDays()
+			workspaceSetting.BlockDestructiveActionsWhenImpersonating = securitySetting.GetBlockDestructiveActionsWhenImpersonating()
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED {
 			shortcutRelatedSetting := v.GetShortcutRelated()
 			workspaceSetting.DefaultVisibility = convertVisibilityFromStorepb(shortcutRelatedSetting.GetDefaultVisibility())
+			workspaceSetting.AutoArchiveUnusedDays = shortcutRelatedSetting.GetAutoArchiveUnusedDays()
+			workspaceSetting.DefaultShortcutOrder = shortcutRelatedSetting.GetDefaultShortcutOrder()
+			workspaceSetting.MaxRedirectRateLimit = shortcutRelatedSetting.GetMaxRedirectRateLimit()
+			workspaceSetting.LinkShortenerUrl = shortcutRelatedSetting.GetLinkShortenerUrl()
+			workspaceSetting.GeneratedNameAlphabet = shortcutRelatedSetting.GetGeneratedNameAlphabet()
+			workspaceSetting.GeneratedNameLength = shortcutRelatedSetting.GetGeneratedNameLength()
+			workspaceSetting.CollectionVisibilityPolicy = v1pb.WorkspaceSetting_CollectionVisibilityPolicy(shortcutRelatedSetting.GetCollectionVisibilityPolicy())
+			workspaceSetting.ViewCountPrivacy = v1pb.WorkspaceSetting_ViewCountPrivacy(shortcutRelatedSetting.GetViewCountPrivacy())
+			workspaceSetting.NewUserShortcutCooldownHours = shortcutRelatedSetting.GetNewUserShortcutCooldownHours()
+			workspaceSetting.NewUserShortcutQuota = shortcutRelatedSetting.GetNewUserShortcutQuota()
+			workspaceSetting.StrippedLinkQueryParams = shortcutRelatedSetting.GetStrippedLinkQueryParams()
+			workspaceSetting.PublicRedirectCacheMaxAge = shortcutRelatedSetting.GetPublicRedirectCacheMaxAge()
+			if notFoundRedirect := shortcutRelatedSetting.GetNotFoundRedirect(); notFoundRedirect != nil {
+				workspaceSetting.NotFoundRedirect = &v1pb.WorkspaceSetting_NotFoundRedirect{
+					Mode:        v1pb.WorkspaceSetting_NotFoundRedirect_Mode(notFoundRedirect.Mode),
+					UrlTemplate: notFoundRedirect.UrlTemplate,
+				}
+			}
+			workspaceSetting.TagPolicyConflictResolution = v1pb.WorkspaceSetting_TagPolicyConflictResolution(shortcutRelatedSetting.GetTagPolicyConflictResolution())
+			workspaceSetting.CanonicalLinkQueryOrder = v1pb.WorkspaceSetting_CanonicalLinkQueryOrder(shortcutRelatedSetting.GetCanonicalLinkQueryOrder())
+			workspaceSetting.ThumbnailRendererUrl = shortcutRelatedSetting.GetThumbnailRendererUrl()
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER {
 			identityProviderSetting := v.GetIdentityProvider()
 			workspaceSetting.IdentityProviders = []*v1pb.IdentityProvider{}
 			for _, identityProvider := range identityProviderSetting.GetIdentityProviders() {
-				identityProviderV1pb := convertIdentityProviderFromStore(identityProvider)
-				if currentUser == nil || currentUser.Role != store.RoleAdmin {
-					oauth2Config := identityProviderV1pb.Config.GetOauth2()
-					if oauth2Config != nil {
-						oauth2Config.ClientSecret = ""
-					}
-				}
-				workspaceSetting.IdentityProviders = append(workspaceSetting.IdentityProviders, identityProviderV1pb)
+				workspaceSetting.IdentityProviders = append(workspaceSetting.IdentityProviders, convertIdentityProviderFromStore(identityProvider))
 			}
 		}
 	}
+	if currentUser == nil || currentUser.Role != store.RoleAdmin {
+		return convertPublicWorkspaceSetting(workspaceSetting), nil
+	}
 	return workspaceSetting, nil
 }
 
+// convertPublicWorkspaceSetting returns the workspace settings the users who aren't admins get, e.g. on the sign in
+// page.
+func convertPublicWorkspaceSetting(workspaceSetting *v1pb.WorkspaceSetting) *v1pb.WorkspaceSetting {
+	publicWorkspaceSetting := &v1pb.WorkspaceSetting{
+		Branding:                 workspaceSetting.Branding,
+		CustomStyle:              workspaceSetting.CustomStyle,
+		Title:                    workspaceSetting.Title,
+		LogoUrl:                  workspaceSetting.LogoUrl,
+		ThemeColor:               workspaceSetting.ThemeColor,
+		DefaultVisibility:        workspaceSetting.DefaultVisibility,
+		DisallowUserRegistration: workspaceSetting.DisallowUserRegistration,
+		DisallowPasswordAuth:     workspaceSetting.DisallowPasswordAuth,
+		IdentityProviders:        workspaceSetting.IdentityProviders,
+	}
+	for _, identityProvider := range publicWorkspaceSetting.IdentityProviders {
+		if oauth2Config := identityProvider.Config.GetOauth2(); oauth2Config != nil {
+			oauth2Config.ClientSecret = ""
+		}
+		if oidcConfig := identityProvider.Config.GetOidc(); oidcConfig != nil {
+			oidcConfig.ClientSecret = ""
+		}
+	}
+	return publicWorkspaceSetting
+}
+
 func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb.UpdateWorkspaceSettingRequest) (*v1pb.WorkspaceSetting, error) {
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "update mask is empty")
@@ -100,6 +227,25 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "instance_url" {
+			if request.Setting.InstanceUrl != "" {
+				if u, err := url.Parse(request.Setting.InstanceUrl); err != nil || u.Host == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid instance url: %s", request.Setting.InstanceUrl)
+				}
+			}
+			generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			generalSetting.InstanceUrl = request.Setting.InstanceUrl
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL,
+				Value: &storepb.WorkspaceSetting_General{
+					General: generalSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else if path == "custom_style" {
 			generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
 			if err != nil {
@@ -114,6 +260,38 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "title" || path == "logo_url" || path == "theme_color" {
+			generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			if path == "title" {
+				title := strings.TrimSpace(request.Setting.Title)
+				if utf8.RuneCountInString(title) > maxWorkspaceTitleLength {
+					return nil, status.Errorf(codes.InvalidArgument, "title must be at most %d characters", maxWorkspaceTitleLength)
+				}
+				generalSetting.Title = title
+			} else if path == "logo_url" {
+				if request.Setting.LogoUrl != "" {
+					if u, err := url.Parse(request.Setting.LogoUrl); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+						return nil, status.Errorf(codes.InvalidArgument, "invalid logo url: %s", request.Setting.LogoUrl)
+					}
+				}
+				generalSetting.LogoUrl = request.Setting.LogoUrl
+			} else {
+				if request.Setting.ThemeColor != "" && !themeColorRegexp.MatchString(request.Setting.ThemeColor) {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid theme color %q, expect a hex color e.g. #2563eb", request.Setting.ThemeColor)
+				}
+				generalSetting.ThemeColor = strings.ToLower(request.Setting.ThemeColor)
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL,
+				Value: &storepb.WorkspaceSetting_General{
+					General: generalSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else if path == "default_visibility" {
 			shortcutRelatedSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
 				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
@@ -138,6 +316,306 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "auto_archive_unused_days" {
+			if request.Setting.AutoArchiveUnusedDays < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "auto archive unused days must not be negative")
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			if shortcutRelatedSetting == nil {
+				shortcutRelatedSetting = &storepb.WorkspaceSetting{
+					Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+					Value: &storepb.WorkspaceSetting_ShortcutRelated{
+						ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{},
+					},
+				}
+			}
+			shortcutRelatedSetting.GetShortcutRelated().AutoArchiveUnusedDays = request.Setting.AutoArchiveUnusedDays
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting.GetShortcutRelated(),
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "default_shortcut_order" {
+			if _, err := parseShortcutOrderBy(request.Setting.DefaultShortcutOrder); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid default shortcut order: %v", err)
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			if shortcutRelatedSetting == nil {
+				shortcutRelatedSetting = &storepb.WorkspaceSetting{
+					Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+					Value: &storepb.WorkspaceSetting_ShortcutRelated{
+						ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{},
+					},
+				}
+			}
+			shortcutRelatedSetting.GetShortcutRelated().DefaultShortcutOrder = request.Setting.DefaultShortcutOrder
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting.GetShortcutRelated(),
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "max_redirect_rate_limit" {
+			if request.Setting.MaxRedirectRateLimit < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "max redirect rate limit must not be negative")
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.MaxRedirectRateLimit = request.Setting.MaxRedirectRateLimit
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "link_shortener_url" {
+			if request.Setting.LinkShortenerUrl != "" {
+				if u, err := url.Parse(request.Setting.LinkShortenerUrl); err != nil || u.Host == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid link shortener url: %s", request.Setting.LinkShortenerUrl)
+				}
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.LinkShortenerUrl = request.Setting.LinkShortenerUrl
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "thumbnail_renderer_url" {
+			if request.Setting.ThumbnailRendererUrl != "" {
+				if u, err := url.Parse(request.Setting.ThumbnailRendererUrl); err != nil || u.Host == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid thumbnail renderer url: %s", request.Setting.ThumbnailRendererUrl)
+				}
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.ThumbnailRendererUrl = request.Setting.ThumbnailRendererUrl
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "generated_name_alphabet" {
+			if request.Setting.GeneratedNameAlphabet != "" {
+				if err := validateGeneratedNameAlphabet(request.Setting.GeneratedNameAlphabet); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid generated name alphabet: %v", err)
+				}
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.GeneratedNameAlphabet = request.Setting.GeneratedNameAlphabet
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "generated_name_length" {
+			if request.Setting.GeneratedNameLength < 0 || request.Setting.GeneratedNameLength > maxGeneratedNameLength {
+				return nil, status.Errorf(codes.InvalidArgument, "generated name length must be between 0 and %d", maxGeneratedNameLength)
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.GeneratedNameLength = request.Setting.GeneratedNameLength
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "collection_visibility_policy" {
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.CollectionVisibilityPolicy = storepb.WorkspaceSetting_ShortcutRelatedSetting_CollectionVisibilityPolicy(request.Setting.CollectionVisibilityPolicy)
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "view_count_privacy" {
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.ViewCountPrivacy = storepb.WorkspaceSetting_ShortcutRelatedSetting_ViewCountPrivacy(request.Setting.ViewCountPrivacy)
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "new_user_shortcut_cooldown_hours" {
+			if request.Setting.NewUserShortcutCooldownHours < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "new user shortcut cooldown hours must not be negative")
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.NewUserShortcutCooldownHours = request.Setting.NewUserShortcutCooldownHours
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "new_user_shortcut_quota" {
+			if request.Setting.NewUserShortcutQuota < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "new user shortcut quota must not be negative")
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.NewUserShortcutQuota = request.Setting.NewUserShortcutQuota
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "stripped_link_query_params" {
+			params := []string{}
+			for _, param := range request.Setting.StrippedLinkQueryParams {
+				param = strings.TrimSpace(param)
+				if param == "" || param == "*" || strings.ContainsAny(param, "&=#?") {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid link query param %q", param)
+				}
+				params = append(params, param)
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.StrippedLinkQueryParams = params
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "public_redirect_cache_max_age" {
+			if request.Setting.PublicRedirectCacheMaxAge < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "public redirect cache max age must not be negative")
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.PublicRedirectCacheMaxAge = request.Setting.PublicRedirectCacheMaxAge
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "not_found_redirect" {
+			notFoundRedirect := request.Setting.NotFoundRedirect
+			if notFoundRedirect != nil && notFoundRedirect.Mode == v1pb.WorkspaceSetting_NotFoundRedirect_URL_TEMPLATE && !isValidNotFoundRedirectURLTemplate(notFoundRedirect.UrlTemplate) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid not found redirect url template: %s", notFoundRedirect.UrlTemplate)
+			}
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.NotFoundRedirect = nil
+			if notFoundRedirect != nil && notFoundRedirect.Mode != v1pb.WorkspaceSetting_NotFoundRedirect_MODE_UNSPECIFIED {
+				shortcutRelatedSetting.NotFoundRedirect = &storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect{
+					Mode: storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_Mode(notFoundRedirect.Mode),
+				}
+				if notFoundRedirect.Mode == v1pb.WorkspaceSetting_NotFoundRedirect_URL_TEMPLATE {
+					shortcutRelatedSetting.NotFoundRedirect.UrlTemplate = notFoundRedirect.UrlTemplate
+				}
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "tag_policy_conflict_resolution" {
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.TagPolicyConflictResolution = storepb.WorkspaceSetting_ShortcutRelatedSetting_TagPolicyConflictResolution(request.Setting.TagPolicyConflictResolution)
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "canonical_link_query_order" {
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			shortcutRelatedSetting.CanonicalLinkQueryOrder = storepb.WorkspaceSetting_ShortcutRelatedSetting_CanonicalLinkQueryOrder(request.Setting.CanonicalLinkQueryOrder)
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else if path == "identity_providers" {
 			identityProviderSetting := &storepb.WorkspaceSetting_IdentityProviderSetting{}
 			for _, identityProvider := range request.Setting.IdentityProviders {
@@ -179,6 +657,240 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "max_sessions_per_user" {
+			if request.Setting.MaxSessionsPerUser < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "max sessions per user must not be negative")
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.MaxSessionsPerUser = request.Setting.MaxSessionsPerUser
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "session_limit_policy" {
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.SessionLimitPolicy = storepb.WorkspaceSetting_SecuritySetting_SessionLimitPolicy(request.Setting.SessionLimitPolicy)
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "audit_log_retention_days" {
+			if request.Setting.AuditLogRetentionDays < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "audit log retention days must not be negative")
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.AuditLogRetentionDays = request.Setting.AuditLogRetentionDays
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "require_email_verification" {
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.RequireEmailVerification = request.Setting.RequireEmailVerification
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "unverified_user_ttl_days" {
+			if request.Setting.UnverifiedUserTtlDays < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "unverified user ttl days must not be negative")
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.UnverifiedUserTtlDays = request.Setting.UnverifiedUserTtlDays
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "block_destructive_actions_when_impersonating" {
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.BlockDestructiveActionsWhenImpersonating = request.Setting.BlockDestructiveActionsWhenImpersonating
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "max_failed_sign_ins" {
+			if request.Setting.MaxFailedSignIns < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "max failed sign ins must not be negative")
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.MaxFailedSignIns = request.Setting.MaxFailedSignIns
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "failed_sign_in_window_minutes" {
+			if request.Setting.FailedSignInWindowMinutes < 0 || request.Setting.FailedSignInWindowMinutes > maxFailedSignInWindowMinutes {
+				return nil, status.Errorf(codes.InvalidArgument, "failed sign in window minutes must be between 0 and %d", maxFailedSignInWindowMinutes)
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.FailedSignInWindowMinutes = request.Setting.FailedSignInWindowMinutes
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "account_lockout_threshold" {
+			if request.Setting.AccountLockoutThreshold < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "account lockout threshold must not be negative")
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.AccountLockoutThreshold = request.Setting.AccountLockoutThreshold
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "account_lockout_minutes" {
+			if request.Setting.AccountLockoutMinutes < 0 || request.Setting.AccountLockoutMinutes > maxAccountLockoutMinutes {
+				return nil, status.Errorf(codes.InvalidArgument, "account lockout minutes must be between 0 and %d", maxAccountLockoutMinutes)
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.AccountLockoutMinutes = request.Setting.AccountLockoutMinutes
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "password_min_length" {
+			if request.Setting.PasswordMinLength < 0 || request.Setting.PasswordMinLength > maxPasswordLength {
+				return nil, status.Errorf(codes.InvalidArgument, "password min length must be between 0 and %d", maxPasswordLength)
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.PasswordMinLength = request.Setting.PasswordMinLength
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "password_require_uppercase" {
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.PasswordRequireUppercase = request.Setting.PasswordRequireUppercase
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "password_require_lowercase" {
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.PasswordRequireLowercase = request.Setting.PasswordRequireLowercase
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "password_require_digit" {
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.PasswordRequireDigit = request.Setting.PasswordRequireDigit
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "password_require_symbol" {
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.PasswordRequireSymbol = request.Setting.PasswordRequireSymbol
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid path: %s", path)
 		}
@@ -191,6 +903,108 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 	return workspaceSetting, nil
 }
 
+func (s *APIV1Service) GetServerConfig(ctx context.Context, _ *v1pb.GetServerConfigRequest) (*v1pb.ServerConfig, error) {
+	identityProviderSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	identityProviders := []*storepb.IdentityProvider{}
+	if identityProviderSetting != nil {
+		identityProviders = identityProviderSetting.GetIdentityProvider().GetIdentityProviders()
+	}
+	return buildServerConfig(s.Profile, s.Secret, s.LicenseService.GetSubscription(), identityProviders), nil
+}
+
+func (s *APIV1Service) GetMigrationStatus(ctx context.Context, _ *v1pb.GetMigrationStatusRequest) (*v1pb.MigrationStatus, error) {
+	migrationStatus, err := s.Store.GetMigrationStatus(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get migration status: %v", err)
+	}
+	return convertMigrationStatusFromStore(migrationStatus), nil
+}
+
+func (s *APIV1Service) RebuildShortcutSearchIndex(ctx context.Context, _ *v1pb.RebuildShortcutSearchIndexRequest) (*emptypb.Empty, error) {
+	if err := s.Store.RebuildShortcutSearchIndex(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rebuild shortcut search index: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func convertMigrationStatusFromStore(migrationStatus *store.MigrationStatus) *v1pb.MigrationStatus {
+	result := &v1pb.MigrationStatus{
+		CurrentVersion:    migrationStatus.CurrentVersion,
+		SchemaVersion:     migrationStatus.SchemaVersion,
+		AppliedMigrations: []*v1pb.MigrationStatus_AppliedMigration{},
+		PendingMigrations: []*v1pb.MigrationStatus_PendingMigration{},
+	}
+	for _, migrationHistory := range migrationStatus.Applied {
+		result.AppliedMigrations = append(result.AppliedMigrations, &v1pb.MigrationStatus_AppliedMigration{
+			Version:    migrationHistory.Version,
+			CreateTime: timestamppb.New(time.Unix(migrationHistory.CreatedTs, 0)),
+		})
+	}
+	for _, pendingMigration := range migrationStatus.Pending {
+		result.PendingMigrations = append(result.PendingMigrations, &v1pb.MigrationStatus_PendingMigration{
+			Version:  pendingMigration.Version,
+			FilePath: pendingMigration.FilePath,
+		})
+	}
+	return result
+}
+
+// redactedValue replaces any secret value in the server config, same as url.URL.Redacted.
+const redactedValue = "xxxxx"
+
+func buildServerConfig(profile *profile.Profile, secret string, subscription *v1pb.Subscription, identityProviders []*storepb.IdentityProvider) *v1pb.ServerConfig {
+	serverConfig := &v1pb.ServerConfig{
+		Mode:                profile.Mode,
+		Version:             profile.Version,
+		Port:                int32(profile.Port),
+		Data:                profile.Data,
+		Driver:              profile.Driver,
+		Dsn:                 redactDSN(profile.DSN),
+		AccessTokenDuration: int64(AccessTokenDuration.Seconds()),
+		CookieDuration:      int64(CookieExpDuration.Seconds()),
+		Subscription:        subscription,
+		IdentityProviders:   []*v1pb.IdentityProvider{},
+	}
+	if secret != "" {
+		serverConfig.Secret = redactedValue
+	}
+	for _, identityProvider := range identityProviders {
+		identityProviderV1pb := convertIdentityProviderFromStore(identityProvider)
+		if oauth2Config := identityProviderV1pb.Config.GetOauth2(); oauth2Config != nil && oauth2Config.ClientSecret != "" {
+			oauth2Config.ClientSecret = redactedValue
+		}
+		if oidcConfig := identityProviderV1pb.Config.GetOidc(); oidcConfig != nil && oidcConfig.ClientSecret != "" {
+			oidcConfig.ClientSecret = redactedValue
+		}
+		serverConfig.IdentityProviders = append(serverConfig.IdentityProviders, identityProviderV1pb)
+	}
+	return serverConfig
+}
+
+// isValidNotFoundRedirectURLTemplate returns whether the template is an absolute http(s) URL once "{name}" is replaced.
+func isValidNotFoundRedirectURLTemplate(template string) bool {
+	u, err := url.Parse(strings.ReplaceAll(template, "{name}", "name"))
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+var dsnPasswordRegexp = regexp.MustCompile(`(?i)(password\s*=\s*)('[^']*'|\S+)`)
+
+// redactDSN hides the password of both URL and key/value styled DSNs.
+func redactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.User != nil {
+		return u.Redacted()
+	}
+	return dsnPasswordRegexp.ReplaceAllString(dsn, "${1}"+redactedValue)
+}
+
 var ownerCache *v1pb.User
 
 func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error) {
@@ -218,10 +1032,11 @@ func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider
 		return nil
 	}
 	return &v1pb.IdentityProvider{
-		Id:     identityProvider.Id,
-		Title:  identityProvider.Title,
-		Type:   v1pb.IdentityProvider_Type(identityProvider.Type),
-		Config: convertIdentityProviderConfigFromStore(identityProvider.Config),
+		Id:           identityProvider.Id,
+		Title:        identityProvider.Title,
+		Type:         v1pb.IdentityProvider_Type(identityProvider.Type),
+		Config:       convertIdentityProviderConfigFromStore(identityProvider.Config),
+		EmailDomains: identityProvider.EmailDomains,
 	}
 }
 
@@ -245,6 +1060,18 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 			},
 		}
 	}
+	if oidcConfig := identityProviderConfig.GetOidc(); oidcConfig != nil {
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_Oidc{
+				Oidc: &v1pb.IdentityProviderConfig_OIDCConfig{
+					Issuer:       oidcConfig.Issuer,
+					ClientId:     oidcConfig.ClientId,
+					ClientSecret: oidcConfig.ClientSecret,
+					Scopes:       oidcConfig.Scopes,
+				},
+			},
+		}
+	}
 	return nil
 }
 
@@ -253,10 +1080,11 @@ func convertIdentityProviderToStore(identityProvider *v1pb.IdentityProvider) *st
 		return nil
 	}
 	return &storepb.IdentityProvider{
-		Id:     identityProvider.Id,
-		Title:  identityProvider.Title,
-		Type:   storepb.IdentityProvider_Type(identityProvider.Type),
-		Config: convertIdentityProviderConfigToStore(identityProvider.Config),
+		Id:           identityProvider.Id,
+		Title:        identityProvider.Title,
+		Type:         storepb.IdentityProvider_Type(identityProvider.Type),
+		Config:       convertIdentityProviderConfigToStore(identityProvider.Config),
+		EmailDomains: identityProvider.EmailDomains,
 	}
 }
 
@@ -280,5 +1108,17 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 			},
 		}
 	}
+	if oidcConfig := identityProviderConfig.GetOidc(); oidcConfig != nil {
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_Oidc{
+				Oidc: &storepb.IdentityProviderConfig_OIDCConfig{
+					Issuer:       oidcConfig.Issuer,
+					ClientId:     oidcConfig.ClientId,
+					ClientSecret: oidcConfig.ClientSecret,
+					Scopes:       oidcConfig.Scopes,
+				},
+			},
+		}
+	}
 	return nil
 }
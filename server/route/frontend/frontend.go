@@ -4,10 +4,15 @@ import (
 	"context"
 	"embed"
 	"fmt"
+	"html/template"
 	"io/fs"
 	"log/slog"
+	"math"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -16,7 +21,6 @@ import (
 
 	"github.com/yourselfhosted/slash/internal/util"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
-	"github.com/yourselfhosted/slash/server/common"
 	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/store"
 )
@@ -31,16 +35,23 @@ const (
 type FrontendService struct {
 	Profile *profile.Profile
 	Store   *store.Store
+
+	redirectRateLimiter  *redirectRateLimiter
+	shortcutViewRecorder *shortcutViewRecorder
 }
 
 func NewFrontendService(profile *profile.Profile, store *store.Store) *FrontendService {
 	return &FrontendService{
-		Profile: profile,
-		Store:   store,
+		Profile:              profile,
+		Store:                store,
+		redirectRateLimiter:  newRedirectRateLimiter(),
+		shortcutViewRecorder: newShortcutViewRecorder(store),
 	}
 }
 
-func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
+func (s *FrontendService) Serve(ctx context.Context, e *echo.Echo) {
+	go s.shortcutViewRecorder.run(ctx)
+
 	// Use echo static middleware to serve the built dist folder.
 	// Reference: https://github.com/labstack/echo/blob/master/middleware/static.go
 	e.Use(middleware.StaticWithConfig(middleware.StaticConfig{
@@ -83,24 +94,76 @@ func (s *FrontendService) registerRoutes(e *echo.Echo) {
 	e.GET("/s/:shortcutName", func(c echo.Context) error {
 		ctx := c.Request().Context()
 		shortcutName := c.Param("shortcutName")
-		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-			Name: &shortcutName,
-		})
+		// The page is served to anonymous visitors, the app resolves the name again for the signed-in user,
+		// whose private shortcut with the name comes first.
+		shortcut, err := s.Store.ResolveShortcutName(ctx, shortcutName, 0)
 		// If any error occurs or the shortcut is not found, return the raw `index.html`.
-		if err != nil || shortcut == nil {
+		if err != nil {
+			return c.HTML(http.StatusOK, rawIndexHTML)
+		}
+		// The expired shortcuts are treated as unknown names.
+		if shortcut == nil || store.IsShortcutExpired(shortcut, time.Now().Unix()) {
+			if redirectURL := s.getNotFoundRedirectURL(ctx, shortcutName); redirectURL != "" {
+				// The name may be taken by a shortcut later.
+				c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+				return c.Redirect(http.StatusFound, redirectURL)
+			}
 			return c.HTML(http.StatusOK, rawIndexHTML)
 		}
+		// Refuse to redirect into a loop that was introduced after the links were saved.
+		if err := s.Store.CheckShortcutRedirectLoop(ctx, shortcut.Name, shortcut.Link); err != nil {
+			if errors.Is(err, store.ErrShortcutRedirectLoop) {
+				return c.String(http.StatusLoopDetected, err.Error())
+			}
+			slog.Warn("failed to check shortcut redirect loop", slog.String("error", err.Error()))
+		}
+		if limit := s.getRedirectRateLimit(ctx, shortcut); limit > 0 {
+			if ok, retryAfter := s.redirectRateLimiter.Allow(shortcut.Id, limit); !ok {
+				c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				return c.String(http.StatusTooManyRequests, "too many redirects of this shortcut, please retry later")
+			}
+		}
 
-		// Create shortcut view activity.
-		if err := s.createShortcutViewActivity(ctx, c.Request(), shortcut); err != nil {
-			slog.Warn("failed to create shortcut view activity", slog.String("error", err.Error()))
+		// Record the view without waiting for it to be written.
+		if err := s.recordShortcutView(c.Request(), shortcut); err != nil {
+			slog.Warn("failed to record shortcut view", slog.String("error", err.Error()))
+		}
+
+		// Preview the shortcut in the language of the client.
+		acceptLanguage := c.Request().Header.Get("Accept-Language")
+		s.setRedirectCacheControl(ctx, c, shortcut)
+		if isMetaRefreshRedirect(shortcut) {
+			metaRefreshHTML, err := generateMetaRefreshHTML(shortcut, acceptLanguage)
+			if err != nil {
+				return c.String(http.StatusInternalServerError, err.Error())
+			}
+			return c.HTML(http.StatusOK, metaRefreshHTML)
 		}
 
 		// Inject shortcut metadata into `index.html`.
-		indexHTML := strings.ReplaceAll(rawIndexHTML, headerMetadataPlaceholder, generateShortcutMetadata(shortcut).String())
+		indexHTML := strings.ReplaceAll(rawIndexHTML, headerMetadataPlaceholder, generateShortcutMetadata(shortcut, acceptLanguage).String())
 		return c.HTML(http.StatusOK, indexHTML)
 	})
 
+	// HEAD lets monitoring tools check public shortcuts without a body, a recorded view or a counted redirect.
+	e.HEAD("/s/:shortcutName", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		shortcutName := c.Param("shortcutName")
+		shortcut, err := s.Store.ResolveShortcutName(ctx, shortcutName, 0)
+		if err != nil {
+			return c.NoContent(http.StatusInternalServerError)
+		}
+		if shortcut == nil || shortcut.Visibility != storepb.Visibility_PUBLIC || store.IsShortcutExpired(shortcut, time.Now().Unix()) {
+			return c.NoContent(http.StatusNotFound)
+		}
+		if err := s.Store.CheckShortcutRedirectLoop(ctx, shortcut.Name, shortcut.Link); err != nil && errors.Is(err, store.ErrShortcutRedirectLoop) {
+			return c.NoContent(http.StatusLoopDetected)
+		}
+		s.setRedirectCacheControl(ctx, c, shortcut)
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMETextHTMLCharsetUTF8)
+		return c.NoContent(http.StatusOK)
+	})
+
 	e.GET("/c/:collectionName", func(c echo.Context) error {
 		ctx := c.Request().Context()
 		collectionName := c.Param("collectionName")
@@ -118,8 +181,69 @@ func (s *FrontendService) registerRoutes(e *echo.Echo) {
 	})
 }
 
-func (s *FrontendService) createShortcutViewActivity(ctx context.Context, request *http.Request, shortcut *storepb.Shortcut) error {
-	ip := getReadUserIP(request)
+// getNotFoundRedirectURL returns the URL the unknown shortcut name redirects to with the workspace not_found_redirect,
+// empty to show the not found page.
+func (s *FrontendService) getNotFoundRedirectURL(ctx context.Context, shortcutName string) string {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get workspace shortcut related setting", slog.String("error", err.Error()))
+		return ""
+	}
+	notFoundRedirect := shortcutRelatedSetting.GetNotFoundRedirect()
+	switch notFoundRedirect.GetMode() {
+	case storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_SEARCH:
+		return "/shortcuts?search=" + url.QueryEscape(shortcutName)
+	case storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_URL_TEMPLATE:
+		return strings.ReplaceAll(notFoundRedirect.UrlTemplate, "{name}", url.QueryEscape(shortcutName))
+	}
+	return ""
+}
+
+// getRedirectRateLimit returns the redirects per minute allowed for the shortcut, capped by the workspace maximum.
+// Zero means unlimited.
+func (s *FrontendService) getRedirectRateLimit(ctx context.Context, shortcut *storepb.Shortcut) int32 {
+	limit := shortcut.RedirectRateLimit
+	if limit <= 0 {
+		return 0
+	}
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get workspace shortcut related setting", slog.String("error", err.Error()))
+		return limit
+	}
+	if maxLimit := shortcutRelatedSetting.MaxRedirectRateLimit; maxLimit > 0 && limit > maxLimit {
+		return maxLimit
+	}
+	return limit
+}
+
+// setRedirectCacheControl sets the Cache-Control header of the redirect of the shortcut: public shortcuts can be cached
+// for the max-age of the workspace, and the other ones must not be stored by browsers or intermediaries.
+// The cached redirects are not counted as views.
+func (s *FrontendService) setRedirectCacheControl(ctx context.Context, c echo.Context, shortcut *storepb.Shortcut) {
+	header := c.Response().Header()
+	if shortcut.Visibility != storepb.Visibility_PUBLIC {
+		header.Set(echo.HeaderCacheControl, "no-store")
+		return
+	}
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get workspace shortcut related setting", slog.String("error", err.Error()))
+		header.Set(echo.HeaderCacheControl, "no-cache")
+		return
+	}
+	maxAge := shortcutRelatedSetting.PublicRedirectCacheMaxAge
+	if maxAge <= 0 {
+		header.Set(echo.HeaderCacheControl, "no-cache")
+		return
+	}
+	header.Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", maxAge))
+	// The preview is localized by the Accept-Language of the request.
+	header.Add(echo.HeaderVary, "Accept-Language")
+}
+
+func (s *FrontendService) recordShortcutView(request *http.Request, shortcut *storepb.Shortcut) error {
+	ip := s.Profile.GetClientIP(request.RemoteAddr, strings.Join(request.Header.Values("X-Forwarded-For"), ","))
 	referer := request.Header.Get("Referer")
 	userAgent := request.Header.Get("User-Agent")
 	params := map[string]*storepb.ActivityShorcutViewPayload_ValueList{}
@@ -137,30 +261,15 @@ func (s *FrontendService) createShortcutViewActivity(ctx context.Context, reques
 	if err != nil {
 		return errors.Wrap(err, "Failed to marshal activity payload")
 	}
-	activity := &store.Activity{
-		CreatorID: common.BotID,
-		Type:      store.ActivityShortcutView,
-		Level:     store.ActivityInfo,
-		Payload:   string(payloadStr),
-	}
-	_, err = s.Store.CreateActivity(ctx, activity)
-	if err != nil {
-		return errors.Wrap(err, "Failed to create activity")
-	}
+	s.shortcutViewRecorder.Record(&shortcutView{
+		workspaceID: shortcut.WorkspaceId,
+		shortcutID:  shortcut.Id,
+		viewedTs:    time.Now().Unix(),
+		payload:     string(payloadStr),
+	})
 	return nil
 }
 
-func getReadUserIP(r *http.Request) string {
-	ip := r.Header.Get("X-Real-Ip")
-	if ip == "" {
-		ip = r.Header.Get("X-Forwarded-For")
-	}
-	if ip == "" {
-		ip = r.RemoteAddr
-	}
-	return ip
-}
-
 func getFileSystem(path string) http.FileSystem {
 	fs, err := fs.Sub(embeddedFiles, path)
 	if err != nil {
@@ -170,7 +279,7 @@ func getFileSystem(path string) http.FileSystem {
 	return http.FS(fs)
 }
 
-func generateShortcutMetadata(shortcut *storepb.Shortcut) *Metadata {
+func generateShortcutMetadata(shortcut *storepb.Shortcut, acceptLanguage string) *Metadata {
 	metadata := getDefaultMetadata()
 	title, description := shortcut.Title, shortcut.Description
 	if shortcut.OgMetadata != nil {
@@ -182,11 +291,36 @@ func generateShortcutMetadata(shortcut *storepb.Shortcut) *Metadata {
 		}
 		metadata.ImageURL = shortcut.OgMetadata.Image
 	}
+	// The localized text is more specific than the Open Graph metadata.
+	if localization := getShortcutLocalization(shortcut, acceptLanguage); localization != nil {
+		if localization.Title != "" {
+			title = localization.Title
+		}
+		if localization.Description != "" {
+			description = localization.Description
+		}
+	}
 	metadata.Title = title
 	metadata.Description = description
 	return metadata
 }
 
+// getShortcutLocalization returns the localization of the shortcut best matching the Accept-Language, or nil.
+func getShortcutLocalization(shortcut *storepb.Shortcut, acceptLanguage string) *storepb.ShortcutLocalization {
+	if len(shortcut.Localizations) == 0 {
+		return nil
+	}
+	locales := make([]string, 0, len(shortcut.Localizations))
+	for locale := range shortcut.Localizations {
+		locales = append(locales, locale)
+	}
+	locale, ok := util.MatchLocale(acceptLanguage, locales)
+	if !ok {
+		return nil
+	}
+	return shortcut.Localizations[locale]
+}
+
 func generateCollectionMetadata(collection *storepb.Collection) *Metadata {
 	metadata := getDefaultMetadata()
 	metadata.Title = collection.Title
@@ -194,6 +328,53 @@ func generateCollectionMetadata(collection *storepb.Collection) *Metadata {
 	return metadata
 }
 
+// isMetaRefreshRedirect returns whether the shortcut is redirected with the meta refresh page instead of the app.
+// The page discloses the link without signing in, so it is only used for public shortcuts to web links.
+func isMetaRefreshRedirect(shortcut *storepb.Shortcut) bool {
+	if !shortcut.MetaRefreshRedirect || shortcut.Visibility != storepb.Visibility_PUBLIC {
+		return false
+	}
+	link, err := url.Parse(shortcut.Link)
+	if err != nil {
+		return false
+	}
+	return link.Scheme == "http" || link.Scheme == "https"
+}
+
+var metaRefreshTemplate = template.Must(template.New("meta-refresh").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="utf-8" />
+    <meta http-equiv="refresh" content="0; url={{.Link}}" />
+    <meta name="robots" content="noindex" />
+    <title>{{.Title}}</title>
+  </head>
+  <body>
+    <p>Redirecting to <a href="{{.Link}}" target="_blank" rel="noopener noreferrer">{{.Link}}</a></p>
+  </body>
+</html>
+`))
+
+// generateMetaRefreshHTML returns the page redirecting to the shortcut link for the clients that can't run the app,
+// e.g. iframes and email clients. The link opens in a new tab when the refresh is blocked.
+func generateMetaRefreshHTML(shortcut *storepb.Shortcut, acceptLanguage string) (string, error) {
+	title := shortcut.Title
+	if localization := getShortcutLocalization(shortcut, acceptLanguage); localization != nil && localization.Title != "" {
+		title = localization.Title
+	}
+	if title == "" {
+		title = shortcut.Name
+	}
+	var builder strings.Builder
+	if err := metaRefreshTemplate.Execute(&builder, map[string]string{
+		"Link":  shortcut.Link,
+		"Title": title,
+	}); err != nil {
+		return "", errors.Wrap(err, "failed to generate meta refresh page")
+	}
+	return builder.String(), nil
+}
+
 func getRawIndexHTML() string {
 	bytes, _ := embeddedFiles.ReadFile("dist/index.html")
 	return string(bytes)
@@ -0,0 +1,538 @@
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+	teststore "github.com/yourselfhosted/slash/test/store"
+)
+
+func TestShortcutRedirectRateLimit(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	s := NewFrontendService(test.GetTestingProfile(t), ts)
+	e := echo.New()
+	s.registerRoutes(e)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test",
+	})
+	require.NoError(t, err)
+	createShortcut := func(name string, redirectRateLimit int32) {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:         user.ID,
+			Name:              name,
+			Link:              "https://example.com",
+			Visibility:        storepb.Visibility_PUBLIC,
+			OgMetadata:        &storepb.OpenGraphMetadata{},
+			RedirectRateLimit: redirectRateLimit,
+		})
+		require.NoError(t, err)
+	}
+	visit := func(name string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/s/"+name, nil))
+		return recorder
+	}
+
+	createShortcut("limited", 2)
+	createShortcut("unlimited", 0)
+	require.Equal(t, http.StatusOK, visit("limited").Code)
+	require.Equal(t, http.StatusOK, visit("limited").Code)
+	recorder := visit("limited")
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.NotEmpty(t, recorder.Header().Get("Retry-After"))
+	for i := 0; i < 5; i++ {
+		require.Equal(t, http.StatusOK, visit("unlimited").Code)
+	}
+
+	// Rejected redirects are not counted as views.
+	s.shortcutViewRecorder.flush(ctx)
+	limitedName := "limited"
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{Name: &limitedName})
+	require.NoError(t, err)
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type:              store.ActivityShortcutView,
+		PayloadShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(activities))
+	require.Equal(t, int32(2), shortcut.ViewCount)
+
+	// The workspace maximum caps the shortcut limit.
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+				MaxRedirectRateLimit: 1,
+			},
+		},
+	})
+	require.NoError(t, err)
+	createShortcut("capped", 100)
+	require.Equal(t, http.StatusOK, visit("capped").Code)
+	require.Equal(t, http.StatusTooManyRequests, visit("capped").Code)
+}
+
+func TestRedirectRateLimiterWindow(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	limiter := newRedirectRateLimiter()
+	limiter.now = func() time.Time { return now }
+
+	ok, _ := limiter.Allow(1, 1)
+	require.True(t, ok)
+	ok, retryAfter := limiter.Allow(1, 1)
+	require.False(t, ok)
+	require.Equal(t, time.Minute, retryAfter)
+	ok, _ = limiter.Allow(2, 1)
+	require.True(t, ok)
+
+	now = now.Add(45 * time.Second)
+	ok, retryAfter = limiter.Allow(1, 1)
+	require.False(t, ok)
+	require.Equal(t, 15*time.Second, retryAfter)
+
+	now = now.Add(15 * time.Second)
+	ok, _ = limiter.Allow(1, 1)
+	require.True(t, ok)
+	require.Equal(t, 1, len(limiter.windows))
+}
+
+func TestShortcutViewRecorder(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test",
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://example.com",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	getShortcut := func() *storepb.Shortcut {
+		shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+		require.NoError(t, err)
+		return shortcut
+	}
+
+	// The views beyond the queue size are dropped rather than blocking the redirects.
+	recorder := newShortcutViewRecorder(ts)
+	for i := 0; i < shortcutViewQueueSize+10; i++ {
+		recorder.Record(&shortcutView{shortcutID: shortcut.Id, viewedTs: int64(1000 + i), payload: "{}"})
+	}
+	require.Equal(t, int32(0), getShortcut().ViewCount)
+	require.Equal(t, 1, len(recorder.full))
+	recorder.flush(ctx)
+	require.Equal(t, int32(shortcutViewQueueSize), getShortcut().ViewCount)
+	require.Equal(t, int64(1000+shortcutViewQueueSize-1), getShortcut().LastViewedTs)
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{Type: store.ActivityShortcutView})
+	require.NoError(t, err)
+	require.Equal(t, shortcutViewQueueSize, len(activities))
+
+	// The queued views are flushed when the worker stops.
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		recorder.run(runCtx)
+		close(done)
+	}()
+	recorder.Record(&shortcutView{shortcutID: shortcut.Id, viewedTs: 5000, payload: "{}"})
+	cancel()
+	<-done
+	require.Equal(t, int32(shortcutViewQueueSize+1), getShortcut().ViewCount)
+	require.Equal(t, int64(5000), getShortcut().LastViewedTs)
+}
+
+func TestShortcutRedirectHead(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	s := NewFrontendService(test.GetTestingProfile(t), ts)
+	e := echo.New()
+	s.registerRoutes(e)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test",
+	})
+	require.NoError(t, err)
+	for name, visibility := range map[string]storepb.Visibility{"public": storepb.Visibility_PUBLIC, "workspace": storepb.Visibility_WORKSPACE} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:         user.ID,
+			Name:              name,
+			Link:              "https://example.com",
+			Visibility:        visibility,
+			OgMetadata:        &storepb.OpenGraphMetadata{},
+			RedirectRateLimit: 1,
+		})
+		require.NoError(t, err)
+	}
+	serve := func(method, name string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(method, "/s/"+name, nil))
+		return recorder
+	}
+
+	for i := 0; i < 2; i++ {
+		head := serve(http.MethodHead, "public")
+		require.Equal(t, http.StatusOK, head.Code)
+		require.Empty(t, head.Body.String())
+	}
+	// HEAD requests neither count against the rate limit nor record views.
+	get := serve(http.MethodGet, "public")
+	require.Equal(t, http.StatusOK, get.Code)
+	require.Equal(t, get.Header().Get(echo.HeaderContentType), serve(http.MethodHead, "public").Header().Get(echo.HeaderContentType))
+	s.shortcutViewRecorder.flush(ctx)
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutView,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(activities))
+
+	require.Equal(t, http.StatusNotFound, serve(http.MethodHead, "workspace").Code)
+	require.Equal(t, http.StatusNotFound, serve(http.MethodHead, "missing").Code)
+}
+
+func TestShortcutMetaRefreshRedirect(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	s := NewFrontendService(test.GetTestingProfile(t), ts)
+	e := echo.New()
+	s.registerRoutes(e)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test",
+	})
+	require.NoError(t, err)
+	createShortcut := func(name string, visibility storepb.Visibility, metaRefreshRedirect bool) {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:           user.ID,
+			Name:                name,
+			Title:               `Docs <"quoted">`,
+			Link:                "https://example.com/docs?a=1&b=2",
+			Visibility:          visibility,
+			OgMetadata:          &storepb.OpenGraphMetadata{},
+			MetaRefreshRedirect: metaRefreshRedirect,
+		})
+		require.NoError(t, err)
+	}
+	visit := func(name string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/s/"+name, nil))
+		return recorder
+	}
+	createShortcut("meta", storepb.Visibility_PUBLIC, true)
+	createShortcut("default", storepb.Visibility_PUBLIC, false)
+	createShortcut("internal", storepb.Visibility_WORKSPACE, true)
+
+	recorder := visit("meta")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, echo.MIMETextHTMLCharsetUTF8, recorder.Header().Get(echo.HeaderContentType))
+	body := recorder.Body.String()
+	require.Contains(t, body, `<meta http-equiv="refresh" content="0; url=https://example.com/docs?a=1&amp;b=2" />`)
+	require.Contains(t, body, `<a href="https://example.com/docs?a=1&amp;b=2" target="_blank" rel="noopener noreferrer">`)
+	require.Contains(t, body, `<title>Docs &lt;&#34;quoted&#34;&gt;</title>`)
+
+	// The app redirects by default, and for the shortcuts that are not public.
+	for _, name := range []string{"default", "internal"} {
+		recorder := visit(name)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.NotContains(t, recorder.Body.String(), `http-equiv="refresh"`, name)
+	}
+
+	// Views through the meta refresh page are recorded.
+	s.shortcutViewRecorder.flush(ctx)
+	metaName := "meta"
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{Name: &metaName})
+	require.NoError(t, err)
+	require.True(t, shortcut.MetaRefreshRedirect)
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type:              store.ActivityShortcutView,
+		PayloadShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(activities))
+}
+
+func TestShortcutLocalizedPreview(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	s := NewFrontendService(test.GetTestingProfile(t), ts)
+	e := echo.New()
+	s.registerRoutes(e)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test",
+	})
+	require.NoError(t, err)
+	for _, metaRefreshRedirect := range []bool{false, true} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:           user.ID,
+			Name:                fmt.Sprintf("docs-%t", metaRefreshRedirect),
+			Title:               "Docs",
+			Link:                "https://example.com",
+			Visibility:          storepb.Visibility_PUBLIC,
+			OgMetadata:          &storepb.OpenGraphMetadata{Title: "Docs preview"},
+			MetaRefreshRedirect: metaRefreshRedirect,
+			Localizations: map[string]*storepb.ShortcutLocalization{
+				"fr": {Title: "Documentation"},
+			},
+		})
+		require.NoError(t, err)
+	}
+	visit := func(name, acceptLanguage string) string {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/s/"+name, nil)
+		request.Header.Set("Accept-Language", acceptLanguage)
+		e.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		return recorder.Body.String()
+	}
+
+	require.Contains(t, visit("docs-true", "fr-FR"), `<title>Documentation</title>`)
+	require.Contains(t, visit("docs-true", "de"), `<title>Docs</title>`)
+
+	// The localized title overrides the Open Graph one, which is kept without a matching localization.
+	name := "docs-false"
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, "Documentation", generateShortcutMetadata(shortcut, "fr-FR").Title)
+	require.Equal(t, "Docs preview", generateShortcutMetadata(shortcut, "de").Title)
+}
+
+func TestShortcutRedirectCacheControl(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	s := NewFrontendService(test.GetTestingProfile(t), ts)
+	e := echo.New()
+	s.registerRoutes(e)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test",
+	})
+	require.NoError(t, err)
+	for name, visibility := range map[string]storepb.Visibility{"public": storepb.Visibility_PUBLIC, "workspace": storepb.Visibility_WORKSPACE} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com",
+			Visibility: visibility,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+	serve := func(method, name string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(method, "/s/"+name, nil))
+		return recorder
+	}
+	setMaxAge := func(maxAge int32) {
+		_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+			Value: &storepb.WorkspaceSetting_ShortcutRelated{
+				ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{PublicRedirectCacheMaxAge: maxAge},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, "no-cache", serve(http.MethodGet, "public").Header().Get(echo.HeaderCacheControl))
+	require.Equal(t, "no-store", serve(http.MethodGet, "workspace").Header().Get(echo.HeaderCacheControl))
+
+	setMaxAge(300)
+	for _, method := range []string{http.MethodGet, http.MethodHead} {
+		recorder := serve(method, "public")
+		require.Equal(t, http.StatusOK, recorder.Code, method)
+		require.Equal(t, "public, max-age=300", recorder.Header().Get(echo.HeaderCacheControl), method)
+		require.Contains(t, recorder.Header().Values(echo.HeaderVary), "Accept-Language", method)
+	}
+	require.Equal(t, "no-store", serve(http.MethodGet, "workspace").Header().Get(echo.HeaderCacheControl))
+	// The app served for unknown shortcuts is not a redirect.
+	require.Empty(t, serve(http.MethodGet, "missing").Header().Get(echo.HeaderCacheControl))
+}
+
+func TestShortcutNotFoundRedirect(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	s := NewFrontendService(test.GetTestingProfile(t), ts)
+	e := echo.New()
+	s.registerRoutes(e)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "docs",
+		Link:       "https://example.com/docs",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	serve := func(name string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/s/"+name, nil))
+		return recorder
+	}
+	setNotFoundRedirect := func(notFoundRedirect *storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect) {
+		_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+			Value: &storepb.WorkspaceSetting_ShortcutRelated{
+				ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{NotFoundRedirect: notFoundRedirect},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// Disabled by default, the app shows the not found page.
+	recorder := serve("missing")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Empty(t, recorder.Header().Get(echo.HeaderLocation))
+
+	setNotFoundRedirect(&storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect{
+		Mode: storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_SEARCH,
+	})
+	recorder = serve("team%20docs")
+	require.Equal(t, http.StatusFound, recorder.Code)
+	require.Equal(t, "/shortcuts?search=team+docs", recorder.Header().Get(echo.HeaderLocation))
+	require.Equal(t, "no-store", recorder.Header().Get(echo.HeaderCacheControl))
+
+	setNotFoundRedirect(&storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect{
+		Mode:        storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_URL_TEMPLATE,
+		UrlTemplate: "https://wiki.example.com/search?q={name}&from=slash",
+	})
+	recorder = serve("a&b")
+	require.Equal(t, http.StatusFound, recorder.Code)
+	require.Equal(t, "https://wiki.example.com/search?q=a%26b&from=slash", recorder.Header().Get(echo.HeaderLocation))
+
+	// A template without the name is a default URL.
+	setNotFoundRedirect(&storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect{
+		Mode:        storepb.WorkspaceSetting_ShortcutRelatedSetting_NotFoundRedirect_URL_TEMPLATE,
+		UrlTemplate: "https://example.com",
+	})
+	require.Equal(t, "https://example.com", serve("missing").Header().Get(echo.HeaderLocation))
+
+	// The known shortcuts are not redirected to the fallback.
+	recorder = serve("docs")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Empty(t, recorder.Header().Get(echo.HeaderLocation))
+
+	// The expired shortcuts are redirected like unknown names.
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "campaign",
+		Link:       "https://example.com/campaign",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		ExpireTs:   time.Now().Add(-time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com", serve("campaign").Header().Get(echo.HeaderLocation))
+}
+
+func TestDeletedShortcutRedirect(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	s := NewFrontendService(test.GetTestingProfile(t), ts)
+	e := echo.New()
+	s.registerRoutes(e)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test",
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:           user.ID,
+		Name:                "docs",
+		Title:               "Docs",
+		Link:                "https://example.com/docs",
+		Visibility:          storepb.Visibility_PUBLIC,
+		OgMetadata:          &storepb.OpenGraphMetadata{},
+		MetaRefreshRedirect: true,
+	})
+	require.NoError(t, err)
+	visit := func(method string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(method, "/s/docs", nil))
+		return recorder
+	}
+	require.Contains(t, visit(http.MethodGet).Body.String(), `http-equiv="refresh"`)
+
+	// The deleted shortcut is an unknown name, without a redirect nor a recorded view.
+	_, err = ts.ArchiveShortcut(ctx, shortcut.Id)
+	require.NoError(t, err)
+	recorder := visit(http.MethodGet)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.NotContains(t, recorder.Body.String(), `http-equiv="refresh"`)
+	require.NotContains(t, recorder.Body.String(), "https://example.com/docs")
+	require.Equal(t, http.StatusNotFound, visit(http.MethodHead).Code)
+	s.shortcutViewRecorder.flush(ctx)
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type:              store.ActivityShortcutView,
+		PayloadShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(activities))
+}
+
+func TestPrivateShortcutRedirect(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	s := NewFrontendService(test.GetTestingProfile(t), ts)
+	e := echo.New()
+	s.registerRoutes(e)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test",
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:   user.ID,
+		Name:        "plans",
+		Title:       "Secret plans",
+		Description: "Only for me",
+		Link:        "https://example.com/plans",
+		Visibility:  storepb.Visibility_PRIVATE,
+		OgMetadata: &storepb.OpenGraphMetadata{
+			Image: "https://example.com/plans.png",
+		},
+		MetaRefreshRedirect: true,
+	})
+	require.NoError(t, err)
+
+	// The private shortcut is an unknown name to anonymous visitors, without a preview, a redirect nor a recorded view.
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/s/plans", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, getRawIndexHTML(), recorder.Body.String())
+	s.shortcutViewRecorder.flush(ctx)
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type:              store.ActivityShortcutView,
+		PayloadShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Empty(t, activities)
+}
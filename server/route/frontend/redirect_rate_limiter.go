@@ -0,0 +1,55 @@
+package frontend
+
+import (
+	"sync"
+	"time"
+)
+
+const redirectRateLimitWindow = time.Minute
+
+// redirectRateLimiter counts the redirects of each shortcut in fixed one-minute windows.
+type redirectRateLimiter struct {
+	mu      sync.Mutex
+	windows map[int32]*redirectRateLimitWindowState
+	now     func() time.Time
+}
+
+type redirectRateLimitWindowState struct {
+	start time.Time
+	count int32
+}
+
+func newRedirectRateLimiter() *redirectRateLimiter {
+	return &redirectRateLimiter{
+		windows: map[int32]*redirectRateLimitWindowState{},
+		now:     time.Now,
+	}
+}
+
+// Allow records a redirect of the shortcut and reports whether it is within the limit per minute.
+// When it isn't, the returned duration is the time until the current window resets.
+func (l *redirectRateLimiter) Allow(shortcutID int32, limit int32) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	window, ok := l.windows[shortcutID]
+	if !ok || now.Sub(window.start) >= redirectRateLimitWindow {
+		l.evictExpired(now)
+		window = &redirectRateLimitWindowState{start: now}
+		l.windows[shortcutID] = window
+	}
+	if window.count >= limit {
+		return false, window.start.Add(redirectRateLimitWindow).Sub(now)
+	}
+	window.count++
+	return true, 0
+}
+
+func (l *redirectRateLimiter) evictExpired(now time.Time) {
+	for shortcutID, window := range l.windows {
+		if now.Sub(window.start) >= redirectRateLimitWindow {
+			delete(l.windows, shortcutID)
+		}
+	}
+}
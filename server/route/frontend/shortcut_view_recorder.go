@@ -0,0 +1,123 @@
+package frontend
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/yourselfhosted/slash/server/common"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// shortcutViewQueueSize is the number of views waiting to be recorded, beyond which views are dropped.
+	shortcutViewQueueSize = 1024
+	// shortcutViewBatchSize is the number of queued views that triggers a flush before the interval.
+	shortcutViewBatchSize = 100
+	// shortcutViewFlushInterval is the longest time a view waits to be recorded.
+	shortcutViewFlushInterval = 2 * time.Second
+)
+
+// shortcutView is a redirect of a shortcut waiting to be recorded.
+type shortcutView struct {
+	// workspaceID is the workspace of the shortcut, the views are recorded in it.
+	workspaceID string
+	shortcutID  int32
+	viewedTs    int64
+	// payload is the marshaled view activity payload.
+	payload string
+}
+
+// shortcutViewRecorder records the views of shortcuts off the redirect path.
+// The views are queued and a single worker writes them in batches, adding up the views of each shortcut.
+type shortcutViewRecorder struct {
+	Store *store.Store
+
+	views chan *shortcutView
+	full  chan struct{}
+	// mu serializes the flushes of the worker and the callers of flush.
+	mu sync.Mutex
+}
+
+func newShortcutViewRecorder(store *store.Store) *shortcutViewRecorder {
+	return &shortcutViewRecorder{
+		Store: store,
+		views: make(chan *shortcutView, shortcutViewQueueSize),
+		full:  make(chan struct{}, 1),
+	}
+}
+
+// Record queues the view without blocking. The view is dropped when the queue is full.
+func (r *shortcutViewRecorder) Record(view *shortcutView) {
+	select {
+	case r.views <- view:
+	default:
+		slog.Warn("shortcut view queue is full, dropping view", slog.Int("shortcutId", int(view.shortcutID)))
+		return
+	}
+	if len(r.views) >= shortcutViewBatchSize {
+		select {
+		case r.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// run flushes the queued views every interval or when a batch is queued, until the context is done.
+// The views queued by then are flushed before it returns.
+func (r *shortcutViewRecorder) run(ctx context.Context) {
+	// The flushes aren't canceled with the context so that the views taken from the queue aren't lost.
+	flushCtx := context.WithoutCancel(ctx)
+	ticker := time.NewTicker(shortcutViewFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			r.flush(flushCtx)
+			return
+		case <-ticker.C:
+			r.flush(flushCtx)
+		case <-r.full:
+			r.flush(flushCtx)
+		}
+	}
+}
+
+// flush records the views queued so far as view activities and adds them to the view counts of the shortcuts,
+// in the workspaces of the shortcuts.
+func (r *shortcutViewRecorder) flush(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	viewsByID := map[int32]*store.ShortcutViews{}
+	shortcutViewsByWorkspace := map[string][]*store.ShortcutViews{}
+	workspaceIDs := []string{}
+	for n := len(r.views); n > 0; n-- {
+		view := <-r.views
+		if _, err := r.Store.CreateActivity(store.WithWorkspaceID(ctx, view.workspaceID), &store.Activity{
+			CreatorID: common.BotID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   view.payload,
+		}); err != nil {
+			slog.Warn("failed to create shortcut view activity", slog.String("error", err.Error()))
+		}
+		views, ok := viewsByID[view.shortcutID]
+		if !ok {
+			views = &store.ShortcutViews{ShortcutID: view.shortcutID}
+			viewsByID[view.shortcutID] = views
+			if _, ok := shortcutViewsByWorkspace[view.workspaceID]; !ok {
+				workspaceIDs = append(workspaceIDs, view.workspaceID)
+			}
+			shortcutViewsByWorkspace[view.workspaceID] = append(shortcutViewsByWorkspace[view.workspaceID], views)
+		}
+		views.Count++
+		views.LastViewedTs = max(views.LastViewedTs, view.viewedTs)
+	}
+	for _, workspaceID := range workspaceIDs {
+		if err := r.Store.AddShortcutViews(store.WithWorkspaceID(ctx, workspaceID), shortcutViewsByWorkspace[workspaceID]); err != nil {
+			slog.Warn("failed to add shortcut views", slog.String("workspace", workspaceID), slog.String("error", err.Error()))
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Package archive provides a runner to archive the shortcuts that have not been visited for a long time or have expired.
+package archive
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/common"
+	"github.com/yourselfhosted/slash/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule archiver every 12 hours.
+const runnerInterval = time.Hour * 12
+
+// maxNoticeDuration is how long before archiving the owner gets notified.
+const maxNoticeDuration = time.Hour * 24 * 7
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	for _, workspaceID := range r.Store.ListWorkspaceIDs() {
+		workspaceCtx := store.WithWorkspaceID(ctx, workspaceID)
+		if err := r.ArchiveUnusedShortcuts(workspaceCtx, time.Now()); err != nil {
+			slog.Error("failed to archive unused shortcuts", slog.String("workspace", workspaceID), slog.String("error", err.Error()))
+		}
+		if err := r.ArchiveExpiredShortcuts(workspaceCtx, time.Now()); err != nil {
+			slog.Error("failed to archive expired shortcuts", slog.String("workspace", workspaceID), slog.String("error", err.Error()))
+		}
+	}
+}
+
+// ArchiveExpiredShortcuts archives the shortcuts past their expire time.
+func (r *Runner) ArchiveExpiredShortcuts(ctx context.Context, now time.Time) error {
+	rowStatus, nowTs := storepb.RowStatus_NORMAL, now.Unix()
+	shortcuts, err := r.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus: &rowStatus,
+		ExpiredAt: &nowTs,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list shortcuts")
+	}
+	for _, shortcut := range shortcuts {
+		if err := r.archiveShortcut(ctx, shortcut, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ArchiveUnusedShortcuts archives the shortcuts without any visit in the configured window,
+// and notifies the owners of the shortcuts that are going to be archived soon.
+// The favorite shortcuts of the users are kept however long they go unused.
+func (r *Runner) ArchiveUnusedShortcuts(ctx context.Context, now time.Time) error {
+	shortcutRelatedSetting, err := r.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace setting")
+	}
+	unusedDays := shortcutRelatedSetting.GetShortcutRelated().GetAutoArchiveUnusedDays()
+	if unusedDays <= 0 {
+		return nil
+	}
+	unusedDuration := time.Duration(unusedDays) * 24 * time.Hour
+	noticeDuration := min(maxNoticeDuration, unusedDuration/2)
+
+	rowStatus := storepb.RowStatus_NORMAL
+	shortcuts, err := r.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus: &rowStatus,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list shortcuts")
+	}
+	favoriteShortcutIDs, err := r.getFavoriteShortcutIDs(ctx)
+	if err != nil {
+		return err
+	}
+	lastViewedTs, err := r.Store.ListShortcutLastViewedTs(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list shortcut last viewed times")
+	}
+	for _, shortcut := range shortcuts {
+		if favoriteShortcutIDs[shortcut.Id] {
+			continue
+		}
+		// The shortcuts never visited are last used when they were last changed.
+		lastUsedTs := max(shortcut.CreatedTs, shortcut.UpdatedTs, lastViewedTs[shortcut.Id])
+		archiveTime := time.Unix(lastUsedTs, 0).Add(unusedDuration)
+		if !now.Before(archiveTime) {
+			if err := r.archiveShortcut(ctx, shortcut, now); err != nil {
+				return err
+			}
+		} else if !now.Before(archiveTime.Add(-noticeDuration)) {
+			if err := r.noticeShortcutOwner(ctx, shortcut, lastUsedTs, archiveTime); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getFavoriteShortcutIDs returns the ids of the shortcuts that are a favorite of any user.
+func (r *Runner) getFavoriteShortcutIDs(ctx context.Context) (map[int32]bool, error) {
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_USER_SETTING_FAVORITE_SHORTCUTS,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user settings")
+	}
+	favoriteShortcutIDs := map[int32]bool{}
+	for _, userSetting := range userSettings {
+		for _, shortcutID := range userSetting.GetFavoriteShortcuts().GetShortcutIds() {
+			favoriteShortcutIDs[shortcutID] = true
+		}
+	}
+	return favoriteShortcutIDs, nil
+}
+
+func (r *Runner) archiveShortcut(ctx context.Context, shortcut *storepb.Shortcut, now time.Time) error {
+	if _, err := r.Store.ArchiveShortcut(ctx, shortcut.Id); err != nil {
+		return errors.Wrap(err, "failed to archive shortcut")
+	}
+	return r.createArchiveActivity(ctx, store.ActivityShortcutArchive, shortcut, now)
+}
+
+// noticeShortcutOwner notifies the owner once per unused period that the shortcut is going to be archived.
+// The notice is recorded as an activity of the owner, which ListUserArchiveNotices returns to them.
+func (r *Runner) noticeShortcutOwner(ctx context.Context, shortcut *storepb.Shortcut, lastUsedTs int64, archiveTime time.Time) error {
+	activity, err := r.Store.GetActivity(ctx, &store.FindActivity{
+		Type:              store.ActivityShortcutArchiveNotice,
+		PayloadShortcutID: &shortcut.Id,
+		CreatedTsAfter:    &lastUsedTs,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get activity")
+	}
+	if activity != nil {
+		return nil
+	}
+	return r.createArchiveActivity(ctx, store.ActivityShortcutArchiveNotice, shortcut, archiveTime)
+}
+
+func (r *Runner) createArchiveActivity(ctx context.Context, activityType store.ActivityType, shortcut *storepb.Shortcut, archiveTime time.Time) error {
+	payload := &storepb.ActivityShortcutArchivePayload{
+		ShortcutId: shortcut.Id,
+		ArchivedTs: archiveTime.Unix(),
+	}
+	payloadStr, err := protojson.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal activity payload")
+	}
+	creatorID, level := int32(common.BotID), store.ActivityInfo
+	if activityType == store.ActivityShortcutArchiveNotice {
+		creatorID, level = shortcut.CreatorId, store.ActivityWarn
+	}
+	if _, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: creatorID,
+		Type:      activityType,
+		Level:     level,
+		Payload:   string(payloadStr),
+	}); err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	return nil
+}
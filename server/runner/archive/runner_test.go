@@ -0,0 +1,187 @@
+package archive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestArchiveUnusedShortcuts(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("only runs against a fresh sqlite database")
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts := store.New(dbDriver, profile)
+	require.NoError(t, ts.Migrate(ctx))
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test_nickname",
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	daysAgo := func(days int) int64 {
+		return now.Add(-time.Duration(days) * 24 * time.Hour).Unix()
+	}
+	createShortcut := func(name string, createdTs int64) *storepb.Shortcut {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		_, err = dbDriver.GetDB().ExecContext(ctx, "UPDATE shortcut SET created_ts = $1, updated_ts = $1 WHERE id = $2", createdTs, shortcut.Id)
+		require.NoError(t, err)
+		return shortcut
+	}
+	visitShortcut := func(shortcut *storepb.Shortcut, visitedTs int64) {
+		payload, err := protojson.Marshal(&storepb.ActivityShorcutViewPayload{ShortcutId: shortcut.Id})
+		require.NoError(t, err)
+		activity, err := ts.CreateActivity(ctx, &store.Activity{
+			Type:    store.ActivityShortcutView,
+			Level:   store.ActivityInfo,
+			Payload: string(payload),
+		})
+		require.NoError(t, err)
+		_, err = dbDriver.GetDB().ExecContext(ctx, "UPDATE activity SET created_ts = $1 WHERE id = $2", visitedTs, activity.ID)
+		require.NoError(t, err)
+	}
+
+	unused := createShortcut("unused", daysAgo(60))
+	staleVisited := createShortcut("stale-visited", daysAgo(60))
+	visitShortcut(staleVisited, daysAgo(45))
+	recentlyVisited := createShortcut("recently-visited", daysAgo(60))
+	visitShortcut(recentlyVisited, daysAgo(1))
+	recentlyCreated := createShortcut("recently-created", daysAgo(2))
+	almostUnused := createShortcut("almost-unused", daysAgo(25))
+	favorite := createShortcut("favorite", daysAgo(60))
+	favoriteVisited := createShortcut("favorite-visited", daysAgo(60))
+	visitShortcut(favoriteVisited, daysAgo(45))
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_FAVORITE_SHORTCUTS,
+		Value: &storepb.UserSetting_FavoriteShortcuts{
+			FavoriteShortcuts: &storepb.UserSetting_FavoriteShortcutsSetting{
+				ShortcutIds: []int32{favorite.Id, favoriteVisited.Id},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	runner := NewRunner(ts)
+	// Auto-archive is disabled by default.
+	require.NoError(t, runner.ArchiveUnusedShortcuts(ctx, now))
+	requireRowStatus(ctx, t, ts, unused, storepb.RowStatus_NORMAL)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+				AutoArchiveUnusedDays: 30,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, runner.ArchiveUnusedShortcuts(ctx, now))
+	requireRowStatus(ctx, t, ts, unused, storepb.RowStatus_ARCHIVED)
+	requireRowStatus(ctx, t, ts, staleVisited, storepb.RowStatus_ARCHIVED)
+	requireRowStatus(ctx, t, ts, recentlyVisited, storepb.RowStatus_NORMAL)
+	requireRowStatus(ctx, t, ts, recentlyCreated, storepb.RowStatus_NORMAL)
+	requireRowStatus(ctx, t, ts, almostUnused, storepb.RowStatus_NORMAL)
+	// The favorite shortcuts are kept however long they go unused.
+	requireRowStatus(ctx, t, ts, favorite, storepb.RowStatus_NORMAL)
+	requireRowStatus(ctx, t, ts, favoriteVisited, storepb.RowStatus_NORMAL)
+
+	archiveActivities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutArchive,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(archiveActivities))
+
+	// The owner of the almost unused shortcut gets notified only once.
+	require.NoError(t, runner.ArchiveUnusedShortcuts(ctx, now))
+	noticeActivities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutArchiveNotice,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(noticeActivities))
+	payload := &storepb.ActivityShortcutArchivePayload{}
+	require.NoError(t, protojson.Unmarshal([]byte(noticeActivities[0].Payload), payload))
+	require.Equal(t, almostUnused.Id, payload.ShortcutId)
+	require.Equal(t, user.ID, noticeActivities[0].CreatorID)
+}
+
+func TestArchiveExpiredShortcuts(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("only runs against a fresh sqlite database")
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts := store.New(dbDriver, profile)
+	require.NoError(t, ts.Migrate(ctx))
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test_nickname",
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	createShortcut := func(name string, expireTs int64) *storepb.Shortcut {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+			ExpireTs:   expireTs,
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	expired := createShortcut("expired", now.Add(-time.Hour).Unix())
+	expiring := createShortcut("expiring", now.Add(time.Hour).Unix())
+	neverExpiring := createShortcut("never-expiring", 0)
+
+	runner := NewRunner(ts)
+	require.NoError(t, runner.ArchiveExpiredShortcuts(ctx, now))
+	requireRowStatus(ctx, t, ts, expired, storepb.RowStatus_ARCHIVED)
+	requireRowStatus(ctx, t, ts, expiring, storepb.RowStatus_NORMAL)
+	requireRowStatus(ctx, t, ts, neverExpiring, storepb.RowStatus_NORMAL)
+
+	// The archived shortcuts are not archived again.
+	require.NoError(t, runner.ArchiveExpiredShortcuts(ctx, now.Add(2*time.Hour)))
+	requireRowStatus(ctx, t, ts, expiring, storepb.RowStatus_ARCHIVED)
+	requireRowStatus(ctx, t, ts, neverExpiring, storepb.RowStatus_NORMAL)
+	archiveActivities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutArchive,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(archiveActivities))
+}
+
+func requireRowStatus(ctx context.Context, t *testing.T, ts *store.Store, shortcut *storepb.Shortcut, rowStatus storepb.RowStatus) {
+	list, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID:              &shortcut.Id,
+		IncludeArchived: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, rowStatus, list[0].RowStatus, "shortcut %s", shortcut.Name)
+}
@@ -0,0 +1,64 @@
+// Package auditlog provides a runner to purge the audit logs past the workspace retention.
+package auditlog
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+// Schedule purger every hour so that audit logs don't outlive the retention by more than that.
+const runnerInterval = time.Hour
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	for _, workspaceID := range r.Store.ListWorkspaceIDs() {
+		if err := r.PurgeExpiredAuditLogs(store.WithWorkspaceID(ctx, workspaceID), time.Now()); err != nil {
+			slog.Error("failed to purge audit logs", slog.String("workspace", workspaceID), slog.String("error", err.Error()))
+		}
+	}
+}
+
+// PurgeExpiredAuditLogs deletes the audit logs created more than the retention days before now.
+// Audit logs are kept forever when the retention is not set.
+func (r *Runner) PurgeExpiredAuditLogs(ctx context.Context, now time.Time) error {
+	securitySetting, err := r.Store.GetWorkspaceSecuritySetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace security setting")
+	}
+	retentionDays := securitySetting.GetAuditLogRetentionDays()
+	if retentionDays <= 0 {
+		return nil
+	}
+	retention := time.Duration(retentionDays) * 24 * time.Hour
+	return r.Store.DeleteActivities(ctx, &store.DeleteActivity{
+		CreatedTsBefore: now.Add(-retention).Unix(),
+	})
+}
@@ -0,0 +1,72 @@
+package auditlog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestPurgeExpiredAuditLogs(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("only runs against a fresh sqlite database")
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts := store.New(dbDriver, profile)
+	require.NoError(t, ts.Migrate(ctx))
+
+	now := time.Unix(time.Now().Unix(), 0)
+	createActivity := func(createdTs int64) *store.Activity {
+		activity, err := ts.CreateActivity(ctx, &store.Activity{
+			Type:  store.ActivityUserSignIn,
+			Level: store.ActivityInfo,
+		})
+		require.NoError(t, err)
+		_, err = dbDriver.GetDB().ExecContext(ctx, "UPDATE activity SET created_ts = $1 WHERE id = $2", createdTs, activity.ID)
+		require.NoError(t, err)
+		return activity
+	}
+	listActivityIDs := func() []int32 {
+		activities, err := ts.ListActivities(ctx, &store.FindActivity{})
+		require.NoError(t, err)
+		ids := []int32{}
+		for _, activity := range activities {
+			ids = append(ids, activity.ID)
+		}
+		return ids
+	}
+	setRetentionDays := func(days int32) {
+		_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+			Value: &storepb.WorkspaceSetting_Security{
+				Security: &storepb.WorkspaceSetting_SecuritySetting{AuditLogRetentionDays: days},
+			},
+		})
+		require.NoError(t, err)
+	}
+	retention := 30 * 24 * time.Hour
+	expired := createActivity(now.Add(-retention - time.Second).Unix())
+	boundary := createActivity(now.Add(-retention).Unix())
+	recent := createActivity(now.Add(-time.Hour).Unix())
+	runner := NewRunner(ts)
+
+	// Audit logs are kept forever without a retention.
+	require.NoError(t, runner.PurgeExpiredAuditLogs(ctx, now))
+	require.Equal(t, []int32{expired.ID, boundary.ID, recent.ID}, listActivityIDs())
+
+	// Only the audit logs older than the retention are purged.
+	setRetentionDays(30)
+	require.NoError(t, runner.PurgeExpiredAuditLogs(ctx, now))
+	require.Equal(t, []int32{boundary.ID, recent.ID}, listActivityIDs())
+	require.NoError(t, runner.PurgeExpiredAuditLogs(ctx, now.Add(time.Second)))
+	require.Equal(t, []int32{recent.ID}, listActivityIDs())
+}
@@ -0,0 +1,57 @@
+// Package tombstone provides a runner to purge the shortcut tombstones past their retention.
+package tombstone
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+// DefaultRetention is how long tombstones are kept for incremental sync clients.
+const DefaultRetention = time.Hour * 24 * 30
+
+// Schedule purger every 24 hours.
+const runnerInterval = time.Hour * 24
+
+type Runner struct {
+	Store     *store.Store
+	Retention time.Duration
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store:     store,
+		Retention: DefaultRetention,
+	}
+}
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	for _, workspaceID := range r.Store.ListWorkspaceIDs() {
+		if err := r.PurgeExpiredTombstones(store.WithWorkspaceID(ctx, workspaceID), time.Now()); err != nil {
+			slog.Error("failed to purge shortcut tombstones", slog.String("workspace", workspaceID), slog.String("error", err.Error()))
+		}
+	}
+}
+
+// PurgeExpiredTombstones deletes the tombstones older than the retention.
+func (r *Runner) PurgeExpiredTombstones(ctx context.Context, now time.Time) error {
+	return r.Store.DeleteShortcutTombstones(ctx, &store.DeleteShortcutTombstone{
+		DeletedTsBefore: now.Add(-r.Retention).Unix(),
+	})
+}
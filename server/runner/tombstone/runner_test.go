@@ -0,0 +1,64 @@
+package tombstone
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestPurgeExpiredTombstones(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("only runs against a fresh sqlite database")
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts := store.New(dbDriver, profile)
+	require.NoError(t, ts.Migrate(ctx))
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test_nickname",
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	deleteShortcut := func(name string, deletedTs int64) *storepb.Shortcut {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		require.NoError(t, ts.SoftDeleteShortcut(ctx, &store.DeleteShortcut{ID: shortcut.Id}))
+		_, err = dbDriver.GetDB().ExecContext(ctx, "UPDATE shortcut_tombstone SET deleted_ts = $1 WHERE shortcut_id = $2", deletedTs, shortcut.Id)
+		require.NoError(t, err)
+		return shortcut
+	}
+	deleteShortcut("expired", now.Add(-DefaultRetention-time.Hour).Unix())
+	recent := deleteShortcut("recent", now.Add(-time.Hour).Unix())
+
+	runner := NewRunner(ts)
+	require.NoError(t, runner.PurgeExpiredTombstones(ctx, now))
+
+	tombstones, err := ts.ListShortcutTombstones(ctx, &store.FindShortcutTombstone{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tombstones))
+	require.Equal(t, recent.Id, tombstones[0].ShortcutID)
+
+	// Once past the retention, the remaining tombstone is purged too.
+	require.NoError(t, runner.PurgeExpiredTombstones(ctx, now.Add(DefaultRetention)))
+	tombstones, err = ts.ListShortcutTombstones(ctx, &store.FindShortcutTombstone{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tombstones))
+}
@@ -0,0 +1,90 @@
+// Package verification provides a runner to purge the users who didn't verify their email within the workspace TTL.
+package verification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// Schedule purger every hour so that unverified users don't outlive the TTL by more than that.
+const runnerInterval = time.Hour
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	for _, workspaceID := range r.Store.ListWorkspaceIDs() {
+		if err := r.PurgeUnverifiedUsers(store.WithWorkspaceID(ctx, workspaceID), time.Now()); err != nil {
+			slog.Error("failed to purge unverified users", slog.String("workspace", workspaceID), slog.String("error", err.Error()))
+		}
+	}
+}
+
+// PurgeUnverifiedUsers deletes the users who signed up more than the TTL days before now and never verified their email.
+// Unverified users are kept forever when the TTL is not set.
+func (r *Runner) PurgeUnverifiedUsers(ctx context.Context, now time.Time) error {
+	securitySetting, err := r.Store.GetWorkspaceSecuritySetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace security setting")
+	}
+	ttlDays := securitySetting.GetUnverifiedUserTtlDays()
+	if ttlDays <= 0 {
+		return nil
+	}
+	createdTsBefore := now.Add(-time.Duration(ttlDays) * 24 * time.Hour).Unix()
+
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list email verifications")
+	}
+	for _, userSetting := range userSettings {
+		if userSetting.GetEmailVerification().GetVerified() {
+			continue
+		}
+		user, err := r.Store.GetUser(ctx, &store.FindUser{
+			ID:              &userSetting.UserId,
+			IncludeArchived: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get user")
+		}
+		// The admins are never purged, e.g. a user promoted before verifying.
+		if user == nil || user.Role == store.RoleAdmin || user.CreatedTs >= createdTsBefore {
+			continue
+		}
+		if err := r.Store.DeleteUser(ctx, &store.DeleteUser{ID: user.ID}); err != nil {
+			return errors.Wrapf(err, "failed to delete user %d", user.ID)
+		}
+		slog.Info("purged unverified user", slog.Int("user_id", int(user.ID)), slog.String("email", user.Email))
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package verification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestPurgeUnverifiedUsers(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("only runs against a fresh sqlite database")
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts := store.New(dbDriver, profile)
+	require.NoError(t, ts.Migrate(ctx))
+
+	createUser := func(nickname string, role store.Role, verification *storepb.UserSetting_EmailVerificationSetting) *store.User {
+		user, err := ts.CreateUser(ctx, &store.User{
+			Role:     role,
+			Email:    nickname + "@test.com",
+			Nickname: nickname,
+		})
+		require.NoError(t, err)
+		if verification != nil {
+			_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+				UserId: user.ID,
+				Key:    storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION,
+				Value: &storepb.UserSetting_EmailVerification{
+					EmailVerification: verification,
+				},
+			})
+			require.NoError(t, err)
+		}
+		return user
+	}
+	listNicknames := func() []string {
+		users, err := ts.ListUsers(ctx, &store.FindUser{})
+		require.NoError(t, err)
+		nicknames := []string{}
+		for _, user := range users {
+			nicknames = append(nicknames, user.Nickname)
+		}
+		return nicknames
+	}
+	createUser("admin", store.RoleAdmin, &storepb.UserSetting_EmailVerificationSetting{})
+	createUser("invited", store.RoleUser, nil)
+	createUser("verified", store.RoleUser, &storepb.UserSetting_EmailVerificationSetting{Verified: true})
+	createUser("unverified", store.RoleUser, &storepb.UserSetting_EmailVerificationSetting{})
+
+	runner := NewRunner(ts)
+	later := time.Now().Add(31 * 24 * time.Hour)
+	// Unverified users are kept without a TTL.
+	require.NoError(t, runner.PurgeUnverifiedUsers(ctx, later))
+	require.ElementsMatch(t, []string{"admin", "invited", "verified", "unverified"}, listNicknames())
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+		Value: &storepb.WorkspaceSetting_Security{
+			Security: &storepb.WorkspaceSetting_SecuritySetting{UnverifiedUserTtlDays: 30},
+		},
+	})
+	require.NoError(t, err)
+	// The unverified users are kept within the TTL.
+	require.NoError(t, runner.PurgeUnverifiedUsers(ctx, time.Now()))
+	require.Len(t, listNicknames(), 4)
+	require.NoError(t, runner.PurgeUnverifiedUsers(ctx, later))
+	require.ElementsMatch(t, []string{"admin", "invited", "verified"}, listNicknames())
+}
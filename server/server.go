@@ -16,10 +16,15 @@ import (
 	"github.com/yourselfhosted/slash/server/profile"
 	apiv1 "github.com/yourselfhosted/slash/server/route/api/v1"
 	"github.com/yourselfhosted/slash/server/route/frontend"
+	"github.com/yourselfhosted/slash/server/runner/archive"
+	"github.com/yourselfhosted/slash/server/runner/auditlog"
 	licensern "github.com/yourselfhosted/slash/server/runner/license"
+	"github.com/yourselfhosted/slash/server/runner/tombstone"
+	"github.com/yourselfhosted/slash/server/runner/verification"
 	"github.com/yourselfhosted/slash/server/runner/version"
 	"github.com/yourselfhosted/slash/server/service/license"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/metrics"
 )
 
 type Server struct {
@@ -50,6 +55,9 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 		licenseService: licenseService,
 	}
 
+	// Resolve the workspace of the requests before routing them.
+	e.Pre(apiv1.NewWorkspaceMiddleware(profile))
+
 	// Serve frontend.
 	frontendService := frontend.NewFrontendService(profile, store)
 	frontendService.Serve(ctx, e)
@@ -71,6 +79,9 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	})
 
 	s.apiV1Service = apiv1.NewAPIV1Service(secret, profile, store, licenseService, s.Profile.Port+1)
+	if err := s.apiV1Service.SeedWorkspaceShortcuts(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to seed workspace shortcuts")
+	}
 	// Register gRPC gateway as api v1.
 	if err := s.apiV1Service.RegisterGateway(ctx, e); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -79,10 +90,19 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	return s, nil
 }
 
+// RegisterStoreMetrics serves the store operation metrics on /metrics in the Prometheus text format.
+func (s *Server) RegisterStoreMetrics(storeMetrics *metrics.Metrics) {
+	s.e.GET("/metrics", func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderContentType, "text/plain; version=0.0.4; charset=utf-8")
+		c.Response().WriteHeader(http.StatusOK)
+		return storeMetrics.WritePrometheus(c.Response())
+	})
+}
+
 func (s *Server) Start(ctx context.Context) error {
 	s.StartBackgroundRunners(ctx)
-	// Start gRPC server.
-	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", s.Profile.Port+1))
+	// Start gRPC server on the loopback, only the gateway connects to it and it tells the handlers the IP of the client.
+	listen, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", s.Profile.Port+1))
 	if err != nil {
 		return err
 	}
@@ -121,9 +141,21 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 	licenseRunner.RunOnce(ctx)
 	versionRunner := version.NewRunner(s.Store, s.Profile)
 	versionRunner.RunOnce(ctx)
+	archiveRunner := archive.NewRunner(s.Store)
+	archiveRunner.RunOnce(ctx)
+	tombstoneRunner := tombstone.NewRunner(s.Store)
+	tombstoneRunner.RunOnce(ctx)
+	auditLogRunner := auditlog.NewRunner(s.Store)
+	auditLogRunner.RunOnce(ctx)
+	verificationRunner := verification.NewRunner(s.Store)
+	verificationRunner.RunOnce(ctx)
 
 	go licenseRunner.Run(ctx)
 	go versionRunner.Run(ctx)
+	go archiveRunner.Run(ctx)
+	go tombstoneRunner.Run(ctx)
+	go auditLogRunner.Run(ctx)
+	go verificationRunner.Run(ctx)
 }
 
 func (s *Server) getSecretSession(ctx context.Context) (string, error) {
@@ -32,7 +32,7 @@ func NewLicenseService(profile *profile.Profile, store *store.Store) *LicenseSer
 	return &LicenseService{
 		Profile:            profile,
 		Store:              store,
-		cachedSubscription: getSubscriptionForFreePlan(),
+		cachedSubscription: getSubscriptionForFreePlan(profile),
 	}
 }
 
@@ -42,7 +42,7 @@ func (s *LicenseService) LoadSubscription(ctx context.Context) (*v1pb.Subscripti
 		return nil, errors.Wrap(err, "failed to get workspace general setting")
 	}
 
-	subscription := getSubscriptionForFreePlan()
+	subscription := getSubscriptionForFreePlan(s.Profile)
 	licenseKey := workspaceGeneralSetting.LicenseKey
 	if licenseKey == "" {
 		s.cachedSubscription = subscription
@@ -115,6 +115,18 @@ func (s *LicenseService) IsFeatureEnabled(feature FeatureType) bool {
 	return slices.Contains(s.cachedSubscription.Features, feature.String())
 }
 
+// MaxUsers returns the maximum number of active users of the workspace, zero when unlimited.
+// It is the seats of the license, or the configured maximum of the profile when the license sets none.
+func (s *LicenseService) MaxUsers() int {
+	if s.IsFeatureEnabled(FeatureTypeUnlimitedAccounts) {
+		return 0
+	}
+	if seats := s.cachedSubscription.Seats; seats > 0 {
+		return int(seats)
+	}
+	return getDefaultMaxUsers(s.Profile)
+}
+
 type ValidateResult struct {
 	Plan        v1pb.PlanType
 	ExpiresTime time.Time
@@ -210,10 +222,20 @@ func parseLicenseKey(licenseKey string) (*Claims, error) {
 	return claims, nil
 }
 
-func getSubscriptionForFreePlan() *v1pb.Subscription {
+// DefaultMaxUsers is the maximum number of active users when neither the license nor the profile sets one.
+const DefaultMaxUsers = 5
+
+func getDefaultMaxUsers(profile *profile.Profile) int {
+	if profile != nil && profile.MaxUsers > 0 {
+		return profile.MaxUsers
+	}
+	return DefaultMaxUsers
+}
+
+func getSubscriptionForFreePlan(profile *profile.Profile) *v1pb.Subscription {
 	return &v1pb.Subscription{
 		Plan:             v1pb.PlanType_FREE,
-		Seats:            5,
+		Seats:            int32(getDefaultMaxUsers(profile)),
 		ShortcutsLimit:   100,
 		CollectionsLimit: 5,
 		Features:         []string{
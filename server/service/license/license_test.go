@@ -0,0 +1,29 @@
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/server/profile"
+)
+
+func TestMaxUsers(t *testing.T) {
+	s := NewLicenseService(&profile.Profile{}, nil)
+	// The free plan grants unlimited accounts.
+	require.Equal(t, 0, s.MaxUsers())
+	require.Equal(t, int32(DefaultMaxUsers), s.GetSubscription().Seats)
+
+	s.cachedSubscription = &v1pb.Subscription{Plan: v1pb.PlanType_FREE}
+	require.Equal(t, DefaultMaxUsers, s.MaxUsers())
+	s.Profile.MaxUsers = 20
+	require.Equal(t, 20, s.MaxUsers())
+	require.Equal(t, int32(20), getSubscriptionForFreePlan(s.Profile).Seats)
+
+	// The seats of the license take precedence.
+	s.cachedSubscription = &v1pb.Subscription{Plan: v1pb.PlanType_PRO, Seats: 50}
+	require.Equal(t, 50, s.MaxUsers())
+	s.cachedSubscription.Features = []string{FeatureTypeUnlimitedAccounts.String()}
+	require.Equal(t, 0, s.MaxUsers())
+}
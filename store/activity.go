@@ -11,6 +11,16 @@ const (
 	ActivityShortcutCreate ActivityType = "shortcut.create"
 	// ActivityShortcutView is the activity type of shortcut view.
 	ActivityShortcutView ActivityType = "shortcut.view"
+	// ActivityShortcutArchiveNotice is the activity type of notifying the owner before a shortcut is auto-archived.
+	ActivityShortcutArchiveNotice ActivityType = "shortcut.archive-notice"
+	// ActivityShortcutArchive is the activity type of shortcut auto-archive.
+	ActivityShortcutArchive ActivityType = "shortcut.archive"
+	// ActivityUserSignIn is the activity type of user sign in.
+	ActivityUserSignIn ActivityType = "user.sign-in"
+	// ActivityUserAdminTransfer is the activity type of an admin promoting a user to admin.
+	ActivityUserAdminTransfer ActivityType = "user.admin-transfer"
+	// ActivityUserImpersonate is the activity type of an admin impersonating a user.
+	ActivityUserImpersonate ActivityType = "user.impersonate"
 )
 
 func (t ActivityType) String() string {
@@ -19,6 +29,16 @@ func (t ActivityType) String() string {
 		return "shortcut.create"
 	case ActivityShortcutView:
 		return "shortcut.view"
+	case ActivityShortcutArchiveNotice:
+		return "shortcut.archive-notice"
+	case ActivityShortcutArchive:
+		return "shortcut.archive"
+	case ActivityUserSignIn:
+		return "user.sign-in"
+	case ActivityUserAdminTransfer:
+		return "user.admin-transfer"
+	case ActivityUserImpersonate:
+		return "user.impersonate"
 	}
 	return ""
 }
@@ -56,10 +76,41 @@ type Activity struct {
 }
 
 type FindActivity struct {
+	CreatorID         *int32
 	Type              ActivityType
 	Level             ActivityLevel
 	PayloadShortcutID *int32
 	CreatedTsAfter    *int64
+	// IDAfter and Limit page through the activities, which are ordered by id.
+	IDAfter *int32
+	Limit   *int
+}
+
+// FindShortcutViewStats is the range of the view activities of a shortcut to aggregate.
+type FindShortcutViewStats struct {
+	ShortcutID int32
+	// CreatedTsAfter is inclusive and CreatedTsBefore is exclusive.
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+}
+
+// ShortcutViewCount is the number of views with the key.
+type ShortcutViewCount struct {
+	Key   string
+	Count int32
+}
+
+// ShortcutViewStats is the view activities of a shortcut aggregated by referer, user agent and day.
+type ShortcutViewStats struct {
+	Total      int32
+	Referers   []*ShortcutViewCount
+	UserAgents []*ShortcutViewCount
+	// Days is keyed by the UTC day formatted as YYYY-MM-DD, in order of the day.
+	Days []*ShortcutViewCount
+}
+
+type DeleteActivity struct {
+	CreatedTsBefore int64
 }
 
 func (s *Store) CreateActivity(ctx context.Context, create *Activity) (*Activity, error) {
@@ -83,3 +134,18 @@ func (s *Store) GetActivity(ctx context.Context, find *FindActivity) (*Activity,
 	activity := list[0]
 	return activity, nil
 }
+
+// DeleteActivities purges the activities created before the given time.
+func (s *Store) DeleteActivities(ctx context.Context, delete *DeleteActivity) error {
+	return s.driver.DeleteActivities(ctx, delete)
+}
+
+// ListShortcutLastViewedTs returns the time of the latest view activity of each viewed shortcut, keyed by shortcut id.
+func (s *Store) ListShortcutLastViewedTs(ctx context.Context) (map[int32]int64, error) {
+	return s.driver.ListShortcutLastViewedTs(ctx)
+}
+
+// GetShortcutViewStats aggregates the view activities of the shortcut in the range.
+func (s *Store) GetShortcutViewStats(ctx context.Context, find *FindShortcutViewStats) (*ShortcutViewStats, error) {
+	return s.driver.GetShortcutViewStats(ctx, find)
+}
@@ -1,7 +1,15 @@
 package store
 
-import "fmt"
+import (
+	"fmt"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
 
 func getUserSettingCacheKey(userID int32, key string) string {
 	return fmt.Sprintf("%d-%s", userID, key)
 }
+
+func getWorkspaceSettingCacheKey(workspaceID string, key storepb.WorkspaceSettingKey) string {
+	return fmt.Sprintf("%s-%s", workspaceID, key)
+}
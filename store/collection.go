@@ -14,6 +14,8 @@ type UpdateCollection struct {
 	Description *string
 	ShortcutIDs []int32
 	Visibility  *storepb.Visibility
+	// ShareTokenHash is set to the empty string to revoke the share token.
+	ShareTokenHash *string
 }
 
 type FindCollection struct {
@@ -21,6 +23,10 @@ type FindCollection struct {
 	CreatorID      *int32
 	Name           *string
 	VisibilityList []storepb.Visibility
+	// VisibleTo matches the collections the visibility lets a user see, like the shortcuts, nil doesn't filter by
+	// visibility.
+	VisibleTo      *ShortcutVisibilityFilter
+	ShareTokenHash *string
 }
 
 type DeleteCollection struct {
@@ -53,6 +59,25 @@ func (s *Store) GetCollection(ctx context.Context, find *FindCollection) (*store
 	return collection, nil
 }
 
+// AddCollectionShortcut appends the shortcut to the shortcuts of the collection unless it has it already, and returns
+// the collection, nil when not found. The shortcut is appended in a single statement, so that concurrent additions
+// don't overwrite each other.
+func (s *Store) AddCollectionShortcut(ctx context.Context, collectionID, shortcutID int32) (*storepb.Collection, error) {
+	if err := s.driver.AddCollectionShortcut(ctx, collectionID, shortcutID); err != nil {
+		return nil, err
+	}
+	return s.GetCollection(ctx, &FindCollection{ID: &collectionID})
+}
+
+// RemoveCollectionShortcut removes the shortcut from the shortcuts of the collection if it has it, and returns the
+// collection, nil when not found.
+func (s *Store) RemoveCollectionShortcut(ctx context.Context, collectionID, shortcutID int32) (*storepb.Collection, error) {
+	if err := s.driver.RemoveCollectionShortcut(ctx, collectionID, shortcutID); err != nil {
+		return nil, err
+	}
+	return s.GetCollection(ctx, &FindCollection{ID: &collectionID})
+}
+
 func (s *Store) DeleteCollection(ctx context.Context, delete *DeleteCollection) error {
 	return s.driver.DeleteCollection(ctx, delete)
 }
@@ -12,10 +12,22 @@ func ConvertRowStatusStringToStorepb(status string) storepb.RowStatus {
 	return storepb.RowStatus_ARCHIVED
 }
 
+// matchRowStatus returns whether a row with the row status matches the row status filter of a find, which excludes
+// the archived rows when nil unless includeArchived is set.
+func matchRowStatus(rowStatus storepb.RowStatus, filter *storepb.RowStatus, includeArchived bool) bool {
+	if filter != nil {
+		return rowStatus == *filter
+	}
+	return includeArchived || rowStatus == storepb.RowStatus_NORMAL
+}
+
 func ConvertVisibilityStringToStorepb(visibility string) storepb.Visibility {
 	if visibility == "PUBLIC" {
 		return storepb.Visibility_PUBLIC
 	}
+	if visibility == "PRIVATE" {
+		return storepb.Visibility_PRIVATE
+	}
 	// Otherwise, fallback to workspace visibility.
 	return storepb.Visibility_WORKSPACE
 }
@@ -14,8 +14,8 @@ import (
 )
 
 func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
-	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility"}
-	args := []any{create.CreatorId, create.Name, create.Title, create.Description, pq.Array(create.ShortcutIds), create.Visibility.String()}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "workspace_id"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, pq.Array(create.ShortcutIds), create.Visibility.String(), store.GetWorkspaceID(ctx)}
 
 	stmt := `
 		INSERT INTO collection (` + strings.Join(set, ", ") + `)
@@ -50,6 +50,9 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = "+placeholder(len(args)+1)), append(args, update.Visibility.String())
 	}
+	if update.ShareTokenHash != nil {
+		set, args = append(set, "share_token_hash = "+placeholder(len(args)+1)), append(args, *update.ShareTokenHash)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -57,10 +60,10 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 	stmt := `
 		UPDATE collection
 		SET ` + strings.Join(set, ", ") + `
-		WHERE id = ` + placeholder(len(args)+1) + `
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility
+		WHERE id = ` + placeholder(len(args)+1) + ` AND workspace_id = ` + placeholder(len(args)+2) + `
+		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, share_token_hash
 	`
-	args = append(args, update.ID)
+	args = append(args, update.ID, store.GetWorkspaceID(ctx))
 	collection := &storepb.Collection{}
 	var shortcutIDs []sql.NullInt32
 	var visibility string
@@ -74,6 +77,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		&collection.Description,
 		pq.Array(&shortcutIDs),
 		&visibility,
+		&collection.ShareTokenHash,
 	); err != nil {
 		return nil, err
 	}
@@ -89,7 +93,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 }
 
 func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([]*storepb.Collection, error) {
-	where, args := []string{"1 = 1"}, []any{}
+	where, args := []string{"workspace_id = $1"}, []any{store.GetWorkspaceID(ctx)}
 	if v := find.ID; v != nil {
 		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -99,13 +103,24 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 	if v := find.Name; v != nil {
 		where, args = append(where, "name = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.ShareTokenHash; v != nil {
+		where, args = append(where, "share_token_hash = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		list := []string{}
 		for _, visibility := range v {
-			list, args = append(list, placeholder(len(args)+1)), append(args, visibility)
+			list, args = append(list, placeholder(len(args)+1)), append(args, visibility.String())
 		}
 		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
 	}
+	if v := find.VisibleTo; v != nil {
+		if v.UserID == 0 {
+			where, args = append(where, fmt.Sprintf("visibility = %s", placeholder(len(args)+1))), append(args, storepb.Visibility_PUBLIC.String())
+		} else {
+			where = append(where, fmt.Sprintf("(visibility != %s OR creator_id = %s)", placeholder(len(args)+1), placeholder(len(args)+2)))
+			args = append(args, storepb.Visibility_PRIVATE.String(), v.UserID)
+		}
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -117,7 +132,8 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			title,
 			description,
 			shortcut_ids,
-			visibility
+			visibility,
+			share_token_hash
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -143,6 +159,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			&collection.Description,
 			pq.Array(&shortcutIDs),
 			&visibility,
+			&collection.ShareTokenHash,
 		); err != nil {
 			return nil, err
 		}
@@ -164,9 +181,36 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 }
 
 func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollection) error {
-	if _, err := d.db.ExecContext(ctx, `DELETE FROM collection WHERE id = $1`, delete.ID); err != nil {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM collection WHERE id = $1 AND workspace_id = $2`, delete.ID, store.GetWorkspaceID(ctx)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AddCollectionShortcut appends the shortcut to the shortcut ids of the collection in a single statement, unless they
+// already contain it.
+func (d *DB) AddCollectionShortcut(ctx context.Context, collectionID, shortcutID int32) error {
+	stmt := `
+		UPDATE collection
+		SET shortcut_ids = array_append(shortcut_ids, $1::INTEGER)
+		WHERE id = $2 AND workspace_id = $3 AND NOT ($1::INTEGER = ANY(shortcut_ids))
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, shortcutID, collectionID, store.GetWorkspaceID(ctx)); err != nil {
 		return err
 	}
+	return nil
+}
 
+// RemoveCollectionShortcut removes the shortcut from the shortcut ids of the collection in a single statement.
+func (d *DB) RemoveCollectionShortcut(ctx context.Context, collectionID, shortcutID int32) error {
+	stmt := `
+		UPDATE collection
+		SET shortcut_ids = array_remove(shortcut_ids, $1::INTEGER)
+		WHERE id = $2 AND workspace_id = $3 AND $1::INTEGER = ANY(shortcut_ids)
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, shortcutID, collectionID, store.GetWorkspaceID(ctx)); err != nil {
+		return err
+	}
 	return nil
 }
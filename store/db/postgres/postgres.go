@@ -2,19 +2,33 @@ package postgres
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"log"
+	"net/url"
+	"strings"
 
-	// Import the PostgreSQL driver.
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db/stmtcache"
 )
 
+// newConnector creates the connector of the PostgreSQL connection, replaceable in tests.
+var newConnector = func(dsn string) (driver.Connector, error) {
+	return pq.NewConnector(dsn)
+}
+
+// statementCacheSize bounds the number of prepared statements of the hot queries kept by the driver.
+const statementCacheSize = 128
+
 type DB struct {
 	db      *sql.DB
 	profile *profile.Profile
+	// stmtCache holds the prepared statements of the queries run on every request, e.g. ListUsers and ListShortcuts.
+	stmtCache *stmtcache.Cache
 }
 
 func NewDB(profile *profile.Profile) (store.Driver, error) {
@@ -22,16 +36,29 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 		return nil, errors.New("profile is nil")
 	}
 
-	// Open the PostgreSQL connection
-	db, err := sql.Open("postgres", profile.DSN)
+	dsn, err := buildDSN(profile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build dsn")
+	}
+	connector, err := newConnector(dsn)
 	if err != nil {
 		log.Printf("Failed to open database: %s", err)
 		return nil, errors.Wrapf(err, "failed to open database: %s", profile.DSN)
 	}
+	// Open the PostgreSQL connection
+	db := sql.OpenDB(connector)
+	// Connect eagerly so that TLS misconfigurations fail at startup.
+	if profile.IsPostgresTLSRequired() {
+		if err := db.Ping(); err != nil {
+			db.Close()
+			return nil, errors.Wrapf(err, "failed to establish a TLS connection with sslmode %s", profile.PostgresSSLMode)
+		}
+	}
 
 	var driver store.Driver = &DB{
-		db:      db,
-		profile: profile,
+		db:        db,
+		profile:   profile,
+		stmtCache: stmtcache.New(db, statementCacheSize),
 	}
 	return driver, nil
 }
@@ -40,6 +67,56 @@ func (d *DB) GetDB() *sql.DB {
 	return d.db
 }
 
+func (d *DB) ResetStatementCache() error {
+	return d.stmtCache.Reset()
+}
+
 func (d *DB) Close() error {
+	if err := d.stmtCache.Reset(); err != nil {
+		return err
+	}
 	return d.db.Close()
 }
+
+// buildDSN returns the DSN of the profile with the TLS parameters of the profile applied.
+// Both URL and key=value DSNs are supported.
+func buildDSN(profile *profile.Profile) (string, error) {
+	params := [][2]string{}
+	for _, param := range [][2]string{
+		{"sslmode", profile.PostgresSSLMode},
+		{"sslrootcert", profile.PostgresSSLRootCert},
+		{"sslcert", profile.PostgresSSLCert},
+		{"sslkey", profile.PostgresSSLKey},
+	} {
+		if param[1] != "" {
+			params = append(params, param)
+		}
+	}
+	if len(params) == 0 {
+		return profile.DSN, nil
+	}
+
+	if strings.HasPrefix(profile.DSN, "postgres://") || strings.HasPrefix(profile.DSN, "postgresql://") {
+		u, err := url.Parse(profile.DSN)
+		if err != nil {
+			return "", err
+		}
+		query := u.Query()
+		for _, param := range params {
+			query.Set(param[0], param[1])
+		}
+		u.RawQuery = query.Encode()
+		return u.String(), nil
+	}
+
+	// Later values take precedence in key=value DSNs.
+	list := []string{}
+	if dsn := strings.TrimSpace(profile.DSN); dsn != "" {
+		list = append(list, dsn)
+	}
+	for _, param := range params {
+		value := strings.ReplaceAll(strings.ReplaceAll(param[1], `\`, `\\`), `'`, `\'`)
+		list = append(list, fmt.Sprintf("%s='%s'", param[0], value))
+	}
+	return strings.Join(list, " "), nil
+}
@@ -2,9 +2,12 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
@@ -13,8 +16,51 @@ import (
 )
 
 func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
-	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag"}
-	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " ")}
+	return createShortcut(ctx, d.db, create)
+}
+
+func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
+	return updateShortcut(ctx, d.db, update)
+}
+
+func (d *DB) ImportShortcuts(ctx context.Context, importShortcuts *store.ImportShortcuts) ([]*storepb.Shortcut, []*storepb.Shortcut, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	created := []*storepb.Shortcut{}
+	for _, create := range importShortcuts.Creates {
+		shortcut, err := createShortcut(ctx, tx, create)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to create shortcut %q", create.Name)
+		}
+		created = append(created, shortcut)
+	}
+	updated := []*storepb.Shortcut{}
+	for _, update := range importShortcuts.Updates {
+		shortcut, err := updateShortcut(ctx, tx, update)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to update shortcut %d", update.ID)
+		}
+		updated = append(updated, shortcut)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+	return created, updated, nil
+}
+
+// rowQueryer is a database or a transaction.
+type rowQueryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func createShortcut(ctx context.Context, db rowQueryer, create *storepb.Shortcut) (*storepb.Shortcut, error) {
+	create.WorkspaceId = store.GetWorkspaceID(ctx)
+	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag", "redirect_rate_limit", "summary", "meta_refresh_redirect", "expire_ts", "favicon_url", "workspace_id"}
+	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " "), create.RedirectRateLimit, create.Summary, create.MetaRefreshRedirect, create.ExpireTs, create.FaviconUrl, create.WorkspaceId}
 	if create.OgMetadata != nil {
 		set = append(set, "og_metadata")
 		openGraphMetadataBytes, err := protojson.Marshal(create.OgMetadata)
@@ -23,25 +69,41 @@ func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*sto
 		}
 		args = append(args, string(openGraphMetadataBytes))
 	}
+	if len(create.Localizations) > 0 {
+		localizations, err := store.MarshalShortcutLocalizations(create.Localizations)
+		if err != nil {
+			return nil, err
+		}
+		set, args = append(set, "localizations"), append(args, localizations)
+	}
 
 	stmt := fmt.Sprintf(`
 		INSERT INTO shortcut (%s)
 		VALUES (%s)
-		RETURNING id, created_ts, updated_ts
+		RETURNING id, created_ts, updated_ts, row_status
 	`, strings.Join(set, ","), placeholders(len(args)))
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	var rowStatus string
+	if err := db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.Id,
 		&create.CreatedTs,
 		&create.UpdatedTs,
+		&rowStatus,
 	); err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, store.ErrShortcutNameExists
+		}
 		return nil, err
 	}
+	create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut := create
 	return shortcut, nil
 }
 
-func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
+func updateShortcut(ctx context.Context, db rowQueryer, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
 	set, args := []string{}, []any{}
+	if update.RowStatus != nil {
+		set, args = append(set, fmt.Sprintf("row_status = $%d", len(args)+1)), append(args, update.RowStatus.String())
+	}
 	if update.Name != nil {
 		set, args = append(set, fmt.Sprintf("name = $%d", len(args)+1)), append(args, *update.Name)
 	}
@@ -67,25 +129,45 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		}
 		set, args = append(set, fmt.Sprintf("og_metadata = $%d", len(args)+1)), append(args, string(openGraphMetadataBytes))
 	}
+	if update.RedirectRateLimit != nil {
+		set, args = append(set, fmt.Sprintf("redirect_rate_limit = $%d", len(args)+1)), append(args, *update.RedirectRateLimit)
+	}
+	if update.Summary != nil {
+		set, args = append(set, fmt.Sprintf("summary = $%d", len(args)+1)), append(args, *update.Summary)
+	}
+	if update.MetaRefreshRedirect != nil {
+		set, args = append(set, fmt.Sprintf("meta_refresh_redirect = $%d", len(args)+1)), append(args, *update.MetaRefreshRedirect)
+	}
+	if update.ExpireTs != nil {
+		set, args = append(set, fmt.Sprintf("expire_ts = $%d", len(args)+1)), append(args, *update.ExpireTs)
+	}
+	if update.Localizations != nil {
+		localizations, err := store.MarshalShortcutLocalizations(update.Localizations)
+		if err != nil {
+			return nil, err
+		}
+		set, args = append(set, fmt.Sprintf("localizations = $%d", len(args)+1)), append(args, localizations)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
 
-	args = append(args, update.ID)
+	args = append(args, update.ID, store.GetWorkspaceID(ctx))
 	stmt := fmt.Sprintf(`
 		UPDATE shortcut
 		SET %s
-		WHERE id = $%d
-		RETURNING id, creator_id, created_ts, updated_ts, name, link, title, description, visibility, tag, og_metadata
-	`, strings.Join(set, ","), len(args))
+		WHERE id = $%d AND workspace_id = $%d
+		RETURNING id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata, redirect_rate_limit, summary, meta_refresh_redirect, localizations, view_count, last_viewed_ts, expire_ts, favicon_url, workspace_id
+	`, strings.Join(set, ","), len(args)-1, len(args))
 
 	shortcut := &storepb.Shortcut{}
-	var visibility, tags, openGraphMetadataString string
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	var rowStatus, visibility, tags, openGraphMetadataString, localizationsString string
+	if err := db.QueryRowContext(ctx, stmt, args...).Scan(
 		&shortcut.Id,
 		&shortcut.CreatorId,
 		&shortcut.CreatedTs,
 		&shortcut.UpdatedTs,
+		&rowStatus,
 		&shortcut.Name,
 		&shortcut.Link,
 		&shortcut.Title,
@@ -93,9 +175,22 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		&visibility,
 		&tags,
 		&openGraphMetadataString,
+		&shortcut.RedirectRateLimit,
+		&shortcut.Summary,
+		&shortcut.MetaRefreshRedirect,
+		&localizationsString,
+		&shortcut.ViewCount,
+		&shortcut.LastViewedTs,
+		&shortcut.ExpireTs,
+		&shortcut.FaviconUrl,
+		&shortcut.WorkspaceId,
 	); err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, store.ErrShortcutNameExists
+		}
 		return nil, err
 	}
+	shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 	shortcut.Tags = filterTags(strings.Split(tags, " "))
 	var ogMetadata storepb.OpenGraphMetadata
@@ -103,49 +198,57 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		return nil, err
 	}
 	shortcut.OgMetadata = &ogMetadata
+	localizations, err := store.UnmarshalShortcutLocalizations(localizationsString)
+	if err != nil {
+		return nil, err
+	}
+	shortcut.Localizations = localizations
 	return shortcut, nil
 }
 
 func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*storepb.Shortcut, error) {
-	where, args := []string{"1 = 1"}, []any{}
-	if v := find.ID; v != nil {
-		where, args = append(where, fmt.Sprintf("id = %s", placeholder(len(args)+1))), append(args, *v)
-	}
-	if v := find.CreatorID; v != nil {
-		where, args = append(where, fmt.Sprintf("creator_id = %s", placeholder(len(args)+1))), append(args, *v)
-	}
-	if v := find.Name; v != nil {
-		where, args = append(where, fmt.Sprintf("name = %s", placeholder(len(args)+1))), append(args, *v)
-	}
-	if v := find.VisibilityList; len(v) != 0 {
-		list := []string{}
-		for _, visibility := range v {
-			list = append(list, placeholder(len(args)+1))
-			args = append(args, visibility)
+	where, args := buildShortcutFilter(store.GetWorkspaceID(ctx), find)
+	if v := find.Cursor; v != nil {
+		condition, cursorArgs, err := buildShortcutCursor(find.GetOrderBy(), v, len(args))
+		if err != nil {
+			return nil, err
 		}
-		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
+		where, args = append(where, condition), append(args, cursorArgs...)
 	}
-	if v := find.Tag; v != nil {
-		where, args = append(where, fmt.Sprintf("tag LIKE %s", placeholder(len(args)+1))), append(args, "%"+*v+"%")
+	limit := ""
+	if v := find.Limit; v != nil {
+		limit = fmt.Sprintf(" LIMIT %d", *v)
 	}
+	source, sourceArgs := buildShortcutSource(find, len(args))
+	args = append(args, sourceArgs...)
 
-	rows, err := d.db.QueryContext(ctx, fmt.Sprintf(`
+	rows, err := d.stmtCache.QueryContext(ctx, fmt.Sprintf(`
 		SELECT
 			id,
 			creator_id,
 			created_ts,
 			updated_ts,
+			row_status,
 			name,
 			link,
 			title,
 			description,
 			visibility,
 			tag,
-			og_metadata
-		FROM shortcut
+			og_metadata,
+			redirect_rate_limit,
+			summary,
+			meta_refresh_redirect,
+			localizations,
+			view_count,
+			last_viewed_ts,
+			expire_ts,
+			favicon_url,
+			workspace_id
+		FROM %s
 		WHERE %s
-		ORDER BY created_ts DESC
-	`, strings.Join(where, " AND ")), args...)
+		ORDER BY %s%s
+	`, source, strings.Join(where, " AND "), buildShortcutOrderBy(find.GetOrderBy()), limit), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,12 +257,13 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	list := make([]*storepb.Shortcut, 0)
 	for rows.Next() {
 		shortcut := &storepb.Shortcut{}
-		var visibility, tags, openGraphMetadataString string
+		var rowStatus, visibility, tags, openGraphMetadataString, localizationsString string
 		if err := rows.Scan(
 			&shortcut.Id,
 			&shortcut.CreatorId,
 			&shortcut.CreatedTs,
 			&shortcut.UpdatedTs,
+			&rowStatus,
 			&shortcut.Name,
 			&shortcut.Link,
 			&shortcut.Title,
@@ -167,9 +271,19 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			&visibility,
 			&tags,
 			&openGraphMetadataString,
+			&shortcut.RedirectRateLimit,
+			&shortcut.Summary,
+			&shortcut.MetaRefreshRedirect,
+			&localizationsString,
+			&shortcut.ViewCount,
+			&shortcut.LastViewedTs,
+			&shortcut.ExpireTs,
+			&shortcut.FaviconUrl,
+			&shortcut.WorkspaceId,
 		); err != nil {
 			return nil, err
 		}
+		shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 		shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 		shortcut.Tags = filterTags(strings.Split(tags, " "))
 		var ogMetadata storepb.OpenGraphMetadata
@@ -177,6 +291,11 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			return nil, err
 		}
 		shortcut.OgMetadata = &ogMetadata
+		localizations, err := store.UnmarshalShortcutLocalizations(localizationsString)
+		if err != nil {
+			return nil, err
+		}
+		shortcut.Localizations = localizations
 		list = append(list, shortcut)
 	}
 
@@ -186,11 +305,294 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	return list, nil
 }
 
+// RebuildShortcutSearchIndex does nothing, the keywords are matched with ILIKE without a search index.
+func (*DB) RebuildShortcutSearchIndex(context.Context) error {
+	return nil
+}
+
+func (d *DB) ListShortcutGroups(ctx context.Context, find *store.FindShortcutGroup) ([]*store.ShortcutGroup, error) {
+	shortcutFind := find.Find
+	if shortcutFind == nil {
+		shortcutFind = &store.FindShortcut{}
+	}
+	where, args := buildShortcutFilter(store.GetWorkspaceID(ctx), shortcutFind)
+	limit := ""
+	if v := find.Limit; v != nil {
+		limit = fmt.Sprintf(" LIMIT %d", *v)
+	}
+
+	var query string
+	switch find.Field {
+	case store.ShortcutGroupFieldVisibility, store.ShortcutGroupFieldCreatorID:
+		query = fmt.Sprintf(`
+			SELECT CAST(%s AS TEXT) AS group_key, COUNT(*) AS group_count, MIN(id)
+			FROM shortcut
+			WHERE %s
+			GROUP BY %s
+			ORDER BY group_count DESC, group_key ASC%s
+		`, find.Field, strings.Join(where, " AND "), find.Field, limit)
+	case store.ShortcutGroupFieldTag:
+		query = fmt.Sprintf(`
+			SELECT group_key, COUNT(DISTINCT id) AS group_count, MIN(id)
+			FROM (SELECT id, unnest(string_to_array(tag, ' ')) AS group_key FROM shortcut WHERE %s) AS shortcut_tag
+			WHERE group_key <> ''
+			GROUP BY group_key
+			ORDER BY group_count DESC, group_key ASC%s
+		`, strings.Join(where, " AND "), limit)
+	default:
+		return nil, errors.Errorf("unsupported group field %q", find.Field)
+	}
+
+	rows, err := d.stmtCache.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutGroup{}
+	for rows.Next() {
+		group := &store.ShortcutGroup{}
+		if err := rows.Scan(&group.Key, &group.Count, &group.SampleID); err != nil {
+			return nil, err
+		}
+		list = append(list, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) AddShortcutViews(ctx context.Context, views []*store.ShortcutViews) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `UPDATE shortcut SET view_count = view_count + $1, last_viewed_ts = GREATEST(last_viewed_ts, $2) WHERE id = $3 AND workspace_id = $4`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, view := range views {
+		if _, err := stmt.ExecContext(ctx, view.Count, view.LastViewedTs, view.ShortcutID, store.GetWorkspaceID(ctx)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 func (d *DB) DeleteShortcut(ctx context.Context, delete *store.DeleteShortcut) error {
-	_, err := d.db.ExecContext(ctx, "DELETE FROM shortcut WHERE id = $1", delete.ID)
+	_, err := d.db.ExecContext(ctx, "DELETE FROM shortcut WHERE id = $1 AND workspace_id = $2", delete.ID, store.GetWorkspaceID(ctx))
 	return err
 }
 
+func (d *DB) RestoreShortcut(ctx context.Context, restore *store.RestoreShortcut, deletedTs int64) (*storepb.Shortcut, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if restore.MergeIntoID == nil {
+		rowStatus := storepb.RowStatus_NORMAL
+		shortcut, err := updateShortcut(ctx, tx, &store.UpdateShortcut{
+			ID:        restore.ID,
+			RowStatus: &rowStatus,
+			Name:      restore.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+		return shortcut, nil
+	}
+
+	mergeIntoID, workspaceID := *restore.MergeIntoID, store.GetWorkspaceID(ctx)
+	if _, err := tx.ExecContext(ctx, `UPDATE activity SET payload = jsonb_set(payload::JSONB, '{shortcutId}', to_jsonb($1::INTEGER))::TEXT WHERE workspace_id = $2 AND type = $3 AND CAST(payload::JSON->>'shortcutId' AS INTEGER) = $4`, mergeIntoID, workspaceID, store.ActivityShortcutView.String(), restore.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to move shortcut views")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE shortcut SET
+			view_count = view_count + (SELECT view_count FROM shortcut WHERE id = $1 AND workspace_id = $3),
+			last_viewed_ts = GREATEST(last_viewed_ts, (SELECT last_viewed_ts FROM shortcut WHERE id = $1 AND workspace_id = $3))
+		WHERE id = $2 AND workspace_id = $3
+	`, restore.ID, mergeIntoID, workspaceID); err != nil {
+		return nil, errors.Wrap(err, "failed to merge shortcut view counts")
+	}
+	if err := softDeleteShortcut(ctx, tx, workspaceID, restore.ID, deletedTs); err != nil {
+		return nil, errors.Wrap(err, "failed to delete merged shortcut")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	list, err := d.ListShortcuts(ctx, &store.FindShortcut{ID: &mergeIntoID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("shortcut %d not found", mergeIntoID)
+	}
+	return list[0], nil
+}
+
+// buildShortcutFilter returns the conditions and args of the filters of the find in the workspace, without its cursor.
+// The equality conditions come first, in the order of the columns of the shortcut indexes, and the
+// pattern matches last as they can't seek an index but are checked on its entries.
+func buildShortcutFilter(workspaceID string, find *store.FindShortcut) ([]string, []any) {
+	where, args := []string{"workspace_id = $1"}, []any{workspaceID}
+	if v := find.ID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = %s", placeholder(len(args)+1))), append(args, *v)
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, fmt.Sprintf("name = %s", placeholder(len(args)+1))), append(args, *v)
+	}
+	if v := find.VisibilityList; len(v) != 0 {
+		list := []string{}
+		for _, visibility := range v {
+			list = append(list, placeholder(len(args)+1))
+			args = append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, fmt.Sprintf("creator_id = %s", placeholder(len(args)+1))), append(args, *v)
+	}
+	if v := find.VisibleTo; v != nil {
+		if v.UserID == 0 {
+			where, args = append(where, fmt.Sprintf("visibility = %s", placeholder(len(args)+1))), append(args, storepb.Visibility_PUBLIC.String())
+		} else {
+			where = append(where, fmt.Sprintf("(visibility != %s OR creator_id = %s)", placeholder(len(args)+1), placeholder(len(args)+2)))
+			args = append(args, storepb.Visibility_PRIVATE.String(), v.UserID)
+		}
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, fmt.Sprintf("row_status = %s", placeholder(len(args)+1))), append(args, v.String())
+	} else if !find.IncludeArchived {
+		where, args = append(where, fmt.Sprintf("row_status = %s", placeholder(len(args)+1))), append(args, storepb.RowStatus_NORMAL.String())
+	}
+	if v := find.ExpiredAt; v != nil {
+		where, args = append(where, fmt.Sprintf("expire_ts != 0 AND expire_ts <= %s", placeholder(len(args)+1))), append(args, *v)
+	}
+	if v := find.NotExpiredAt; v != nil {
+		where, args = append(where, fmt.Sprintf("(expire_ts = 0 OR expire_ts > %s)", placeholder(len(args)+1))), append(args, *v)
+	}
+	if v := find.Tag; v != nil {
+		where, args = append(where, fmt.Sprintf("tag LIKE %s", placeholder(len(args)+1))), append(args, "%"+*v+"%")
+	}
+	if v := find.Tags; len(v) != 0 {
+		// The tags are stored separated by spaces, so pad them to match whole tags.
+		list := []string{}
+		for _, tag := range v {
+			list, args = append(list, fmt.Sprintf(`(' ' || tag || ' ') LIKE %s ESCAPE '\'`, placeholder(len(args)+1))), append(args, "% "+escapeLikePattern(tag)+" %")
+		}
+		operator := " AND "
+		if find.TagsMatchAny {
+			operator = " OR "
+		}
+		where = append(where, "("+strings.Join(list, operator)+")")
+	}
+	if v := find.Keyword; v != nil {
+		list := []string{}
+		for _, field := range []string{"name", "title", "description", "tag"} {
+			list, args = append(list, fmt.Sprintf(`%s ILIKE %s ESCAPE '\'`, field, placeholder(len(args)+1))), append(args, "%"+escapeLikePattern(*v)+"%")
+		}
+		where = append(where, "("+strings.Join(list, " OR ")+")")
+	}
+	if v := find.Access; v != nil && len(v.RestrictedTags) != 0 {
+		matchAnyTag := func(tags []string) string {
+			list := []string{}
+			for _, tag := range tags {
+				list, args = append(list, fmt.Sprintf(`(' ' || tag || ' ') LIKE %s ESCAPE '\'`, placeholder(len(args)+1))), append(args, "% "+escapeLikePattern(tag)+" %")
+			}
+			return "(" + strings.Join(list, " OR ") + ")"
+		}
+		conditions := []string{fmt.Sprintf("creator_id = %s", placeholder(len(args)+1)), fmt.Sprintf("visibility = %s", placeholder(len(args)+2))}
+		args = append(args, v.UserID, storepb.Visibility_PUBLIC.String())
+		if v.RequireAllGranted {
+			if deniedTags := v.GetDeniedTags(); len(deniedTags) != 0 {
+				conditions = append(conditions, "NOT "+matchAnyTag(deniedTags))
+			} else {
+				conditions = append(conditions, "1 = 1")
+			}
+		} else {
+			conditions = append(conditions, "NOT "+matchAnyTag(v.RestrictedTags))
+			if len(v.GrantedTags) != 0 {
+				conditions = append(conditions, matchAnyTag(v.GrantedTags))
+			}
+		}
+		where = append(where, "("+strings.Join(conditions, " OR ")+")")
+	}
+	return where, args
+}
+
+// buildShortcutSource returns the shortcut table, with the keyword_rank column when the find has a keyword.
+// The placeholders are numbered after the offset.
+func buildShortcutSource(find *store.FindShortcut, offset int) (string, []any) {
+	if find.Keyword == nil {
+		return "shortcut", nil
+	}
+	pattern := "%" + escapeLikePattern(*find.Keyword) + "%"
+	return fmt.Sprintf(`(
+		SELECT
+			*,
+			CASE
+				WHEN name ILIKE %s ESCAPE '\' THEN 0
+				WHEN title ILIKE %s ESCAPE '\' THEN 1
+				WHEN description ILIKE %s ESCAPE '\' THEN 2
+				ELSE 3
+			END AS keyword_rank
+		FROM shortcut
+	) AS shortcut`, placeholder(offset+1), placeholder(offset+2), placeholder(offset+3)), []any{pattern, pattern, pattern}
+}
+
+func buildShortcutOrderBy(orderBy []*store.ShortcutOrderBy) string {
+	list := []string{}
+	for _, v := range orderBy {
+		direction := "ASC"
+		if v.Desc {
+			direction = "DESC"
+		}
+		list = append(list, fmt.Sprintf("%s %s", v.Field, direction))
+	}
+	return strings.Join(append(list, "id ASC"), ", ")
+}
+
+// buildShortcutCursor returns the condition of the shortcuts after the cursor in the order, which is
+// the fields of FindShortcut.GetOrderBy followed by the id. The placeholders are numbered after the offset.
+func buildShortcutCursor(orderBy []*store.ShortcutOrderBy, cursor *store.ShortcutCursor, offset int) (string, []any, error) {
+	if len(cursor.Values) != len(orderBy) {
+		return "", nil, errors.Errorf("cursor has %d values for %d order by fields", len(cursor.Values), len(orderBy))
+	}
+	orderBy = append(slices.Clone(orderBy), &store.ShortcutOrderBy{Field: "id"})
+	values := append(slices.Clone(cursor.Values), cursor.ID)
+	conditions, args := []string{}, []any{}
+	for i := range orderBy {
+		and := []string{}
+		for j, v := range orderBy[:i+1] {
+			if j < i {
+				and, args = append(and, fmt.Sprintf("%s = %s", v.Field, placeholder(offset+len(args)+1))), append(args, values[j])
+				continue
+			}
+			operator := ">"
+			if v.Desc {
+				operator = "<"
+			}
+			and, args = append(and, fmt.Sprintf("%s %s %s", v.Field, operator, placeholder(offset+len(args)+1))), append(args, values[j])
+		}
+		conditions = append(conditions, "("+strings.Join(and, " AND ")+")")
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", args, nil
+}
+
+// escapeLikePattern escapes the wildcards of LIKE patterns with backslashes.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func filterTags(tags []string) []string {
 	result := []string{}
 	for _, tag := range tags {
@@ -200,3 +602,9 @@ func filterTags(tags []string) []string {
 	}
 	return result
 }
+
+// isUniqueConstraintError returns whether the error is a violation of a UNIQUE constraint, e.g. of the active shortcut names.
+func isUniqueConstraintError(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) SoftDeleteShortcut(ctx context.Context, delete *store.DeleteShortcut, deletedTs int64) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := softDeleteShortcut(ctx, tx, store.GetWorkspaceID(ctx), delete.ID, deletedTs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// softDeleteShortcut deletes the shortcut of the workspace and leaves a tombstone of it in the transaction.
+func softDeleteShortcut(ctx context.Context, tx *sql.Tx, workspaceID string, id int32, deletedTs int64) error {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO shortcut_tombstone (shortcut_id, creator_id, name, deleted_ts, workspace_id)
+		SELECT id, creator_id, name, $1::BIGINT, workspace_id FROM shortcut WHERE id = $2 AND workspace_id = $3
+		ON CONFLICT(shortcut_id) DO UPDATE
+		SET creator_id = EXCLUDED.creator_id, name = EXCLUDED.name, deleted_ts = EXCLUDED.deleted_ts, workspace_id = EXCLUDED.workspace_id
+	`, deletedTs, id, workspaceID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM shortcut WHERE id = $1 AND workspace_id = $2`, id, workspaceID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) ListShortcutTombstones(ctx context.Context, find *store.FindShortcutTombstone) ([]*store.ShortcutTombstone, error) {
+	where, args := "workspace_id = $1", []any{store.GetWorkspaceID(ctx)}
+	if find.DeletedTsAfter != nil {
+		where, args = where+" AND deleted_ts > $2", append(args, *find.DeletedTsAfter)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			shortcut_id,
+			creator_id,
+			name,
+			deleted_ts
+		FROM shortcut_tombstone
+		WHERE `+where+`
+		ORDER BY deleted_ts ASC, shortcut_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutTombstone{}
+	for rows.Next() {
+		tombstone := &store.ShortcutTombstone{}
+		if err := rows.Scan(
+			&tombstone.ShortcutID,
+			&tombstone.CreatorID,
+			&tombstone.Name,
+			&tombstone.DeletedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, tombstone)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteShortcutTombstones(ctx context.Context, delete *store.DeleteShortcutTombstone) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut_tombstone WHERE workspace_id = $1 AND deleted_ts < $2`, store.GetWorkspaceID(ctx), delete.DeletedTsBefore); err != nil {
+		return err
+	}
+	return nil
+}
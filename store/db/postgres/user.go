@@ -2,9 +2,12 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
 
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -14,17 +17,20 @@ func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, e
 			email,
 			nickname,
 			password_hash,
-			role
+			role,
+			workspace_id
 		)
-		VALUES ($1, $2, $3, $4)
+		VALUES ($1, $2, $3, $4, $5)
 		RETURNING id, created_ts, updated_ts, row_status
 	`
+	create.WorkspaceID = store.GetWorkspaceID(ctx)
 	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt,
 		create.Email,
 		create.Nickname,
 		create.PasswordHash,
 		create.Role,
+		create.WorkspaceID,
 	).Scan(
 		&create.ID,
 		&create.CreatedTs,
@@ -39,6 +45,51 @@ func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, e
 	return user, nil
 }
 
+func (d *DB) CreateUsers(ctx context.Context, creates []*store.User) ([]*store.User, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	list := []*store.User{}
+	for _, create := range creates {
+		create.WorkspaceID = store.GetWorkspaceID(ctx)
+		var rowStatus string
+		if err := tx.QueryRowContext(ctx, `
+			INSERT INTO "user" (
+				email,
+				nickname,
+				password_hash,
+				role,
+				workspace_id
+			)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id, created_ts, updated_ts, row_status
+		`,
+			create.Email,
+			create.Nickname,
+			create.PasswordHash,
+			create.Role,
+			create.WorkspaceID,
+		).Scan(
+			&create.ID,
+			&create.CreatedTs,
+			&create.UpdatedTs,
+			&rowStatus,
+		); err != nil {
+			return nil, err
+		}
+		create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
+		list = append(list, create)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
 func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.User, error) {
 	set, args := []string{}, []any{}
 	if v := update.RowStatus; v != nil {
@@ -56,6 +107,12 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	if v := update.Role; v != nil {
 		set, args = append(set, "role = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.FailedSignInCount; v != nil {
+		set, args = append(set, "failed_sign_in_count = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.LockedUntilTs; v != nil {
+		set, args = append(set, "locked_until_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no fields to update")
 	}
@@ -63,10 +120,10 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	stmt := `
 		UPDATE "user"
 		SET ` + strings.Join(set, ", ") + `
-		WHERE id = ` + placeholder(len(args)+1) + `
-		RETURNING id, created_ts, updated_ts, row_status, email, nickname, password_hash, role
+		WHERE id = ` + placeholder(len(args)+1) + ` AND workspace_id = ` + placeholder(len(args)+2) + `
+		RETURNING id, created_ts, updated_ts, row_status, email, nickname, password_hash, role, workspace_id, failed_sign_in_count, locked_until_ts
 	`
-	args = append(args, update.ID)
+	args = append(args, update.ID, store.GetWorkspaceID(ctx))
 	user := &store.User{}
 	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -78,6 +135,9 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 		&user.Nickname,
 		&user.PasswordHash,
 		&user.Role,
+		&user.WorkspaceID,
+		&user.FailedSignInCount,
+		&user.LockedUntilTs,
 	); err != nil {
 		return nil, err
 	}
@@ -87,13 +147,15 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 }
 
 func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User, error) {
-	where, args := []string{"1 = 1"}, []any{}
+	where, args := []string{"workspace_id = $1"}, []any{store.GetWorkspaceID(ctx)}
 
 	if v := find.ID; v != nil {
 		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
 	}
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "row_status = "+placeholder(len(args)+1)), append(args, v.String())
+	} else if !find.IncludeArchived {
+		where, args = append(where, "row_status = "+placeholder(len(args)+1)), append(args, storepb.RowStatus_NORMAL.String())
 	}
 	if v := find.Email; v != nil {
 		where, args = append(where, "email = "+placeholder(len(args)+1)), append(args, *v)
@@ -104,6 +166,13 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	if v := find.Role; v != nil {
 		where, args = append(where, "role = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.IDAfter; v != nil {
+		where, args = append(where, "id > "+placeholder(len(args)+1)), append(args, *v)
+	}
+	orderBy := "updated_ts DESC, created_ts DESC"
+	if find.IDAfter != nil || find.Limit != nil {
+		orderBy = "id ASC"
+	}
 
 	query := `
 		SELECT 
@@ -114,12 +183,17 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			email,
 			nickname,
 			password_hash,
-			role
+			role,
+			workspace_id,
+			failed_sign_in_count,
+			locked_until_ts
 		FROM "user"
 		WHERE ` + strings.Join(where, " AND ") + `
-		ORDER BY updated_ts DESC, created_ts DESC
-	`
-	rows, err := d.db.QueryContext(ctx, query, args...)
+		ORDER BY ` + orderBy
+	if find.Limit != nil {
+		query += fmt.Sprintf(" LIMIT %d", *find.Limit)
+	}
+	rows, err := d.stmtCache.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +212,9 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 			&user.Nickname,
 			&user.PasswordHash,
 			&user.Role,
+			&user.WorkspaceID,
+			&user.FailedSignInCount,
+			&user.LockedUntilTs,
 		); err != nil {
 			return nil, err
 		}
@@ -152,9 +229,92 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	return list, nil
 }
 
-func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
-	if _, err := d.db.ExecContext(ctx, `DELETE FROM "user" WHERE id = $1`, delete.ID); err != nil {
+func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser, deletedTs int64) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	workspaceID := store.GetWorkspaceID(ctx)
+	if v := delete.ShortcutOwnerID; v != nil {
+		if _, err := tx.ExecContext(ctx, `UPDATE shortcut SET creator_id = $1 WHERE creator_id = $2 AND workspace_id = $3`, *v, delete.ID, workspaceID); err != nil {
+			if isUniqueConstraintError(err) {
+				return store.ErrShortcutNameExists
+			}
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE collection SET creator_id = $1 WHERE creator_id = $2 AND workspace_id = $3`, *v, delete.ID, workspaceID); err != nil {
+			return err
+		}
+	} else if delete.Archive {
+		// The shortcuts are kept archived, so that they can be restored.
+		if _, err := tx.ExecContext(ctx, `UPDATE shortcut SET row_status = $1 WHERE creator_id = $2 AND workspace_id = $3`, storepb.RowStatus_ARCHIVED.String(), delete.ID, workspaceID); err != nil {
+			return err
+		}
+	} else {
+		if err := softDeleteUserShortcuts(ctx, tx, workspaceID, delete.ID, deletedTs); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM collection WHERE creator_id = $1 AND workspace_id = $2`, delete.ID, workspaceID); err != nil {
+			return err
+		}
+	}
+
+	if delete.Archive {
+		if _, err := tx.ExecContext(ctx, `UPDATE "user" SET row_status = $1 WHERE id = $2 AND workspace_id = $3`, storepb.RowStatus_ARCHIVED.String(), delete.ID, workspaceID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM user_setting WHERE user_id = $1 AND key = $2`, delete.ID, storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS.String()); err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+	// The rows of the user are deleted first, as the foreign keys referencing the user don't cascade.
+	for _, stmt := range []string{
+		`DELETE FROM user_setting WHERE user_id = $1`,
+		`DELETE FROM user_avatar WHERE user_id = $1`,
+		`DELETE FROM shortcut_name_reservation WHERE user_id = $1`,
+		`DELETE FROM tag_policy WHERE user_id = $1`,
+	} {
+		if _, err := tx.ExecContext(ctx, stmt, delete.ID); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM "user" WHERE id = $1 AND workspace_id = $2`, delete.ID, workspaceID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// softDeleteUserShortcuts deletes the shortcuts of the user in the transaction, leaving their tombstones as
+// SoftDeleteShortcut does.
+func softDeleteUserShortcuts(ctx context.Context, tx *sql.Tx, workspaceID string, userID int32, deletedTs int64) error {
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM shortcut WHERE creator_id = $1 AND workspace_id = $2`, userID, workspaceID)
+	if err != nil {
 		return err
 	}
+	ids := []int32{}
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return err
+	}
+	rows.Close()
+
+	for _, id := range ids {
+		if err := softDeleteShortcut(ctx, tx, workspaceID, id, deletedTs); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -35,6 +35,42 @@ func (d *DB) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting)
 			return nil, err
 		}
 		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_INVITATION {
+		valueBytes, err := protojson.Marshal(upsert.GetInvitation())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION {
+		valueBytes, err := protojson.Marshal(upsert.GetEmailVerification())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_PASSWORD_RESET {
+		valueBytes, err := protojson.Marshal(upsert.GetPasswordReset())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_TOTP {
+		valueBytes, err := protojson.Marshal(upsert.GetTotp())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_IDENTITY_LINKS {
+		valueBytes, err := protojson.Marshal(upsert.GetIdentityLinks())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_FAVORITE_SHORTCUTS {
+		valueBytes, err := protojson.Marshal(upsert.GetFavoriteShortcuts())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
 	} else {
 		return nil, errors.New("invalid user setting key")
 	}
@@ -98,6 +134,54 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 			userSetting.Value = &storepb.UserSetting_General{
 				General: userSettingGeneral,
 			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_INVITATION {
+			userSettingInvitation := &storepb.UserSetting_InvitationSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), userSettingInvitation); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_Invitation{
+				Invitation: userSettingInvitation,
+			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION {
+			userSettingEmailVerification := &storepb.UserSetting_EmailVerificationSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), userSettingEmailVerification); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_EmailVerification{
+				EmailVerification: userSettingEmailVerification,
+			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_PASSWORD_RESET {
+			userSettingPasswordReset := &storepb.UserSetting_PasswordResetSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), userSettingPasswordReset); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_PasswordReset{
+				PasswordReset: userSettingPasswordReset,
+			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_TOTP {
+			userSettingTotp := &storepb.UserSetting_TotpSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), userSettingTotp); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_Totp{
+				Totp: userSettingTotp,
+			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_IDENTITY_LINKS {
+			userSettingIdentityLinks := &storepb.UserSetting_IdentityLinksSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), userSettingIdentityLinks); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_IdentityLinks{
+				IdentityLinks: userSettingIdentityLinks,
+			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_FAVORITE_SHORTCUTS {
+			userSettingFavoriteShortcuts := &storepb.UserSetting_FavoriteShortcutsSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), userSettingFavoriteShortcuts); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_FavoriteShortcuts{
+				FavoriteShortcuts: userSettingFavoriteShortcuts,
+			}
 		} else {
 			// Skip unknown key.
 			continue
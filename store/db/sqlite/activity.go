@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/yourselfhosted/slash/store"
@@ -13,9 +14,10 @@ func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store
 			creator_id,
 			type,
 			level,
-			payload
+			payload,
+			workspace_id
 		)
-		VALUES (?, ?, ?, ?)
+		VALUES (?, ?, ?, ?, ?)
 		RETURNING id, created_ts
 	`
 	if err := d.db.QueryRowContext(ctx, stmt,
@@ -23,6 +25,7 @@ func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store
 		create.Type.String(),
 		create.Level.String(),
 		create.Payload,
+		store.GetWorkspaceID(ctx),
 	).Scan(
 		&create.ID,
 		&create.CreatedTs,
@@ -35,7 +38,10 @@ func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store
 }
 
 func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*store.Activity, error) {
-	where, args := []string{"1 = 1"}, []any{}
+	where, args := []string{"workspace_id = ?"}, []any{store.GetWorkspaceID(ctx)}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = ?"), append(args, *find.CreatorID)
+	}
 	if find.Type != "" {
 		where, args = append(where, "type = ?"), append(args, find.Type.String())
 	}
@@ -48,6 +54,9 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 	if find.CreatedTsAfter != nil {
 		where, args = append(where, "created_ts > ?"), append(args, *find.CreatedTsAfter)
 	}
+	if find.IDAfter != nil {
+		where, args = append(where, "id > ?"), append(args, *find.IDAfter)
+	}
 
 	query := `
 		SELECT
@@ -58,7 +67,11 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 			level,
 			payload
 		FROM activity
-		WHERE ` + strings.Join(where, " AND ")
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY id ASC`
+	if find.Limit != nil {
+		query += fmt.Sprintf(" LIMIT %d", *find.Limit)
+	}
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -88,3 +101,93 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 
 	return list, nil
 }
+
+func (d *DB) DeleteActivities(ctx context.Context, delete *store.DeleteActivity) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM activity WHERE workspace_id = ? AND created_ts < ?`, store.GetWorkspaceID(ctx), delete.CreatedTsBefore); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) GetShortcutViewStats(ctx context.Context, find *store.FindShortcutViewStats) (*store.ShortcutViewStats, error) {
+	where, args := []string{"workspace_id = ?", "type = ?", "json_extract(payload, '$.shortcutId') = ?"}, []any{store.GetWorkspaceID(ctx), store.ActivityShortcutView.String(), find.ShortcutID}
+	if find.CreatedTsAfter != nil {
+		where, args = append(where, "created_ts >= ?"), append(args, *find.CreatedTsAfter)
+	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts < ?"), append(args, *find.CreatedTsBefore)
+	}
+
+	stats := &store.ShortcutViewStats{}
+	var err error
+	if stats.Referers, err = d.countShortcutViews(ctx, "COALESCE(json_extract(payload, '$.referer'), '')", where, args); err != nil {
+		return nil, err
+	}
+	if stats.UserAgents, err = d.countShortcutViews(ctx, "COALESCE(json_extract(payload, '$.userAgent'), '')", where, args); err != nil {
+		return nil, err
+	}
+	if stats.Days, err = d.countShortcutViews(ctx, "strftime('%Y-%m-%d', created_ts, 'unixepoch')", where, args); err != nil {
+		return nil, err
+	}
+	for _, day := range stats.Days {
+		stats.Total += day.Count
+	}
+	return stats, nil
+}
+
+func (d *DB) ListShortcutLastViewedTs(ctx context.Context) (map[int32]int64, error) {
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT json_extract(payload, '$.shortcutId') AS shortcut_id, MAX(created_ts)
+		FROM activity
+		WHERE workspace_id = ? AND type = ? AND json_extract(payload, '$.shortcutId') IS NOT NULL
+		GROUP BY shortcut_id`,
+		store.GetWorkspaceID(ctx), store.ActivityShortcutView.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lastViewedTs := map[int32]int64{}
+	for rows.Next() {
+		var shortcutID int32
+		var ts int64
+		if err := rows.Scan(&shortcutID, &ts); err != nil {
+			return nil, err
+		}
+		lastViewedTs[shortcutID] = ts
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return lastViewedTs, nil
+}
+
+// countShortcutViews returns the number of the view activities matching the conditions by the key expression, in order of the key.
+func (d *DB) countShortcutViews(ctx context.Context, key string, where []string, args []any) ([]*store.ShortcutViewCount, error) {
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT `+key+` AS view_key, COUNT(*)
+		FROM activity
+		WHERE `+strings.Join(where, " AND ")+`
+		GROUP BY view_key
+		ORDER BY view_key ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewCount{}
+	for rows.Next() {
+		count := &store.ShortcutViewCount{}
+		if err := rows.Scan(&count.Key, &count.Count); err != nil {
+			return nil, err
+		}
+		list = append(list, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
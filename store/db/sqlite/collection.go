@@ -14,9 +14,9 @@ import (
 )
 
 func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
-	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility"}
-	args := []any{create.CreatorId, create.Name, create.Title, create.Description, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(create.ShortcutIds)), ","), "[]"), create.Visibility.String()}
-	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "workspace_id"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(create.ShortcutIds)), ","), "[]"), create.Visibility.String(), store.GetWorkspaceID(ctx)}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
 
 	stmt := `
 		INSERT INTO collection (
@@ -53,18 +53,21 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = ?"), append(args, update.Visibility.String())
 	}
+	if update.ShareTokenHash != nil {
+		set, args = append(set, "share_token_hash = ?"), append(args, *update.ShareTokenHash)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
-	args = append(args, update.ID)
+	args = append(args, update.ID, store.GetWorkspaceID(ctx))
 
 	stmt := `
 		UPDATE collection
 		SET
 			` + strings.Join(set, ", ") + `
 		WHERE
-			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility
+			id = ? AND workspace_id = ?
+		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, share_token_hash
 	`
 	collection := &storepb.Collection{}
 	var shortcutIDs, visibility string
@@ -78,6 +81,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		&collection.Description,
 		&shortcutIDs,
 		&visibility,
+		&collection.ShareTokenHash,
 	); err != nil {
 		return nil, err
 	}
@@ -97,7 +101,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 }
 
 func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([]*storepb.Collection, error) {
-	where, args := []string{"1 = 1"}, []any{}
+	where, args := []string{"workspace_id = ?"}, []any{store.GetWorkspaceID(ctx)}
 	if v := find.ID; v != nil {
 		where, args = append(where, "id = ?"), append(args, *v)
 	}
@@ -107,14 +111,24 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 	if v := find.Name; v != nil {
 		where, args = append(where, "name = ?"), append(args, *v)
 	}
+	if v := find.ShareTokenHash; v != nil {
+		where, args = append(where, "share_token_hash = ?"), append(args, *v)
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		list := []string{}
 		for _, visibility := range v {
-			list = append(list, fmt.Sprintf("$%d", len(args)+1))
-			args = append(args, visibility)
+			list = append(list, "?")
+			args = append(args, visibility.String())
 		}
 		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
 	}
+	if v := find.VisibleTo; v != nil {
+		if v.UserID == 0 {
+			where, args = append(where, "visibility = ?"), append(args, storepb.Visibility_PUBLIC.String())
+		} else {
+			where, args = append(where, "(visibility != ? OR creator_id = ?)"), append(args, storepb.Visibility_PRIVATE.String(), v.UserID)
+		}
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -126,7 +140,8 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			title,
 			description,
 			shortcut_ids,
-			visibility
+			visibility,
+			share_token_hash
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -151,6 +166,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			&collection.Description,
 			&shortcutIDs,
 			&visibility,
+			&collection.ShareTokenHash,
 		); err != nil {
 			return nil, err
 		}
@@ -176,10 +192,38 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 }
 
 func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollection) error {
-	if _, err := d.db.ExecContext(ctx, `DELETE FROM collection WHERE id = ?`, delete.ID); err != nil {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM collection WHERE id = ? AND workspace_id = ?`, delete.ID, store.GetWorkspaceID(ctx)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AddCollectionShortcut appends the shortcut to the comma separated shortcut ids of the collection in a single
+// statement, unless they already contain it.
+func (d *DB) AddCollectionShortcut(ctx context.Context, collectionID, shortcutID int32) error {
+	stmt := `
+		UPDATE collection
+		SET shortcut_ids = CASE WHEN shortcut_ids = '' THEN CAST(? AS TEXT) ELSE shortcut_ids || ',' || ? END
+		WHERE id = ? AND workspace_id = ? AND ',' || shortcut_ids || ',' NOT LIKE '%,' || ? || ',%'
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, shortcutID, shortcutID, collectionID, store.GetWorkspaceID(ctx), shortcutID); err != nil {
 		return err
 	}
+	return nil
+}
 
+// RemoveCollectionShortcut removes the shortcut from the comma separated shortcut ids of the collection in a single
+// statement.
+func (d *DB) RemoveCollectionShortcut(ctx context.Context, collectionID, shortcutID int32) error {
+	stmt := `
+		UPDATE collection
+		SET shortcut_ids = TRIM(REPLACE(',' || shortcut_ids || ',', ',' || ? || ',', ','), ',')
+		WHERE id = ? AND workspace_id = ? AND ',' || shortcut_ids || ',' LIKE '%,' || ? || ',%'
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, shortcutID, collectionID, store.GetWorkspaceID(ctx), shortcutID); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4,19 +4,72 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
 )
 
 func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
-	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag"}
-	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " ")}
-	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	return createShortcut(ctx, d.db, create)
+}
+
+func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
+	return updateShortcut(ctx, d.db, update)
+}
+
+func (d *DB) ImportShortcuts(ctx context.Context, importShortcuts *store.ImportShortcuts) ([]*storepb.Shortcut, []*storepb.Shortcut, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	created := []*storepb.Shortcut{}
+	for _, create := range importShortcuts.Creates {
+		shortcut, err := createShortcut(ctx, tx, create)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to create shortcut %q", create.Name)
+		}
+		created = append(created, shortcut)
+	}
+	updated := []*storepb.Shortcut{}
+	for _, update := range importShortcuts.Updates {
+		shortcut, err := updateShortcut(ctx, tx, update)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to update shortcut %d", update.ID)
+		}
+		updated = append(updated, shortcut)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+	return created, updated, nil
+}
+
+// rowQueryer is a database or a transaction.
+type rowQueryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func createShortcut(ctx context.Context, db rowQueryer, create *storepb.Shortcut) (*storepb.Shortcut, error) {
+	create.WorkspaceId = store.GetWorkspaceID(ctx)
+	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag", "redirect_rate_limit", "summary", "meta_refresh_redirect", "expire_ts", "favicon_url", "workspace_id"}
+	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " "), create.RedirectRateLimit, create.Summary, create.MetaRefreshRedirect, create.ExpireTs, create.FaviconUrl, create.WorkspaceId}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
+	if len(create.Localizations) > 0 {
+		localizations, err := store.MarshalShortcutLocalizations(create.Localizations)
+		if err != nil {
+			return nil, err
+		}
+		set, args, placeholder = append(set, "localizations"), append(args, localizations), append(placeholder, "?")
+	}
 	if create.OgMetadata != nil {
 		set = append(set, "og_metadata")
 		openGraphMetadataBytes, err := protojson.Marshal(create.OgMetadata)
@@ -32,21 +85,30 @@ func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*sto
 			` + strings.Join(set, ", ") + `
 		)
 		VALUES (` + strings.Join(placeholder, ",") + `)
-		RETURNING id, created_ts, updated_ts
+		RETURNING id, created_ts, updated_ts, row_status
 	`
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	var rowStatus string
+	if err := db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.Id,
 		&create.CreatedTs,
 		&create.UpdatedTs,
+		&rowStatus,
 	); err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, store.ErrShortcutNameExists
+		}
 		return nil, err
 	}
+	create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut := create
 	return shortcut, nil
 }
 
-func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
+func updateShortcut(ctx context.Context, db rowQueryer, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
 	set, args := []string{}, []any{}
+	if update.RowStatus != nil {
+		set, args = append(set, "row_status = ?"), append(args, update.RowStatus.String())
+	}
 	if update.Name != nil {
 		set, args = append(set, "name = ?"), append(args, *update.Name)
 	}
@@ -72,26 +134,46 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		}
 		set, args = append(set, "og_metadata = ?"), append(args, string(openGraphMetadataBytes))
 	}
+	if update.RedirectRateLimit != nil {
+		set, args = append(set, "redirect_rate_limit = ?"), append(args, *update.RedirectRateLimit)
+	}
+	if update.Summary != nil {
+		set, args = append(set, "summary = ?"), append(args, *update.Summary)
+	}
+	if update.MetaRefreshRedirect != nil {
+		set, args = append(set, "meta_refresh_redirect = ?"), append(args, *update.MetaRefreshRedirect)
+	}
+	if update.ExpireTs != nil {
+		set, args = append(set, "expire_ts = ?"), append(args, *update.ExpireTs)
+	}
+	if update.Localizations != nil {
+		localizations, err := store.MarshalShortcutLocalizations(update.Localizations)
+		if err != nil {
+			return nil, err
+		}
+		set, args = append(set, "localizations = ?"), append(args, localizations)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
-	args = append(args, update.ID)
+	args = append(args, update.ID, store.GetWorkspaceID(ctx))
 
 	stmt := `
 		UPDATE shortcut
 		SET
 			` + strings.Join(set, ", ") + `
 		WHERE
-			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, name, link, title, description, visibility, tag, og_metadata
+			id = ? AND workspace_id = ?
+		RETURNING id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata, redirect_rate_limit, summary, meta_refresh_redirect, localizations, view_count, last_viewed_ts, expire_ts, favicon_url, workspace_id
 	`
 	shortcut := &storepb.Shortcut{}
-	var visibility, tags, openGraphMetadataString string
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	var rowStatus, visibility, tags, openGraphMetadataString, localizationsString string
+	if err := db.QueryRowContext(ctx, stmt, args...).Scan(
 		&shortcut.Id,
 		&shortcut.CreatorId,
 		&shortcut.CreatedTs,
 		&shortcut.UpdatedTs,
+		&rowStatus,
 		&shortcut.Name,
 		&shortcut.Link,
 		&shortcut.Title,
@@ -99,9 +181,22 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		&visibility,
 		&tags,
 		&openGraphMetadataString,
+		&shortcut.RedirectRateLimit,
+		&shortcut.Summary,
+		&shortcut.MetaRefreshRedirect,
+		&localizationsString,
+		&shortcut.ViewCount,
+		&shortcut.LastViewedTs,
+		&shortcut.ExpireTs,
+		&shortcut.FaviconUrl,
+		&shortcut.WorkspaceId,
 	); err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, store.ErrShortcutNameExists
+		}
 		return nil, err
 	}
+	shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 	shortcut.Tags = filterTags(strings.Split(tags, " "))
 	var ogMetadata storepb.OpenGraphMetadata
@@ -109,50 +204,24 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		return nil, err
 	}
 	shortcut.OgMetadata = &ogMetadata
+	localizations, err := store.UnmarshalShortcutLocalizations(localizationsString)
+	if err != nil {
+		return nil, err
+	}
+	shortcut.Localizations = localizations
 	return shortcut, nil
 }
 
 func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*storepb.Shortcut, error) {
-	where, args := []string{"1 = 1"}, []any{}
-	if v := find.ID; v != nil {
-		where, args = append(where, "id = ?"), append(args, *v)
-	}
-	if v := find.CreatorID; v != nil {
-		where, args = append(where, "creator_id = ?"), append(args, *v)
-	}
-	if v := find.Name; v != nil {
-		where, args = append(where, "name = ?"), append(args, *v)
-	}
-	if v := find.VisibilityList; len(v) != 0 {
-		list := []string{}
-		for _, visibility := range v {
-			list = append(list, fmt.Sprintf("$%d", len(args)+1))
-			args = append(args, visibility.String())
-		}
-		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
+	searchIndex, err := d.useShortcutSearchIndex(ctx, find)
+	if err != nil {
+		return nil, err
 	}
-	if v := find.Tag; v != nil {
-		where, args = append(where, "tag LIKE ?"), append(args, "%"+*v+"%")
+	query, args, err := buildListShortcutsQuery(store.GetWorkspaceID(ctx), find, searchIndex)
+	if err != nil {
+		return nil, err
 	}
-
-	rows, err := d.db.QueryContext(ctx, `
-		SELECT
-			id,
-			creator_id,
-			created_ts,
-			updated_ts,
-			name,
-			link,
-			title,
-			description,
-			visibility,
-			tag,
-			og_metadata
-		FROM shortcut
-		WHERE `+strings.Join(where, " AND ")+`
-		ORDER BY created_ts DESC`,
-		args...,
-	)
+	rows, err := d.stmtCache.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -161,12 +230,13 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	list := make([]*storepb.Shortcut, 0)
 	for rows.Next() {
 		shortcut := &storepb.Shortcut{}
-		var visibility, tags, openGraphMetadataString string
+		var rowStatus, visibility, tags, openGraphMetadataString, localizationsString string
 		if err := rows.Scan(
 			&shortcut.Id,
 			&shortcut.CreatorId,
 			&shortcut.CreatedTs,
 			&shortcut.UpdatedTs,
+			&rowStatus,
 			&shortcut.Name,
 			&shortcut.Link,
 			&shortcut.Title,
@@ -174,9 +244,19 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			&visibility,
 			&tags,
 			&openGraphMetadataString,
+			&shortcut.RedirectRateLimit,
+			&shortcut.Summary,
+			&shortcut.MetaRefreshRedirect,
+			&localizationsString,
+			&shortcut.ViewCount,
+			&shortcut.LastViewedTs,
+			&shortcut.ExpireTs,
+			&shortcut.FaviconUrl,
+			&shortcut.WorkspaceId,
 		); err != nil {
 			return nil, err
 		}
+		shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 		shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 		shortcut.Tags = filterTags(strings.Split(tags, " "))
 		var ogMetadata storepb.OpenGraphMetadata
@@ -184,6 +264,11 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			return nil, err
 		}
 		shortcut.OgMetadata = &ogMetadata
+		localizations, err := store.UnmarshalShortcutLocalizations(localizationsString)
+		if err != nil {
+			return nil, err
+		}
+		shortcut.Localizations = localizations
 		list = append(list, shortcut)
 	}
 
@@ -193,14 +278,148 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	return list, nil
 }
 
+func (d *DB) ListShortcutGroups(ctx context.Context, find *store.FindShortcutGroup) ([]*store.ShortcutGroup, error) {
+	shortcutFind := find.Find
+	if shortcutFind == nil {
+		shortcutFind = &store.FindShortcut{}
+	}
+	searchIndex, err := d.useShortcutSearchIndex(ctx, shortcutFind)
+	if err != nil {
+		return nil, err
+	}
+	where, args := buildShortcutFilter(store.GetWorkspaceID(ctx), shortcutFind, searchIndex)
+	limit := ""
+	if v := find.Limit; v != nil {
+		limit = fmt.Sprintf(" LIMIT %d", *v)
+	}
+
+	var query string
+	switch find.Field {
+	case store.ShortcutGroupFieldVisibility, store.ShortcutGroupFieldCreatorID:
+		query = fmt.Sprintf(`
+			SELECT CAST(%s AS TEXT) AS group_key, COUNT(*) AS group_count, MIN(id)
+			FROM shortcut
+			WHERE %s
+			GROUP BY %s
+			ORDER BY group_count DESC, group_key ASC%s
+		`, find.Field, strings.Join(where, " AND "), find.Field, limit)
+	case store.ShortcutGroupFieldTag:
+		// Split the tags separated by spaces into a row per tag.
+		query = fmt.Sprintf(`
+			WITH RECURSIVE shortcut_tag(id, group_key, rest) AS (
+				SELECT id, '', tag || ' ' FROM shortcut WHERE %s
+				UNION ALL
+				SELECT id, substr(rest, 1, instr(rest, ' ') - 1), substr(rest, instr(rest, ' ') + 1) FROM shortcut_tag WHERE rest <> ''
+			)
+			SELECT group_key, COUNT(DISTINCT id) AS group_count, MIN(id)
+			FROM shortcut_tag
+			WHERE group_key <> ''
+			GROUP BY group_key
+			ORDER BY group_count DESC, group_key ASC%s
+		`, strings.Join(where, " AND "), limit)
+	default:
+		return nil, errors.Errorf("unsupported group field %q", find.Field)
+	}
+
+	rows, err := d.stmtCache.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutGroup{}
+	for rows.Next() {
+		group := &store.ShortcutGroup{}
+		if err := rows.Scan(&group.Key, &group.Count, &group.SampleID); err != nil {
+			return nil, err
+		}
+		list = append(list, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) AddShortcutViews(ctx context.Context, views []*store.ShortcutViews) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `UPDATE shortcut SET view_count = view_count + ?, last_viewed_ts = MAX(last_viewed_ts, ?) WHERE id = ? AND workspace_id = ?`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, view := range views {
+		if _, err := stmt.ExecContext(ctx, view.Count, view.LastViewedTs, view.ShortcutID, store.GetWorkspaceID(ctx)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 func (d *DB) DeleteShortcut(ctx context.Context, delete *store.DeleteShortcut) error {
-	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ?`, delete.ID); err != nil {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ? AND workspace_id = ?`, delete.ID, store.GetWorkspaceID(ctx)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+func (d *DB) RestoreShortcut(ctx context.Context, restore *store.RestoreShortcut, deletedTs int64) (*storepb.Shortcut, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if restore.MergeIntoID == nil {
+		rowStatus := storepb.RowStatus_NORMAL
+		shortcut, err := updateShortcut(ctx, tx, &store.UpdateShortcut{
+			ID:        restore.ID,
+			RowStatus: &rowStatus,
+			Name:      restore.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+		return shortcut, nil
+	}
+
+	mergeIntoID, workspaceID := *restore.MergeIntoID, store.GetWorkspaceID(ctx)
+	if _, err := tx.ExecContext(ctx, `UPDATE activity SET payload = json_set(payload, '$.shortcutId', ?) WHERE workspace_id = ? AND type = ? AND json_extract(payload, '$.shortcutId') = ?`, mergeIntoID, workspaceID, store.ActivityShortcutView.String(), restore.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to move shortcut views")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE shortcut SET
+			view_count = view_count + (SELECT view_count FROM shortcut WHERE id = ? AND workspace_id = ?),
+			last_viewed_ts = MAX(last_viewed_ts, (SELECT last_viewed_ts FROM shortcut WHERE id = ? AND workspace_id = ?))
+		WHERE id = ? AND workspace_id = ?
+	`, restore.ID, workspaceID, restore.ID, workspaceID, mergeIntoID, workspaceID); err != nil {
+		return nil, errors.Wrap(err, "failed to merge shortcut view counts")
+	}
+	if err := softDeleteShortcut(ctx, tx, workspaceID, restore.ID, deletedTs); err != nil {
+		return nil, errors.Wrap(err, "failed to delete merged shortcut")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	list, err := d.ListShortcuts(ctx, &store.FindShortcut{ID: &mergeIntoID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("shortcut %d not found", mergeIntoID)
+	}
+	return list[0], nil
+}
+
 func vacuumShortcut(ctx context.Context, tx *sql.Tx) error {
 	stmt := `DELETE FROM shortcut WHERE creator_id NOT IN (SELECT id FROM user)`
 	_, err := tx.ExecContext(ctx, stmt)
@@ -211,6 +430,207 @@ func vacuumShortcut(ctx context.Context, tx *sql.Tx) error {
 	return nil
 }
 
+// buildListShortcutsQuery returns the query and args of ListShortcuts for the find, which matches its keyword with
+// the search index when searchIndex is set.
+func buildListShortcutsQuery(workspaceID string, find *store.FindShortcut, searchIndex bool) (string, []any, error) {
+	where, args := buildShortcutFilter(workspaceID, find, searchIndex)
+	if v := find.Cursor; v != nil {
+		condition, cursorArgs, err := buildShortcutCursor(find.GetOrderBy(), v)
+		if err != nil {
+			return "", nil, err
+		}
+		where, args = append(where, condition), append(args, cursorArgs...)
+	}
+	limit := ""
+	if v := find.Limit; v != nil {
+		limit = fmt.Sprintf(" LIMIT %d", *v)
+	}
+	// The args of the source come first as it is before the conditions.
+	source, sourceArgs := buildShortcutSource(find)
+	args = append(sourceArgs, args...)
+
+	query := `
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			updated_ts,
+			row_status,
+			name,
+			link,
+			title,
+			description,
+			visibility,
+			tag,
+			og_metadata,
+			redirect_rate_limit,
+			summary,
+			meta_refresh_redirect,
+			localizations,
+			view_count,
+			last_viewed_ts,
+			expire_ts,
+			favicon_url,
+			workspace_id
+		FROM ` + source + `
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY ` + buildShortcutOrderBy(find.GetOrderBy()) + limit
+	return query, args, nil
+}
+
+// buildShortcutFilter returns the conditions and args of the filters of the find in the workspace, without its cursor.
+// The equality conditions come first, in the order of the columns of the shortcut indexes, and the
+// pattern matches last as they can't seek an index but are checked on its entries. The keyword is matched with the
+// search index when searchIndex is set, see useShortcutSearchIndex.
+func buildShortcutFilter(workspaceID string, find *store.FindShortcut, searchIndex bool) ([]string, []any) {
+	where, args := []string{"workspace_id = ?"}, []any{workspaceID}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "name = ?"), append(args, *v)
+	}
+	if v := find.VisibilityList; len(v) != 0 {
+		list := []string{}
+		for _, visibility := range v {
+			list, args = append(list, "?"), append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = ?"), append(args, *v)
+	}
+	if v := find.VisibleTo; v != nil {
+		if v.UserID == 0 {
+			where, args = append(where, "visibility = ?"), append(args, storepb.Visibility_PUBLIC.String())
+		} else {
+			where, args = append(where, "(visibility != ? OR creator_id = ?)"), append(args, storepb.Visibility_PRIVATE.String(), v.UserID)
+		}
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = ?"), append(args, v.String())
+	} else if !find.IncludeArchived {
+		where, args = append(where, "row_status = ?"), append(args, storepb.RowStatus_NORMAL.String())
+	}
+	if v := find.ExpiredAt; v != nil {
+		where, args = append(where, "expire_ts != 0 AND expire_ts <= ?"), append(args, *v)
+	}
+	if v := find.NotExpiredAt; v != nil {
+		where, args = append(where, "(expire_ts = 0 OR expire_ts > ?)"), append(args, *v)
+	}
+	if v := find.Tag; v != nil {
+		where, args = append(where, "tag LIKE ?"), append(args, "%"+*v+"%")
+	}
+	if v := find.Tags; len(v) != 0 {
+		// The tags are stored separated by spaces, so pad them to match whole tags.
+		list := []string{}
+		for _, tag := range v {
+			list, args = append(list, `(' ' || tag || ' ') LIKE ? ESCAPE '\'`), append(args, "% "+escapeLikePattern(tag)+" %")
+		}
+		operator := " AND "
+		if find.TagsMatchAny {
+			operator = " OR "
+		}
+		where = append(where, "("+strings.Join(list, operator)+")")
+	}
+	if v := find.Keyword; v != nil {
+		if searchIndex {
+			where, args = append(where, "id IN (SELECT rowid FROM shortcut_fts WHERE shortcut_fts MATCH ?)"), append(args, formatSearchIndexPhrase(*v))
+		} else {
+			pattern := "%" + escapeLikePattern(*v) + "%"
+			where, args = append(where, `(name LIKE ? ESCAPE '\' OR title LIKE ? ESCAPE '\' OR description LIKE ? ESCAPE '\' OR tag LIKE ? ESCAPE '\')`), append(args, pattern, pattern, pattern, pattern)
+		}
+	}
+	if v := find.Access; v != nil && len(v.RestrictedTags) != 0 {
+		matchAnyTag := func(tags []string) string {
+			list := []string{}
+			for _, tag := range tags {
+				list, args = append(list, `(' ' || tag || ' ') LIKE ? ESCAPE '\'`), append(args, "% "+escapeLikePattern(tag)+" %")
+			}
+			return "(" + strings.Join(list, " OR ") + ")"
+		}
+		conditions := []string{"creator_id = ?", "visibility = ?"}
+		args = append(args, v.UserID, storepb.Visibility_PUBLIC.String())
+		if v.RequireAllGranted {
+			if deniedTags := v.GetDeniedTags(); len(deniedTags) != 0 {
+				conditions = append(conditions, "NOT "+matchAnyTag(deniedTags))
+			} else {
+				conditions = append(conditions, "1 = 1")
+			}
+		} else {
+			conditions = append(conditions, "NOT "+matchAnyTag(v.RestrictedTags))
+			if len(v.GrantedTags) != 0 {
+				conditions = append(conditions, matchAnyTag(v.GrantedTags))
+			}
+		}
+		where = append(where, "("+strings.Join(conditions, " OR ")+")")
+	}
+	return where, args
+}
+
+// buildShortcutSource returns the shortcut table, with the keyword_rank column when the find has a keyword.
+func buildShortcutSource(find *store.FindShortcut) (string, []any) {
+	if find.Keyword == nil {
+		return "shortcut", nil
+	}
+	pattern := "%" + escapeLikePattern(*find.Keyword) + "%"
+	return `(
+		SELECT
+			*,
+			CASE
+				WHEN name LIKE ? ESCAPE '\' THEN 0
+				WHEN title LIKE ? ESCAPE '\' THEN 1
+				WHEN description LIKE ? ESCAPE '\' THEN 2
+				ELSE 3
+			END AS keyword_rank
+		FROM shortcut
+	) AS shortcut`, []any{pattern, pattern, pattern}
+}
+
+func buildShortcutOrderBy(orderBy []*store.ShortcutOrderBy) string {
+	list := []string{}
+	for _, v := range orderBy {
+		direction := "ASC"
+		if v.Desc {
+			direction = "DESC"
+		}
+		list = append(list, fmt.Sprintf("%s %s", v.Field, direction))
+	}
+	return strings.Join(append(list, "id ASC"), ", ")
+}
+
+// buildShortcutCursor returns the condition of the shortcuts after the cursor in the order, which is
+// the fields of FindShortcut.GetOrderBy followed by the id.
+func buildShortcutCursor(orderBy []*store.ShortcutOrderBy, cursor *store.ShortcutCursor) (string, []any, error) {
+	if len(cursor.Values) != len(orderBy) {
+		return "", nil, errors.Errorf("cursor has %d values for %d order by fields", len(cursor.Values), len(orderBy))
+	}
+	orderBy = append(slices.Clone(orderBy), &store.ShortcutOrderBy{Field: "id"})
+	values := append(slices.Clone(cursor.Values), cursor.ID)
+	conditions, args := []string{}, []any{}
+	for i := range orderBy {
+		and := []string{}
+		for j, v := range orderBy[:i+1] {
+			if j < i {
+				and, args = append(and, fmt.Sprintf("%s = ?", v.Field)), append(args, values[j])
+				continue
+			}
+			operator := ">"
+			if v.Desc {
+				operator = "<"
+			}
+			and, args = append(and, fmt.Sprintf("%s %s ?", v.Field, operator)), append(args, values[j])
+		}
+		conditions = append(conditions, "("+strings.Join(and, " AND ")+")")
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", args, nil
+}
+
+// escapeLikePattern escapes the wildcards of LIKE patterns with backslashes.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func filterTags(tags []string) []string {
 	result := []string{}
 	for _, tag := range tags {
@@ -220,3 +640,9 @@ func filterTags(tags []string) []string {
 	}
 	return result
 }
+
+// isUniqueConstraintError returns whether the error is a violation of a UNIQUE constraint, e.g. of the active shortcut names.
+func isUniqueConstraintError(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
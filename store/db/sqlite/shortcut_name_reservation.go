@@ -0,0 +1,71 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) ReserveShortcutName(ctx context.Context, reservation *store.ShortcutNameReservation, nowTs int64) (*store.ShortcutNameReservation, error) {
+	// The insert is skipped when a shortcut of the workspace of the namespace uses the name, and the conflicting
+	// reservation is only taken over when held by the same user or expired.
+	stmt := `
+		INSERT INTO shortcut_name_reservation (
+			namespace, name, user_id, expires_ts
+		)
+		SELECT ?, ?, ?, ?
+		WHERE NOT EXISTS (SELECT 1 FROM shortcut WHERE workspace_id = ? AND name = ?)
+		ON CONFLICT(namespace, name) DO UPDATE
+		SET user_id = EXCLUDED.user_id, expires_ts = EXCLUDED.expires_ts
+		WHERE shortcut_name_reservation.user_id = EXCLUDED.user_id OR shortcut_name_reservation.expires_ts <= ?
+		RETURNING namespace, name, user_id, expires_ts
+	`
+	result := &store.ShortcutNameReservation{}
+	if err := d.db.QueryRowContext(ctx, stmt, reservation.Namespace, reservation.Name, reservation.UserID, reservation.ExpiresTs, reservation.Namespace, reservation.Name, nowTs).Scan(
+		&result.Namespace,
+		&result.Name,
+		&result.UserID,
+		&result.ExpiresTs,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrShortcutNameTaken
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *DB) GetShortcutNameReservation(ctx context.Context, find *store.FindShortcutNameReservation) (*store.ShortcutNameReservation, error) {
+	query := `
+		SELECT
+			namespace,
+			name,
+			user_id,
+			expires_ts
+		FROM shortcut_name_reservation
+		WHERE namespace = ? AND name = ?
+	`
+	reservation := &store.ShortcutNameReservation{}
+	if err := d.db.QueryRowContext(ctx, query, find.Namespace, find.Name).Scan(
+		&reservation.Namespace,
+		&reservation.Name,
+		&reservation.UserID,
+		&reservation.ExpiresTs,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return reservation, nil
+}
+
+func (d *DB) DeleteShortcutNameReservation(ctx context.Context, delete *store.DeleteShortcutNameReservation) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut_name_reservation WHERE namespace = ? AND name = ?`, delete.Namespace, delete.Name); err != nil {
+		return err
+	}
+	return nil
+}
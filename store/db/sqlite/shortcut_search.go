@@ -0,0 +1,55 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+// minSearchIndexKeywordLength is the length of the shortest keyword the trigram tokenizer of the search index
+// matches, the shorter keywords are matched with LIKE.
+const minSearchIndexKeywordLength = 3
+
+// hasShortcutSearchIndex returns whether the shortcut_fts table of the migrations is available to match the keywords.
+// The keywords are matched with LIKE when it is missing or the SQLite build lacks FTS5.
+func (d *DB) hasShortcutSearchIndex(ctx context.Context) (bool, error) {
+	d.searchIndexMu.Lock()
+	defer d.searchIndexMu.Unlock()
+	if d.searchIndex != nil {
+		return *d.searchIndex, nil
+	}
+	var available bool
+	if err := d.db.QueryRowContext(ctx, `
+		SELECT sqlite_compileoption_used('ENABLE_FTS5') AND EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'shortcut_fts')
+	`).Scan(&available); err != nil {
+		return false, err
+	}
+	d.searchIndex = &available
+	return available, nil
+}
+
+// RebuildShortcutSearchIndex rebuilds the search index from the shortcut table, e.g. when it drifted from the shortcuts.
+func (d *DB) RebuildShortcutSearchIndex(ctx context.Context) error {
+	available, err := d.hasShortcutSearchIndex(ctx)
+	if err != nil || !available {
+		return err
+	}
+	_, err = d.db.ExecContext(ctx, `INSERT INTO shortcut_fts (shortcut_fts) VALUES ('rebuild')`)
+	return err
+}
+
+// useShortcutSearchIndex returns whether the keyword of the find is matched with the search index.
+func (d *DB) useShortcutSearchIndex(ctx context.Context, find *store.FindShortcut) (bool, error) {
+	if find.Keyword == nil || utf8.RuneCountInString(*find.Keyword) < minSearchIndexKeywordLength {
+		return false, nil
+	}
+	return d.hasShortcutSearchIndex(ctx)
+}
+
+// formatSearchIndexPhrase returns the FTS5 phrase of the keyword, which the trigram tokenizer matches as a substring
+// case-insensitively, like LIKE.
+func formatSearchIndexPhrase(keyword string) string {
+	return `"` + strings.ReplaceAll(keyword, `"`, `""`) + `"`
+}
@@ -0,0 +1,87 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestListShortcutsQueryPlan(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("only runs against a fresh sqlite database")
+	}
+	driver, err := NewDB(profile)
+	require.NoError(t, err)
+	require.NoError(t, store.New(driver, profile).Migrate(ctx))
+	d := driver.(*DB)
+	explain := func(find *store.FindShortcut, searchIndex bool) string {
+		query, args, err := buildListShortcutsQuery(store.DefaultWorkspaceID, find, searchIndex)
+		require.NoError(t, err)
+		rows, err := d.db.QueryContext(ctx, "EXPLAIN QUERY PLAN "+query, args...)
+		require.NoError(t, err)
+		defer rows.Close()
+		details := []string{}
+		for rows.Next() {
+			var id, parent, notUsed int
+			var detail string
+			require.NoError(t, rows.Scan(&id, &parent, &notUsed, &detail))
+			details = append(details, detail)
+		}
+		require.NoError(t, rows.Err())
+		return strings.Join(details, "\n")
+	}
+
+	rowStatus := storepb.RowStatus_NORMAL
+	creatorID := int32(1)
+	keyword := "docs"
+	tests := []struct {
+		find        *store.FindShortcut
+		searchIndex bool
+		// detail is in the query plan, e.g. the index the query searches.
+		detail string
+	}{
+		{
+			find: &store.FindShortcut{
+				RowStatus:      &rowStatus,
+				VisibilityList: []storepb.Visibility{storepb.Visibility_PUBLIC},
+				Tags:           []string{"docs"},
+			},
+			detail: "USING INDEX idx_shortcut_visibility_row_status_tag",
+		},
+		{
+			find: &store.FindShortcut{
+				VisibilityList: []storepb.Visibility{storepb.Visibility_PUBLIC, storepb.Visibility_WORKSPACE},
+				Keyword:        &keyword,
+			},
+			detail: "USING INDEX idx_shortcut_visibility_row_status_tag",
+		},
+		{
+			find: &store.FindShortcut{
+				VisibilityList: []storepb.Visibility{storepb.Visibility_PUBLIC, storepb.Visibility_WORKSPACE},
+				Keyword:        &keyword,
+			},
+			searchIndex: true,
+			detail:      "SCAN shortcut_fts VIRTUAL TABLE INDEX",
+		},
+		{
+			find: &store.FindShortcut{
+				CreatorID: &creatorID,
+				RowStatus: &rowStatus,
+				OrderBy:   []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldCreatedTs, Desc: true}},
+			},
+			detail: "USING INDEX idx_shortcut_creator_id_row_status",
+		},
+	}
+	for _, test := range tests {
+		plan := explain(test.find, test.searchIndex)
+		require.Contains(t, plan, test.detail, plan)
+	}
+}
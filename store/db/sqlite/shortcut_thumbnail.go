@@ -0,0 +1,75 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) UpsertShortcutThumbnail(ctx context.Context, upsert *store.ShortcutThumbnail) (*store.ShortcutThumbnail, error) {
+	stmt := `
+		INSERT INTO shortcut_thumbnail (
+			shortcut_id, link, content_type, content, workspace_id
+		)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(shortcut_id) DO UPDATE
+		SET created_ts = EXCLUDED.created_ts, link = EXCLUDED.link, content_type = EXCLUDED.content_type, content = EXCLUDED.content
+		RETURNING shortcut_id, created_ts, link, content_type, content
+	`
+	shortcutThumbnail := &store.ShortcutThumbnail{}
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.ShortcutID, upsert.Link, upsert.ContentType, upsert.Content, store.GetWorkspaceID(ctx)).Scan(
+		&shortcutThumbnail.ShortcutID,
+		&shortcutThumbnail.CreatedTs,
+		&shortcutThumbnail.Link,
+		&shortcutThumbnail.ContentType,
+		&shortcutThumbnail.Content,
+	); err != nil {
+		return nil, err
+	}
+	return shortcutThumbnail, nil
+}
+
+func (d *DB) ListShortcutThumbnails(ctx context.Context, find *store.FindShortcutThumbnail) ([]*store.ShortcutThumbnail, error) {
+	where, args := []string{"workspace_id = ?"}, []any{store.GetWorkspaceID(ctx)}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = ?"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			shortcut_id,
+			created_ts,
+			link,
+			content_type,
+			content
+		FROM shortcut_thumbnail
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY shortcut_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutThumbnail{}
+	for rows.Next() {
+		shortcutThumbnail := &store.ShortcutThumbnail{}
+		if err := rows.Scan(
+			&shortcutThumbnail.ShortcutID,
+			&shortcutThumbnail.CreatedTs,
+			&shortcutThumbnail.Link,
+			&shortcutThumbnail.ContentType,
+			&shortcutThumbnail.Content,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, shortcutThumbnail)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"database/sql"
+	"sync"
 
 	"github.com/pkg/errors"
 	// SQLite driver.
@@ -9,11 +10,20 @@ import (
 
 	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db/stmtcache"
 )
 
+// statementCacheSize bounds the number of prepared statements of the hot queries kept by the driver.
+const statementCacheSize = 128
+
 type DB struct {
 	db      *sql.DB
 	profile *profile.Profile
+	// stmtCache holds the prepared statements of the queries run on every request, e.g. ListUsers and ListShortcuts.
+	stmtCache *stmtcache.Cache
+	// searchIndexMu guards searchIndex, whether the shortcut search index is available, nil until checked.
+	searchIndexMu sync.Mutex
+	searchIndex   *bool
 }
 
 // NewDB opens a database specified by its database driver name and a
@@ -44,7 +54,7 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 		return nil, errors.Wrapf(err, "failed to open db with dsn: %s", profile.DSN)
 	}
 
-	driver := DB{db: sqliteDB, profile: profile}
+	driver := DB{db: sqliteDB, profile: profile, stmtCache: stmtcache.New(sqliteDB, statementCacheSize)}
 
 	return &driver, nil
 }
@@ -53,6 +63,17 @@ func (d *DB) GetDB() *sql.DB {
 	return d.db
 }
 
+func (d *DB) ResetStatementCache() error {
+	// The search index may have been created with the schema too.
+	d.searchIndexMu.Lock()
+	d.searchIndex = nil
+	d.searchIndexMu.Unlock()
+	return d.stmtCache.Reset()
+}
+
 func (d *DB) Close() error {
+	if err := d.stmtCache.Reset(); err != nil {
+		return err
+	}
 	return d.db.Close()
 }
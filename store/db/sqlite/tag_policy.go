@@ -0,0 +1,92 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) UpsertTagPolicy(ctx context.Context, upsert *store.TagPolicy) (*store.TagPolicy, error) {
+	stmt := `
+		INSERT INTO tag_policy (
+			tag, user_id, role, workspace_id
+		)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(tag, user_id) DO UPDATE
+		SET role = EXCLUDED.role
+		RETURNING id, created_ts, tag, user_id, role
+	`
+	tagPolicy := &store.TagPolicy{}
+	var role string
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.Tag, upsert.UserID, upsert.Role.String(), store.GetWorkspaceID(ctx)).Scan(
+		&tagPolicy.ID,
+		&tagPolicy.CreatedTs,
+		&tagPolicy.Tag,
+		&tagPolicy.UserID,
+		&role,
+	); err != nil {
+		return nil, err
+	}
+	tagPolicy.Role = store.TagPolicyRole(role)
+	return tagPolicy, nil
+}
+
+func (d *DB) ListTagPolicies(ctx context.Context, find *store.FindTagPolicy) ([]*store.TagPolicy, error) {
+	where, args := []string{"workspace_id = ?"}, []any{store.GetWorkspaceID(ctx)}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.Tag; v != nil {
+		where, args = append(where, "tag = ?"), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = ?"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			created_ts,
+			tag,
+			user_id,
+			role
+		FROM tag_policy
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY tag ASC, id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.TagPolicy{}
+	for rows.Next() {
+		tagPolicy := &store.TagPolicy{}
+		var role string
+		if err := rows.Scan(
+			&tagPolicy.ID,
+			&tagPolicy.CreatedTs,
+			&tagPolicy.Tag,
+			&tagPolicy.UserID,
+			&role,
+		); err != nil {
+			return nil, err
+		}
+		tagPolicy.Role = store.TagPolicyRole(role)
+		list = append(list, tagPolicy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteTagPolicy(ctx context.Context, delete *store.DeleteTagPolicy) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM tag_policy WHERE id = ? AND workspace_id = ?`, delete.ID, store.GetWorkspaceID(ctx)); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) UpsertUserAvatar(ctx context.Context, upsert *store.UserAvatar) (*store.UserAvatar, error) {
+	stmt := `
+		INSERT INTO user_avatar (
+			user_id, content_type, content, workspace_id
+		)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE
+		SET created_ts = EXCLUDED.created_ts, content_type = EXCLUDED.content_type, content = EXCLUDED.content
+		RETURNING user_id, created_ts, content_type, content
+	`
+	userAvatar := &store.UserAvatar{}
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.UserID, upsert.ContentType, upsert.Content, store.GetWorkspaceID(ctx)).Scan(
+		&userAvatar.UserID,
+		&userAvatar.CreatedTs,
+		&userAvatar.ContentType,
+		&userAvatar.Content,
+	); err != nil {
+		return nil, err
+	}
+	return userAvatar, nil
+}
+
+func (d *DB) ListUserAvatars(ctx context.Context, find *store.FindUserAvatar) ([]*store.UserAvatar, error) {
+	where, args := []string{"workspace_id = ?"}, []any{store.GetWorkspaceID(ctx)}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = ?"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			user_id,
+			created_ts,
+			content_type,
+			content
+		FROM user_avatar
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserAvatar{}
+	for rows.Next() {
+		userAvatar := &store.UserAvatar{}
+		if err := rows.Scan(
+			&userAvatar.UserID,
+			&userAvatar.CreatedTs,
+			&userAvatar.ContentType,
+			&userAvatar.Content,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, userAvatar)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteUserAvatar(ctx context.Context, delete *store.DeleteUserAvatar) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM user_avatar WHERE user_id = ? AND workspace_id = ?`, delete.UserID, store.GetWorkspaceID(ctx)); err != nil {
+		return err
+	}
+	return nil
+}
@@ -15,11 +15,12 @@ import (
 func (d *DB) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.WorkspaceSetting) (*storepb.WorkspaceSetting, error) {
 	stmt := `
 		INSERT INTO workspace_setting (
+			workspace_id,
 			key,
 			value
 		)
-		VALUES (?, ?)
-		ON CONFLICT(key) DO UPDATE 
+		VALUES (?, ?, ?)
+		ON CONFLICT(workspace_id, key) DO UPDATE 
 		SET value = EXCLUDED.value
 	`
 	var valueString string
@@ -47,11 +48,23 @@ func (d *DB) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Workspa
 			return nil, err
 		}
 		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_EMBED {
+		valueBytes, err := protojson.Marshal(upsert.GetEmbed())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REPO_SYNC {
+		valueBytes, err := protojson.Marshal(upsert.GetRepoSync())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
 	} else {
 		return nil, errors.New("invalid workspace setting key")
 	}
 
-	if _, err := d.db.ExecContext(ctx, stmt, upsert.Key.String(), valueString); err != nil {
+	if _, err := d.db.ExecContext(ctx, stmt, store.GetWorkspaceID(ctx), upsert.Key.String(), valueString); err != nil {
 		return nil, err
 	}
 
@@ -60,7 +73,7 @@ func (d *DB) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Workspa
 }
 
 func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspaceSetting) ([]*storepb.WorkspaceSetting, error) {
-	where, args := []string{"1 = 1"}, []any{}
+	where, args := []string{"workspace_id = ?"}, []any{store.GetWorkspaceID(ctx)}
 
 	if find.Key != storepb.WorkspaceSettingKey_WORKSPACE_SETTING_KEY_UNSPECIFIED {
 		where, args = append(where, "key = ?"), append(args, find.Key.String())
@@ -122,6 +135,22 @@ func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspac
 			workspaceSetting.Value = &storepb.WorkspaceSetting_IdentityProvider{
 				IdentityProvider: workspaceSettingIdentityProvider,
 			}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_EMBED {
+			workspaceSettingEmbed := &storepb.WorkspaceSetting_EmbedSetting{}
+			if err := protojsonUnmarshaler.Unmarshal([]byte(valueString), workspaceSettingEmbed); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Embed{
+				Embed: workspaceSettingEmbed,
+			}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REPO_SYNC {
+			workspaceSettingRepoSync := &storepb.WorkspaceSetting_RepoSyncSetting{}
+			if err := protojsonUnmarshaler.Unmarshal([]byte(valueString), workspaceSettingRepoSync); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_RepoSync{
+				RepoSync: workspaceSettingRepoSync,
+			}
 		} else if slices.Contains([]storepb.WorkspaceSettingKey{
 			storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY,
 			storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION,
@@ -145,9 +174,9 @@ func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspac
 func (d *DB) DeleteWorkspaceSetting(ctx context.Context, key storepb.WorkspaceSettingKey) error {
 	stmt := `
 		DELETE FROM workspace_setting
-		WHERE key = ?
+		WHERE workspace_id = ? AND key = ?
 	`
-	if _, err := d.db.ExecContext(ctx, stmt, key.String()); err != nil {
+	if _, err := d.db.ExecContext(ctx, stmt, store.GetWorkspaceID(ctx), key.String()); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,156 @@
+// Package stmtcache provides a bounded cache of prepared statements keyed by their query text.
+package stmtcache
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+)
+
+// Cache prepares each query once and reuses the statement across calls, evicting the least recently used
+// statements over its capacity. It is safe for concurrent use, and a zero capacity disables the caching.
+//
+// Evicted statements are closed once the calls started with them return, and database/sql keeps them open
+// until the rows of those calls are closed.
+type Cache struct {
+	db       *sql.DB
+	capacity int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type cacheEntry struct {
+	query string
+	stmt  *sql.Stmt
+	// refs is the number of calls using the statement, it is closed when no call uses it after being removed.
+	refs    int
+	removed bool
+}
+
+func New(db *sql.DB, capacity int) *Cache {
+	return &Cache{
+		db:       db,
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		lru:      list.New(),
+	}
+}
+
+func (c *Cache) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	entry, err := c.acquire(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return c.db.QueryContext(ctx, query, args...)
+	}
+	defer c.release(entry)
+	rows, err := entry.stmt.QueryContext(ctx, args...)
+	if err != nil {
+		c.invalidate(entry, err)
+		return nil, err
+	}
+	return rows, nil
+}
+
+// Len returns the number of cached statements.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// Reset removes the cached statements, e.g. after the schema is migrated.
+func (c *Cache) Reset() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var errs []error
+	for c.lru.Len() > 0 {
+		if err := c.removeLocked(c.lru.Front()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// acquire returns the cached entry of the query, preparing it on a miss, and holds it until released.
+// It returns nil without an error when the caching is disabled.
+func (c *Cache) acquire(ctx context.Context, query string) (*cacheEntry, error) {
+	if c.capacity <= 0 {
+		return nil, nil
+	}
+	c.mu.Lock()
+	if element, ok := c.entries[query]; ok {
+		c.lru.MoveToFront(element)
+		entry := element.Value.(*cacheEntry)
+		entry.refs++
+		c.mu.Unlock()
+		return entry, nil
+	}
+	c.mu.Unlock()
+
+	// Prepare without holding the lock so that a slow prepare doesn't block the hits.
+	stmt, err := c.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[query]; ok {
+		// Another call prepared the same query in the meantime.
+		_ = stmt.Close()
+		c.lru.MoveToFront(element)
+		entry := element.Value.(*cacheEntry)
+		entry.refs++
+		return entry, nil
+	}
+	entry := &cacheEntry{query: query, stmt: stmt, refs: 1}
+	c.entries[query] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.capacity {
+		_ = c.removeLocked(c.lru.Back())
+	}
+	return entry, nil
+}
+
+func (c *Cache) release(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry.refs--
+	if entry.removed && entry.refs == 0 {
+		_ = entry.stmt.Close()
+	}
+}
+
+// invalidate removes the statement when it failed for another reason than the call being canceled,
+// so that the next call prepares the query again.
+func (c *Cache) invalidate(entry *cacheEntry, err error) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[entry.query]; ok && element.Value.(*cacheEntry) == entry {
+		_ = c.removeLocked(element)
+	}
+}
+
+// removeLocked removes the element from the cache, and closes its statement unless a call still uses it.
+func (c *Cache) removeLocked(element *list.Element) error {
+	entry := element.Value.(*cacheEntry)
+	c.lru.Remove(element)
+	delete(c.entries, entry.query)
+	entry.removed = true
+	if entry.refs > 0 {
+		return nil
+	}
+	return entry.stmt.Close()
+}
@@ -0,0 +1,155 @@
+package stmtcache
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	// SQLite driver.
+	_ "modernc.org/sqlite"
+)
+
+func newTestingDB(t testing.TB) *sql.DB {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "stmtcache.db")+"?_pragma=journal_mode(WAL)")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	_, err = db.Exec(`CREATE TABLE user (id INTEGER PRIMARY KEY, email TEXT NOT NULL, nickname TEXT NOT NULL)`)
+	require.NoError(t, err)
+	for i := 1; i <= 100; i++ {
+		_, err := db.Exec(`INSERT INTO user (email, nickname) VALUES (?, ?)`, fmt.Sprintf("user%d@test.com", i), fmt.Sprintf("user%d", i))
+		require.NoError(t, err)
+	}
+	return db
+}
+
+func listEmails(ctx context.Context, cache *Cache, query string, args ...any) ([]string, error) {
+	rows, err := cache.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	emails := []string{}
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		emails = append(emails, email)
+	}
+	return emails, rows.Err()
+}
+
+func queryEmails(ctx context.Context, t testing.TB, cache *Cache, query string, args ...any) []string {
+	emails, err := listEmails(ctx, cache, query, args...)
+	require.NoError(t, err)
+	return emails
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	db := newTestingDB(t)
+	cache := New(db, 2)
+	byID := `SELECT email FROM user WHERE id = ?`
+	byNickname := `SELECT email FROM user WHERE nickname = ?`
+	byEmail := `SELECT email FROM user WHERE email = ?`
+
+	// Statements are reused across calls with different arguments.
+	require.Equal(t, []string{"user1@test.com"}, queryEmails(ctx, t, cache, byID, 1))
+	require.Equal(t, []string{"user2@test.com"}, queryEmails(ctx, t, cache, byID, 2))
+	require.Equal(t, 1, cache.Len())
+
+	// The least recently used statement is evicted over the capacity.
+	require.Equal(t, []string{"user3@test.com"}, queryEmails(ctx, t, cache, byNickname, "user3"))
+	require.Equal(t, []string{"user1@test.com"}, queryEmails(ctx, t, cache, byID, 1))
+	require.Equal(t, []string{"user4@test.com"}, queryEmails(ctx, t, cache, byEmail, "user4@test.com"))
+	require.Equal(t, 2, cache.Len())
+	cache.mu.Lock()
+	_, ok := cache.entries[byNickname]
+	cache.mu.Unlock()
+	require.False(t, ok)
+
+	// Failing statements are dropped and prepared again once the schema is fixed.
+	_, err := db.Exec(`ALTER TABLE user RENAME TO account`)
+	require.NoError(t, err)
+	_, err = cache.QueryContext(ctx, byID, 1)
+	require.Error(t, err)
+	require.Equal(t, 1, cache.Len())
+	_, err = db.Exec(`ALTER TABLE account RENAME TO user`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"user1@test.com"}, queryEmails(ctx, t, cache, byID, 1))
+
+	require.NoError(t, cache.Reset())
+	require.Equal(t, 0, cache.Len())
+	require.Equal(t, []string{"user1@test.com"}, queryEmails(ctx, t, cache, byID, 1))
+
+	// A zero capacity disables the caching.
+	disabled := New(db, 0)
+	require.Equal(t, []string{"user1@test.com"}, queryEmails(ctx, t, disabled, byID, 1))
+	require.Equal(t, 0, disabled.Len())
+}
+
+func TestCacheConcurrency(t *testing.T) {
+	ctx := context.Background()
+	cache := New(newTestingDB(t), 4)
+
+	// More distinct queries than the capacity keep evicting statements while other calls use them.
+	wg := sync.WaitGroup{}
+	errs := make(chan error, 20*20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				id := (i+j)%10 + 1
+				query := fmt.Sprintf(`SELECT email FROM user WHERE id = ? AND %d = %d`, id, id)
+				emails, err := listEmails(ctx, cache, query, id)
+				if err == nil && (len(emails) != 1 || emails[0] != fmt.Sprintf("user%d@test.com", id)) {
+					err = fmt.Errorf("unexpected emails %v of user %d", emails, id)
+				}
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.LessOrEqual(t, cache.Len(), 4)
+}
+
+func benchmarkQuery(b *testing.B, capacity int) {
+	ctx := context.Background()
+	cache := New(newTestingDB(b), capacity)
+	query := `SELECT email FROM user WHERE id = ? AND nickname != ''`
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		queryEmails(ctx, b, cache, query, i%100+1)
+	}
+}
+
+func BenchmarkQueryPrepared(b *testing.B) {
+	benchmarkQuery(b, 1)
+}
+
+func BenchmarkQueryUnprepared(b *testing.B) {
+	benchmarkQuery(b, 0)
+}
+
+// TestPreparedStatementLatency compares the latency of the same query with and without the cache.
+// The timings depend on the machine, so they are reported rather than asserted.
+func TestPreparedStatementLatency(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the latency comparison in short mode")
+	}
+	prepared := testing.Benchmark(BenchmarkQueryPrepared)
+	unprepared := testing.Benchmark(BenchmarkQueryUnprepared)
+	require.Positive(t, prepared.N)
+	require.Positive(t, unprepared.N)
+	t.Logf("prepared: %s/op, unprepared: %s/op", time.Duration(prepared.NsPerOp()), time.Duration(unprepared.NsPerOp()))
+}
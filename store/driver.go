@@ -9,8 +9,11 @@ import (
 
 // Driver is an interface for store driver.
 // It contains all methods that store database driver should implement.
+// The methods are scoped to the workspace of the context, see GetWorkspaceID.
 type Driver interface {
 	GetDB() *sql.DB
+	// ResetStatementCache closes the cached prepared statements, which may be stale after the schema changes.
+	ResetStatementCache() error
 	Close() error
 
 	// MigrationHistory model related methods.
@@ -20,24 +23,61 @@ type Driver interface {
 	// Activity model related methods.
 	CreateActivity(ctx context.Context, create *Activity) (*Activity, error)
 	ListActivities(ctx context.Context, find *FindActivity) ([]*Activity, error)
+	DeleteActivities(ctx context.Context, delete *DeleteActivity) error
+	GetShortcutViewStats(ctx context.Context, find *FindShortcutViewStats) (*ShortcutViewStats, error)
+	ListShortcutLastViewedTs(ctx context.Context) (map[int32]int64, error)
 
 	// Collection model related methods.
 	CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error)
 	UpdateCollection(ctx context.Context, update *UpdateCollection) (*storepb.Collection, error)
 	ListCollections(ctx context.Context, find *FindCollection) ([]*storepb.Collection, error)
 	DeleteCollection(ctx context.Context, delete *DeleteCollection) error
+	AddCollectionShortcut(ctx context.Context, collectionID, shortcutID int32) error
+	RemoveCollectionShortcut(ctx context.Context, collectionID, shortcutID int32) error
 
 	// Shortcut model related methods.
 	CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error)
 	UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*storepb.Shortcut, error)
+	ImportShortcuts(ctx context.Context, importShortcuts *ImportShortcuts) ([]*storepb.Shortcut, []*storepb.Shortcut, error)
 	ListShortcuts(ctx context.Context, find *FindShortcut) ([]*storepb.Shortcut, error)
+	ListShortcutGroups(ctx context.Context, find *FindShortcutGroup) ([]*ShortcutGroup, error)
+	AddShortcutViews(ctx context.Context, views []*ShortcutViews) error
 	DeleteShortcut(ctx context.Context, delete *DeleteShortcut) error
+	RestoreShortcut(ctx context.Context, restore *RestoreShortcut, deletedTs int64) (*storepb.Shortcut, error)
+	// RebuildShortcutSearchIndex rebuilds the index the keywords of the shortcuts are matched with, when the driver has one.
+	RebuildShortcutSearchIndex(ctx context.Context) error
+
+	// ShortcutTombstone model related methods.
+	SoftDeleteShortcut(ctx context.Context, delete *DeleteShortcut, deletedTs int64) error
+	ListShortcutTombstones(ctx context.Context, find *FindShortcutTombstone) ([]*ShortcutTombstone, error)
+	DeleteShortcutTombstones(ctx context.Context, delete *DeleteShortcutTombstone) error
+
+	// ShortcutThumbnail model related methods.
+	UpsertShortcutThumbnail(ctx context.Context, upsert *ShortcutThumbnail) (*ShortcutThumbnail, error)
+	ListShortcutThumbnails(ctx context.Context, find *FindShortcutThumbnail) ([]*ShortcutThumbnail, error)
+
+	// UserAvatar model related methods.
+	UpsertUserAvatar(ctx context.Context, upsert *UserAvatar) (*UserAvatar, error)
+	ListUserAvatars(ctx context.Context, find *FindUserAvatar) ([]*UserAvatar, error)
+	DeleteUserAvatar(ctx context.Context, delete *DeleteUserAvatar) error
+
+	// ShortcutNameReservation model related methods.
+	ReserveShortcutName(ctx context.Context, reservation *ShortcutNameReservation, nowTs int64) (*ShortcutNameReservation, error)
+	GetShortcutNameReservation(ctx context.Context, find *FindShortcutNameReservation) (*ShortcutNameReservation, error)
+	DeleteShortcutNameReservation(ctx context.Context, delete *DeleteShortcutNameReservation) error
+
+	// TagPolicy model related methods.
+	UpsertTagPolicy(ctx context.Context, upsert *TagPolicy) (*TagPolicy, error)
+	ListTagPolicies(ctx context.Context, find *FindTagPolicy) ([]*TagPolicy, error)
+	DeleteTagPolicy(ctx context.Context, delete *DeleteTagPolicy) error
 
 	// User model related methods.
 	CreateUser(ctx context.Context, create *User) (*User, error)
+	// CreateUsers creates all the users in a transaction.
+	CreateUsers(ctx context.Context, creates []*User) ([]*User, error)
 	UpdateUser(ctx context.Context, update *UpdateUser) (*User, error)
 	ListUsers(ctx context.Context, find *FindUser) ([]*User, error)
-	DeleteUser(ctx context.Context, delete *DeleteUser) error
+	DeleteUser(ctx context.Context, delete *DeleteUser, deletedTs int64) error
 
 	// UserSetting model related methods.
 	UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error)
@@ -0,0 +1,363 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// Driver is a store driver that records the metrics of the operations of the wrapped driver.
+type Driver struct {
+	driver  store.Driver
+	metrics *Metrics
+}
+
+// NewDriver wraps the driver to record the metrics of its operations.
+func NewDriver(driver store.Driver, metrics *Metrics) *Driver {
+	return &Driver{
+		driver:  driver,
+		metrics: metrics,
+	}
+}
+
+// ObserveCache records the lookups in the store caches, the driver being a store.CacheObserver.
+func (d *Driver) ObserveCache(cache string, hit bool) {
+	d.metrics.ObserveCache(cache, hit)
+}
+
+func (d *Driver) GetDB() *sql.DB {
+	return d.driver.GetDB()
+}
+
+func (d *Driver) ResetStatementCache() error {
+	return d.driver.ResetStatementCache()
+}
+
+func (d *Driver) Close() error {
+	return d.driver.Close()
+}
+
+func (d *Driver) UpsertMigrationHistory(ctx context.Context, upsert *store.UpsertMigrationHistory) (*store.MigrationHistory, error) {
+	start := time.Now()
+	result, err := d.driver.UpsertMigrationHistory(ctx, upsert)
+	d.metrics.Observe("UpsertMigrationHistory", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListMigrationHistories(ctx context.Context, find *store.FindMigrationHistory) ([]*store.MigrationHistory, error) {
+	start := time.Now()
+	result, err := d.driver.ListMigrationHistories(ctx, find)
+	d.metrics.Observe("ListMigrationHistories", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) CreateActivity(ctx context.Context, create *store.Activity) (*store.Activity, error) {
+	start := time.Now()
+	result, err := d.driver.CreateActivity(ctx, create)
+	d.metrics.Observe("CreateActivity", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListActivities(ctx context.Context, find *store.FindActivity) ([]*store.Activity, error) {
+	start := time.Now()
+	result, err := d.driver.ListActivities(ctx, find)
+	d.metrics.Observe("ListActivities", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) DeleteActivities(ctx context.Context, delete *store.DeleteActivity) error {
+	start := time.Now()
+	err := d.driver.DeleteActivities(ctx, delete)
+	d.metrics.Observe("DeleteActivities", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) GetShortcutViewStats(ctx context.Context, find *store.FindShortcutViewStats) (*store.ShortcutViewStats, error) {
+	start := time.Now()
+	result, err := d.driver.GetShortcutViewStats(ctx, find)
+	d.metrics.Observe("GetShortcutViewStats", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListShortcutLastViewedTs(ctx context.Context) (map[int32]int64, error) {
+	start := time.Now()
+	result, err := d.driver.ListShortcutLastViewedTs(ctx)
+	d.metrics.Observe("ListShortcutLastViewedTs", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
+	start := time.Now()
+	result, err := d.driver.CreateCollection(ctx, create)
+	d.metrics.Observe("CreateCollection", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) UpdateCollection(ctx context.Context, update *store.UpdateCollection) (*storepb.Collection, error) {
+	start := time.Now()
+	result, err := d.driver.UpdateCollection(ctx, update)
+	d.metrics.Observe("UpdateCollection", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListCollections(ctx context.Context, find *store.FindCollection) ([]*storepb.Collection, error) {
+	start := time.Now()
+	result, err := d.driver.ListCollections(ctx, find)
+	d.metrics.Observe("ListCollections", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) DeleteCollection(ctx context.Context, delete *store.DeleteCollection) error {
+	start := time.Now()
+	err := d.driver.DeleteCollection(ctx, delete)
+	d.metrics.Observe("DeleteCollection", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) AddCollectionShortcut(ctx context.Context, collectionID, shortcutID int32) error {
+	start := time.Now()
+	err := d.driver.AddCollectionShortcut(ctx, collectionID, shortcutID)
+	d.metrics.Observe("AddCollectionShortcut", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) RemoveCollectionShortcut(ctx context.Context, collectionID, shortcutID int32) error {
+	start := time.Now()
+	err := d.driver.RemoveCollectionShortcut(ctx, collectionID, shortcutID)
+	d.metrics.Observe("RemoveCollectionShortcut", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
+	start := time.Now()
+	result, err := d.driver.CreateShortcut(ctx, create)
+	d.metrics.Observe("CreateShortcut", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
+	start := time.Now()
+	result, err := d.driver.UpdateShortcut(ctx, update)
+	d.metrics.Observe("UpdateShortcut", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ImportShortcuts(ctx context.Context, importShortcuts *store.ImportShortcuts) ([]*storepb.Shortcut, []*storepb.Shortcut, error) {
+	start := time.Now()
+	created, updated, err := d.driver.ImportShortcuts(ctx, importShortcuts)
+	d.metrics.Observe("ImportShortcuts", time.Since(start), err)
+	return created, updated, err
+}
+
+func (d *Driver) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*storepb.Shortcut, error) {
+	start := time.Now()
+	result, err := d.driver.ListShortcuts(ctx, find)
+	d.metrics.Observe("ListShortcuts", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListShortcutGroups(ctx context.Context, find *store.FindShortcutGroup) ([]*store.ShortcutGroup, error) {
+	start := time.Now()
+	result, err := d.driver.ListShortcutGroups(ctx, find)
+	d.metrics.Observe("ListShortcutGroups", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) RebuildShortcutSearchIndex(ctx context.Context) error {
+	start := time.Now()
+	err := d.driver.RebuildShortcutSearchIndex(ctx)
+	d.metrics.Observe("RebuildShortcutSearchIndex", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) AddShortcutViews(ctx context.Context, views []*store.ShortcutViews) error {
+	start := time.Now()
+	err := d.driver.AddShortcutViews(ctx, views)
+	d.metrics.Observe("AddShortcutViews", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) DeleteShortcut(ctx context.Context, delete *store.DeleteShortcut) error {
+	start := time.Now()
+	err := d.driver.DeleteShortcut(ctx, delete)
+	d.metrics.Observe("DeleteShortcut", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) RestoreShortcut(ctx context.Context, restore *store.RestoreShortcut, deletedTs int64) (*storepb.Shortcut, error) {
+	start := time.Now()
+	result, err := d.driver.RestoreShortcut(ctx, restore, deletedTs)
+	d.metrics.Observe("RestoreShortcut", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) SoftDeleteShortcut(ctx context.Context, delete *store.DeleteShortcut, deletedTs int64) error {
+	start := time.Now()
+	err := d.driver.SoftDeleteShortcut(ctx, delete, deletedTs)
+	d.metrics.Observe("SoftDeleteShortcut", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) ListShortcutTombstones(ctx context.Context, find *store.FindShortcutTombstone) ([]*store.ShortcutTombstone, error) {
+	start := time.Now()
+	result, err := d.driver.ListShortcutTombstones(ctx, find)
+	d.metrics.Observe("ListShortcutTombstones", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) DeleteShortcutTombstones(ctx context.Context, delete *store.DeleteShortcutTombstone) error {
+	start := time.Now()
+	err := d.driver.DeleteShortcutTombstones(ctx, delete)
+	d.metrics.Observe("DeleteShortcutTombstones", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) UpsertShortcutThumbnail(ctx context.Context, upsert *store.ShortcutThumbnail) (*store.ShortcutThumbnail, error) {
+	start := time.Now()
+	result, err := d.driver.UpsertShortcutThumbnail(ctx, upsert)
+	d.metrics.Observe("UpsertShortcutThumbnail", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListShortcutThumbnails(ctx context.Context, find *store.FindShortcutThumbnail) ([]*store.ShortcutThumbnail, error) {
+	start := time.Now()
+	result, err := d.driver.ListShortcutThumbnails(ctx, find)
+	d.metrics.Observe("ListShortcutThumbnails", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) UpsertUserAvatar(ctx context.Context, upsert *store.UserAvatar) (*store.UserAvatar, error) {
+	start := time.Now()
+	result, err := d.driver.UpsertUserAvatar(ctx, upsert)
+	d.metrics.Observe("UpsertUserAvatar", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListUserAvatars(ctx context.Context, find *store.FindUserAvatar) ([]*store.UserAvatar, error) {
+	start := time.Now()
+	result, err := d.driver.ListUserAvatars(ctx, find)
+	d.metrics.Observe("ListUserAvatars", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) DeleteUserAvatar(ctx context.Context, delete *store.DeleteUserAvatar) error {
+	start := time.Now()
+	err := d.driver.DeleteUserAvatar(ctx, delete)
+	d.metrics.Observe("DeleteUserAvatar", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) ReserveShortcutName(ctx context.Context, reservation *store.ShortcutNameReservation, nowTs int64) (*store.ShortcutNameReservation, error) {
+	start := time.Now()
+	result, err := d.driver.ReserveShortcutName(ctx, reservation, nowTs)
+	d.metrics.Observe("ReserveShortcutName", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) GetShortcutNameReservation(ctx context.Context, find *store.FindShortcutNameReservation) (*store.ShortcutNameReservation, error) {
+	start := time.Now()
+	result, err := d.driver.GetShortcutNameReservation(ctx, find)
+	d.metrics.Observe("GetShortcutNameReservation", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) DeleteShortcutNameReservation(ctx context.Context, delete *store.DeleteShortcutNameReservation) error {
+	start := time.Now()
+	err := d.driver.DeleteShortcutNameReservation(ctx, delete)
+	d.metrics.Observe("DeleteShortcutNameReservation", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) UpsertTagPolicy(ctx context.Context, upsert *store.TagPolicy) (*store.TagPolicy, error) {
+	start := time.Now()
+	result, err := d.driver.UpsertTagPolicy(ctx, upsert)
+	d.metrics.Observe("UpsertTagPolicy", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListTagPolicies(ctx context.Context, find *store.FindTagPolicy) ([]*store.TagPolicy, error) {
+	start := time.Now()
+	result, err := d.driver.ListTagPolicies(ctx, find)
+	d.metrics.Observe("ListTagPolicies", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) DeleteTagPolicy(ctx context.Context, delete *store.DeleteTagPolicy) error {
+	start := time.Now()
+	err := d.driver.DeleteTagPolicy(ctx, delete)
+	d.metrics.Observe("DeleteTagPolicy", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) CreateUser(ctx context.Context, create *store.User) (*store.User, error) {
+	start := time.Now()
+	result, err := d.driver.CreateUser(ctx, create)
+	d.metrics.Observe("CreateUser", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) CreateUsers(ctx context.Context, creates []*store.User) ([]*store.User, error) {
+	start := time.Now()
+	result, err := d.driver.CreateUsers(ctx, creates)
+	d.metrics.Observe("CreateUsers", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.User, error) {
+	start := time.Now()
+	result, err := d.driver.UpdateUser(ctx, update)
+	d.metrics.Observe("UpdateUser", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User, error) {
+	start := time.Now()
+	result, err := d.driver.ListUsers(ctx, find)
+	d.metrics.Observe("ListUsers", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) DeleteUser(ctx context.Context, delete *store.DeleteUser, deletedTs int64) error {
+	start := time.Now()
+	err := d.driver.DeleteUser(ctx, delete, deletedTs)
+	d.metrics.Observe("DeleteUser", time.Since(start), err)
+	return err
+}
+
+func (d *Driver) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error) {
+	start := time.Now()
+	result, err := d.driver.UpsertUserSetting(ctx, upsert)
+	d.metrics.Observe("UpsertUserSetting", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListUserSettings(ctx context.Context, find *store.FindUserSetting) ([]*storepb.UserSetting, error) {
+	start := time.Now()
+	result, err := d.driver.ListUserSettings(ctx, find)
+	d.metrics.Observe("ListUserSettings", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.WorkspaceSetting) (*storepb.WorkspaceSetting, error) {
+	start := time.Now()
+	result, err := d.driver.UpsertWorkspaceSetting(ctx, upsert)
+	d.metrics.Observe("UpsertWorkspaceSetting", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspaceSetting) ([]*storepb.WorkspaceSetting, error) {
+	start := time.Now()
+	result, err := d.driver.ListWorkspaceSettings(ctx, find)
+	d.metrics.Observe("ListWorkspaceSettings", time.Since(start), err)
+	return result, err
+}
+
+func (d *Driver) DeleteWorkspaceSetting(ctx context.Context, key storepb.WorkspaceSettingKey) error {
+	start := time.Now()
+	err := d.driver.DeleteWorkspaceSetting(ctx, key)
+	d.metrics.Observe("DeleteWorkspaceSetting", time.Since(start), err)
+	return err
+}
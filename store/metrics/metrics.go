@@ -0,0 +1,187 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+)
+
+// latencyBuckets are the upper bounds in seconds of the latency histogram buckets.
+var latencyBuckets = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5}
+
+// Metrics collects the number, errors and latencies of the store operations, and the hits and misses of the store
+// caches.
+type Metrics struct {
+	mu         sync.Mutex
+	operations map[string]*operationMetrics
+	caches     map[string]*CacheStats
+}
+
+type operationMetrics struct {
+	count  uint64
+	errors uint64
+	// buckets holds the cumulative counts of latencyBuckets.
+	buckets []uint64
+	sum     time.Duration
+}
+
+// OperationStats is a snapshot of the metrics of an operation.
+type OperationStats struct {
+	Count  uint64
+	Errors uint64
+	Total  time.Duration
+}
+
+// CacheStats is a snapshot of the metrics of a cache.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+func New() *Metrics {
+	return &Metrics{
+		operations: map[string]*operationMetrics{},
+		caches:     map[string]*CacheStats{},
+	}
+}
+
+// Observe records an operation that took duration and failed with err if not nil.
+func (m *Metrics) Observe(operation string, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics, ok := m.operations[operation]
+	if !ok {
+		metrics = &operationMetrics{buckets: make([]uint64, len(latencyBuckets))}
+		m.operations[operation] = metrics
+	}
+	metrics.count++
+	if err != nil {
+		metrics.errors++
+	}
+	metrics.sum += duration
+	for i, bound := range latencyBuckets {
+		if duration.Seconds() <= bound {
+			metrics.buckets[i]++
+		}
+	}
+}
+
+// ObserveCache records a lookup in the cache.
+func (m *Metrics) ObserveCache(cache string, hit bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats, ok := m.caches[cache]
+	if !ok {
+		stats = &CacheStats{}
+		m.caches[cache] = stats
+	}
+	if hit {
+		stats.Hits++
+	} else {
+		stats.Misses++
+	}
+}
+
+// CacheStats returns the metrics of the cache.
+func (m *Metrics) CacheStats(cache string) CacheStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats, ok := m.caches[cache]
+	if !ok {
+		return CacheStats{}
+	}
+	return *stats
+}
+
+// Stats returns the metrics of the operation.
+func (m *Metrics) Stats(operation string) OperationStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics, ok := m.operations[operation]
+	if !ok {
+		return OperationStats{}
+	}
+	return OperationStats{
+		Count:  metrics.count,
+		Errors: metrics.errors,
+		Total:  metrics.sum,
+	}
+}
+
+// WritePrometheus writes the metrics in the Prometheus text exposition format.
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	operations := make([]string, 0, len(m.operations))
+	for operation := range m.operations {
+		operations = append(operations, operation)
+	}
+	slices.Sort(operations)
+
+	if _, err := fmt.Fprint(w, "# HELP slash_store_operations_total The number of store operations.\n# TYPE slash_store_operations_total counter\n"); err != nil {
+		return err
+	}
+	for _, operation := range operations {
+		if _, err := fmt.Fprintf(w, "slash_store_operations_total{operation=%q} %d\n", operation, m.operations[operation].count); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprint(w, "# HELP slash_store_operation_errors_total The number of failed store operations.\n# TYPE slash_store_operation_errors_total counter\n"); err != nil {
+		return err
+	}
+	for _, operation := range operations {
+		if _, err := fmt.Fprintf(w, "slash_store_operation_errors_total{operation=%q} %d\n", operation, m.operations[operation].errors); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprint(w, "# HELP slash_store_operation_duration_seconds The latency of store operations.\n# TYPE slash_store_operation_duration_seconds histogram\n"); err != nil {
+		return err
+	}
+	for _, operation := range operations {
+		metrics := m.operations[operation]
+		for i, bound := range latencyBuckets {
+			if _, err := fmt.Fprintf(w, "slash_store_operation_duration_seconds_bucket{operation=%q,le=\"%g\"} %d\n", operation, bound, metrics.buckets[i]); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "slash_store_operation_duration_seconds_bucket{operation=%q,le=\"+Inf\"} %d\n", operation, metrics.count); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "slash_store_operation_duration_seconds_sum{operation=%q} %g\n", operation, metrics.sum.Seconds()); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "slash_store_operation_duration_seconds_count{operation=%q} %d\n", operation, metrics.count); err != nil {
+			return err
+		}
+	}
+
+	caches := make([]string, 0, len(m.caches))
+	for cache := range m.caches {
+		caches = append(caches, cache)
+	}
+	slices.Sort(caches)
+	if _, err := fmt.Fprint(w, "# HELP slash_store_cache_hits_total The number of store cache hits.\n# TYPE slash_store_cache_hits_total counter\n"); err != nil {
+		return err
+	}
+	for _, cache := range caches {
+		if _, err := fmt.Fprintf(w, "slash_store_cache_hits_total{cache=%q} %d\n", cache, m.caches[cache].Hits); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprint(w, "# HELP slash_store_cache_misses_total The number of store cache misses.\n# TYPE slash_store_cache_misses_total counter\n"); err != nil {
+		return err
+	}
+	for _, cache := range caches {
+		if _, err := fmt.Fprintf(w, "slash_store_cache_misses_total{cache=%q} %d\n", cache, m.caches[cache].Misses); err != nil {
+			return err
+		}
+	}
+	return nil
+}
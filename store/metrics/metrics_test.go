@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestDriverMetrics(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("only runs against a fresh sqlite database")
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	storeMetrics := New()
+	ts := store.New(NewDriver(dbDriver, storeMetrics), profile)
+	require.NoError(t, ts.Migrate(ctx))
+
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "test@test.com",
+		Nickname: "test_nickname",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), storeMetrics.Stats("CreateUser").Count)
+	before := storeMetrics.Stats("ListUsers").Count
+	for i := 0; i < 3; i++ {
+		_, err := ts.ListUsers(ctx, &store.FindUser{ID: &user.ID})
+		require.NoError(t, err)
+	}
+	require.Equal(t, before+3, storeMetrics.Stats("ListUsers").Count)
+	require.Zero(t, storeMetrics.Stats("ListUsers").Errors)
+
+	// Failures are counted as errors.
+	_, err = ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "test@test.com",
+		Nickname: "test_nickname",
+	})
+	require.Error(t, err)
+	require.Equal(t, uint64(2), storeMetrics.Stats("CreateUser").Count)
+	require.Equal(t, uint64(1), storeMetrics.Stats("CreateUser").Errors)
+
+	var sb strings.Builder
+	require.NoError(t, storeMetrics.WritePrometheus(&sb))
+	require.Contains(t, sb.String(), `slash_store_operations_total{operation="CreateUser"} 2`)
+	require.Contains(t, sb.String(), `slash_store_operation_errors_total{operation="CreateUser"} 1`)
+	require.Contains(t, sb.String(), `slash_store_operation_duration_seconds_count{operation="CreateUser"} 2`)
+	require.Contains(t, sb.String(), `slash_store_operation_duration_seconds_bucket{operation="CreateUser",le="+Inf"} 2`)
+}
+
+func TestCacheMetrics(t *testing.T) {
+	storeMetrics := New()
+	NewDriver(nil, storeMetrics).ObserveCache("shortcut_name", false)
+	storeMetrics.ObserveCache("shortcut_name", true)
+	storeMetrics.ObserveCache("shortcut_name", true)
+	require.Equal(t, CacheStats{Hits: 2, Misses: 1}, storeMetrics.CacheStats("shortcut_name"))
+	require.Zero(t, storeMetrics.CacheStats("other"))
+
+	var sb strings.Builder
+	require.NoError(t, storeMetrics.WritePrometheus(&sb))
+	require.Contains(t, sb.String(), `slash_store_cache_hits_total{cache="shortcut_name"} 2`)
+	require.Contains(t, sb.String(), `slash_store_cache_misses_total{cache="shortcut_name"} 1`)
+}
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN localizations TEXT NOT NULL DEFAULT '{}';
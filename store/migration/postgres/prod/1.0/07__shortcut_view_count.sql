@@ -0,0 +1,7 @@
+ALTER TABLE shortcut ADD COLUMN view_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE shortcut ADD COLUMN last_viewed_ts BIGINT NOT NULL DEFAULT 0;
+
+UPDATE shortcut SET
+  view_count = (SELECT COUNT(*) FROM activity WHERE activity.type = 'shortcut.view' AND CAST(activity.payload::JSON->>'shortcutId' AS INTEGER) = shortcut.id),
+  last_viewed_ts = COALESCE((SELECT MAX(created_ts) FROM activity WHERE activity.type = 'shortcut.view' AND CAST(activity.payload::JSON->>'shortcutId' AS INTEGER) = shortcut.id), 0);
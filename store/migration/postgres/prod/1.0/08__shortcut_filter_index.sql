@@ -0,0 +1,3 @@
+CREATE INDEX idx_shortcut_visibility_row_status_tag ON shortcut(visibility, row_status, tag);
+
+CREATE INDEX idx_shortcut_creator_id_row_status ON shortcut(creator_id, row_status);
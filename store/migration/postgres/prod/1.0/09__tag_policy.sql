@@ -0,0 +1,8 @@
+CREATE TABLE tag_policy (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  tag TEXT NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('READ', 'MANAGE')),
+  UNIQUE(tag, user_id)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE shortcut DROP CONSTRAINT IF EXISTS shortcut_name_key;
+
+CREATE UNIQUE INDEX idx_shortcut_active_name ON shortcut(name) WHERE row_status = 'NORMAL';
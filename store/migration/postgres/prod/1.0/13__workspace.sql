@@ -0,0 +1,36 @@
+-- workspace_setting
+ALTER TABLE workspace_setting ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE workspace_setting DROP CONSTRAINT IF EXISTS workspace_setting_key_key;
+
+ALTER TABLE workspace_setting ADD CONSTRAINT workspace_setting_workspace_id_key_key UNIQUE (workspace_id, key);
+
+-- user
+ALTER TABLE "user" ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE "user" DROP CONSTRAINT IF EXISTS user_email_key;
+
+ALTER TABLE "user" ADD CONSTRAINT user_workspace_id_email_key UNIQUE (workspace_id, email);
+
+-- shortcut
+ALTER TABLE shortcut ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS idx_shortcut_active_name;
+
+CREATE UNIQUE INDEX idx_shortcut_active_name ON shortcut(workspace_id, name) WHERE row_status = 'NORMAL';
+
+-- activity
+ALTER TABLE activity ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
+
+-- collection
+ALTER TABLE collection ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE collection DROP CONSTRAINT IF EXISTS collection_name_key;
+
+ALTER TABLE collection ADD CONSTRAINT collection_workspace_id_name_key UNIQUE (workspace_id, name);
+
+-- shortcut_tombstone
+ALTER TABLE shortcut_tombstone ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
+
+-- tag_policy
+ALTER TABLE tag_policy ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_shortcut_active_name;
+
+CREATE UNIQUE INDEX idx_shortcut_active_name ON shortcut(workspace_id, name) WHERE row_status = 'NORMAL' AND visibility != 'PRIVATE';
+
+CREATE UNIQUE INDEX idx_shortcut_active_private_name ON shortcut(workspace_id, creator_id, name) WHERE row_status = 'NORMAL' AND visibility = 'PRIVATE';
@@ -0,0 +1,3 @@
+ALTER TABLE "user" ADD COLUMN failed_sign_in_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE "user" ADD COLUMN locked_until_ts BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- The full-text search index of the shortcuts is SQLite only, the keyword search of PostgreSQL keeps using ILIKE.
+SELECT 1;
@@ -0,0 +1,7 @@
+CREATE TABLE user_avatar (
+  user_id INTEGER PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content_type TEXT NOT NULL,
+  content BYTEA NOT NULL,
+  workspace_id TEXT NOT NULL DEFAULT ''
+);
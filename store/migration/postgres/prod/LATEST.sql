@@ -6,8 +6,10 @@ CREATE TABLE migration_history (
 
 -- workspace_setting
 CREATE TABLE workspace_setting (
-  key TEXT NOT NULL UNIQUE,
-  value TEXT NOT NULL
+  key TEXT NOT NULL,
+  value TEXT NOT NULL,
+  workspace_id TEXT NOT NULL DEFAULT '',
+  UNIQUE(workspace_id, key)
 );
 
 -- user
@@ -16,10 +18,14 @@ CREATE TABLE "user" (
   created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
-  email TEXT NOT NULL UNIQUE,
+  email TEXT NOT NULL,
   nickname TEXT NOT NULL,
   password_hash TEXT NOT NULL,
-  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'USER')) DEFAULT 'USER'
+  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'USER')) DEFAULT 'USER',
+  workspace_id TEXT NOT NULL DEFAULT '',
+  failed_sign_in_count INTEGER NOT NULL DEFAULT 0,
+  locked_until_ts BIGINT NOT NULL DEFAULT 0,
+  UNIQUE(workspace_id, email)
 );
 
 CREATE INDEX idx_user_email ON "user"(email);
@@ -39,17 +45,34 @@ CREATE TABLE shortcut (
   created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
-  name TEXT NOT NULL UNIQUE,
+  name TEXT NOT NULL,
   link TEXT NOT NULL,
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
   visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
   tag TEXT NOT NULL DEFAULT '',
-  og_metadata TEXT NOT NULL DEFAULT '{}'
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  redirect_rate_limit INTEGER NOT NULL DEFAULT 0,
+  summary TEXT NOT NULL DEFAULT '',
+  meta_refresh_redirect BOOLEAN NOT NULL DEFAULT FALSE,
+  localizations TEXT NOT NULL DEFAULT '{}',
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_ts BIGINT NOT NULL DEFAULT 0,
+  expire_ts BIGINT NOT NULL DEFAULT 0,
+  favicon_url TEXT NOT NULL DEFAULT '',
+  workspace_id TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_shortcut_name ON shortcut(name);
 
+CREATE INDEX idx_shortcut_visibility_row_status_tag ON shortcut(visibility, row_status, tag);
+
+CREATE INDEX idx_shortcut_creator_id_row_status ON shortcut(creator_id, row_status);
+
+CREATE UNIQUE INDEX idx_shortcut_active_name ON shortcut(workspace_id, name) WHERE row_status = 'NORMAL' AND visibility != 'PRIVATE';
+
+CREATE UNIQUE INDEX idx_shortcut_active_private_name ON shortcut(workspace_id, creator_id, name) WHERE row_status = 'NORMAL' AND visibility = 'PRIVATE';
+
 -- activity
 CREATE TABLE activity (
   id SERIAL PRIMARY KEY,
@@ -57,7 +80,8 @@ CREATE TABLE activity (
   created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   type TEXT NOT NULL DEFAULT '',
   level TEXT NOT NULL CHECK (level IN ('INFO', 'WARN', 'ERROR')) DEFAULT 'INFO',
-  payload TEXT NOT NULL DEFAULT '{}'
+  payload TEXT NOT NULL DEFAULT '{}',
+  workspace_id TEXT NOT NULL DEFAULT ''
 );
 
 -- collection
@@ -66,11 +90,66 @@ CREATE TABLE collection (
   creator_id INTEGER REFERENCES "user"(id) NOT NULL,
   created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
-  name TEXT NOT NULL UNIQUE,
+  name TEXT NOT NULL,
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
   shortcut_ids INTEGER ARRAY NOT NULL,
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE'
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  workspace_id TEXT NOT NULL DEFAULT '',
+  share_token_hash TEXT NOT NULL DEFAULT '',
+  UNIQUE(workspace_id, name)
 );
 
 CREATE INDEX idx_collection_name ON collection(name);
+
+CREATE UNIQUE INDEX idx_collection_share_token_hash ON collection(share_token_hash) WHERE share_token_hash != '';
+
+-- shortcut_name_reservation
+CREATE TABLE shortcut_name_reservation (
+  namespace TEXT NOT NULL DEFAULT '',
+  name TEXT NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) NOT NULL,
+  expires_ts BIGINT NOT NULL,
+  UNIQUE(namespace, name)
+);
+
+-- shortcut_tombstone
+CREATE TABLE shortcut_tombstone (
+  shortcut_id INTEGER PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  deleted_ts BIGINT NOT NULL,
+  workspace_id TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_shortcut_tombstone_deleted_ts ON shortcut_tombstone(deleted_ts);
+
+-- shortcut_thumbnail
+CREATE TABLE shortcut_thumbnail (
+  shortcut_id INTEGER PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  link TEXT NOT NULL,
+  content_type TEXT NOT NULL,
+  content BYTEA NOT NULL,
+  workspace_id TEXT NOT NULL DEFAULT ''
+);
+
+-- tag_policy
+CREATE TABLE tag_policy (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  tag TEXT NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('READ', 'MANAGE')),
+  workspace_id TEXT NOT NULL DEFAULT '',
+  UNIQUE(tag, user_id)
+);
+
+-- user_avatar
+CREATE TABLE user_avatar (
+  user_id INTEGER PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content_type TEXT NOT NULL,
+  content BYTEA NOT NULL,
+  workspace_id TEXT NOT NULL DEFAULT ''
+);
@@ -0,0 +1,7 @@
+CREATE TABLE shortcut_name_reservation (
+  namespace TEXT NOT NULL DEFAULT '',
+  name TEXT NOT NULL,
+  user_id INTEGER NOT NULL,
+  expires_ts BIGINT NOT NULL,
+  UNIQUE(namespace, name)
+);
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN redirect_rate_limit INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+CREATE TABLE shortcut_tombstone (
+  shortcut_id INTEGER PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  deleted_ts BIGINT NOT NULL
+);
+
+CREATE INDEX idx_shortcut_tombstone_deleted_ts ON shortcut_tombstone(deleted_ts);
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN summary TEXT NOT NULL DEFAULT '';
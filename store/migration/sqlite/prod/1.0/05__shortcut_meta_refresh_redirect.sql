@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN meta_refresh_redirect INTEGER NOT NULL DEFAULT 0;
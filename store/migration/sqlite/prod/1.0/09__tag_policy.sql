@@ -0,0 +1,8 @@
+CREATE TABLE tag_policy (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  tag TEXT NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('READ', 'MANAGE')),
+  UNIQUE(tag, user_id)
+);
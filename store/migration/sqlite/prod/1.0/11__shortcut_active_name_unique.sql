@@ -0,0 +1,76 @@
+ALTER TABLE shortcut RENAME TO shortcut_old;
+
+CREATE TABLE shortcut (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  name TEXT NOT NULL,
+  link TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  tag TEXT NOT NULL DEFAULT '',
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  redirect_rate_limit INTEGER NOT NULL DEFAULT 0,
+  summary TEXT NOT NULL DEFAULT '',
+  meta_refresh_redirect INTEGER NOT NULL DEFAULT 0,
+  localizations TEXT NOT NULL DEFAULT '{}',
+  view_count INTEGER NOT NULL DEFAULT 0,
+  last_viewed_ts BIGINT NOT NULL DEFAULT 0,
+  expire_ts BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO shortcut (
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  row_status,
+  name,
+  link,
+  title,
+  description,
+  visibility,
+  tag,
+  og_metadata,
+  redirect_rate_limit,
+  summary,
+  meta_refresh_redirect,
+  localizations,
+  view_count,
+  last_viewed_ts,
+  expire_ts
+)
+SELECT
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  row_status,
+  name,
+  link,
+  title,
+  description,
+  visibility,
+  tag,
+  og_metadata,
+  redirect_rate_limit,
+  summary,
+  meta_refresh_redirect,
+  localizations,
+  view_count,
+  last_viewed_ts,
+  expire_ts
+FROM shortcut_old;
+
+DROP TABLE shortcut_old;
+
+CREATE INDEX idx_shortcut_name ON shortcut(name);
+
+CREATE INDEX idx_shortcut_visibility_row_status_tag ON shortcut(visibility, row_status, tag);
+
+CREATE INDEX idx_shortcut_creator_id_row_status ON shortcut(creator_id, row_status);
+
+CREATE UNIQUE INDEX idx_shortcut_active_name ON shortcut(name) WHERE row_status = 'NORMAL';
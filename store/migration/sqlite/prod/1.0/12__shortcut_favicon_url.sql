@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN favicon_url TEXT NOT NULL DEFAULT '';
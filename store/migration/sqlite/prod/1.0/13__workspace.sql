@@ -0,0 +1,77 @@
+-- workspace_setting
+ALTER TABLE workspace_setting RENAME TO workspace_setting_old;
+
+CREATE TABLE workspace_setting (
+  key TEXT NOT NULL,
+  value TEXT NOT NULL,
+  workspace_id TEXT NOT NULL DEFAULT '',
+  UNIQUE(workspace_id, key)
+);
+
+INSERT INTO workspace_setting (key, value)
+SELECT key, value FROM workspace_setting_old;
+
+DROP TABLE workspace_setting_old;
+
+-- user
+ALTER TABLE user RENAME TO user_old;
+
+CREATE TABLE user (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  email TEXT NOT NULL,
+  nickname TEXT NOT NULL,
+  password_hash TEXT NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'USER')) DEFAULT 'USER',
+  workspace_id TEXT NOT NULL DEFAULT '',
+  UNIQUE(workspace_id, email)
+);
+
+INSERT INTO user (id, created_ts, updated_ts, row_status, email, nickname, password_hash, role)
+SELECT id, created_ts, updated_ts, row_status, email, nickname, password_hash, role FROM user_old;
+
+DROP TABLE user_old;
+
+CREATE INDEX idx_user_email ON user(email);
+
+-- shortcut
+ALTER TABLE shortcut ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS idx_shortcut_active_name;
+
+CREATE UNIQUE INDEX idx_shortcut_active_name ON shortcut(workspace_id, name) WHERE row_status = 'NORMAL';
+
+-- activity
+ALTER TABLE activity ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
+
+-- collection
+ALTER TABLE collection RENAME TO collection_old;
+
+CREATE TABLE collection (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  shortcut_ids INTEGER[] NOT NULL,
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  workspace_id TEXT NOT NULL DEFAULT '',
+  UNIQUE(workspace_id, name)
+);
+
+INSERT INTO collection (id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility)
+SELECT id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility FROM collection_old;
+
+DROP TABLE collection_old;
+
+CREATE INDEX idx_collection_name ON collection(name);
+
+-- shortcut_tombstone
+ALTER TABLE shortcut_tombstone ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
+
+-- tag_policy
+ALTER TABLE tag_policy ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,8 @@
+CREATE TABLE shortcut_thumbnail (
+  shortcut_id INTEGER PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  link TEXT NOT NULL,
+  content_type TEXT NOT NULL,
+  content BLOB NOT NULL,
+  workspace_id TEXT NOT NULL DEFAULT ''
+);
@@ -0,0 +1,24 @@
+CREATE VIRTUAL TABLE shortcut_fts USING fts5(
+  name,
+  title,
+  description,
+  tag,
+  content = 'shortcut',
+  content_rowid = 'id',
+  tokenize = 'trigram'
+);
+
+CREATE TRIGGER shortcut_fts_insert AFTER INSERT ON shortcut BEGIN
+  INSERT INTO shortcut_fts (rowid, name, title, description, tag) VALUES (new.id, new.name, new.title, new.description, new.tag);
+END;
+
+CREATE TRIGGER shortcut_fts_delete AFTER DELETE ON shortcut BEGIN
+  INSERT INTO shortcut_fts (shortcut_fts, rowid, name, title, description, tag) VALUES ('delete', old.id, old.name, old.title, old.description, old.tag);
+END;
+
+CREATE TRIGGER shortcut_fts_update AFTER UPDATE OF name, title, description, tag ON shortcut BEGIN
+  INSERT INTO shortcut_fts (shortcut_fts, rowid, name, title, description, tag) VALUES ('delete', old.id, old.name, old.title, old.description, old.tag);
+  INSERT INTO shortcut_fts (rowid, name, title, description, tag) VALUES (new.id, new.name, new.title, new.description, new.tag);
+END;
+
+INSERT INTO shortcut_fts (shortcut_fts) VALUES ('rebuild');
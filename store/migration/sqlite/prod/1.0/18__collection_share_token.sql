@@ -0,0 +1,3 @@
+ALTER TABLE collection ADD COLUMN share_token_hash TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX idx_collection_share_token_hash ON collection(share_token_hash) WHERE share_token_hash != '';
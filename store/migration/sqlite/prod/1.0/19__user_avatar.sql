@@ -0,0 +1,7 @@
+CREATE TABLE user_avatar (
+  user_id INTEGER PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  content_type TEXT NOT NULL,
+  content BLOB NOT NULL,
+  workspace_id TEXT NOT NULL DEFAULT ''
+);
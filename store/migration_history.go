@@ -11,3 +11,21 @@ type UpsertMigrationHistory struct {
 
 type FindMigrationHistory struct {
 }
+
+// MigrationStatus is the state of the schema of the database against the migrations of the server.
+type MigrationStatus struct {
+	// CurrentVersion is the latest applied schema version, empty when the database has no schema yet.
+	CurrentVersion string
+	// SchemaVersion is the schema version of the server, reached once the pending migrations are applied.
+	SchemaVersion string
+	// Applied are the migration histories in order of version.
+	Applied []*MigrationHistory
+	Pending []*PendingMigration
+}
+
+// PendingMigration is a migration file that is applied by the next migrate.
+type PendingMigration struct {
+	// Version is the schema version reached by the migration.
+	Version  string
+	FilePath string
+}
@@ -61,11 +61,10 @@ func (s *Store) Migrate(ctx context.Context) error {
 		}
 
 		if common.IsVersionGreaterThan(schemaVersion, latestMigrationHistoryVersion) {
-			filePaths, err := fs.Glob(migrationFS, fmt.Sprintf("%s*/*.sql", s.getMigrationBasePath()))
+			pendingMigrations, err := s.listPendingMigrations(latestMigrationHistoryVersion, schemaVersion)
 			if err != nil {
-				return errors.Wrap(err, "failed to read migration files")
+				return err
 			}
-			sort.Strings(filePaths)
 
 			// Start a transaction to apply the latest schema.
 			tx, err := s.driver.GetDB().Begin()
@@ -75,20 +74,14 @@ func (s *Store) Migrate(ctx context.Context) error {
 			defer tx.Rollback()
 
 			slog.Info("start migration", slog.String("currentSchemaVersion", latestMigrationHistoryVersion), slog.String("targetSchemaVersion", schemaVersion))
-			for _, filePath := range filePaths {
-				fileSchemaVersion, err := s.getSchemaVersionOfMigrateScript(filePath)
+			for _, pendingMigration := range pendingMigrations {
+				bytes, err := migrationFS.ReadFile(pendingMigration.FilePath)
 				if err != nil {
-					return errors.Wrap(err, "failed to get schema version of migrate script")
+					return errors.Wrapf(err, "failed to read minor version migration file: %s", pendingMigration.FilePath)
 				}
-				if common.IsVersionGreaterThan(fileSchemaVersion, latestMigrationHistoryVersion) && common.IsVersionGreaterOrEqualThan(schemaVersion, fileSchemaVersion) {
-					bytes, err := migrationFS.ReadFile(filePath)
-					if err != nil {
-						return errors.Wrapf(err, "failed to read minor version migration file: %s", filePath)
-					}
-					stmt := string(bytes)
-					if err := s.execute(ctx, tx, stmt); err != nil {
-						return errors.Wrapf(err, "migrate error: %s", stmt)
-					}
+				stmt := string(bytes)
+				if err := s.execute(ctx, tx, stmt); err != nil {
+					return errors.Wrapf(err, "migrate error: %s", stmt)
 				}
 			}
 
@@ -116,9 +109,74 @@ func (s *Store) Migrate(ctx context.Context) error {
 	if err := s.migrateWorkspaceSettings(ctx); err != nil {
 		return errors.Wrap(err, "failed to migrate workspace settings")
 	}
+	// The statements prepared against the previous schema may be stale.
+	if err := s.driver.ResetStatementCache(); err != nil {
+		return errors.Wrap(err, "failed to reset statement cache")
+	}
 	return nil
 }
 
+// GetMigrationStatus returns the applied migrations and the ones Migrate would apply, without changing the schema.
+func (s *Store) GetMigrationStatus(ctx context.Context) (*MigrationStatus, error) {
+	schemaVersion, err := s.GetCurrentSchemaVersion()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get current schema version")
+	}
+	status := &MigrationStatus{
+		SchemaVersion: schemaVersion,
+		Applied:       []*MigrationHistory{},
+		Pending:       []*PendingMigration{},
+	}
+	migrationHistoryList, err := s.driver.ListMigrationHistories(ctx, &FindMigrationHistory{})
+	// Like in pre-migrate, the latest schema is applied when no migration history is found.
+	if err != nil || len(migrationHistoryList) == 0 {
+		status.Pending = append(status.Pending, &PendingMigration{
+			Version:  schemaVersion,
+			FilePath: s.getMigrationBasePath() + LatestSchemaFileName,
+		})
+		return status, nil
+	}
+
+	sort.Slice(migrationHistoryList, func(i, j int) bool {
+		return common.IsVersionGreaterThan(migrationHistoryList[j].Version, migrationHistoryList[i].Version)
+	})
+	status.Applied = migrationHistoryList
+	status.CurrentVersion = migrationHistoryList[len(migrationHistoryList)-1].Version
+	// Only the prod mode applies the migration files, the others keep the schema they were created with.
+	if s.profile.Mode == "prod" && common.IsVersionGreaterThan(schemaVersion, status.CurrentVersion) {
+		pendingMigrations, err := s.listPendingMigrations(status.CurrentVersion, schemaVersion)
+		if err != nil {
+			return nil, err
+		}
+		status.Pending = pendingMigrations
+	}
+	return status, nil
+}
+
+// listPendingMigrations returns the migration files after the current version up to the schema version, in order.
+func (s *Store) listPendingMigrations(currentVersion, schemaVersion string) ([]*PendingMigration, error) {
+	filePaths, err := fs.Glob(migrationFS, fmt.Sprintf("%s*/*.sql", s.getMigrationBasePath()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read migration files")
+	}
+	sort.Strings(filePaths)
+
+	pendingMigrations := []*PendingMigration{}
+	for _, filePath := range filePaths {
+		fileSchemaVersion, err := s.getSchemaVersionOfMigrateScript(filePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get schema version of migrate script")
+		}
+		if common.IsVersionGreaterThan(fileSchemaVersion, currentVersion) && common.IsVersionGreaterOrEqualThan(schemaVersion, fileSchemaVersion) {
+			pendingMigrations = append(pendingMigrations, &PendingMigration{
+				Version:  fileSchemaVersion,
+				FilePath: filePath,
+			})
+		}
+	}
+	return pendingMigrations, nil
+}
+
 func (s *Store) preMigrate(ctx context.Context) error {
 	migrationHistoryList, err := s.driver.ListMigrationHistories(ctx, &FindMigrationHistory{})
 	// If any error occurs or no migration history found, apply the latest schema.
@@ -2,40 +2,220 @@ package store
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 )
 
+// ErrShortcutNameExists is returned when creating, renaming or restoring a shortcut with the name of another active
+// shortcut of its name scope, see GetShortcutNameScope.
+var ErrShortcutNameExists = errors.New("shortcut name already exists")
+
 type UpdateShortcut struct {
 	ID int32
 
-	Name              *string
-	Link              *string
-	Title             *string
-	Description       *string
-	Visibility        *storepb.Visibility
-	Tag               *string
-	OpenGraphMetadata *storepb.OpenGraphMetadata
+	RowStatus           *storepb.RowStatus
+	Name                *string
+	Link                *string
+	Title               *string
+	Description         *string
+	Visibility          *storepb.Visibility
+	Tag                 *string
+	OpenGraphMetadata   *storepb.OpenGraphMetadata
+	RedirectRateLimit   *int32
+	Summary             *string
+	MetaRefreshRedirect *bool
+	// ExpireTs of zero removes the expiration.
+	ExpireTs *int64
+	// Localizations replaces all the localized variants, an empty map removes them.
+	Localizations map[string]*storepb.ShortcutLocalization
+}
+
+// ImportShortcuts is the shortcuts created and updated together by an import, all of them or none.
+type ImportShortcuts struct {
+	Creates []*storepb.Shortcut
+	Updates []*UpdateShortcut
 }
 
 type FindShortcut struct {
-	ID             *int32
-	CreatorID      *int32
-	Name           *string
-	VisibilityList []storepb.Visibility
-	Tag            *string
+	ID        *int32
+	CreatorID *int32
+	// RowStatus matches the shortcuts with the row status, the archived shortcuts are excluded when nil unless
+	// IncludeArchived is set.
+	RowStatus       *storepb.RowStatus
+	IncludeArchived bool
+	Name            *string
+	VisibilityList  []storepb.Visibility
+	Tag             *string
+	// Tags matches the shortcuts with all of the tags, or with any of them when TagsMatchAny is set.
+	Tags         []string
+	TagsMatchAny bool
+	// Keyword matches the shortcuts with it in their name, title, description or tags, case-insensitively.
+	// The matches are ordered by the first of these fields they match before the order by fields.
+	Keyword *string
+	// ExpiredAt matches the shortcuts expired at the time, and NotExpiredAt the ones not expired at it.
+	ExpiredAt    *int64
+	NotExpiredAt *int64
+	// OrderBy defaults to created_ts descending when empty, and ties are broken by id.
+	OrderBy []*ShortcutOrderBy
+	// VisibleTo matches the shortcuts the visibility lets a user see, nil doesn't filter by visibility.
+	VisibleTo *ShortcutVisibilityFilter
+	// Access matches the shortcuts the tag policies let a user read, nil doesn't filter by access.
+	Access *ShortcutAccessFilter
+	// Cursor and Limit page through the shortcuts in the order.
+	Cursor *ShortcutCursor
+	Limit  *int
+}
+
+// ShortcutVisibilityFilter matches the shortcuts a user sees by their visibility: the public ones, the workspace ones
+// when signed in, and the private ones of the user.
+type ShortcutVisibilityFilter struct {
+	// UserID is zero for anonymous visitors, who only see the public shortcuts.
+	UserID int32
+}
+
+// ShortcutAccessFilter matches the shortcuts a user can read by the tag policies: the shortcuts of the user,
+// the public ones, and the ones whose restricted tags grant the user access.
+type ShortcutAccessFilter struct {
+	UserID int32
+	// RestrictedTags is the tags with policies, the shortcuts without any of them are readable.
+	RestrictedTags []string
+	// GrantedTags is the restricted tags with a policy for the user.
+	GrantedTags []string
+	// RequireAllGranted requires all the restricted tags of a shortcut to be granted instead of any of them.
+	RequireAllGranted bool
+}
+
+// GetDeniedTags returns the restricted tags that are not granted.
+func (f *ShortcutAccessFilter) GetDeniedTags() []string {
+	deniedTags := []string{}
+	for _, tag := range f.RestrictedTags {
+		if !slices.Contains(f.GrantedTags, tag) {
+			deniedTags = append(deniedTags, tag)
+		}
+	}
+	return deniedTags
+}
+
+// IsShortcutExpired returns whether the shortcut is expired at the time.
+func IsShortcutExpired(shortcut *storepb.Shortcut, ts int64) bool {
+	return shortcut.ExpireTs != 0 && shortcut.ExpireTs <= ts
+}
+
+// ShortcutCursor is the position of a shortcut in the order of a list, the list continues after it.
+type ShortcutCursor struct {
+	ID int32
+	// Values are the values of the shortcut for the fields of FindShortcut.GetOrderBy, in the same order.
+	Values []any
+}
+
+// ShortcutOrderField is a sortable column of the shortcut table.
+type ShortcutOrderField string
+
+const (
+	ShortcutOrderFieldName      ShortcutOrderField = "name"
+	ShortcutOrderFieldTitle     ShortcutOrderField = "title"
+	ShortcutOrderFieldCreatedTs ShortcutOrderField = "created_ts"
+	ShortcutOrderFieldUpdatedTs ShortcutOrderField = "updated_ts"
+	// ShortcutOrderFieldLastViewedTs is the time of the last redirect, zero for the shortcuts never viewed.
+	ShortcutOrderFieldLastViewedTs ShortcutOrderField = "last_viewed_ts"
+	// ShortcutOrderFieldKeywordRank is the rank of the keyword match, see GetShortcutKeywordRank.
+	// It is computed by the query and not a column of the table.
+	ShortcutOrderFieldKeywordRank ShortcutOrderField = "keyword_rank"
+)
+
+type ShortcutOrderBy struct {
+	Field ShortcutOrderField
+	Desc  bool
+}
+
+// DefaultShortcutOrderBy is the order of the shortcuts when FindShortcut.OrderBy is empty.
+var DefaultShortcutOrderBy = []*ShortcutOrderBy{{Field: ShortcutOrderFieldCreatedTs, Desc: true}}
+
+// GetOrderBy returns the order of the found shortcuts without the id tie-breaker: by keyword rank
+// when the find has a keyword, then by the order by fields or the default order.
+func (find *FindShortcut) GetOrderBy() []*ShortcutOrderBy {
+	orderBy := find.OrderBy
+	if len(orderBy) == 0 {
+		orderBy = DefaultShortcutOrderBy
+	}
+	if find.Keyword != nil {
+		orderBy = append([]*ShortcutOrderBy{{Field: ShortcutOrderFieldKeywordRank}}, orderBy...)
+	}
+	return orderBy
+}
+
+// GetShortcutKeywordRank returns the rank of the keyword match of the shortcut: 0 for its name,
+// 1 for its title, 2 for its description and 3 for its tags.
+func GetShortcutKeywordRank(shortcut *storepb.Shortcut, keyword string) int64 {
+	keyword = strings.ToLower(keyword)
+	for rank, value := range []string{shortcut.Name, shortcut.Title, shortcut.Description} {
+		if strings.Contains(strings.ToLower(value), keyword) {
+			return int64(rank)
+		}
+	}
+	return 3
+}
+
+// ShortcutGroupField is a field the shortcuts are grouped by.
+type ShortcutGroupField string
+
+const (
+	// ShortcutGroupFieldTag groups the shortcuts by each of their tags, the untagged shortcuts are in no group.
+	ShortcutGroupFieldTag        ShortcutGroupField = "tag"
+	ShortcutGroupFieldVisibility ShortcutGroupField = "visibility"
+	ShortcutGroupFieldCreatorID  ShortcutGroupField = "creator_id"
+)
+
+type FindShortcutGroup struct {
+	// Find filters the grouped shortcuts, its order, cursor and limit are ignored.
+	Find  *FindShortcut
+	Field ShortcutGroupField
+	// Limit is the maximum number of groups, which are ordered by count descending and then by key.
+	Limit *int
+}
+
+// ShortcutGroup is the shortcuts with the same value of the grouped field.
+type ShortcutGroup struct {
+	Key   string
+	Count int32
+	// SampleID is the id of the first created shortcut of the group.
+	SampleID int32
+}
+
+// ShortcutViews is the views of a shortcut to add to its view count.
+type ShortcutViews struct {
+	ShortcutID   int32
+	Count        int32
+	LastViewedTs int64
 }
 
 type DeleteShortcut struct {
 	ID int32
 }
 
+// RestoreShortcut restores an archived shortcut, or merges it into an active shortcut instead.
+type RestoreShortcut struct {
+	ID int32
+	// Name renames the restored shortcut when set.
+	Name *string
+	// MergeIntoID moves the views of the shortcut to the active shortcut and deletes it when set.
+	MergeIntoID *int32
+}
+
 func (s *Store) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
 	shortcut, err := s.driver.CreateShortcut(ctx, create)
 	if err != nil {
 		return nil, err
 	}
 	s.shortcutCache.Store(shortcut.Id, shortcut)
+	s.shortcutNameCache.invalidate(shortcut)
 	return shortcut, nil
 }
 
@@ -45,9 +225,46 @@ func (s *Store) UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*st
 		return nil, err
 	}
 	s.shortcutCache.Store(shortcut.Id, shortcut)
+	s.shortcutNameCache.invalidate(shortcut)
 	return shortcut, nil
 }
 
+// ArchiveShortcut archives the shortcut, which the finds exclude then unless they include the archived shortcuts.
+// It frees the name of the shortcut for the other shortcuts of its name scope.
+func (s *Store) ArchiveShortcut(ctx context.Context, id int32) (*storepb.Shortcut, error) {
+	rowStatus := storepb.RowStatus_ARCHIVED
+	return s.UpdateShortcut(ctx, &UpdateShortcut{
+		ID:        id,
+		RowStatus: &rowStatus,
+	})
+}
+
+// UnarchiveShortcut makes the archived shortcut active again, and returns ErrShortcutNameExists when another active
+// shortcut of its name scope took its name in the meantime.
+func (s *Store) UnarchiveShortcut(ctx context.Context, id int32) (*storepb.Shortcut, error) {
+	rowStatus := storepb.RowStatus_NORMAL
+	return s.UpdateShortcut(ctx, &UpdateShortcut{
+		ID:        id,
+		RowStatus: &rowStatus,
+	})
+}
+
+// ImportShortcuts creates and updates the shortcuts in a single transaction, and returns the created and updated ones.
+func (s *Store) ImportShortcuts(ctx context.Context, importShortcuts *ImportShortcuts) ([]*storepb.Shortcut, []*storepb.Shortcut, error) {
+	created, updated, err := s.driver.ImportShortcuts(ctx, importShortcuts)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, shortcut := range created {
+		s.shortcutCache.Store(shortcut.Id, shortcut)
+	}
+	for _, shortcut := range updated {
+		s.shortcutCache.Store(shortcut.Id, shortcut)
+	}
+	s.shortcutNameCache.invalidate(append(created, updated...)...)
+	return created, updated, nil
+}
+
 func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*storepb.Shortcut, error) {
 	list, err := s.driver.ListShortcuts(ctx, find)
 	if err != nil {
@@ -59,9 +276,39 @@ func (s *Store) ListShortcuts(ctx context.Context, find *FindShortcut) ([]*store
 	return list, nil
 }
 
+func (s *Store) ListShortcutGroups(ctx context.Context, find *FindShortcutGroup) ([]*ShortcutGroup, error) {
+	return s.driver.ListShortcutGroups(ctx, find)
+}
+
+// RebuildShortcutSearchIndex rebuilds the search index of the shortcut keywords from the shortcuts of all the
+// workspaces, in case it drifted from them.
+func (s *Store) RebuildShortcutSearchIndex(ctx context.Context) error {
+	return s.driver.RebuildShortcutSearchIndex(ctx)
+}
+
+// AddShortcutViews adds the views to the view counts of the shortcuts and moves their last view times forward.
+func (s *Store) AddShortcutViews(ctx context.Context, views []*ShortcutViews) error {
+	if len(views) == 0 {
+		return nil
+	}
+	if err := s.driver.AddShortcutViews(ctx, views); err != nil {
+		return err
+	}
+	ids := make([]int32, 0, len(views))
+	for _, view := range views {
+		s.shortcutCache.Delete(view.ShortcutID)
+		ids = append(ids, view.ShortcutID)
+	}
+	s.shortcutNameCache.invalidateIDs(ids...)
+	return nil
+}
+
 func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.Shortcut, error) {
 	if find.ID != nil {
-		if cache, ok := s.shortcutCache.Load(*find.ID); ok {
+		if cache, ok := s.shortcutCache.Load(*find.ID); ok && cache.(*storepb.Shortcut).WorkspaceId == GetWorkspaceID(ctx) {
+			if !matchRowStatus(cache.(*storepb.Shortcut).RowStatus, find.RowStatus, find.IncludeArchived) {
+				return nil, nil
+			}
 			return cache.(*storepb.Shortcut), nil
 		}
 	}
@@ -75,8 +322,112 @@ func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.S
 		return nil, nil
 	}
 
+	// Archived shortcuts can share the name of an active one, which is preferred.
 	shortcut := shortcuts[0]
+	for _, v := range shortcuts {
+		if v.RowStatus == storepb.RowStatus_NORMAL {
+			shortcut = v
+			break
+		}
+	}
+	s.shortcutCache.Store(shortcut.Id, shortcut)
+	return shortcut, nil
+}
+
+// GetShortcutNameScope returns the scope the name of an active shortcut is unique in within its workspace:
+// the id of the creator for a private shortcut, and 0 for the workspace and public shortcuts, which share their names.
+func GetShortcutNameScope(visibility storepb.Visibility, creatorID int32) int32 {
+	if visibility == storepb.Visibility_PRIVATE {
+		return creatorID
+	}
+	return 0
+}
+
+// GetShortcutInNameScope returns the active shortcut with the name in the scope of GetShortcutNameScope.
+func (s *Store) GetShortcutInNameScope(ctx context.Context, name string, scope int32) (*storepb.Shortcut, error) {
+	normalStatus := storepb.RowStatus_NORMAL
+	shortcuts, err := s.ListShortcuts(ctx, &FindShortcut{
+		Name:      &name,
+		RowStatus: &normalStatus,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, shortcut := range shortcuts {
+		if GetShortcutNameScope(shortcut.Visibility, shortcut.CreatorId) == scope {
+			return shortcut, nil
+		}
+	}
+	return nil, nil
+}
+
+// ResolveShortcutName returns the shortcut the name resolves to for the user, 0 for an anonymous visitor.
+// The private shortcut of the user comes first, then the workspace one and then the public one. The private shortcuts
+// of other users and the archived shortcuts never resolve, so the name is unknown when only those are left.
+// The shortcuts of the name are cached for the profile's ShortcutCacheTTL, and a name without any for a few seconds.
+func (s *Store) ResolveShortcutName(ctx context.Context, name string, userID int32) (*storepb.Shortcut, error) {
+	shortcuts, err := s.listShortcutsOfName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	rank := func(shortcut *storepb.Shortcut) int {
+		switch shortcut.Visibility {
+		case storepb.Visibility_WORKSPACE:
+			return 1
+		case storepb.Visibility_PUBLIC:
+			return 2
+		}
+		return 0
+	}
+	var resolved *storepb.Shortcut
+	for _, shortcut := range shortcuts {
+		if shortcut.RowStatus != storepb.RowStatus_NORMAL {
+			continue
+		}
+		if shortcut.Visibility == storepb.Visibility_PRIVATE && (userID == 0 || shortcut.CreatorId != userID) {
+			continue
+		}
+		if resolved == nil || rank(shortcut) < rank(resolved) {
+			resolved = shortcut
+		}
+	}
+	return resolved, nil
+}
+
+// listShortcutsOfName returns the active shortcuts of the name. The archived ones are left to RestoreShortcut, which
+// finds them by id.
+func (s *Store) listShortcutsOfName(ctx context.Context, name string) ([]*storepb.Shortcut, error) {
+	if s.shortcutNameCache == nil {
+		return s.ListShortcuts(ctx, &FindShortcut{
+			Name: &name,
+		})
+	}
+	workspaceID := GetWorkspaceID(ctx)
+	shortcuts, version, ok := s.shortcutNameCache.get(workspaceID, name)
+	if ok {
+		return shortcuts, nil
+	}
+	shortcuts, err := s.ListShortcuts(ctx, &FindShortcut{
+		Name: &name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.shortcutNameCache.put(workspaceID, name, version, shortcuts)
+	return shortcuts, nil
+}
+
+// RestoreShortcut restores or merges the archived shortcut in a single transaction, and returns the restored
+// shortcut or the one it was merged into. The merged shortcut leaves a tombstone like a deleted one.
+func (s *Store) RestoreShortcut(ctx context.Context, restore *RestoreShortcut) (*storepb.Shortcut, error) {
+	shortcut, err := s.driver.RestoreShortcut(ctx, restore, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+	s.shortcutCache.Delete(restore.ID)
 	s.shortcutCache.Store(shortcut.Id, shortcut)
+	s.shortcutNameCache.invalidateIDs(restore.ID)
+	s.shortcutNameCache.invalidate(shortcut)
 	return shortcut, nil
 }
 
@@ -86,5 +437,43 @@ func (s *Store) DeleteShortcut(ctx context.Context, delete *DeleteShortcut) erro
 	}
 
 	s.shortcutCache.Delete(delete.ID)
+	s.shortcutNameCache.invalidateIDs(delete.ID)
 	return nil
 }
+
+// MarshalShortcutLocalizations returns the localizations as the JSON object stored in the localizations column.
+func MarshalShortcutLocalizations(localizations map[string]*storepb.ShortcutLocalization) (string, error) {
+	object := map[string]json.RawMessage{}
+	for locale, localization := range localizations {
+		bytes, err := protojson.Marshal(localization)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal shortcut localization")
+		}
+		object[locale] = bytes
+	}
+	bytes, err := json.Marshal(object)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal shortcut localizations")
+	}
+	return string(bytes), nil
+}
+
+// UnmarshalShortcutLocalizations parses the JSON object stored in the localizations column.
+func UnmarshalShortcutLocalizations(s string) (map[string]*storepb.ShortcutLocalization, error) {
+	object := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(s), &object); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal shortcut localizations")
+	}
+	if len(object) == 0 {
+		return nil, nil
+	}
+	localizations := map[string]*storepb.ShortcutLocalization{}
+	for locale, bytes := range object {
+		localization := &storepb.ShortcutLocalization{}
+		if err := protojson.Unmarshal(bytes, localization); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal shortcut localization")
+		}
+		localizations[locale] = localization
+	}
+	return localizations, nil
+}
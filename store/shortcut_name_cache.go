@@ -0,0 +1,171 @@
+package store
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// shortcutNameNotFoundTTL bounds the time a name without shortcuts is cached for, so that a shortcut created by
+// another instance of the server resolves shortly after.
+const shortcutNameNotFoundTTL = 5 * time.Second
+
+// CacheObserver is implemented by the drivers that record the hits and misses of the store caches.
+type CacheObserver interface {
+	ObserveCache(cache string, hit bool)
+}
+
+type shortcutNameKey struct {
+	workspaceID string
+	name        string
+}
+
+type shortcutNameEntry struct {
+	key       shortcutNameKey
+	shortcuts []*storepb.Shortcut
+	expireAt  time.Time
+}
+
+// shortcutNameCache is a LRU cache of the active shortcuts of the names, which the resolution of the names ranks for
+// the users.
+type shortcutNameCache struct {
+	mu       sync.Mutex
+	size     int
+	ttl      time.Duration
+	entries  map[shortcutNameKey]*list.Element
+	order    *list.List // of *shortcutNameEntry, the most recently used first
+	names    map[int32]shortcutNameKey
+	version  uint64
+	now      func() time.Time
+	observer CacheObserver
+}
+
+func newShortcutNameCache(size int, ttl time.Duration, observer CacheObserver) *shortcutNameCache {
+	return &shortcutNameCache{
+		size:     size,
+		ttl:      ttl,
+		entries:  map[shortcutNameKey]*list.Element{},
+		order:    list.New(),
+		names:    map[int32]shortcutNameKey{},
+		now:      time.Now,
+		observer: observer,
+	}
+}
+
+// get returns the shortcuts of the name if cached. Otherwise, it returns the version to put the loaded shortcuts with.
+func (c *shortcutNameCache) get(workspaceID, name string) ([]*storepb.Shortcut, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := shortcutNameKey{workspaceID: workspaceID, name: name}
+	element, ok := c.entries[key]
+	if ok && c.now().After(element.Value.(*shortcutNameEntry).expireAt) {
+		c.remove(element)
+		ok = false
+	}
+	if c.observer != nil {
+		c.observer.ObserveCache("shortcut_name", ok)
+	}
+	if !ok {
+		return nil, c.version, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*shortcutNameEntry).shortcuts, 0, true
+}
+
+// put caches the shortcuts of the name unless the cache was invalidated since the version was returned by get,
+// because the shortcuts may have been loaded before the change then.
+func (c *shortcutNameCache) put(workspaceID, name string, version uint64, shortcuts []*storepb.Shortcut) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if version != c.version {
+		return
+	}
+	key := shortcutNameKey{workspaceID: workspaceID, name: name}
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	ttl := c.ttl
+	if len(shortcuts) == 0 {
+		ttl = min(ttl, shortcutNameNotFoundTTL)
+	}
+	c.entries[key] = c.order.PushFront(&shortcutNameEntry{
+		key:       key,
+		shortcuts: shortcuts,
+		expireAt:  c.now().Add(ttl),
+	})
+	for _, shortcut := range shortcuts {
+		c.names[shortcut.Id] = key
+	}
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// invalidate evicts the names of the shortcuts and the names they had when cached. Like the other invalidations, it
+// is a no-op on the nil cache of a disabled one.
+func (c *shortcutNameCache) invalidate(shortcuts ...*storepb.Shortcut) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	for _, shortcut := range shortcuts {
+		c.evict(shortcutNameKey{workspaceID: shortcut.WorkspaceId, name: shortcut.Name})
+		if key, ok := c.names[shortcut.Id]; ok {
+			c.evict(key)
+		}
+	}
+}
+
+// invalidateIDs evicts the names the shortcuts had when cached.
+func (c *shortcutNameCache) invalidateIDs(ids ...int32) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	for _, id := range ids {
+		if key, ok := c.names[id]; ok {
+			c.evict(key)
+		}
+	}
+}
+
+// purge evicts all the names.
+func (c *shortcutNameCache) purge() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	c.entries = map[shortcutNameKey]*list.Element{}
+	c.order.Init()
+	c.names = map[int32]shortcutNameKey{}
+}
+
+func (c *shortcutNameCache) evict(key shortcutNameKey) {
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+}
+
+func (c *shortcutNameCache) remove(element *list.Element) {
+	entry := element.Value.(*shortcutNameEntry)
+	c.order.Remove(element)
+	delete(c.entries, entry.key)
+	for _, shortcut := range entry.shortcuts {
+		if c.names[shortcut.Id] == entry.key {
+			delete(c.names, shortcut.Id)
+		}
+	}
+}
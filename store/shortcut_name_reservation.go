@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultShortcutNamespace is the namespace of the shortcuts of the default workspace,
+// as the shortcut names are namespaced by the workspace IDs.
+const DefaultShortcutNamespace = DefaultWorkspaceID
+
+// ErrShortcutNameTaken is returned when the shortcut name is used by a shortcut or reserved by another user.
+var ErrShortcutNameTaken = errors.New("shortcut name is taken")
+
+// ShortcutNameReservation is a temporary claim of a shortcut name by a user.
+type ShortcutNameReservation struct {
+	Namespace string
+	Name      string
+	UserID    int32
+	ExpiresTs int64
+}
+
+type FindShortcutNameReservation struct {
+	Namespace string
+	Name      string
+}
+
+type DeleteShortcutNameReservation struct {
+	Namespace string
+	Name      string
+}
+
+// ReserveShortcutName atomically claims the name for the user for the ttl duration.
+// It returns ErrShortcutNameTaken if a shortcut already uses the name or another user holds an unexpired reservation.
+// Reserving a name again by the same user extends the reservation.
+func (s *Store) ReserveShortcutName(ctx context.Context, namespace, name string, userID int32, ttl time.Duration) (*ShortcutNameReservation, error) {
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("ttl must be positive")
+	}
+	now := time.Now()
+	return s.driver.ReserveShortcutName(ctx, &ShortcutNameReservation{
+		Namespace: namespace,
+		Name:      name,
+		UserID:    userID,
+		ExpiresTs: now.Add(ttl).Unix(),
+	}, now.Unix())
+}
+
+// GetShortcutNameReservation returns the unexpired reservation of the name, or nil if there is none.
+func (s *Store) GetShortcutNameReservation(ctx context.Context, find *FindShortcutNameReservation) (*ShortcutNameReservation, error) {
+	reservation, err := s.driver.GetShortcutNameReservation(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil || reservation.ExpiresTs <= time.Now().Unix() {
+		return nil, nil
+	}
+	return reservation, nil
+}
+
+func (s *Store) DeleteShortcutNameReservation(ctx context.Context, delete *DeleteShortcutNameReservation) error {
+	return s.driver.DeleteShortcutNameReservation(ctx, delete)
+}
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MaxShortcutRedirectDepth is the maximum number of shortcuts a redirect chain may go through.
+const MaxShortcutRedirectDepth = 5
+
+// ErrShortcutRedirectLoop is returned when the link of a shortcut leads back to itself through the instance.
+var ErrShortcutRedirectLoop = errors.New("shortcut redirect loop detected")
+
+// CheckShortcutRedirectLoop follows the chain of links targeting shortcuts of this instance,
+// starting from the shortcut with the given name and link.
+// It returns ErrShortcutRedirectLoop if the chain contains a cycle or is longer than MaxShortcutRedirectDepth.
+// The check is skipped when the instance url isn't configured.
+func (s *Store) CheckShortcutRedirectLoop(ctx context.Context, name, link string) error {
+	generalSetting, err := s.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return err
+	}
+	instanceURL := generalSetting.GetInstanceUrl()
+	if instanceURL == "" {
+		return nil
+	}
+
+	visited := map[string]bool{name: true}
+	chain := []string{name}
+	for depth := 0; ; depth++ {
+		target, ok := ShortcutNameFromLink(instanceURL, link)
+		if !ok {
+			return nil
+		}
+		chain = append(chain, target)
+		if visited[target] {
+			return errors.Wrap(ErrShortcutRedirectLoop, strings.Join(chain, " -> "))
+		}
+		if depth >= MaxShortcutRedirectDepth {
+			return errors.Wrapf(ErrShortcutRedirectLoop, "more than %d redirects: %s", MaxShortcutRedirectDepth, strings.Join(chain, " -> "))
+		}
+		visited[target] = true
+		// The links are followed the way anonymous visitors are redirected.
+		shortcut, err := s.ResolveShortcutName(ctx, target, 0)
+		if err != nil {
+			return err
+		}
+		if shortcut == nil {
+			return nil
+		}
+		link = shortcut.Link
+	}
+}
+
+// ShortcutNameFromLink returns the name of the shortcut the link targets when it points to the instance at instanceURL.
+func ShortcutNameFromLink(instanceURL, link string) (string, bool) {
+	base, err := url.Parse(instanceURL)
+	if err != nil || base.Host == "" {
+		return "", false
+	}
+	u, err := url.Parse(link)
+	if err != nil || !strings.EqualFold(u.Host, base.Host) {
+		return "", false
+	}
+	prefix := strings.TrimSuffix(base.Path, "/") + "/s/"
+	if !strings.HasPrefix(u.Path, prefix) {
+		return "", false
+	}
+	name, _, _ := strings.Cut(strings.TrimPrefix(u.Path, prefix), "/")
+	if name == "" {
+		return "", false
+	}
+	return name, true
+}
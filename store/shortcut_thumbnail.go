@@ -0,0 +1,44 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ShortcutThumbnail is the cached preview image of the destination of a shortcut.
+// It is rendered from the link of the shortcut at the time, so a thumbnail of another link is stale.
+type ShortcutThumbnail struct {
+	ShortcutID  int32
+	CreatedTs   int64
+	Link        string
+	ContentType string
+	Content     []byte
+}
+
+type FindShortcutThumbnail struct {
+	ShortcutID *int32
+}
+
+// UpsertShortcutThumbnail stores the thumbnail of the shortcut, replacing its previous one.
+func (s *Store) UpsertShortcutThumbnail(ctx context.Context, upsert *ShortcutThumbnail) (*ShortcutThumbnail, error) {
+	if upsert.ContentType == "" || len(upsert.Content) == 0 {
+		return nil, errors.New("content type and content are required")
+	}
+	return s.driver.UpsertShortcutThumbnail(ctx, upsert)
+}
+
+func (s *Store) ListShortcutThumbnails(ctx context.Context, find *FindShortcutThumbnail) ([]*ShortcutThumbnail, error) {
+	return s.driver.ListShortcutThumbnails(ctx, find)
+}
+
+func (s *Store) GetShortcutThumbnail(ctx context.Context, find *FindShortcutThumbnail) (*ShortcutThumbnail, error) {
+	list, err := s.ListShortcutThumbnails(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
@@ -0,0 +1,43 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// ShortcutTombstone is the record of a deleted shortcut kept for incremental sync clients.
+type ShortcutTombstone struct {
+	ShortcutID int32
+	CreatorID  int32
+	Name       string
+	DeletedTs  int64
+}
+
+type FindShortcutTombstone struct {
+	DeletedTsAfter *int64
+}
+
+type DeleteShortcutTombstone struct {
+	DeletedTsBefore int64
+}
+
+// SoftDeleteShortcut deletes the shortcut and leaves a tombstone of it.
+func (s *Store) SoftDeleteShortcut(ctx context.Context, delete *DeleteShortcut) error {
+	if err := s.driver.SoftDeleteShortcut(ctx, delete, time.Now().Unix()); err != nil {
+		return err
+	}
+
+	s.shortcutCache.Delete(delete.ID)
+	s.shortcutNameCache.invalidateIDs(delete.ID)
+	return nil
+}
+
+// ListShortcutTombstones returns the tombstones ordered by deleted time.
+func (s *Store) ListShortcutTombstones(ctx context.Context, find *FindShortcutTombstone) ([]*ShortcutTombstone, error) {
+	return s.driver.ListShortcutTombstones(ctx, find)
+}
+
+// DeleteShortcutTombstones purges the tombstones deleted before the given time.
+func (s *Store) DeleteShortcutTombstones(ctx context.Context, delete *DeleteShortcutTombstone) error {
+	return s.driver.DeleteShortcutTombstones(ctx, delete)
+}
@@ -11,18 +11,27 @@ type Store struct {
 	profile *profile.Profile
 	driver  Driver
 
-	workspaceSettingCache sync.Map // map[string]*WorkspaceSetting
+	workspaceSettingCache sync.Map // map[string]*WorkspaceSetting keyed by workspace and key
 	userCache             sync.Map // map[int]*User
 	userSettingCache      sync.Map // map[string]*UserSetting
 	shortcutCache         sync.Map // map[int]*Shortcut
+	// shortcutNameCache caches the shortcuts of the resolved names, nil when disabled.
+	shortcutNameCache *shortcutNameCache
+
+	userAccessTokenBatchers sync.Map // map[int32]*userAccessTokenBatcher
 }
 
 // New creates a new instance of Store.
 func New(driver Driver, profile *profile.Profile) *Store {
-	return &Store{
+	s := &Store{
 		driver:  driver,
 		profile: profile,
 	}
+	if profile.ShortcutCacheSize > 0 {
+		observer, _ := driver.(CacheObserver)
+		s.shortcutNameCache = newShortcutNameCache(profile.ShortcutCacheSize, profile.ShortcutCacheTTL, observer)
+	}
+	return s
 }
 
 // Close closes the database connection.
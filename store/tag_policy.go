@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// TagPolicyRole is the role a tag policy grants over the shortcuts with its tag.
+type TagPolicyRole string
+
+const (
+	// TagPolicyRoleRead lets the user read the shortcuts.
+	TagPolicyRoleRead TagPolicyRole = "READ"
+	// TagPolicyRoleManage lets the user read, update and delete the shortcuts.
+	TagPolicyRoleManage TagPolicyRole = "MANAGE"
+)
+
+func (r TagPolicyRole) String() string {
+	return string(r)
+}
+
+// TagPolicy grants a user a role over all the shortcuts with a tag.
+// The shortcuts with a tag that has policies are restricted to the granted users besides their creator and the admins.
+type TagPolicy struct {
+	ID        int32
+	CreatedTs int64
+	Tag       string
+	UserID    int32
+	Role      TagPolicyRole
+}
+
+type FindTagPolicy struct {
+	ID     *int32
+	Tag    *string
+	UserID *int32
+}
+
+type DeleteTagPolicy struct {
+	ID int32
+}
+
+// UpsertTagPolicy creates the policy, or replaces the role of the existing policy of the user for the tag.
+func (s *Store) UpsertTagPolicy(ctx context.Context, upsert *TagPolicy) (*TagPolicy, error) {
+	if upsert.Tag == "" {
+		return nil, errors.New("tag is required")
+	}
+	if upsert.Role != TagPolicyRoleRead && upsert.Role != TagPolicyRoleManage {
+		return nil, errors.Errorf("invalid role %q", upsert.Role)
+	}
+	return s.driver.UpsertTagPolicy(ctx, upsert)
+}
+
+func (s *Store) ListTagPolicies(ctx context.Context, find *FindTagPolicy) ([]*TagPolicy, error) {
+	return s.driver.ListTagPolicies(ctx, find)
+}
+
+func (s *Store) GetTagPolicy(ctx context.Context, find *FindTagPolicy) (*TagPolicy, error) {
+	list, err := s.ListTagPolicies(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteTagPolicy(ctx context.Context, delete *DeleteTagPolicy) error {
+	return s.driver.DeleteTagPolicy(ctx, delete)
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 )
@@ -29,28 +31,46 @@ type User struct {
 	Nickname     string
 	PasswordHash string
 	Role         Role
+	WorkspaceID  string
+	// FailedSignInCount is the number of consecutive failed sign ins since the last successful one or lockout.
+	FailedSignInCount int32
+	// LockedUntilTs is the time until which the user can't sign in, zero when the user was never locked.
+	LockedUntilTs int64
 }
 
 type UpdateUser struct {
 	ID int32
 
-	RowStatus    *storepb.RowStatus
-	Email        *string
-	Nickname     *string
-	PasswordHash *string
-	Role         *Role
+	RowStatus         *storepb.RowStatus
+	Email             *string
+	Nickname          *string
+	PasswordHash      *string
+	Role              *Role
+	FailedSignInCount *int32
+	LockedUntilTs     *int64
 }
 
 type FindUser struct {
-	ID        *int32
-	RowStatus *storepb.RowStatus
-	Email     *string
-	Nickname  *string
-	Role      *Role
+	ID *int32
+	// RowStatus matches the users with the row status, the archived users are excluded when nil unless IncludeArchived is set.
+	RowStatus       *storepb.RowStatus
+	IncludeArchived bool
+	Email           *string
+	Nickname        *string
+	Role            *Role
+	// IDAfter and Limit page through the users, which are ordered by id when either is set instead of by update time.
+	IDAfter *int32
+	Limit   *int
 }
 
 type DeleteUser struct {
 	ID int32
+	// Archive archives the user rather than deleting them. Their access tokens are revoked either way.
+	Archive bool
+	// ShortcutOwnerID is the user the shortcuts and collections of the user are reassigned to. When unset, the
+	// shortcuts of an archived user are archived with them, and the ones of a deleted user are deleted with their
+	// collections, leaving tombstones.
+	ShortcutOwnerID *int32
 }
 
 func (s *Store) CreateUser(ctx context.Context, create *User) (*User, error) {
@@ -62,6 +82,18 @@ func (s *Store) CreateUser(ctx context.Context, create *User) (*User, error) {
 	return user, nil
 }
 
+// CreateUsers creates all the users or none of them.
+func (s *Store) CreateUsers(ctx context.Context, creates []*User) ([]*User, error) {
+	users, err := s.driver.CreateUsers(ctx, creates)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		s.userCache.Store(user.ID, user)
+	}
+	return users, nil
+}
+
 func (s *Store) UpdateUser(ctx context.Context, update *UpdateUser) (*User, error) {
 	user, err := s.driver.UpdateUser(ctx, update)
 	if err != nil {
@@ -71,6 +103,25 @@ func (s *Store) UpdateUser(ctx context.Context, update *UpdateUser) (*User, erro
 	return user, nil
 }
 
+// ArchiveUser archives the user, which the finds exclude then unless they include the archived users.
+// The email of an archived user stays taken.
+func (s *Store) ArchiveUser(ctx context.Context, id int32) (*User, error) {
+	rowStatus := storepb.RowStatus_ARCHIVED
+	return s.UpdateUser(ctx, &UpdateUser{
+		ID:        id,
+		RowStatus: &rowStatus,
+	})
+}
+
+// UnarchiveUser makes the archived user active again.
+func (s *Store) UnarchiveUser(ctx context.Context, id int32) (*User, error) {
+	rowStatus := storepb.RowStatus_NORMAL
+	return s.UpdateUser(ctx, &UpdateUser{
+		ID:        id,
+		RowStatus: &rowStatus,
+	})
+}
+
 func (s *Store) ListUsers(ctx context.Context, find *FindUser) ([]*User, error) {
 	list, err := s.driver.ListUsers(ctx, find)
 	if err != nil {
@@ -84,7 +135,11 @@ func (s *Store) ListUsers(ctx context.Context, find *FindUser) ([]*User, error)
 
 func (s *Store) GetUser(ctx context.Context, find *FindUser) (*User, error) {
 	if find.ID != nil {
-		if cache, ok := s.userCache.Load(*find.ID); ok {
+		// The users are cached by their id across the workspaces.
+		if cache, ok := s.userCache.Load(*find.ID); ok && cache.(*User).WorkspaceID == GetWorkspaceID(ctx) {
+			if !matchRowStatus(cache.(*User).RowStatus, find.RowStatus, find.IncludeArchived) {
+				return nil, nil
+			}
 			return cache.(*User), nil
 		}
 	}
@@ -101,11 +156,32 @@ func (s *Store) GetUser(ctx context.Context, find *FindUser) (*User, error) {
 	return list[0], nil
 }
 
+// DeleteUser deletes or archives the user in a single transaction, along with reassigning or deleting their shortcuts
+// and collections. It returns ErrShortcutNameExists if a private shortcut of the user has the name of one of the owner.
 func (s *Store) DeleteUser(ctx context.Context, delete *DeleteUser) error {
-	if err := s.driver.DeleteUser(ctx, delete); err != nil {
+	if err := s.driver.DeleteUser(ctx, delete, time.Now().Unix()); err != nil {
 		return err
 	}
 
 	s.userCache.Delete(delete.ID)
+	if delete.Archive {
+		s.userSettingCache.Delete(getUserSettingCacheKey(delete.ID, storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS.String()))
+	} else {
+		prefix := getUserSettingCacheKey(delete.ID, "")
+		s.userSettingCache.Range(func(key, _ any) bool {
+			if strings.HasPrefix(key.(string), prefix) {
+				s.userSettingCache.Delete(key)
+			}
+			return true
+		})
+	}
+	// The shortcuts of the user are reassigned or deleted with it.
+	s.shortcutCache.Range(func(key, value any) bool {
+		if value.(*storepb.Shortcut).CreatorId == delete.ID {
+			s.shortcutCache.Delete(key)
+		}
+		return true
+	})
+	s.shortcutNameCache.purge()
 	return nil
 }
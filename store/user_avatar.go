@@ -0,0 +1,50 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// UserAvatar is the profile image of a user.
+type UserAvatar struct {
+	UserID      int32
+	CreatedTs   int64
+	ContentType string
+	Content     []byte
+}
+
+type FindUserAvatar struct {
+	UserID *int32
+}
+
+type DeleteUserAvatar struct {
+	UserID int32
+}
+
+// UpsertUserAvatar stores the avatar of the user, replacing their previous one.
+func (s *Store) UpsertUserAvatar(ctx context.Context, upsert *UserAvatar) (*UserAvatar, error) {
+	if upsert.ContentType == "" || len(upsert.Content) == 0 {
+		return nil, errors.New("content type and content are required")
+	}
+	return s.driver.UpsertUserAvatar(ctx, upsert)
+}
+
+func (s *Store) ListUserAvatars(ctx context.Context, find *FindUserAvatar) ([]*UserAvatar, error) {
+	return s.driver.ListUserAvatars(ctx, find)
+}
+
+func (s *Store) GetUserAvatar(ctx context.Context, find *FindUserAvatar) (*UserAvatar, error) {
+	list, err := s.ListUserAvatars(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteUserAvatar(ctx context.Context, delete *DeleteUserAvatar) error {
+	return s.driver.DeleteUserAvatar(ctx, delete)
+}
@@ -2,6 +2,10 @@ package store
 
 import (
 	"context"
+	"slices"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 )
@@ -69,3 +73,132 @@ func (s *Store) GetUserAccessTokens(ctx context.Context, userID int32) ([]*store
 	accessTokensUserSetting := userSetting.GetAccessTokens()
 	return accessTokensUserSetting.AccessTokens, nil
 }
+
+// userAccessTokenBatcher coalesces the concurrent access token upserts of a user into one write.
+type userAccessTokenBatcher struct {
+	// writeMu serializes the writes of the access tokens setting.
+	writeMu sync.Mutex
+
+	pendingMu sync.Mutex
+	pending   *userAccessTokenBatch
+}
+
+type userAccessTokenBatch struct {
+	accessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken
+	done         chan struct{}
+	err          error
+}
+
+// UpsertUserAccessTokens adds the access tokens to the user, replacing the ones with the same token.
+// Concurrent calls for the same user, e.g. sign-ins on several devices, are written in a single batch.
+func (s *Store) UpsertUserAccessTokens(ctx context.Context, userID int32, accessTokens ...*storepb.UserSetting_AccessTokensSetting_AccessToken) error {
+	value, _ := s.userAccessTokenBatchers.LoadOrStore(userID, &userAccessTokenBatcher{})
+	batcher := value.(*userAccessTokenBatcher)
+
+	batcher.pendingMu.Lock()
+	if batcher.pending == nil {
+		batcher.pending = &userAccessTokenBatch{done: make(chan struct{})}
+	}
+	batch := batcher.pending
+	batch.accessTokens = append(batch.accessTokens, accessTokens...)
+	batcher.pendingMu.Unlock()
+
+	batcher.writeMu.Lock()
+	defer batcher.writeMu.Unlock()
+	select {
+	case <-batch.done:
+		// Written by another caller of the same batch.
+		return batch.err
+	default:
+	}
+	batcher.pendingMu.Lock()
+	batcher.pending = nil
+	batcher.pendingMu.Unlock()
+
+	batch.err = s.writeUserAccessTokens(ctx, userID, batch.accessTokens)
+	close(batch.done)
+	return batch.err
+}
+
+// TouchUserAccessToken sets the last used time of the access token of the user, unless the token was removed meanwhile.
+func (s *Store) TouchUserAccessToken(ctx context.Context, userID int32, accessToken string, lastUsedTs int64) error {
+	value, _ := s.userAccessTokenBatchers.LoadOrStore(userID, &userAccessTokenBatcher{})
+	batcher := value.(*userAccessTokenBatcher)
+	batcher.writeMu.Lock()
+	defer batcher.writeMu.Unlock()
+
+	userAccessTokens, err := s.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, userAccessToken := range userAccessTokens {
+		if userAccessToken.AccessToken != accessToken {
+			continue
+		}
+		// The cached setting is shared, so the touched token is a copy.
+		touched := proto.Clone(userAccessToken).(*storepb.UserSetting_AccessTokensSetting_AccessToken)
+		touched.LastUsedTs = lastUsedTs
+		return s.writeUserAccessTokens(ctx, userID, []*storepb.UserSetting_AccessTokensSetting_AccessToken{touched})
+	}
+	return nil
+}
+
+// UpdateUserAccessTokens replaces the access tokens of the user with the ones update returns from the current ones, or
+// leaves them unchanged when it returns nil. The update is serialized with the other writes of the access tokens of the
+// user, so that neither loses the tokens of the other. The current access tokens are shared and must not be modified.
+func (s *Store) UpdateUserAccessTokens(ctx context.Context, userID int32, update func(accessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) ([]*storepb.UserSetting_AccessTokensSetting_AccessToken, error)) error {
+	value, _ := s.userAccessTokenBatchers.LoadOrStore(userID, &userAccessTokenBatcher{})
+	batcher := value.(*userAccessTokenBatcher)
+	batcher.writeMu.Lock()
+	defer batcher.writeMu.Unlock()
+
+	userAccessTokens, err := s.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+	updatedUserAccessTokens, err := update(slices.Clone(userAccessTokens))
+	if err != nil || updatedUserAccessTokens == nil {
+		return err
+	}
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.UserSetting_AccessTokensSetting{
+				AccessTokens: updatedUserAccessTokens,
+			},
+		},
+	})
+	return err
+}
+
+func (s *Store) writeUserAccessTokens(ctx context.Context, userID int32, accessTokens []*storepb.UserSetting_AccessTokensSetting_AccessToken) error {
+	userAccessTokens, err := s.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+	indexes := make(map[string]int, len(userAccessTokens))
+	list := make([]*storepb.UserSetting_AccessTokensSetting_AccessToken, 0, len(userAccessTokens)+len(accessTokens))
+	for _, userAccessToken := range userAccessTokens {
+		indexes[userAccessToken.AccessToken] = len(list)
+		list = append(list, userAccessToken)
+	}
+	for _, accessToken := range accessTokens {
+		if index, ok := indexes[accessToken.AccessToken]; ok {
+			list[index] = accessToken
+			continue
+		}
+		indexes[accessToken.AccessToken] = len(list)
+		list = append(list, accessToken)
+	}
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.UserSetting_AccessTokensSetting{
+				AccessTokens: list,
+			},
+		},
+	})
+	return err
+}
@@ -0,0 +1,28 @@
+package store
+
+import "context"
+
+// DefaultWorkspaceID is the workspace of the instances without multiple workspaces,
+// which keeps the data created before the workspaces.
+const DefaultWorkspaceID = ""
+
+type workspaceIDContextKey struct{}
+
+// WithWorkspaceID returns the context whose store operations are scoped to the workspace.
+func WithWorkspaceID(ctx context.Context, workspaceID string) context.Context {
+	return context.WithValue(ctx, workspaceIDContextKey{}, workspaceID)
+}
+
+// GetWorkspaceID returns the workspace the store operations of the context are scoped to,
+// the default workspace when the context has none.
+func GetWorkspaceID(ctx context.Context) string {
+	if workspaceID, ok := ctx.Value(workspaceIDContextKey{}).(string); ok {
+		return workspaceID
+	}
+	return DefaultWorkspaceID
+}
+
+// ListWorkspaceIDs returns the default workspace and the workspaces of the profile.
+func (s *Store) ListWorkspaceIDs() []string {
+	return append([]string{DefaultWorkspaceID}, s.profile.Workspaces...)
+}
@@ -17,7 +17,7 @@ func (s *Store) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Work
 	if err != nil {
 		return nil, err
 	}
-	s.workspaceSettingCache.Store(workspaceSetting.Key, workspaceSetting)
+	s.workspaceSettingCache.Store(getWorkspaceSettingCacheKey(GetWorkspaceID(ctx), workspaceSetting.Key), workspaceSetting)
 	return workspaceSetting, nil
 }
 
@@ -27,14 +27,14 @@ func (s *Store) ListWorkspaceSettings(ctx context.Context, find *FindWorkspaceSe
 		return nil, err
 	}
 	for _, workspaceSetting := range list {
-		s.workspaceSettingCache.Store(workspaceSetting.Key, workspaceSetting)
+		s.workspaceSettingCache.Store(getWorkspaceSettingCacheKey(GetWorkspaceID(ctx), workspaceSetting.Key), workspaceSetting)
 	}
 	return list, nil
 }
 
 func (s *Store) GetWorkspaceSetting(ctx context.Context, find *FindWorkspaceSetting) (*storepb.WorkspaceSetting, error) {
 	if find.Key != storepb.WorkspaceSettingKey_WORKSPACE_SETTING_KEY_UNSPECIFIED {
-		if cache, ok := s.workspaceSettingCache.Load(find.Key); ok {
+		if cache, ok := s.workspaceSettingCache.Load(getWorkspaceSettingCacheKey(GetWorkspaceID(ctx), find.Key)); ok {
 			return cache.(*storepb.WorkspaceSetting), nil
 		}
 	}
@@ -48,7 +48,7 @@ func (s *Store) GetWorkspaceSetting(ctx context.Context, find *FindWorkspaceSett
 	}
 
 	workspaceSetting := list[0]
-	s.workspaceSettingCache.Store(workspaceSetting.Key, workspaceSetting)
+	s.workspaceSettingCache.Store(getWorkspaceSettingCacheKey(GetWorkspaceID(ctx), workspaceSetting.Key), workspaceSetting)
 	return workspaceSetting, nil
 }
 
@@ -56,7 +56,7 @@ func (s *Store) DeleteWorkspaceSetting(ctx context.Context, key storepb.Workspac
 	if err := s.driver.DeleteWorkspaceSetting(ctx, key); err != nil {
 		return errors.Wrap(err, "failed to delete workspace setting")
 	}
-	s.workspaceSettingCache.Delete(key)
+	s.workspaceSettingCache.Delete(getWorkspaceSettingCacheKey(GetWorkspaceID(ctx), key))
 	return nil
 }
 
@@ -90,3 +90,45 @@ func (s *Store) GetWorkspaceSecuritySetting(ctx context.Context) (*storepb.Works
 	}
 	return securitySetting, nil
 }
+
+func (s *Store) GetWorkspaceShortcutRelatedSetting(ctx context.Context) (*storepb.WorkspaceSetting_ShortcutRelatedSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+	})
+	if err != nil {
+		return nil, err
+	}
+	shortcutRelatedSetting := &storepb.WorkspaceSetting_ShortcutRelatedSetting{}
+	if setting != nil && setting.GetShortcutRelated() != nil {
+		shortcutRelatedSetting = setting.GetShortcutRelated()
+	}
+	return shortcutRelatedSetting, nil
+}
+
+func (s *Store) GetWorkspaceEmbedSetting(ctx context.Context) (*storepb.WorkspaceSetting_EmbedSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_EMBED,
+	})
+	if err != nil {
+		return nil, err
+	}
+	embedSetting := &storepb.WorkspaceSetting_EmbedSetting{}
+	if setting != nil && setting.GetEmbed() != nil {
+		embedSetting = setting.GetEmbed()
+	}
+	return embedSetting, nil
+}
+
+func (s *Store) GetWorkspaceRepoSyncSetting(ctx context.Context) (*storepb.WorkspaceSetting_RepoSyncSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_REPO_SYNC,
+	})
+	if err != nil {
+		return nil, err
+	}
+	repoSyncSetting := &storepb.WorkspaceSetting_RepoSyncSetting{}
+	if setting != nil && setting.GetRepoSync() != nil {
+		repoSyncSetting = setting.GetRepoSync()
+	}
+	return repoSyncSetting, nil
+}
@@ -5,8 +5,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
 
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
 )
 
 func TestActivityStore(t *testing.T) {
@@ -28,4 +32,90 @@ func TestActivityStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(list))
 	require.Equal(t, activity, list[0])
+
+	// Activities are paged through in order of id.
+	next, err := ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityShortcutView,
+		Level:     store.ActivityInfo,
+		Payload:   "",
+	})
+	require.NoError(t, err)
+	limit := 1
+	list, err = ts.ListActivities(ctx, &store.FindActivity{Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, []*store.Activity{activity}, list)
+	list, err = ts.ListActivities(ctx, &store.FindActivity{IDAfter: &activity.ID, Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, []*store.Activity{next}, list)
+
+	// Only the activities created before the time are deleted.
+	require.NoError(t, ts.DeleteActivities(ctx, &store.DeleteActivity{CreatedTsBefore: activity.CreatedTs}))
+	list, err = ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	require.NoError(t, ts.DeleteActivities(ctx, &store.DeleteActivity{CreatedTsBefore: next.CreatedTs + 1}))
+	list, err = ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(list))
+}
+
+func TestGetShortcutViewStats(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("only runs against a fresh sqlite database")
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts := store.New(dbDriver, profile)
+	require.NoError(t, ts.Migrate(ctx))
+
+	createView := func(shortcutID int32, referer, userAgent string, createdTs int64) {
+		payload, err := protojson.Marshal(&storepb.ActivityShorcutViewPayload{
+			ShortcutId: shortcutID,
+			Referer:    referer,
+			UserAgent:  userAgent,
+		})
+		require.NoError(t, err)
+		activity, err := ts.CreateActivity(ctx, &store.Activity{
+			Type:    store.ActivityShortcutView,
+			Level:   store.ActivityInfo,
+			Payload: string(payload),
+		})
+		require.NoError(t, err)
+		_, err = dbDriver.GetDB().ExecContext(ctx, "UPDATE activity SET created_ts = $1 WHERE id = $2", createdTs, activity.ID)
+		require.NoError(t, err)
+	}
+	// 2024-01-01T00:00:00Z.
+	day := int64(1704067200)
+	createView(1, "https://a.com", "curl/8.0", day)
+	createView(1, "https://a.com", "curl/8.0", day+3600)
+	createView(1, "", "curl/8.0", day+86400)
+	createView(1, "https://b.com", "", day+3*86400)
+	createView(2, "https://a.com", "curl/8.0", day)
+
+	stats, err := ts.GetShortcutViewStats(ctx, &store.FindShortcutViewStats{ShortcutID: 1})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), stats.Total)
+	require.Equal(t, []*store.ShortcutViewCount{{Key: "", Count: 1}, {Key: "https://a.com", Count: 2}, {Key: "https://b.com", Count: 1}}, stats.Referers)
+	require.Equal(t, []*store.ShortcutViewCount{{Key: "", Count: 1}, {Key: "curl/8.0", Count: 3}}, stats.UserAgents)
+	require.Equal(t, []*store.ShortcutViewCount{{Key: "2024-01-01", Count: 2}, {Key: "2024-01-02", Count: 1}, {Key: "2024-01-04", Count: 1}}, stats.Days)
+
+	// The start of the range is inclusive and the end exclusive.
+	createdTsAfter, createdTsBefore := day+3600, day+3*86400
+	stats, err = ts.GetShortcutViewStats(ctx, &store.FindShortcutViewStats{ShortcutID: 1, CreatedTsAfter: &createdTsAfter, CreatedTsBefore: &createdTsBefore})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), stats.Total)
+	require.Equal(t, []*store.ShortcutViewCount{{Key: "2024-01-01", Count: 1}, {Key: "2024-01-02", Count: 1}}, stats.Days)
+
+	// The latest view of each viewed shortcut is listed at once.
+	lastViewedTs, err := ts.ListShortcutLastViewedTs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[int32]int64{1: day + 3*86400, 2: day}, lastViewedTs)
+
+	// A shortcut without views has empty stats.
+	stats, err = ts.GetShortcutViewStats(ctx, &store.FindShortcutViewStats{ShortcutID: 3})
+	require.NoError(t, err)
+	require.Equal(t, &store.ShortcutViewStats{Referers: []*store.ShortcutViewCount{}, UserAgents: []*store.ShortcutViewCount{}, Days: []*store.ShortcutViewCount{}}, stats)
 }
@@ -5,6 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
 )
 
 func TestGetCurrentSchemaVersion(t *testing.T) {
@@ -13,5 +17,51 @@ func TestGetCurrentSchemaVersion(t *testing.T) {
 
 	currentSchemaVersion, err := ts.GetCurrentSchemaVersion()
 	require.NoError(t, err)
-	require.Equal(t, "1.0.1", currentSchemaVersion)
+	require.Equal(t, "1.0.20", currentSchemaVersion)
+}
+
+func TestGetMigrationStatus(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	resetTestingDB(ctx, profile, dbDriver)
+	ts := store.New(dbDriver, profile)
+
+	// The latest schema is pending on an empty database.
+	migrationStatus, err := ts.GetMigrationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "", migrationStatus.CurrentVersion)
+	require.Equal(t, "1.0.20", migrationStatus.SchemaVersion)
+	require.Equal(t, 1, len(migrationStatus.Pending))
+	require.Equal(t, "1.0.20", migrationStatus.Pending[0].Version)
+	require.Contains(t, migrationStatus.Pending[0].FilePath, store.LatestSchemaFileName)
+
+	require.NoError(t, ts.Migrate(ctx))
+	migrationStatus, err = ts.GetMigrationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "1.0.20", migrationStatus.CurrentVersion)
+	require.Empty(t, migrationStatus.Pending)
+
+	// Seed an older schema version, the migrations after it are pending in order.
+	_, err = dbDriver.GetDB().ExecContext(ctx, "DELETE FROM migration_history")
+	require.NoError(t, err)
+	_, err = dbDriver.UpsertMigrationHistory(ctx, &store.UpsertMigrationHistory{Version: "1.0.10"})
+	require.NoError(t, err)
+	migrationStatus, err = ts.GetMigrationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "1.0.10", migrationStatus.CurrentVersion)
+	require.Equal(t, 1, len(migrationStatus.Applied))
+	pendingVersions := []string{}
+	for _, pendingMigration := range migrationStatus.Pending {
+		pendingVersions = append(pendingVersions, pendingMigration.Version)
+	}
+	require.Equal(t, []string{"1.0.11", "1.0.12", "1.0.13", "1.0.14", "1.0.15", "1.0.16", "1.0.17", "1.0.18", "1.0.19", "1.0.20"}, pendingVersions)
+	require.Contains(t, migrationStatus.Pending[9].FilePath, "19__user_avatar.sql")
+
+	// Getting the status doesn't apply the migrations.
+	migrationHistories, err := dbDriver.ListMigrationHistories(ctx, &store.FindMigrationHistory{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(migrationHistories))
+	require.Equal(t, "1.0.10", migrationHistories[0].Version)
 }
@@ -0,0 +1,107 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/store/metrics"
+	"github.com/yourselfhosted/slash/test"
+)
+
+func TestShortcutNameCache(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.ShortcutCacheSize = 2
+	profile.ShortcutCacheTTL = time.Hour
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	resetTestingDB(ctx, profile, dbDriver)
+	storeMetrics := metrics.New()
+	ts := store.New(metrics.NewDriver(dbDriver, storeMetrics), profile)
+	require.NoError(t, ts.Migrate(ctx))
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	createShortcut := func(name string) *storepb.Shortcut {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	resolve := func(name string) *storepb.Shortcut {
+		shortcut, err := ts.ResolveShortcutName(ctx, name, 0)
+		require.NoError(t, err)
+		return shortcut
+	}
+	requireLookups := func(hits, misses uint64) {
+		t.Helper()
+		require.Equal(t, metrics.CacheStats{Hits: hits, Misses: misses}, storeMetrics.CacheStats("shortcut_name"))
+	}
+
+	// The names not found are cached too, until a shortcut takes them.
+	require.Nil(t, resolve("docs"))
+	require.Nil(t, resolve("docs"))
+	requireLookups(1, 1)
+	docs := createShortcut("docs")
+	require.Equal(t, docs.Id, resolve("docs").Id)
+	require.Equal(t, docs.Id, resolve("docs").Id)
+	requireLookups(2, 2)
+
+	// The updates evict both the old and the new names.
+	require.Nil(t, resolve("wiki"))
+	newName := "wiki"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{ID: docs.Id, Name: &newName})
+	require.NoError(t, err)
+	require.Nil(t, resolve("docs"))
+	require.Equal(t, docs.Id, resolve("wiki").Id)
+	requireLookups(2, 5)
+	require.NoError(t, ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: docs.Id}))
+	require.Nil(t, resolve("wiki"))
+	requireLookups(2, 6)
+
+	// The least recently used name is evicted past the size of the cache.
+	createShortcut("a")
+	createShortcut("b")
+	createShortcut("c")
+	resolve("a")
+	resolve("b")
+	resolve("a")
+	resolve("c")
+	requireLookups(3, 9)
+	resolve("a")
+	resolve("b")
+	requireLookups(4, 10)
+}
+
+func TestShortcutNameCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	profile.ShortcutCacheSize = 10
+	profile.ShortcutCacheTTL = 50 * time.Millisecond
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	resetTestingDB(ctx, profile, dbDriver)
+	storeMetrics := metrics.New()
+	ts := store.New(metrics.NewDriver(dbDriver, storeMetrics), profile)
+	require.NoError(t, ts.Migrate(ctx))
+
+	for i := 0; i < 2; i++ {
+		_, err := ts.ResolveShortcutName(ctx, "docs", 0)
+		require.NoError(t, err)
+	}
+	require.Equal(t, metrics.CacheStats{Hits: 1, Misses: 1}, storeMetrics.CacheStats("shortcut_name"))
+	time.Sleep(100 * time.Millisecond)
+	_, err = ts.ResolveShortcutName(ctx, "docs", 0)
+	require.NoError(t, err)
+	require.Equal(t, metrics.CacheStats{Hits: 1, Misses: 2}, storeMetrics.CacheStats("shortcut_name"))
+}
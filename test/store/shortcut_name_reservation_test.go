@@ -0,0 +1,122 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestReserveShortcutName(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+
+	reservation, err := ts.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "test", user.ID, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, reservation.UserID)
+	require.Equal(t, "test", reservation.Name)
+	found, err := ts.GetShortcutNameReservation(ctx, &store.FindShortcutNameReservation{
+		Namespace: store.DefaultShortcutNamespace,
+		Name:      "test",
+	})
+	require.NoError(t, err)
+	require.Equal(t, reservation, found)
+
+	// Reserving again by the same user extends the reservation.
+	extended, err := ts.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "test", user.ID, 2*time.Hour)
+	require.NoError(t, err)
+	require.Greater(t, extended.ExpiresTs, reservation.ExpiresTs)
+
+	// The same name in another namespace is independent.
+	_, err = ts.ReserveShortcutName(ctx, "team", "test", other.ID, time.Hour)
+	require.NoError(t, err)
+
+	// Deleting the reservation frees the name.
+	err = ts.DeleteShortcutNameReservation(ctx, &store.DeleteShortcutNameReservation{
+		Namespace: store.DefaultShortcutNamespace,
+		Name:      "test",
+	})
+	require.NoError(t, err)
+	_, err = ts.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "test", other.ID, time.Hour)
+	require.NoError(t, err)
+}
+
+func TestReserveShortcutNameConflict(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+
+	_, err = ts.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "test", user.ID, time.Hour)
+	require.NoError(t, err)
+	_, err = ts.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "test", other.ID, time.Hour)
+	require.ErrorIs(t, err, store.ErrShortcutNameTaken)
+	found, err := ts.GetShortcutNameReservation(ctx, &store.FindShortcutNameReservation{
+		Namespace: store.DefaultShortcutNamespace,
+		Name:      "test",
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, found.UserID)
+
+	// Names used by existing shortcuts can't be reserved.
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "existing",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = ts.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "existing", user.ID, time.Hour)
+	require.ErrorIs(t, err, store.ErrShortcutNameTaken)
+
+	_, err = ts.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "", user.ID, time.Hour)
+	require.Error(t, err)
+	_, err = ts.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "test", user.ID, 0)
+	require.Error(t, err)
+}
+
+func TestReserveShortcutNameExpiry(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+
+	_, err = ts.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "test", user.ID, time.Second)
+	require.NoError(t, err)
+	time.Sleep(2 * time.Second)
+
+	found, err := ts.GetShortcutNameReservation(ctx, &store.FindShortcutNameReservation{
+		Namespace: store.DefaultShortcutNamespace,
+		Name:      "test",
+	})
+	require.NoError(t, err)
+	require.Nil(t, found)
+	reservation, err := ts.ReserveShortcutName(ctx, store.DefaultShortcutNamespace, "test", other.ID, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, other.ID, reservation.UserID)
+}
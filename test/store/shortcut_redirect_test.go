@@ -0,0 +1,77 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestShortcutNameFromLink(t *testing.T) {
+	tests := []struct {
+		instanceURL string
+		link        string
+		name        string
+		ok          bool
+	}{
+		{"https://slash.example.com", "https://slash.example.com/s/docs", "docs", true},
+		{"https://slash.example.com/", "http://SLASH.example.com/s/docs/page?q=1", "docs", true},
+		{"https://example.com/slash", "https://example.com/slash/s/docs", "docs", true},
+		{"https://example.com/slash", "https://example.com/s/docs", "", false},
+		{"https://slash.example.com", "https://other.example.com/s/docs", "", false},
+		{"https://slash.example.com", "https://slash.example.com/c/docs", "", false},
+		{"https://slash.example.com", "https://slash.example.com/s/", "", false},
+		{"", "https://slash.example.com/s/docs", "", false},
+	}
+	for _, test := range tests {
+		name, ok := store.ShortcutNameFromLink(test.instanceURL, test.link)
+		require.Equal(t, test.ok, ok, test.link)
+		require.Equal(t, test.name, name, test.link)
+	}
+}
+
+func TestCheckShortcutRedirectLoop(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	createShortcut := func(name, link string) {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       link,
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+	createShortcut("b", "https://slash.example.com/s/c")
+	createShortcut("c", "https://slash.example.com/s/a")
+
+	// Without an instance url there is nothing to resolve against.
+	require.NoError(t, ts.CheckShortcutRedirectLoop(ctx, "a", "https://slash.example.com/s/b"))
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL,
+		Value: &storepb.WorkspaceSetting_General{
+			General: &storepb.WorkspaceSetting_GeneralSetting{
+				InstanceUrl: "https://slash.example.com",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.CheckShortcutRedirectLoop(ctx, "a", "https://example.com"))
+	require.NoError(t, ts.CheckShortcutRedirectLoop(ctx, "a", "https://slash.example.com/s/missing"))
+	require.ErrorIs(t, ts.CheckShortcutRedirectLoop(ctx, "a", "https://slash.example.com/s/a"), store.ErrShortcutRedirectLoop)
+	require.ErrorIs(t, ts.CheckShortcutRedirectLoop(ctx, "a", "https://slash.example.com/s/b"), store.ErrShortcutRedirectLoop)
+
+	// Chains longer than the maximum depth are rejected.
+	for i := 0; i < store.MaxShortcutRedirectDepth; i++ {
+		createShortcut(string(rune('m'+i)), "https://slash.example.com/s/"+string(rune('m'+i+1)))
+	}
+	require.ErrorIs(t, ts.CheckShortcutRedirectLoop(ctx, "l", "https://slash.example.com/s/m"), store.ErrShortcutRedirectLoop)
+	require.NoError(t, ts.CheckShortcutRedirectLoop(ctx, "l", "https://slash.example.com/s/n"))
+}
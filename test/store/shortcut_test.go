@@ -8,6 +8,8 @@ import (
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+	"github.com/yourselfhosted/slash/test"
 )
 
 func TestShortcutStore(t *testing.T) {
@@ -53,3 +55,581 @@ func TestShortcutStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(shortcuts))
 }
+
+func TestCreateShortcutWithExistingName(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	create := func(name string) (*storepb.Shortcut, error) {
+		return ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://test.link",
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+	}
+	shortcut, err := create("test")
+	require.NoError(t, err)
+	_, err = create("test")
+	require.ErrorIs(t, err, store.ErrShortcutNameExists)
+
+	other, err := create("other")
+	require.NoError(t, err)
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   other.Id,
+		Name: &shortcut.Name,
+	})
+	require.ErrorIs(t, err, store.ErrShortcutNameExists)
+
+	// Archived shortcuts don't hold their name.
+	archived := storepb.RowStatus_ARCHIVED
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		RowStatus: &archived,
+	})
+	require.NoError(t, err)
+	_, err = create("test")
+	require.NoError(t, err)
+}
+
+func TestShortcutNameScopes(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	otherUser, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+	create := func(creatorID int32, visibility storepb.Visibility) (*storepb.Shortcut, error) {
+		return ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  creatorID,
+			Name:       "docs",
+			Link:       "https://test.link",
+			Visibility: visibility,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+	}
+
+	// The private shortcuts of each user and the shared shortcut have their own scopes.
+	private, err := create(user.ID, storepb.Visibility_PRIVATE)
+	require.NoError(t, err)
+	otherPrivate, err := create(otherUser.ID, storepb.Visibility_PRIVATE)
+	require.NoError(t, err)
+	workspace, err := create(otherUser.ID, storepb.Visibility_WORKSPACE)
+	require.NoError(t, err)
+	_, err = create(user.ID, storepb.Visibility_PRIVATE)
+	require.ErrorIs(t, err, store.ErrShortcutNameExists)
+	// The workspace and public shortcuts share their scope.
+	_, err = create(user.ID, storepb.Visibility_PUBLIC)
+	require.ErrorIs(t, err, store.ErrShortcutNameExists)
+	publicVisibility := storepb.Visibility_PUBLIC
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:         private.Id,
+		Visibility: &publicVisibility,
+	})
+	require.ErrorIs(t, err, store.ErrShortcutNameExists)
+
+	shortcut, err := ts.GetShortcutInNameScope(ctx, "docs", store.GetShortcutNameScope(storepb.Visibility_PRIVATE, otherUser.ID))
+	require.NoError(t, err)
+	require.Equal(t, otherPrivate.Id, shortcut.Id)
+	shortcut, err = ts.GetShortcutInNameScope(ctx, "docs", store.GetShortcutNameScope(storepb.Visibility_PUBLIC, user.ID))
+	require.NoError(t, err)
+	require.Equal(t, workspace.Id, shortcut.Id)
+
+	// The name resolves to the private shortcut of the user first, then to the shared one.
+	shortcut, err = ts.ResolveShortcutName(ctx, "docs", user.ID)
+	require.NoError(t, err)
+	require.Equal(t, private.Id, shortcut.Id)
+	shortcut, err = ts.ResolveShortcutName(ctx, "docs", otherUser.ID)
+	require.NoError(t, err)
+	require.Equal(t, otherPrivate.Id, shortcut.Id)
+	shortcut, err = ts.ResolveShortcutName(ctx, "docs", 0)
+	require.NoError(t, err)
+	require.Equal(t, workspace.Id, shortcut.Id)
+
+	// The archived shortcuts don't resolve, and neither do the private shortcuts of other users.
+	archived := storepb.RowStatus_ARCHIVED
+	archive := func(shortcut *storepb.Shortcut) {
+		_, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:        shortcut.Id,
+			RowStatus: &archived,
+		})
+		require.NoError(t, err)
+	}
+	archive(workspace)
+	shortcut, err = ts.ResolveShortcutName(ctx, "docs", 0)
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+	archive(otherPrivate)
+	shortcut, err = ts.ResolveShortcutName(ctx, "docs", otherUser.ID)
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+	shortcut, err = ts.ResolveShortcutName(ctx, "docs", user.ID)
+	require.NoError(t, err)
+	require.Equal(t, private.Id, shortcut.Id)
+	archive(private)
+	shortcut, err = ts.ResolveShortcutName(ctx, "docs", user.ID)
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+	public, err := create(user.ID, storepb.Visibility_PUBLIC)
+	require.NoError(t, err)
+	shortcut, err = ts.ResolveShortcutName(ctx, "docs", 0)
+	require.NoError(t, err)
+	require.Equal(t, public.Id, shortcut.Id)
+	shortcut, err = ts.ResolveShortcutName(ctx, "docs", otherUser.ID)
+	require.NoError(t, err)
+	require.Equal(t, public.Id, shortcut.Id)
+}
+
+func TestListShortcutsCursor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, name := range []string{"c", "a", "d", "b"} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Title:      "same",
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+
+	// The ties of the title are broken by id.
+	orderBy := []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldTitle, Desc: true}}
+	limit := 3
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{OrderBy: orderBy, Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "a", "d"}, shortcutNames(shortcuts))
+	last := shortcuts[len(shortcuts)-1]
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		OrderBy: orderBy,
+		Cursor:  &store.ShortcutCursor{ID: last.Id, Values: []any{last.Title}},
+		Limit:   &limit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b"}, shortcutNames(shortcuts))
+
+	orderBy = []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldName, Desc: true}}
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		OrderBy: orderBy,
+		Cursor:  &store.ShortcutCursor{ID: last.Id, Values: []any{last.Name}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "b", "a"}, shortcutNames(shortcuts))
+
+	_, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		OrderBy: orderBy,
+		Cursor:  &store.ShortcutCursor{ID: last.Id},
+	})
+	require.Error(t, err)
+}
+
+func shortcutNames(shortcuts []*storepb.Shortcut) []string {
+	names := []string{}
+	for _, shortcut := range shortcuts {
+		names = append(names, shortcut.Name)
+	}
+	return names
+}
+
+func TestListShortcutsByTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for name, tags := range map[string][]string{
+		"docs":      {"dev", "docs"},
+		"devtools":  {"devtools"},
+		"snake":     {"a_b"},
+		"lookalike": {"axb"},
+		"untagged":  {},
+	} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: storepb.Visibility_WORKSPACE,
+			Tags:       tags,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+	orderBy := []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldName}}
+	tests := []struct {
+		tags     []string
+		matchAny bool
+		want     []string
+	}{
+		{nil, false, []string{"devtools", "docs", "lookalike", "snake", "untagged"}},
+		// The tags match whole, so "dev" doesn't match "devtools".
+		{[]string{"dev"}, false, []string{"docs"}},
+		{[]string{"dev", "docs"}, false, []string{"docs"}},
+		{[]string{"dev", "devtools"}, false, []string{}},
+		{[]string{"dev", "devtools"}, true, []string{"devtools", "docs"}},
+		// The LIKE wildcards of the tags are escaped.
+		{[]string{"a_b"}, false, []string{"snake"}},
+		{[]string{"%"}, true, []string{}},
+	}
+	for _, test := range tests {
+		shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+			OrderBy:      orderBy,
+			Tags:         test.tags,
+			TagsMatchAny: test.matchAny,
+		})
+		require.NoError(t, err)
+		require.Equal(t, test.want, shortcutNames(shortcuts), test.tags)
+	}
+}
+
+func TestListShortcutsVisibleTo(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	owner, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{Role: store.RoleUser, Email: "other@test.com", Nickname: "other"})
+	require.NoError(t, err)
+	for name, visibility := range map[string]storepb.Visibility{
+		"public":    storepb.Visibility_PUBLIC,
+		"workspace": storepb.Visibility_WORKSPACE,
+		"private":   storepb.Visibility_PRIVATE,
+	} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  owner.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: visibility,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+	orderBy := []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldName}}
+	tests := []struct {
+		visibleTo *store.ShortcutVisibilityFilter
+		want      []string
+	}{
+		{nil, []string{"private", "public", "workspace"}},
+		{&store.ShortcutVisibilityFilter{}, []string{"public"}},
+		{&store.ShortcutVisibilityFilter{UserID: other.ID}, []string{"public", "workspace"}},
+		{&store.ShortcutVisibilityFilter{UserID: owner.ID}, []string{"private", "public", "workspace"}},
+	}
+	for _, test := range tests {
+		shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+			OrderBy:   orderBy,
+			VisibleTo: test.visibleTo,
+		})
+		require.NoError(t, err)
+		require.Equal(t, test.want, shortcutNames(shortcuts), test.visibleTo)
+	}
+
+	// The filter applies before the limit, so the pages stay full.
+	limit := 1
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		OrderBy:   orderBy,
+		VisibleTo: &store.ShortcutVisibilityFilter{UserID: other.ID},
+		Limit:     &limit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"public"}, shortcutNames(shortcuts))
+	name := "private"
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_PRIVATE, shortcut.Visibility)
+}
+
+func TestListShortcutGroups(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	ids := map[string]int32{}
+	for _, create := range []struct {
+		name       string
+		tags       []string
+		visibility storepb.Visibility
+	}{
+		{"a", []string{"dev", "go"}, storepb.Visibility_PUBLIC},
+		{"b", []string{"dev", "dev"}, storepb.Visibility_WORKSPACE},
+		{"c", []string{"docs"}, storepb.Visibility_WORKSPACE},
+		{"d", []string{}, storepb.Visibility_WORKSPACE},
+	} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       create.name,
+			Link:       "https://example.com/" + create.name,
+			Visibility: create.visibility,
+			Tags:       create.tags,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		ids[create.name] = shortcut.Id
+	}
+
+	// The repeated tags of a shortcut count once, and the untagged shortcuts are in no group.
+	groups, err := ts.ListShortcutGroups(ctx, &store.FindShortcutGroup{Field: store.ShortcutGroupFieldTag})
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutGroup{
+		{Key: "dev", Count: 2, SampleID: ids["a"]},
+		{Key: "docs", Count: 1, SampleID: ids["c"]},
+		{Key: "go", Count: 1, SampleID: ids["a"]},
+	}, groups)
+
+	limit := 1
+	groups, err = ts.ListShortcutGroups(ctx, &store.FindShortcutGroup{Field: store.ShortcutGroupFieldVisibility, Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutGroup{{Key: "WORKSPACE", Count: 3, SampleID: ids["b"]}}, groups)
+
+	groups, err = ts.ListShortcutGroups(ctx, &store.FindShortcutGroup{
+		Find:  &store.FindShortcut{Tags: []string{"dev"}},
+		Field: store.ShortcutGroupFieldCreatorID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(groups))
+	require.Equal(t, int32(2), groups[0].Count)
+}
+
+func TestListShortcutsByKeyword(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, create := range []*storepb.Shortcut{
+		{Name: "handbook", Title: "Team docs", Description: "The handbook"},
+		{Name: "docs", Title: "Documentation"},
+		{Name: "wiki", Title: "Wiki", Description: "All the DOCS"},
+		{Name: "go", Title: "Go", Tags: []string{"docs"}},
+		{Name: "unrelated", Title: "100% off", Description: "a_b"},
+	} {
+		create.CreatorId = user.ID
+		create.Link = "https://example.com/" + create.Name
+		create.Visibility = storepb.Visibility_WORKSPACE
+		create.OgMetadata = &storepb.OpenGraphMetadata{}
+		_, err := ts.CreateShortcut(ctx, create)
+		require.NoError(t, err)
+	}
+	listNames := func(keyword string, cursor *store.ShortcutCursor, limit *int) []string {
+		shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+			Keyword: &keyword,
+			OrderBy: []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldName}},
+			Cursor:  cursor,
+			Limit:   limit,
+		})
+		require.NoError(t, err)
+		return shortcutNames(shortcuts)
+	}
+
+	// The name matches come first, then the title, description and tag ones.
+	require.Equal(t, []string{"docs", "handbook", "wiki", "go"}, listNames("Docs", nil, nil))
+	limit := 2
+	name := "docs"
+	docs, err := ts.GetShortcut(ctx, &store.FindShortcut{Name: &name})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), store.GetShortcutKeywordRank(docs, "Docs"))
+	require.Equal(t, []string{"handbook", "wiki"}, listNames("Docs", &store.ShortcutCursor{ID: docs.Id, Values: []any{int64(0), docs.Name}}, &limit))
+	// The LIKE wildcards of the keyword are escaped.
+	require.Equal(t, []string{"unrelated"}, listNames("0%", nil, nil))
+	require.Equal(t, []string{"unrelated"}, listNames("a_b", nil, nil))
+	require.Equal(t, []string{}, listNames("a%b", nil, nil))
+}
+
+func TestAddShortcutViews(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcuts := []*storepb.Shortcut{}
+	for _, name := range []string{"a", "b"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: storepb.Visibility_PUBLIC,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int32(0), shortcut.ViewCount)
+		require.Equal(t, int64(0), shortcut.LastViewedTs)
+		shortcuts = append(shortcuts, shortcut)
+	}
+	// Cache the shortcut, the views must not be hidden by the cache.
+	_, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcuts[0].Id})
+	require.NoError(t, err)
+
+	require.NoError(t, ts.AddShortcutViews(ctx, []*store.ShortcutViews{
+		{ShortcutID: shortcuts[0].Id, Count: 3, LastViewedTs: 200},
+		{ShortcutID: shortcuts[1].Id, Count: 1, LastViewedTs: 100},
+	}))
+	// The last view time doesn't move back when an older batch is added later.
+	require.NoError(t, ts.AddShortcutViews(ctx, []*store.ShortcutViews{
+		{ShortcutID: shortcuts[0].Id, Count: 2, LastViewedTs: 150},
+	}))
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcuts[0].Id})
+	require.NoError(t, err)
+	require.Equal(t, int32(5), shortcut.ViewCount)
+	require.Equal(t, int64(200), shortcut.LastViewedTs)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcuts[1].Id})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), shortcut.ViewCount)
+	require.Equal(t, int64(100), shortcut.LastViewedTs)
+}
+
+func TestImportShortcuts(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	existing, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "existing",
+		Link:       "https://example.com/old",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	newShortcut := func(name string) *storepb.Shortcut {
+		return &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		}
+	}
+	newLink := "https://example.com/new"
+
+	// A failing write rolls back the whole import.
+	_, _, err = ts.ImportShortcuts(ctx, &store.ImportShortcuts{
+		Creates: []*storepb.Shortcut{newShortcut("first"), newShortcut("existing")},
+		Updates: []*store.UpdateShortcut{{ID: existing.Id, Link: &newLink}},
+	})
+	require.Error(t, err)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, "https://example.com/old", shortcuts[0].Link)
+
+	created, updated, err := ts.ImportShortcuts(ctx, &store.ImportShortcuts{
+		Creates: []*storepb.Shortcut{newShortcut("first"), newShortcut("second")},
+		Updates: []*store.UpdateShortcut{{ID: existing.Id, Link: &newLink}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(created))
+	require.Equal(t, "second", created[1].Name)
+	require.Equal(t, 1, len(updated))
+	require.Equal(t, newLink, updated[0].Link)
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &existing.Id})
+	require.NoError(t, err)
+	require.Equal(t, newLink, shortcut.Link)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(shortcuts))
+}
+
+func TestArchiveShortcut(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	createShortcut := func(name string) *storepb.Shortcut {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://test.link/" + name,
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	listNames := func(find *store.FindShortcut) []string {
+		shortcuts, err := ts.ListShortcuts(ctx, find)
+		require.NoError(t, err)
+		return shortcutNames(shortcuts)
+	}
+	docs := createShortcut("docs")
+	createShortcut("wiki")
+
+	// The archived shortcuts are excluded unless included or matched by their row status.
+	archived, err := ts.ArchiveShortcut(ctx, docs.Id)
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_ARCHIVED, archived.RowStatus)
+	require.ElementsMatch(t, []string{"wiki"}, listNames(&store.FindShortcut{}))
+	require.ElementsMatch(t, []string{"docs", "wiki"}, listNames(&store.FindShortcut{IncludeArchived: true}))
+	archivedStatus := storepb.RowStatus_ARCHIVED
+	require.ElementsMatch(t, []string{"docs"}, listNames(&store.FindShortcut{RowStatus: &archivedStatus}))
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &docs.Id})
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &docs.Id, IncludeArchived: true})
+	require.NoError(t, err)
+	require.Equal(t, docs.Id, shortcut.Id)
+
+	// The name of the archived shortcut is free, and it can't be unarchived while another shortcut has it.
+	newDocs := createShortcut("docs")
+	_, err = ts.UnarchiveShortcut(ctx, docs.Id)
+	require.ErrorIs(t, err, store.ErrShortcutNameExists)
+	_, err = ts.ArchiveShortcut(ctx, newDocs.Id)
+	require.NoError(t, err)
+	unarchived, err := ts.UnarchiveShortcut(ctx, docs.Id)
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_NORMAL, unarchived.RowStatus)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{Name: &docs.Name})
+	require.NoError(t, err)
+	require.Equal(t, docs.Id, shortcut.Id)
+}
+
+func TestShortcutSearchIndex(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	if profile.Driver != "sqlite" {
+		t.Skip("only runs against a fresh sqlite database")
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	ts := store.New(dbDriver, profile)
+	require.NoError(t, ts.Migrate(ctx))
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "handbook",
+		Link:       "https://example.com/handbook",
+		Title:      "Team docs",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	listNames := func(keyword string) []string {
+		shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{Keyword: &keyword})
+		require.NoError(t, err)
+		return shortcutNames(shortcuts)
+	}
+	require.Equal(t, []string{"handbook"}, listNames("DOCS"))
+
+	// The index follows the updates and deletions of the shortcuts.
+	title := "Team guides"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{ID: shortcut.Id, Title: &title})
+	require.NoError(t, err)
+	require.Equal(t, []string{}, listNames("docs"))
+	require.Equal(t, []string{"handbook"}, listNames("guide"))
+	// The keywords shorter than the trigrams of the index are matched too.
+	require.Equal(t, []string{"handbook"}, listNames("gu"))
+
+	// The index is rebuilt from the shortcuts after drifting from them.
+	_, err = dbDriver.GetDB().ExecContext(ctx, `INSERT INTO shortcut_fts (shortcut_fts) VALUES ('delete-all')`)
+	require.NoError(t, err)
+	require.Equal(t, []string{}, listNames("guide"))
+	require.NoError(t, ts.RebuildShortcutSearchIndex(ctx))
+	require.Equal(t, []string{"handbook"}, listNames("guide"))
+
+	require.NoError(t, ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: shortcut.Id}))
+	require.Equal(t, []string{}, listNames("guide"))
+}
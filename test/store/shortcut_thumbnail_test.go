@@ -0,0 +1,45 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestShortcutThumbnailStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	shortcutID := int32(1)
+	_, err := ts.UpsertShortcutThumbnail(ctx, &store.ShortcutThumbnail{ShortcutID: shortcutID, Link: "https://example.com"})
+	require.Error(t, err)
+	thumbnail, err := ts.UpsertShortcutThumbnail(ctx, &store.ShortcutThumbnail{
+		ShortcutID:  shortcutID,
+		Link:        "https://example.com",
+		ContentType: "image/png",
+		Content:     []byte("png"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("png"), thumbnail.Content)
+
+	// Upserting the thumbnail of the shortcut again replaces it.
+	_, err = ts.UpsertShortcutThumbnail(ctx, &store.ShortcutThumbnail{
+		ShortcutID:  shortcutID,
+		Link:        "https://example.org",
+		ContentType: "image/jpeg",
+		Content:     []byte("jpeg"),
+	})
+	require.NoError(t, err)
+	thumbnail, err = ts.GetShortcutThumbnail(ctx, &store.FindShortcutThumbnail{ShortcutID: &shortcutID})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.org", thumbnail.Link)
+	require.Equal(t, "image/jpeg", thumbnail.ContentType)
+	require.Equal(t, []byte("jpeg"), thumbnail.Content)
+
+	// The thumbnails are scoped to the workspace.
+	thumbnail, err = ts.GetShortcutThumbnail(store.WithWorkspaceID(ctx, "acme"), &store.FindShortcutThumbnail{ShortcutID: &shortcutID})
+	require.NoError(t, err)
+	require.Nil(t, thumbnail)
+}
@@ -0,0 +1,67 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestShortcutTombstone(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	createShortcut := func(name string) *storepb.Shortcut {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://example.com/" + name,
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		return shortcut
+	}
+	first, second := createShortcut("first"), createShortcut("second")
+
+	// Sync clients remember the time of their last sync.
+	lastSync := time.Now().Add(-time.Second).Unix()
+	err = ts.SoftDeleteShortcut(ctx, &store.DeleteShortcut{ID: first.Id})
+	require.NoError(t, err)
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &first.Id})
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+
+	tombstones, err := ts.ListShortcutTombstones(ctx, &store.FindShortcutTombstone{DeletedTsAfter: &lastSync})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tombstones))
+	require.Equal(t, first.Id, tombstones[0].ShortcutID)
+	require.Equal(t, user.ID, tombstones[0].CreatorID)
+	require.Equal(t, "first", tombstones[0].Name)
+
+	// Tombstones deleted before the last sync aren't returned again.
+	lastSync = tombstones[0].DeletedTs
+	tombstones, err = ts.ListShortcutTombstones(ctx, &store.FindShortcutTombstone{DeletedTsAfter: &lastSync})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tombstones))
+
+	err = ts.SoftDeleteShortcut(ctx, &store.DeleteShortcut{ID: second.Id})
+	require.NoError(t, err)
+	tombstones, err = ts.ListShortcutTombstones(ctx, &store.FindShortcutTombstone{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tombstones))
+	require.Equal(t, first.Id, tombstones[0].ShortcutID)
+	require.Equal(t, second.Id, tombstones[1].ShortcutID)
+
+	// Purging removes the tombstones deleted before the given time.
+	err = ts.DeleteShortcutTombstones(ctx, &store.DeleteShortcutTombstone{DeletedTsBefore: time.Now().Add(time.Second).Unix()})
+	require.NoError(t, err)
+	tombstones, err = ts.ListShortcutTombstones(ctx, &store.FindShortcutTombstone{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tombstones))
+}
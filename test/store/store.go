@@ -34,7 +34,12 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS user_setting CASCADE;
 		DROP TABLE IF EXISTS shortcut CASCADE;
 		DROP TABLE IF EXISTS activity CASCADE;
-		DROP TABLE IF EXISTS collection CASCADE;`)
+		DROP TABLE IF EXISTS collection CASCADE;
+		DROP TABLE IF EXISTS shortcut_name_reservation CASCADE;
+		DROP TABLE IF EXISTS shortcut_tombstone CASCADE;
+		DROP TABLE IF EXISTS shortcut_thumbnail CASCADE;
+		DROP TABLE IF EXISTS tag_policy CASCADE;
+		DROP TABLE IF EXISTS user_avatar CASCADE;`)
 		if err != nil {
 			fmt.Printf("failed to reset testing db, error: %+v\n", err)
 			panic(err)
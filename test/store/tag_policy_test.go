@@ -0,0 +1,111 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestTagPolicyStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	tagPolicy, err := ts.UpsertTagPolicy(ctx, &store.TagPolicy{
+		Tag:    "team",
+		UserID: user.ID,
+		Role:   store.TagPolicyRoleRead,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.TagPolicyRoleRead, tagPolicy.Role)
+	_, err = ts.UpsertTagPolicy(ctx, &store.TagPolicy{
+		Tag:    "team",
+		UserID: user.ID,
+		Role:   "OWNER",
+	})
+	require.Error(t, err)
+
+	// Upserting the policy of the user for the tag again replaces its role.
+	updated, err := ts.UpsertTagPolicy(ctx, &store.TagPolicy{
+		Tag:    "team",
+		UserID: user.ID,
+		Role:   store.TagPolicyRoleManage,
+	})
+	require.NoError(t, err)
+	require.Equal(t, tagPolicy.ID, updated.ID)
+	require.Equal(t, store.TagPolicyRoleManage, updated.Role)
+	_, err = ts.UpsertTagPolicy(ctx, &store.TagPolicy{
+		Tag:    "other",
+		UserID: user.ID,
+		Role:   store.TagPolicyRoleRead,
+	})
+	require.NoError(t, err)
+	tag := "team"
+	tagPolicies, err := ts.ListTagPolicies(ctx, &store.FindTagPolicy{Tag: &tag})
+	require.NoError(t, err)
+	require.Equal(t, []*store.TagPolicy{updated}, tagPolicies)
+
+	require.NoError(t, ts.DeleteTagPolicy(ctx, &store.DeleteTagPolicy{ID: updated.ID}))
+	tagPolicies, err = ts.ListTagPolicies(ctx, &store.FindTagPolicy{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tagPolicies))
+	require.Equal(t, "other", tagPolicies[0].Tag)
+}
+
+func TestListShortcutsByAccess(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, shortcut := range []*storepb.Shortcut{
+		{Name: "open", Tags: []string{"docs"}},
+		{Name: "team", Tags: []string{"team"}},
+		{Name: "secret", Tags: []string{"secret"}},
+		{Name: "both", Tags: []string{"team", "secret"}},
+		{Name: "public", Tags: []string{"secret"}, Visibility: storepb.Visibility_PUBLIC},
+	} {
+		shortcut.CreatorId = user.ID + 1
+		shortcut.Link = "https://example.com/" + shortcut.Name
+		shortcut.OgMetadata = &storepb.OpenGraphMetadata{}
+		if shortcut.Visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED {
+			shortcut.Visibility = storepb.Visibility_WORKSPACE
+		}
+		_, err := ts.CreateShortcut(ctx, shortcut)
+		require.NoError(t, err)
+	}
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "mine",
+		Link:       "https://example.com/mine",
+		Tags:       []string{"secret"},
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	list := func(access *store.ShortcutAccessFilter) []string {
+		shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+			Access:  access,
+			OrderBy: []*store.ShortcutOrderBy{{Field: store.ShortcutOrderFieldName}},
+		})
+		require.NoError(t, err)
+		return shortcutNames(shortcuts)
+	}
+
+	access := &store.ShortcutAccessFilter{
+		UserID:         user.ID,
+		RestrictedTags: []string{"secret", "team"},
+		GrantedTags:    []string{"team"},
+	}
+	require.Equal(t, []string{"both", "mine", "open", "public", "team"}, list(access))
+	access.RequireAllGranted = true
+	require.Equal(t, []string{"mine", "open", "public", "team"}, list(access))
+	access.GrantedTags = []string{"secret", "team"}
+	require.Equal(t, []string{"both", "mine", "open", "public", "secret", "team"}, list(access))
+	access.GrantedTags = []string{}
+	access.RequireAllGranted = false
+	require.Equal(t, []string{"mine", "open", "public"}, list(access))
+}
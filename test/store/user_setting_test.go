@@ -2,7 +2,10 @@ package teststore
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -104,3 +107,62 @@ func TestUserSettingStore(t *testing.T) {
 	require.Equal(t, "EN", userSettingGeneral.GetGeneral().Locale)
 	require.Equal(t, "DARK", userSettingGeneral.GetGeneral().ColorTheme)
 }
+
+func TestUpsertUserAccessTokens(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+
+	// Concurrent sign-ins don't lose any access token.
+	const workers, tokensPerWorker = 20, 50
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*tokensPerWorker)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for j := 0; j < tokensPerWorker; j++ {
+				errs <- ts.UpsertUserAccessTokens(ctx, user.ID, &storepb.UserSetting_AccessTokensSetting_AccessToken{
+					AccessToken: fmt.Sprintf("access_token_%d_%d", worker, j),
+					Description: "user login",
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	accessTokens, err := ts.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, workers*tokensPerWorker, len(accessTokens))
+
+	// Upserting an existing access token replaces it.
+	require.NoError(t, ts.UpsertUserAccessTokens(ctx, user.ID,
+		&storepb.UserSetting_AccessTokensSetting_AccessToken{AccessToken: "access_token_0_0", Description: "updated"},
+		&storepb.UserSetting_AccessTokensSetting_AccessToken{AccessToken: "access_token_new"},
+	))
+	accessTokens, err = ts.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, workers*tokensPerWorker+1, len(accessTokens))
+	for _, accessToken := range accessTokens {
+		if accessToken.AccessToken == "access_token_0_0" {
+			require.Equal(t, "updated", accessToken.Description)
+		}
+	}
+
+	// Looking up the access tokens from the database stays fast with many tokens.
+	const lookups = 50
+	start := time.Now()
+	for i := 0; i < lookups; i++ {
+		userSettings, err := ts.ListUserSettings(ctx, &store.FindUserSetting{
+			UserID: &user.ID,
+			Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(userSettings))
+	}
+	require.Less(t, time.Since(start)/lookups, 50*time.Millisecond)
+}
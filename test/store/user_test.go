@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -51,3 +52,57 @@ func createTestingAdminUser(ctx context.Context, ts *store.Store) (*store.User,
 	user, err := ts.CreateUser(ctx, userCreate)
 	return user, err
 }
+
+func TestCreateUsers(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	users, err := ts.CreateUsers(ctx, []*store.User{
+		{Role: store.RoleUser, Email: "alice@test.com", Nickname: "alice"},
+		{Role: store.RoleAdmin, Email: "bob@test.com", Nickname: "bob"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(users))
+	require.NotZero(t, users[0].ID)
+	require.Equal(t, store.RoleAdmin, users[1].Role)
+
+	// Nothing is created when one of the users fails.
+	_, err = ts.CreateUsers(ctx, []*store.User{
+		{Role: store.RoleUser, Email: "carol@test.com", Nickname: "carol"},
+		{Role: store.RoleUser, Email: "alice@test.com", Nickname: "alice"},
+	})
+	require.Error(t, err)
+	userList, err := ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(userList))
+}
+
+func TestArchiveUser(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+
+	// The archived users are excluded unless included, also from the cache.
+	archived, err := ts.ArchiveUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_ARCHIVED, archived.RowStatus)
+	users, err := ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(users))
+	found, err := ts.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Nil(t, found)
+	found, err = ts.GetUser(ctx, &store.FindUser{ID: &user.ID, IncludeArchived: true})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, found.ID)
+
+	// The email of the archived user stays taken.
+	_, err = ts.CreateUser(ctx, &store.User{Role: store.RoleUser, Email: user.Email, Nickname: "other"})
+	require.Error(t, err)
+
+	_, err = ts.UnarchiveUser(ctx, user.ID)
+	require.NoError(t, err)
+	found, err = ts.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_NORMAL, found.RowStatus)
+}
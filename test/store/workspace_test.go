@@ -0,0 +1,80 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestWorkspaceIsolation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	acmeCtx := store.WithWorkspaceID(ctx, "acme")
+
+	// The same email, shortcut and collection names are available in each workspace.
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	acmeUser, err := createTestingAdminUser(acmeCtx, ts)
+	require.NoError(t, err)
+	require.Equal(t, "acme", acmeUser.WorkspaceID)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{CreatorId: user.ID, Name: "docs", Link: "https://docs.example.com", Visibility: storepb.Visibility_WORKSPACE})
+	require.NoError(t, err)
+	acmeShortcut, err := ts.CreateShortcut(acmeCtx, &storepb.Shortcut{CreatorId: acmeUser.ID, Name: "docs", Link: "https://docs.acme.com", Visibility: storepb.Visibility_WORKSPACE})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{CreatorId: user.ID, Name: "team", ShortcutIds: []int32{shortcut.Id}, Visibility: storepb.Visibility_WORKSPACE})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(acmeCtx, &storepb.Collection{CreatorId: acmeUser.ID, Name: "team", ShortcutIds: []int32{acmeShortcut.Id}, Visibility: storepb.Visibility_WORKSPACE})
+	require.NoError(t, err)
+
+	users, err := ts.ListUsers(acmeCtx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, acmeUser.ID, users[0].ID)
+	// The users and the shortcuts of the other workspaces are not found, even by ID.
+	found, err := ts.GetUser(acmeCtx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Nil(t, found)
+	foundShortcut, err := ts.GetShortcut(acmeCtx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Nil(t, foundShortcut)
+	foundShortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &acmeShortcut.Id})
+	require.NoError(t, err)
+	require.Nil(t, foundShortcut)
+	name := "docs"
+	shortcuts, err := ts.ListShortcuts(acmeCtx, &store.FindShortcut{Name: &name})
+	require.NoError(t, err)
+	require.Len(t, shortcuts, 1)
+	require.Equal(t, "https://docs.acme.com", shortcuts[0].Link)
+	collections, err := ts.ListCollections(acmeCtx, &store.FindCollection{})
+	require.NoError(t, err)
+	require.Len(t, collections, 1)
+	require.Equal(t, []int32{acmeShortcut.Id}, collections[0].ShortcutIds)
+
+	// The shortcuts of the other workspaces can't be changed.
+	newLink := "https://evil.example.com"
+	_, err = ts.UpdateShortcut(acmeCtx, &store.UpdateShortcut{ID: shortcut.Id, Link: &newLink})
+	require.Error(t, err)
+	require.NoError(t, ts.DeleteShortcut(acmeCtx, &store.DeleteShortcut{ID: shortcut.Id}))
+	foundShortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, "https://docs.example.com", foundShortcut.Link)
+
+	// The workspace settings are separate.
+	_, err = ts.UpsertWorkspaceSetting(acmeCtx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL,
+		Value: &storepb.WorkspaceSetting_General{
+			General: &storepb.WorkspaceSetting_GeneralSetting{CustomStyle: "acme"},
+		},
+	})
+	require.NoError(t, err)
+	generalSetting, err := ts.GetWorkspaceGeneralSetting(ctx)
+	require.NoError(t, err)
+	require.Empty(t, generalSetting.CustomStyle)
+	acmeGeneralSetting, err := ts.GetWorkspaceGeneralSetting(acmeCtx)
+	require.NoError(t, err)
+	require.Equal(t, "acme", acmeGeneralSetting.CustomStyle)
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/joho/godotenv"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/yourselfhosted/slash/server/common"
 	"github.com/yourselfhosted/slash/server/profile"
@@ -46,6 +47,8 @@ func GetTestingProfile(t *testing.T) *profile.Profile {
 		DSN:     dsn,
 		Driver:  driver,
 		Version: common.GetCurrentVersion(mode),
+		// The cheapest hashes keep the tests fast.
+		PasswordHashCost: bcrypt.MinCost,
 	}
 }
 